@@ -1,15 +1,15 @@
 {{- define "validateReplicas" -}}
-  {{- if eq .Values.mode "Aggregate"}}
+  {{- if and (eq .Values.mode "Aggregate") (not .Values.agentSharding) }}
     {{- if gt (int .Values.replicas) 1 }}
-      {{- fail "Flow-aggregator can only have at most 1 replica in 'Aggregate' mode." }}
+      {{- fail "Flow-aggregator can only have at most 1 replica in 'Aggregate' mode, unless agentSharding is enabled." }}
     {{- end }}
   {{- end }}
 {{- end }}
 
 {{- define "validateAutoscaling" -}}
   {{- with .Values.autoscaling }}
-    {{- if and (ne $.Values.mode "Proxy") .enable }}
-      {{- fail "Autoscaling can only be used in 'Proxy' mode." }}
+    {{- if and (ne $.Values.mode "Proxy") (not $.Values.agentSharding) .enable }}
+      {{- fail "Autoscaling can only be used in 'Proxy' mode, unless agentSharding is enabled." }}
     {{- end }}
     {{- if gt .minReplicas .maxReplicas }}
       {{- fail "autoscaling.minReplicas must be less than or equal to autoscaling.maxReplicas." }}
@@ -487,6 +487,16 @@ func run(o *Options) error {
 		MaxBackups: int(*o.config.AuditLogging.MaxBackups),
 		MaxAge:     int(*o.config.AuditLogging.MaxAge),
 		Compress:   *o.config.AuditLogging.Compress,
+		Format:     o.config.AuditLogging.Format,
+	}
+	if o.config.AuditLogging.Syslog.Enable {
+		auditLoggerOptions.Syslog = &networkpolicy.SyslogOptions{
+			Address:   o.config.AuditLogging.Syslog.Address,
+			Transport: o.config.AuditLogging.Syslog.Transport,
+			Facility:  o.config.AuditLogging.Syslog.Facility,
+			CAFile:    o.config.AuditLogging.Syslog.CAFile,
+			Hostname:  nodeConfig.Name,
+		}
 	}
 
 	var gwPort, tunPort uint32
@@ -582,6 +592,7 @@ func run(o *Options) error {
 			features.DefaultFeatureGate.Enabled(features.EgressTrafficShaping),
 			features.DefaultFeatureGate.Enabled(features.EgressSeparateSubnet),
 			linkMonitor,
+			groupIDAllocator,
 		)
 		if err != nil {
 			return fmt.Errorf("error creating new Egress controller: %v", err)
@@ -714,9 +725,14 @@ func run(o *Options) error {
 			PollInterval:           o.pollInterval,
 			ConnectUplinkToBridge:  connectUplinkToBridge,
 			ProtocolFilter:         o.config.FlowExporter.ProtocolFilter,
+			ConnectionFilter:       o.config.FlowExporter.ConnectionFilter,
+			SamplingRate:           o.config.FlowExporter.SamplingRate,
+			EnableSharding:         o.config.FlowExporter.EnableSharding,
+			EnableTCPMetrics:       o.config.FlowExporter.EnableTCPMetrics,
 		}
 		flowExporter, err = flowexporter.NewFlowExporter(
 			podStore,
+			ifaceStore,
 			proxier,
 			k8sClient,
 			nodeRouteController,
@@ -792,8 +808,12 @@ func run(o *Options) error {
 			egressInformer,
 			bgpPolicyInformer,
 			endpointSliceInformer,
+			namespaceInformer,
+			localPodInformer.Get(),
 			o.enableEgress,
 			k8sClient,
+			routeClient,
+			serviceCIDRProvider,
 			nodeConfig,
 			networkConfig)
 		if err != nil {
@@ -29,6 +29,8 @@ import (
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy"
+	"antrea.io/antrea/pkg/agent/flowexporter/filter"
 	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/cni"
 	agentconfig "antrea.io/antrea/pkg/config/agent"
@@ -62,6 +64,7 @@ const (
 	defaultAuditLogsMaxBackups     = 3
 	defaultAuditLogsMaxAge         = 28
 	defaultAuditLogsCompressed     = true
+	defaultAuditLogsSyslogFacility = "local0"
 	defaultPacketInRate            = 5000
 )
 
@@ -163,6 +166,9 @@ func (o *Options) validate(args []string) error {
 	if err := validation.ValidatePort(o.config.APIPort); err != nil {
 		return fmt.Errorf("apiPort is invalid: %w", err)
 	}
+	if err := o.validateAuditLoggingConfig(); err != nil {
+		return err
+	}
 	if o.config.NodeType == config.ExternalNode.String() {
 		o.nodeType = config.ExternalNode
 		return o.validateExternalNodeOptions()
@@ -289,6 +295,11 @@ func (o *Options) validateFlowExporterConfig() error {
 		}
 		o.flowCollectorAddr = net.JoinHostPort(host, port)
 		o.flowCollectorProto = proto
+		if o.config.FlowExporter.EnableSharding {
+			if ns, _ := k8s.SplitNamespacedName(host); ns == "" {
+				return fmt.Errorf("flowExporter.enableSharding requires flowExporter.flowCollectorAddr to refer to a Service (<Service namespace>/<Service name>)")
+			}
+		}
 
 		// Parse the given flowPollInterval config
 		if o.config.FlowExporter.FlowPollInterval != "" {
@@ -329,6 +340,9 @@ func (o *Options) validateFlowExporterConfig() error {
 		} else {
 			o.staleConnectionTimeout = defaultStaleConnectionTimeout
 		}
+		if _, err := filter.NewConnectionFilter(o.config.FlowExporter.ConnectionFilter, o.config.FlowExporter.SamplingRate); err != nil {
+			return fmt.Errorf("invalid flowExporter.connectionFilter: %w", err)
+		}
 	} else if o.config.FlowExporter.Enable {
 		klog.InfoS("The FlowExporter.enable config option is set to true, but it will be ignored because the FlowExporter feature gate is disabled")
 	}
@@ -766,6 +780,41 @@ func (o *Options) setAuditLoggingDefaultOptions() {
 		compress := defaultAuditLogsCompressed
 		auditLogging.Compress = &compress
 	}
+	if auditLogging.Format == "" {
+		auditLogging.Format = networkpolicy.AuditLogFormatText
+	}
+	if auditLogging.Syslog.Transport == "" {
+		auditLogging.Syslog.Transport = networkpolicy.SyslogTransportTCP
+	}
+	if auditLogging.Syslog.Facility == "" {
+		auditLogging.Syslog.Facility = defaultAuditLogsSyslogFacility
+	}
+}
+
+func (o *Options) validateAuditLoggingConfig() error {
+	auditLogging := &o.config.AuditLogging
+	if auditLogging.Format != networkpolicy.AuditLogFormatText && auditLogging.Format != networkpolicy.AuditLogFormatJSON {
+		return fmt.Errorf("unsupported auditLogging.format %q, must be %s or %s", auditLogging.Format, networkpolicy.AuditLogFormatText, networkpolicy.AuditLogFormatJSON)
+	}
+	if !auditLogging.Syslog.Enable {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(auditLogging.Syslog.Address); err != nil {
+		return fmt.Errorf("invalid auditLogging.syslog.address %q: %w", auditLogging.Syslog.Address, err)
+	}
+	switch auditLogging.Syslog.Transport {
+	case networkpolicy.SyslogTransportUDP, networkpolicy.SyslogTransportTCP, networkpolicy.SyslogTransportTLS:
+	default:
+		return fmt.Errorf("unsupported auditLogging.syslog.transport %q, must be one of %s, %s and %s", auditLogging.Syslog.Transport,
+			networkpolicy.SyslogTransportUDP, networkpolicy.SyslogTransportTCP, networkpolicy.SyslogTransportTLS)
+	}
+	if _, err := networkpolicy.ParseSyslogFacility(auditLogging.Syslog.Facility); err != nil {
+		return fmt.Errorf("invalid auditLogging.syslog.facility: %w", err)
+	}
+	if auditLogging.Syslog.CAFile != "" && auditLogging.Syslog.Transport != networkpolicy.SyslogTransportTLS {
+		return fmt.Errorf("auditLogging.syslog.caFile can only be set when auditLogging.syslog.transport is %s", networkpolicy.SyslogTransportTLS)
+	}
+	return nil
 }
 
 func (o *Options) validateSecondaryNetworkConfig() error {
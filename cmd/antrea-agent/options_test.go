@@ -80,6 +80,86 @@ func TestOptionsValidateTLSOptions(t *testing.T) {
 	}
 }
 
+func TestOptionsValidateAuditLoggingConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		auditLogging agentconfig.AuditLoggingConfig
+		expectedErr  string
+	}{
+		{
+			name:         "default",
+			auditLogging: agentconfig.AuditLoggingConfig{},
+		},
+		{
+			name:         "JSON format",
+			auditLogging: agentconfig.AuditLoggingConfig{Format: "JSON"},
+		},
+		{
+			name:         "invalid format",
+			auditLogging: agentconfig.AuditLoggingConfig{Format: "XML"},
+			expectedErr:  `unsupported auditLogging.format "XML"`,
+		},
+		{
+			name: "syslog over TLS",
+			auditLogging: agentconfig.AuditLoggingConfig{
+				Syslog: agentconfig.AuditLoggingSyslogConfig{
+					Enable:    true,
+					Address:   "syslog.example.com:6514",
+					Transport: "TLS",
+					Facility:  "local4",
+					CAFile:    "/etc/antrea/syslog-ca.crt",
+				},
+			},
+		},
+		{
+			name: "invalid syslog address",
+			auditLogging: agentconfig.AuditLoggingConfig{
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Enable: true, Address: "syslog.example.com"},
+			},
+			expectedErr: `invalid auditLogging.syslog.address "syslog.example.com"`,
+		},
+		{
+			name: "invalid syslog transport",
+			auditLogging: agentconfig.AuditLoggingConfig{
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Enable: true, Address: "10.0.0.1:514", Transport: "HTTP"},
+			},
+			expectedErr: `unsupported auditLogging.syslog.transport "HTTP"`,
+		},
+		{
+			name: "invalid syslog facility",
+			auditLogging: agentconfig.AuditLoggingConfig{
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Enable: true, Address: "10.0.0.1:514", Facility: "user"},
+			},
+			expectedErr: "invalid auditLogging.syslog.facility",
+		},
+		{
+			name: "CA file without TLS",
+			auditLogging: agentconfig.AuditLoggingConfig{
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Enable: true, Address: "10.0.0.1:514", Transport: "UDP", CAFile: "/etc/antrea/syslog-ca.crt"},
+			},
+			expectedErr: "auditLogging.syslog.caFile can only be set when auditLogging.syslog.transport is TLS",
+		},
+		{
+			name: "invalid syslog config ignored when disabled",
+			auditLogging: agentconfig.AuditLoggingConfig{
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Address: "syslog.example.com", Transport: "HTTP"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{AuditLogging: tt.auditLogging}}
+			o.setAuditLoggingDefaultOptions()
+			err := o.validateAuditLoggingConfig()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
 func TestOptionsValidateAntreaProxyConfig(t *testing.T) {
 	tests := []struct {
 		name                            string
@@ -215,6 +295,71 @@ func TestOptionsValidateEgressConfig(t *testing.T) {
 	}
 }
 
+func TestOptionsValidateFlowExporterConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		flowExporterConfig agentconfig.FlowExporterConfig
+		expectedErr        string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name: "valid connectionFilter",
+			flowExporterConfig: agentconfig.FlowExporterConfig{
+				ConnectionFilter: agentconfig.FlowExporterConnectionFilterConfig{
+					Include: []agentconfig.FlowExporterConnectionFilterRule{{Namespaces: []string{"ns1"}, PodSelector: "app=web"}},
+					Exclude: []agentconfig.FlowExporterConnectionFilterRule{{CIDRs: []string{"10.96.0.0/12"}, Ports: []int32{53}}},
+				},
+				SamplingRate: 10,
+			},
+		},
+		{
+			name: "invalid connectionFilter",
+			flowExporterConfig: agentconfig.FlowExporterConfig{
+				ConnectionFilter: agentconfig.FlowExporterConnectionFilterConfig{
+					Exclude: []agentconfig.FlowExporterConnectionFilterRule{{PolicyActions: []string{"Deny"}}},
+				},
+			},
+			expectedErr: `invalid flowExporter.connectionFilter: invalid exclude rule: unsupported policyAction "Deny"`,
+		},
+		{
+			name: "sharding with Service reference",
+			flowExporterConfig: agentconfig.FlowExporterConfig{
+				FlowCollectorAddr: "flow-aggregator/flow-aggregator:14739:grpc",
+				EnableSharding:    true,
+			},
+		},
+		{
+			name: "sharding with IP address",
+			flowExporterConfig: agentconfig.FlowExporterConfig{
+				FlowCollectorAddr: "10.96.0.100:14739:grpc",
+				EnableSharding:    true,
+			},
+			expectedErr: "flowExporter.enableSharding requires flowExporter.flowCollectorAddr to refer to a Service",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.FlowExporter, true)
+
+			tt.flowExporterConfig.Enable = true
+			if tt.flowExporterConfig.FlowCollectorAddr == "" {
+				tt.flowExporterConfig.FlowCollectorAddr = defaultFlowCollectorAddress
+			}
+			o := &Options{config: &agentconfig.AgentConfig{
+				FlowExporter: tt.flowExporterConfig,
+			}}
+			err := o.validateFlowExporterConfig()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
 func TestOptionsValidateMulticastConfig(t *testing.T) {
 	tests := []struct {
 		name              string
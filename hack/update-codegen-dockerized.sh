@@ -89,7 +89,7 @@ MOCKGEN_TARGETS=(
   "pkg/agent/wireguard Interface testing mock_wireguard.go"
   "pkg/agent/util/winnet Interface testing mock_net_windows.go"
   "pkg/antctl AntctlClient ."
-  "pkg/controller/networkpolicy EndpointQuerier,PolicyRuleQuerier testing"
+  "pkg/controller/networkpolicy EndpointQuerier,PolicyAnalyzer,PolicyRuleQuerier testing"
   "pkg/controller/querier ControllerQuerier testing"
   "pkg/flowaggregator/collector Interface testing"
   "pkg/flowaggregator/exporter Interface testing"
@@ -144,6 +144,7 @@ function generate_antrea_client_code {
     --plural-exceptions "ClusterGroupMembers:ClusterGroupMembers" \
     --plural-exceptions "GroupMembers:GroupMembers" \
     --plural-exceptions "NodeLatencyStats:NodeLatencyStats" \
+    --plural-exceptions "NetworkPolicyAnalysis:NetworkPolicyAnalyses" \
     --go-header-file hack/boilerplate/license_header.go.txt
 
   # Generate listers with K8s codegen tools.
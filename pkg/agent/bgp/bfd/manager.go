@@ -0,0 +1,325 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"strconv"
+	"sync"
+	"syscall"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	utilnet "k8s.io/utils/net"
+)
+
+const (
+	// The UDP destination ports of single hop and multihop BFD control packets, as defined in RFC 5881 and RFC 5883.
+	singleHopPort = 3784
+	multihopPort  = 4784
+	// The range of the UDP source ports of BFD control packets, as required by RFC 5881 section 4.
+	sourcePortMin = 49152
+	sourcePortMax = 65535
+	// The maximum number of attempts to find an unused source port.
+	maxSourcePortAttempts = 16
+	// Single hop BFD control packets must be sent and received with a TTL or Hop Limit of 255, as required by
+	// RFC 5881 section 5.
+	singleHopTTL = 255
+	// The maximum size of a received packet.
+	maxPacketSize = 1500
+)
+
+// StateChangeHandler is called when the state of the BFD session with a peer changes.
+type StateChangeHandler func(peerAddress string, oldState, newState State)
+
+type listenerKey struct {
+	isIPv6   bool
+	multihop bool
+}
+
+// Manager manages the BFD sessions with the BGP peers. The sockets receiving control packets are opened when the
+// first session of a given IP family and hop type is added, and are closed when the Manager is stopped.
+type Manager struct {
+	mutex                   sync.RWMutex
+	sessions                map[string]*session
+	sessionsByDiscriminator map[uint32]*session
+	listeners               map[listenerKey]io.Closer
+	onStateChange           StateChangeHandler
+	clock                   clock.Clock
+}
+
+func NewManager(onStateChange StateChangeHandler) *Manager {
+	return &Manager{
+		sessions:                map[string]*session{},
+		sessionsByDiscriminator: map[uint32]*session{},
+		listeners:               map[listenerKey]io.Closer{},
+		onStateChange:           onStateChange,
+		clock:                   clock.RealClock{},
+	}
+}
+
+// AddOrUpdateSession creates a BFD session with the peer, or updates the parameters of the existing session.
+func (m *Manager) AddOrUpdateSession(peerAddress string, config SessionConfig) error {
+	peerIP := net.ParseIP(peerAddress)
+	if peerIP == nil {
+		return fmt.Errorf("invalid peer address: %s", peerAddress)
+	}
+	key := peerIP.String()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if s, exists := m.sessions[key]; exists {
+		if s.multihop == config.Multihop {
+			s.updateConfig(config)
+			return nil
+		}
+		// The session must be recreated as it runs on a different port.
+		m.removeSessionLocked(s)
+	}
+
+	isIPv6 := utilnet.IsIPv6(peerIP)
+	lKey := listenerKey{isIPv6: isIPv6, multihop: config.Multihop}
+	if _, exists := m.listeners[lKey]; !exists {
+		listener, err := m.listen(isIPv6, config.Multihop)
+		if err != nil {
+			return fmt.Errorf("failed to listen for BFD control packets: %w", err)
+		}
+		m.listeners[lKey] = listener
+	}
+	conn, err := dial(peerIP, config.Multihop)
+	if err != nil {
+		return fmt.Errorf("failed to create socket for BFD session with peer %s: %w", key, err)
+	}
+	send := func(b []byte) error {
+		_, err := conn.Write(b)
+		return err
+	}
+	onStateChange := func(oldState, newState State) {
+		if m.onStateChange != nil {
+			m.onStateChange(key, oldState, newState)
+		}
+	}
+	s := newSession(key, m.allocateDiscriminatorLocked(), config, m.clock, send, onStateChange)
+	s.conn = conn
+	m.sessions[key] = s
+	m.sessionsByDiscriminator[s.localDiscriminator] = s
+	go s.run()
+	klog.InfoS("Added BFD session", "peer", key, "multihop", config.Multihop)
+	return nil
+}
+
+// RemoveSession deletes the BFD session with the peer if it exists.
+func (m *Manager) RemoveSession(peerAddress string) {
+	peerIP := net.ParseIP(peerAddress)
+	if peerIP == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if s, exists := m.sessions[peerIP.String()]; exists {
+		m.removeSessionLocked(s)
+	}
+}
+
+func (m *Manager) removeSessionLocked(s *session) {
+	s.stop()
+	delete(m.sessions, s.peerAddress)
+	delete(m.sessionsByDiscriminator, s.localDiscriminator)
+	klog.InfoS("Removed BFD session", "peer", s.peerAddress)
+}
+
+// GetSessionState returns the state of the BFD session with the peer, and false if there is no such session.
+func (m *Manager) GetSessionState(peerAddress string) (State, bool) {
+	peerIP := net.ParseIP(peerAddress)
+	if peerIP == nil {
+		return StateAdminDown, false
+	}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	s, exists := m.sessions[peerIP.String()]
+	if !exists {
+		return StateAdminDown, false
+	}
+	return s.getState(), true
+}
+
+// Stop deletes all BFD sessions and closes the sockets receiving control packets.
+func (m *Manager) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, s := range m.sessions {
+		m.removeSessionLocked(s)
+	}
+	for key, listener := range m.listeners {
+		listener.Close()
+		delete(m.listeners, key)
+	}
+}
+
+func (m *Manager) allocateDiscriminatorLocked() uint32 {
+	for {
+		discriminator := rand.Uint32()
+		if _, exists := m.sessionsByDiscriminator[discriminator]; discriminator != 0 && !exists {
+			return discriminator
+		}
+	}
+}
+
+// lookupSession finds the session a received control packet belongs to, by the discriminator if the peer has learned
+// it, or by the source address otherwise.
+func (m *Manager) lookupSession(p *ControlPacket, srcIP net.IP, multihop bool) *session {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	var s *session
+	if p.YourDiscriminator != 0 {
+		s = m.sessionsByDiscriminator[p.YourDiscriminator]
+		// Only accept packets from the configured peer address.
+		if s != nil && s.peerAddress != srcIP.String() {
+			s = nil
+		}
+	} else {
+		s = m.sessions[srcIP.String()]
+	}
+	if s == nil || s.multihop != multihop {
+		return nil
+	}
+	return s
+}
+
+type readFunc func(b []byte) (n int, ttl int, srcIP net.IP, err error)
+
+func (m *Manager) listen(isIPv6, multihop bool) (io.Closer, error) {
+	port := singleHopPort
+	if multihop {
+		port = multihopPort
+	}
+	network, address := "udp4", net.JoinHostPort(net.IPv4zero.String(), strconv.Itoa(port))
+	if isIPv6 {
+		network, address = "udp6", net.JoinHostPort(net.IPv6zero.String(), strconv.Itoa(port))
+	}
+	conn, err := net.ListenPacket(network, address)
+	if err != nil {
+		return nil, err
+	}
+	var read readFunc
+	if isIPv6 {
+		pc := ipv6.NewPacketConn(conn)
+		if err := pc.SetControlMessage(ipv6.FlagHopLimit, true); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		read = func(b []byte) (int, int, net.IP, error) {
+			n, cm, src, err := pc.ReadFrom(b)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			ttl := -1
+			if cm != nil {
+				ttl = cm.HopLimit
+			}
+			return n, ttl, src.(*net.UDPAddr).IP, nil
+		}
+	} else {
+		pc := ipv4.NewPacketConn(conn)
+		if err := pc.SetControlMessage(ipv4.FlagTTL, true); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		read = func(b []byte) (int, int, net.IP, error) {
+			n, cm, src, err := pc.ReadFrom(b)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			ttl := -1
+			if cm != nil {
+				ttl = cm.TTL
+			}
+			return n, ttl, src.(*net.UDPAddr).IP, nil
+		}
+	}
+	go m.receive(read, multihop)
+	return conn, nil
+}
+
+func (m *Manager) receive(read readFunc, multihop bool) {
+	b := make([]byte, maxPacketSize)
+	for {
+		n, ttl, srcIP, err := read(b)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			klog.ErrorS(err, "Failed to read BFD control packet")
+			continue
+		}
+		if !multihop && ttl != singleHopTTL {
+			klog.V(4).InfoS("Discarded single hop BFD control packet with invalid TTL", "peer", srcIP, "ttl", ttl)
+			continue
+		}
+		p, err := UnmarshalControlPacket(b[:n])
+		if err != nil {
+			klog.V(4).InfoS("Discarded invalid BFD control packet", "peer", srcIP, "err", err)
+			continue
+		}
+		s := m.lookupSession(p, srcIP, multihop)
+		if s == nil {
+			klog.V(4).InfoS("Discarded BFD control packet not belonging to any session", "peer", srcIP)
+			continue
+		}
+		s.handlePacket(p)
+	}
+}
+
+// dial creates the socket sending control packets to the peer, bound to an unused source port in the range required
+// by RFC 5881.
+func dial(peerIP net.IP, multihop bool) (*net.UDPConn, error) {
+	port := singleHopPort
+	if multihop {
+		port = multihopPort
+	}
+	isIPv6 := utilnet.IsIPv6(peerIP)
+	network := "udp4"
+	if isIPv6 {
+		network = "udp6"
+	}
+	remoteAddr := &net.UDPAddr{IP: peerIP, Port: port}
+	for range maxSourcePortAttempts {
+		localAddr := &net.UDPAddr{Port: sourcePortMin + rand.IntN(sourcePortMax-sourcePortMin+1)}
+		conn, err := net.DialUDP(network, localAddr, remoteAddr)
+		if err != nil {
+			if errors.Is(err, syscall.EADDRINUSE) {
+				continue
+			}
+			return nil, err
+		}
+		if isIPv6 {
+			err = ipv6.NewConn(conn).SetHopLimit(singleHopTTL)
+		} else {
+			err = ipv4.NewConn(conn).SetTTL(singleHopTTL)
+		}
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return conn, nil
+	}
+	return nil, fmt.Errorf("no available source port")
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// State is the state of a BFD session. For more details see https://datatracker.ietf.org/doc/html/rfc5880#section-4.1.
+type State uint8
+
+const (
+	StateAdminDown State = iota
+	StateDown
+	StateInit
+	StateUp
+)
+
+func (s State) String() string {
+	switch s {
+	case StateAdminDown:
+		return "AdminDown"
+	case StateDown:
+		return "Down"
+	case StateInit:
+		return "Init"
+	case StateUp:
+		return "Up"
+	default:
+		return "Unknown"
+	}
+}
+
+// Diagnostic specifies the reason for the last change of the local session state.
+type Diagnostic uint8
+
+const (
+	DiagnosticNone                        Diagnostic = 0
+	DiagnosticControlDetectionTimeExpired Diagnostic = 1
+	DiagnosticNeighborSignaledSessionDown Diagnostic = 3
+	DiagnosticAdministrativelyDown        Diagnostic = 7
+)
+
+const (
+	version = 1
+	// The length of a BFD control packet without the authentication section.
+	controlPacketLength = 24
+
+	flagPoll        = 0x20
+	flagFinal       = 0x10
+	flagAuthPresent = 0x04
+	flagDemand      = 0x02
+	flagMultipoint  = 0x01
+	diagnosticMask  = 0x1f
+	stateShift      = 6
+	versionShift    = 5
+)
+
+// ControlPacket is a BFD control packet without the authentication section.
+// For more details see https://datatracker.ietf.org/doc/html/rfc5880#section-4.1.
+type ControlPacket struct {
+	Diagnostic                Diagnostic
+	State                     State
+	Poll                      bool
+	Final                     bool
+	DetectMultiplier          uint8
+	MyDiscriminator           uint32
+	YourDiscriminator         uint32
+	DesiredMinTxInterval      time.Duration
+	RequiredMinRxInterval     time.Duration
+	RequiredMinEchoRxInterval time.Duration
+}
+
+func durationToMicroseconds(d time.Duration) uint32 {
+	return uint32(d / time.Microsecond)
+}
+
+func microsecondsToDuration(us uint32) time.Duration {
+	return time.Duration(us) * time.Microsecond
+}
+
+// Marshal encodes the control packet into its wire format.
+func (p *ControlPacket) Marshal() []byte {
+	b := make([]byte, controlPacketLength)
+	b[0] = version<<versionShift | uint8(p.Diagnostic)&diagnosticMask
+	b[1] = uint8(p.State) << stateShift
+	if p.Poll {
+		b[1] |= flagPoll
+	}
+	if p.Final {
+		b[1] |= flagFinal
+	}
+	b[2] = p.DetectMultiplier
+	b[3] = controlPacketLength
+	binary.BigEndian.PutUint32(b[4:8], p.MyDiscriminator)
+	binary.BigEndian.PutUint32(b[8:12], p.YourDiscriminator)
+	binary.BigEndian.PutUint32(b[12:16], durationToMicroseconds(p.DesiredMinTxInterval))
+	binary.BigEndian.PutUint32(b[16:20], durationToMicroseconds(p.RequiredMinRxInterval))
+	binary.BigEndian.PutUint32(b[20:24], durationToMicroseconds(p.RequiredMinEchoRxInterval))
+	return b
+}
+
+// UnmarshalControlPacket decodes and validates a control packet received from the wire according to
+// https://datatracker.ietf.org/doc/html/rfc5880#section-6.8.6. Packets with authentication, demand mode or the
+// multipoint bit are rejected as they are not supported.
+func UnmarshalControlPacket(b []byte) (*ControlPacket, error) {
+	if len(b) < controlPacketLength {
+		return nil, fmt.Errorf("packet too short: %d bytes", len(b))
+	}
+	if v := b[0] >> versionShift; v != version {
+		return nil, fmt.Errorf("unsupported version: %d", v)
+	}
+	if length := int(b[3]); length < controlPacketLength || length > len(b) {
+		return nil, fmt.Errorf("invalid length: %d", length)
+	}
+	flags := b[1]
+	if flags&flagAuthPresent != 0 {
+		return nil, fmt.Errorf("authentication is not supported")
+	}
+	if flags&flagDemand != 0 {
+		return nil, fmt.Errorf("demand mode is not supported")
+	}
+	if flags&flagMultipoint != 0 {
+		return nil, fmt.Errorf("multipoint bit must not be set")
+	}
+	if flags&flagPoll != 0 && flags&flagFinal != 0 {
+		return nil, fmt.Errorf("poll and final bits must not be both set")
+	}
+	p := &ControlPacket{
+		Diagnostic:                Diagnostic(b[0] & diagnosticMask),
+		State:                     State(flags >> stateShift),
+		Poll:                      flags&flagPoll != 0,
+		Final:                     flags&flagFinal != 0,
+		DetectMultiplier:          b[2],
+		MyDiscriminator:           binary.BigEndian.Uint32(b[4:8]),
+		YourDiscriminator:         binary.BigEndian.Uint32(b[8:12]),
+		DesiredMinTxInterval:      microsecondsToDuration(binary.BigEndian.Uint32(b[12:16])),
+		RequiredMinRxInterval:     microsecondsToDuration(binary.BigEndian.Uint32(b[16:20])),
+		RequiredMinEchoRxInterval: microsecondsToDuration(binary.BigEndian.Uint32(b[20:24])),
+	}
+	if p.DetectMultiplier == 0 {
+		return nil, fmt.Errorf("detect multiplier must not be zero")
+	}
+	if p.MyDiscriminator == 0 {
+		return nil, fmt.Errorf("my discriminator must not be zero")
+	}
+	if p.YourDiscriminator == 0 && p.State != StateDown && p.State != StateAdminDown {
+		return nil, fmt.Errorf("your discriminator must not be zero in state %s", p.State)
+	}
+	return p, nil
+}
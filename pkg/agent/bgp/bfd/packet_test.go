@@ -0,0 +1,251 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestControlPacketMarshalUnmarshal(t *testing.T) {
+	p := &ControlPacket{
+		Diagnostic:            DiagnosticControlDetectionTimeExpired,
+		State:                 StateUp,
+		Poll:                  true,
+		DetectMultiplier:      3,
+		MyDiscriminator:       0x11223344,
+		YourDiscriminator:     0x55667788,
+		DesiredMinTxInterval:  300 * time.Millisecond,
+		RequiredMinRxInterval: 200 * time.Millisecond,
+	}
+	b := p.Marshal()
+	assert.Equal(t, []byte{
+		0x21, 0xe0, 0x03, 0x18,
+		0x11, 0x22, 0x33, 0x44,
+		0x55, 0x66, 0x77, 0x88,
+		0x00, 0x04, 0x93, 0xe0,
+		0x00, 0x03, 0x0d, 0x40,
+		0x00, 0x00, 0x00, 0x00,
+	}, b)
+	got, err := UnmarshalControlPacket(b)
+	require.NoError(t, err)
+	assert.Equal(t, p, got)
+}
+
+func TestUnmarshalInvalidControlPacket(t *testing.T) {
+	valid := &ControlPacket{
+		State:             StateUp,
+		DetectMultiplier:  3,
+		MyDiscriminator:   1,
+		YourDiscriminator: 2,
+	}
+	tests := []struct {
+		name        string
+		mutate      func(b []byte) []byte
+		expectedErr string
+	}{
+		{
+			name:        "too short",
+			mutate:      func(b []byte) []byte { return b[:20] },
+			expectedErr: "packet too short: 20 bytes",
+		},
+		{
+			name:        "invalid version",
+			mutate:      func(b []byte) []byte { b[0] = 0; return b },
+			expectedErr: "unsupported version: 0",
+		},
+		{
+			name:        "length larger than payload",
+			mutate:      func(b []byte) []byte { b[3] = 48; return b },
+			expectedErr: "invalid length: 48",
+		},
+		{
+			name:        "authentication present",
+			mutate:      func(b []byte) []byte { b[1] |= flagAuthPresent; return b },
+			expectedErr: "authentication is not supported",
+		},
+		{
+			name:        "multipoint",
+			mutate:      func(b []byte) []byte { b[1] |= flagMultipoint; return b },
+			expectedErr: "multipoint bit must not be set",
+		},
+		{
+			name:        "zero detect multiplier",
+			mutate:      func(b []byte) []byte { b[2] = 0; return b },
+			expectedErr: "detect multiplier must not be zero",
+		},
+		{
+			name:        "zero my discriminator",
+			mutate:      func(b []byte) []byte { clear(b[4:8]); return b },
+			expectedErr: "my discriminator must not be zero",
+		},
+		{
+			name:        "zero your discriminator in Up state",
+			mutate:      func(b []byte) []byte { clear(b[8:12]); return b },
+			expectedErr: "your discriminator must not be zero in state Up",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalControlPacket(tt.mutate(valid.Marshal()))
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+// TestUnmarshalCapturedControlPacket decodes the UDP payload of a control packet captured from another BFD
+// implementation, which is also used by the tests of the gopacket BFD layer.
+func TestUnmarshalCapturedControlPacket(t *testing.T) {
+	b := []byte{
+		0x20, 0x40, 0x05, 0x18,
+		0x00, 0x00, 0x00, 0x01,
+		0x00, 0x00, 0x00, 0x00,
+		0x00, 0x0f, 0x42, 0x40,
+		0x00, 0x0f, 0x42, 0x40,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	p, err := UnmarshalControlPacket(b)
+	require.NoError(t, err)
+	assert.Equal(t, &ControlPacket{
+		Diagnostic:            DiagnosticNone,
+		State:                 StateDown,
+		DetectMultiplier:      5,
+		MyDiscriminator:       1,
+		DesiredMinTxInterval:  time.Second,
+		RequiredMinRxInterval: time.Second,
+	}, p)
+	assert.Equal(t, b, p.Marshal())
+}
+
+// TestControlPacketInteroperability checks that the control packets are encoded and decoded in the same way as the
+// gopacket BFD layer, which is an independent implementation of RFC 5880.
+func TestControlPacketInteroperability(t *testing.T) {
+	tests := []struct {
+		name   string
+		packet *ControlPacket
+	}{
+		{
+			name: "Down",
+			packet: &ControlPacket{
+				State:                 StateDown,
+				DetectMultiplier:      3,
+				MyDiscriminator:       1,
+				DesiredMinTxInterval:  time.Second,
+				RequiredMinRxInterval: 300 * time.Millisecond,
+			},
+		},
+		{
+			name: "Init",
+			packet: &ControlPacket{
+				State:                 StateInit,
+				DetectMultiplier:      3,
+				MyDiscriminator:       0xffffffff,
+				YourDiscriminator:     1,
+				DesiredMinTxInterval:  time.Second,
+				RequiredMinRxInterval: 300 * time.Millisecond,
+			},
+		},
+		{
+			name: "Up with Poll",
+			packet: &ControlPacket{
+				State:                     StateUp,
+				Poll:                      true,
+				DetectMultiplier:          255,
+				MyDiscriminator:           2,
+				YourDiscriminator:         0xffffffff,
+				DesiredMinTxInterval:      50 * time.Millisecond,
+				RequiredMinRxInterval:     4294967295 * time.Microsecond,
+				RequiredMinEchoRxInterval: 100 * time.Millisecond,
+			},
+		},
+		{
+			name: "Up with Final",
+			packet: &ControlPacket{
+				State:                 StateUp,
+				Final:                 true,
+				DetectMultiplier:      3,
+				MyDiscriminator:       2,
+				YourDiscriminator:     1,
+				DesiredMinTxInterval:  300 * time.Millisecond,
+				RequiredMinRxInterval: 300 * time.Millisecond,
+			},
+		},
+		{
+			name: "AdminDown",
+			packet: &ControlPacket{
+				Diagnostic:        DiagnosticAdministrativelyDown,
+				State:             StateAdminDown,
+				DetectMultiplier:  3,
+				MyDiscriminator:   1,
+				YourDiscriminator: 2,
+			},
+		},
+		{
+			name: "Down after neighbor signaled session down",
+			packet: &ControlPacket{
+				Diagnostic:           DiagnosticNeighborSignaledSessionDown,
+				State:                StateDown,
+				DetectMultiplier:     3,
+				MyDiscriminator:      1,
+				DesiredMinTxInterval: time.Second,
+			},
+		},
+		{
+			name: "Down after detection time expired",
+			packet: &ControlPacket{
+				Diagnostic:           DiagnosticControlDetectionTimeExpired,
+				State:                StateDown,
+				DetectMultiplier:     3,
+				MyDiscriminator:      1,
+				DesiredMinTxInterval: time.Second,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.packet
+			// Decode the marshaled packet with gopacket.
+			decoded := &layers.BFD{}
+			require.NoError(t, decoded.DecodeFromBytes(p.Marshal(), gopacket.NilDecodeFeedback))
+			assert.Equal(t, layers.BFDVersion(version), decoded.Version)
+			assert.Equal(t, layers.BFDDiagnostic(p.Diagnostic), decoded.Diagnostic)
+			assert.Equal(t, layers.BFDState(p.State), decoded.State)
+			assert.Equal(t, p.Poll, decoded.Poll)
+			assert.Equal(t, p.Final, decoded.Final)
+			assert.False(t, decoded.ControlPlaneIndependent)
+			assert.False(t, decoded.AuthPresent)
+			assert.False(t, decoded.Demand)
+			assert.False(t, decoded.Multipoint)
+			assert.Equal(t, layers.BFDDetectMultiplier(p.DetectMultiplier), decoded.DetectMultiplier)
+			assert.Equal(t, layers.BFDDiscriminator(p.MyDiscriminator), decoded.MyDiscriminator)
+			assert.Equal(t, layers.BFDDiscriminator(p.YourDiscriminator), decoded.YourDiscriminator)
+			assert.Equal(t, layers.BFDTimeInterval(p.DesiredMinTxInterval/time.Microsecond), decoded.DesiredMinTxInterval)
+			assert.Equal(t, layers.BFDTimeInterval(p.RequiredMinRxInterval/time.Microsecond), decoded.RequiredMinRxInterval)
+			assert.Equal(t, layers.BFDTimeInterval(p.RequiredMinEchoRxInterval/time.Microsecond), decoded.RequiredMinEchoRxInterval)
+
+			// Decode the packet serialized by gopacket.
+			buf := gopacket.NewSerializeBuffer()
+			require.NoError(t, decoded.SerializeTo(buf, gopacket.SerializeOptions{FixLengths: true}))
+			got, err := UnmarshalControlPacket(buf.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, p, got)
+		})
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// The minimum transmit interval used when the session is not Up, as required by
+	// https://datatracker.ietf.org/doc/html/rfc5880#section-6.8.3.
+	slowTxInterval = time.Second
+	// The interval at which the detection time is checked when the session is not Init or Up.
+	idleDetectionCheckInterval = time.Second
+)
+
+// SessionConfig contains the local parameters of a BFD session.
+type SessionConfig struct {
+	DetectMultiplier      uint8
+	DesiredMinTxInterval  time.Duration
+	RequiredMinRxInterval time.Duration
+	// Multihop indicates whether the peer is multiple hops away, in which case the session runs on the multihop port
+	// as defined in RFC 5883, and the TTL of received packets is not checked.
+	Multihop bool
+}
+
+// session implements the asynchronous mode of a BFD session as defined in RFC 5880. Demand mode, echo function and
+// authentication are not supported.
+type session struct {
+	mutex       sync.Mutex
+	peerAddress string
+	multihop    bool
+	config      SessionConfig
+	clock       clock.Clock
+
+	state                      State
+	diagnostic                 Diagnostic
+	localDiscriminator         uint32
+	remoteDiscriminator        uint32
+	remoteState                State
+	remoteMinRxInterval        time.Duration
+	remoteDesiredMinTxInterval time.Duration
+	remoteDetectMultiplier     uint8
+	lastReceived               time.Time
+	// pollActive indicates whether a Poll Sequence is in progress.
+	pollActive bool
+
+	send func(b []byte) error
+	// conn is the socket sending control packets, closed when the session is stopped.
+	conn          io.Closer
+	onStateChange func(oldState, newState State)
+	// kickCh is used to notify the session loop to transmit a packet and re-evaluate the timers immediately.
+	kickCh chan struct{}
+	stopCh chan struct{}
+}
+
+func newSession(peerAddress string, localDiscriminator uint32, config SessionConfig, clock clock.Clock, send func(b []byte) error, onStateChange func(oldState, newState State)) *session {
+	return &session{
+		peerAddress:        peerAddress,
+		multihop:           config.Multihop,
+		config:             config,
+		clock:              clock,
+		state:              StateDown,
+		localDiscriminator: localDiscriminator,
+		remoteState:        StateDown,
+		// The initial value must be 1 microsecond according to RFC 5880 section 6.8.1.
+		remoteMinRxInterval: time.Microsecond,
+		send:                send,
+		onStateChange:       onStateChange,
+		kickCh:              make(chan struct{}, 1),
+		stopCh:              make(chan struct{}),
+	}
+}
+
+func (s *session) getState() State {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.state
+}
+
+// desiredMinTxIntervalLocked returns the transmit interval the local system desires to use. It must not be less than
+// one second when the session is not Up.
+func (s *session) desiredMinTxIntervalLocked() time.Duration {
+	if s.state != StateUp && s.config.DesiredMinTxInterval < slowTxInterval {
+		return slowTxInterval
+	}
+	return s.config.DesiredMinTxInterval
+}
+
+// detectionTimeLocked calculates the detection time in asynchronous mode according to RFC 5880 section 6.8.4.
+func (s *session) detectionTimeLocked() time.Duration {
+	return time.Duration(s.remoteDetectMultiplier) * max(s.config.RequiredMinRxInterval, s.remoteDesiredMinTxInterval)
+}
+
+func (s *session) setStateLocked(state State, diagnostic Diagnostic) {
+	s.state = state
+	s.diagnostic = diagnostic
+	switch state {
+	case StateUp:
+		// The transmit interval changes once the session is Up, which requires a Poll Sequence.
+		s.pollActive = true
+	case StateDown:
+		s.pollActive = false
+	}
+}
+
+func (s *session) buildPacketLocked(final bool) *ControlPacket {
+	return &ControlPacket{
+		Diagnostic:            s.diagnostic,
+		State:                 s.state,
+		Poll:                  s.pollActive && !final,
+		Final:                 final,
+		DetectMultiplier:      s.config.DetectMultiplier,
+		MyDiscriminator:       s.localDiscriminator,
+		YourDiscriminator:     s.remoteDiscriminator,
+		DesiredMinTxInterval:  s.desiredMinTxIntervalLocked(),
+		RequiredMinRxInterval: s.config.RequiredMinRxInterval,
+	}
+}
+
+// handlePacket processes a validated control packet received from the peer according to RFC 5880 section 6.8.6.
+func (s *session) handlePacket(p *ControlPacket) {
+	s.mutex.Lock()
+	oldState := s.state
+	s.remoteDiscriminator = p.MyDiscriminator
+	s.remoteState = p.State
+	s.remoteMinRxInterval = p.RequiredMinRxInterval
+	s.remoteDesiredMinTxInterval = p.DesiredMinTxInterval
+	s.remoteDetectMultiplier = p.DetectMultiplier
+	s.lastReceived = s.clock.Now()
+	if p.Final {
+		s.pollActive = false
+	}
+	if s.state != StateAdminDown {
+		if p.State == StateAdminDown {
+			if s.state != StateDown {
+				s.setStateLocked(StateDown, DiagnosticNeighborSignaledSessionDown)
+			}
+		} else {
+			switch s.state {
+			case StateDown:
+				if p.State == StateDown {
+					s.setStateLocked(StateInit, DiagnosticNone)
+				} else if p.State == StateInit {
+					s.setStateLocked(StateUp, DiagnosticNone)
+				}
+			case StateInit:
+				if p.State == StateInit || p.State == StateUp {
+					s.setStateLocked(StateUp, DiagnosticNone)
+				}
+			case StateUp:
+				if p.State == StateDown {
+					s.setStateLocked(StateDown, DiagnosticNeighborSignaledSessionDown)
+				}
+			}
+		}
+	}
+	var reply []byte
+	if p.Poll {
+		reply = s.buildPacketLocked(true).Marshal()
+	}
+	newState := s.state
+	s.mutex.Unlock()
+
+	if reply != nil {
+		s.transmit(reply)
+	}
+	if newState != oldState {
+		s.stateChanged(oldState, newState)
+	}
+}
+
+// checkDetectionTimeout brings the session down if no packet has been received from the peer within the detection
+// time. It returns the duration after which the detection time should be checked again.
+func (s *session) checkDetectionTimeout() time.Duration {
+	s.mutex.Lock()
+	if s.state != StateInit && s.state != StateUp {
+		s.mutex.Unlock()
+		return idleDetectionCheckInterval
+	}
+	detectionTime := s.detectionTimeLocked()
+	elapsed := s.clock.Since(s.lastReceived)
+	if elapsed < detectionTime {
+		s.mutex.Unlock()
+		return detectionTime - elapsed
+	}
+	oldState := s.state
+	s.setStateLocked(StateDown, DiagnosticControlDetectionTimeExpired)
+	// The remote discriminator must be reset when the detection time expires, according to RFC 5880 section 6.8.1.
+	s.remoteDiscriminator = 0
+	s.mutex.Unlock()
+
+	s.stateChanged(oldState, StateDown)
+	return idleDetectionCheckInterval
+}
+
+// nextTransmitInterval returns the interval before the next periodic transmission, with the jitter required by
+// RFC 5880 section 6.8.7 applied.
+func (s *session) nextTransmitInterval() time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	interval := max(s.desiredMinTxIntervalLocked(), s.remoteMinRxInterval)
+	// The interval is reduced by 0 to 25%, or by 10 to 25% if the detect multiplier is 1.
+	minPercent, maxPercent := 75, 100
+	if s.config.DetectMultiplier == 1 {
+		maxPercent = 90
+	}
+	return interval * time.Duration(minPercent+rand.IntN(maxPercent-minPercent+1)) / 100
+}
+
+// transmitPeriodic sends a periodic control packet to the peer, unless the peer has signaled it doesn't want to
+// receive any packet.
+func (s *session) transmitPeriodic() {
+	s.mutex.Lock()
+	if s.remoteMinRxInterval == 0 {
+		s.mutex.Unlock()
+		return
+	}
+	b := s.buildPacketLocked(false).Marshal()
+	s.mutex.Unlock()
+	s.transmit(b)
+}
+
+func (s *session) transmit(b []byte) {
+	if err := s.send(b); err != nil && !errors.Is(err, net.ErrClosed) {
+		klog.ErrorS(err, "Failed to send BFD control packet", "peer", s.peerAddress)
+	}
+}
+
+func (s *session) stateChanged(oldState, newState State) {
+	klog.InfoS("BFD session state changed", "peer", s.peerAddress, "oldState", oldState, "newState", newState)
+	s.kick()
+	if s.onStateChange != nil {
+		s.onStateChange(oldState, newState)
+	}
+}
+
+func (s *session) kick() {
+	select {
+	case s.kickCh <- struct{}{}:
+	default:
+	}
+}
+
+// updateConfig updates the local parameters of the session. A Poll Sequence is initiated if the timing parameters
+// change while the session is Up, as required by RFC 5880 section 6.8.3.
+func (s *session) updateConfig(config SessionConfig) {
+	s.mutex.Lock()
+	if s.config == config {
+		s.mutex.Unlock()
+		return
+	}
+	s.config = config
+	if s.state == StateUp {
+		s.pollActive = true
+	}
+	s.mutex.Unlock()
+	s.kick()
+}
+
+// run transmits control packets and checks the detection time until the session is stopped.
+func (s *session) run() {
+	txTimer := s.clock.NewTimer(0)
+	defer txTimer.Stop()
+	detectionTimer := s.clock.NewTimer(s.checkDetectionTimeout())
+	defer detectionTimer.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-s.kickCh:
+			s.transmitPeriodic()
+			txTimer.Stop()
+			txTimer.Reset(s.nextTransmitInterval())
+			detectionTimer.Stop()
+			detectionTimer.Reset(s.checkDetectionTimeout())
+		case <-txTimer.C():
+			s.transmitPeriodic()
+			txTimer.Reset(s.nextTransmitInterval())
+		case <-detectionTimer.C():
+			detectionTimer.Reset(s.checkDetectionTimeout())
+		}
+	}
+}
+
+// stop stops the session loop and notifies the peer that the session is administratively down, so that the peer
+// doesn't tear down the BGP session because of it.
+func (s *session) stop() {
+	close(s.stopCh)
+	s.mutex.Lock()
+	s.setStateLocked(StateAdminDown, DiagnosticAdministrativelyDown)
+	b := s.buildPacketLocked(false).Marshal()
+	s.mutex.Unlock()
+	s.transmit(b)
+	if s.conn != nil {
+		s.conn.Close()
+	}
+}
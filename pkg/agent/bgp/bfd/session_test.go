@@ -0,0 +1,204 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+var testConfig = SessionConfig{
+	DetectMultiplier:      3,
+	DesiredMinTxInterval:  300 * time.Millisecond,
+	RequiredMinRxInterval: 300 * time.Millisecond,
+}
+
+type testSession struct {
+	*session
+	sent         []*ControlPacket
+	stateChanges [][2]State
+}
+
+func newTestSession(t *testing.T, peerAddress string, discriminator uint32, clock *clocktesting.FakeClock) *testSession {
+	ts := &testSession{}
+	send := func(b []byte) error {
+		p, err := UnmarshalControlPacket(b)
+		require.NoError(t, err)
+		ts.sent = append(ts.sent, p)
+		return nil
+	}
+	onStateChange := func(oldState, newState State) {
+		ts.stateChanges = append(ts.stateChanges, [2]State{oldState, newState})
+	}
+	ts.session = newSession(peerAddress, discriminator, testConfig, clock, send, onStateChange)
+	return ts
+}
+
+// exchange makes the session transmit a periodic packet and delivers it to the peer session.
+func exchange(from, to *testSession) *ControlPacket {
+	from.transmitPeriodic()
+	p := from.sent[len(from.sent)-1]
+	to.handlePacket(p)
+	return p
+}
+
+func TestSessionHandshake(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	a := newTestSession(t, "192.168.77.101", 1, fakeClock)
+	b := newTestSession(t, "192.168.77.100", 2, fakeClock)
+
+	p := exchange(a, b)
+	assert.Equal(t, StateDown, p.State)
+	assert.Equal(t, uint32(0), p.YourDiscriminator)
+	assert.Equal(t, time.Second, p.DesiredMinTxInterval, "transmit interval must be at least one second when not Up")
+	assert.Equal(t, StateInit, b.getState())
+
+	p = exchange(b, a)
+	assert.Equal(t, StateInit, p.State)
+	assert.Equal(t, uint32(1), p.YourDiscriminator)
+	assert.Equal(t, StateUp, a.getState())
+
+	p = exchange(a, b)
+	assert.Equal(t, StateUp, p.State)
+	assert.True(t, p.Poll, "a Poll Sequence must be initiated when the transmit interval changes")
+	assert.Equal(t, 300*time.Millisecond, p.DesiredMinTxInterval)
+	assert.Equal(t, StateUp, b.getState())
+	// b replies to the Poll with the Final bit.
+	reply := b.sent[len(b.sent)-1]
+	assert.True(t, reply.Final)
+	a.handlePacket(reply)
+	assert.False(t, a.pollActive)
+
+	assert.Equal(t, [][2]State{{StateDown, StateUp}}, a.stateChanges)
+	assert.Equal(t, [][2]State{{StateDown, StateInit}, {StateInit, StateUp}}, b.stateChanges)
+}
+
+func TestSessionDetectionTimeout(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	a := newTestSession(t, "192.168.77.101", 1, fakeClock)
+	b := newTestSession(t, "192.168.77.100", 2, fakeClock)
+	exchange(a, b)
+	exchange(b, a)
+	exchange(a, b)
+	a.handlePacket(b.sent[len(b.sent)-1])
+	require.Equal(t, StateUp, a.getState())
+
+	// b's desired transmit interval is 300ms once Up, hence the detection time is 900ms.
+	fakeClock.Step(600 * time.Millisecond)
+	assert.Equal(t, 300*time.Millisecond, a.checkDetectionTimeout())
+	assert.Equal(t, StateUp, a.getState())
+
+	fakeClock.Step(300 * time.Millisecond)
+	a.checkDetectionTimeout()
+	assert.Equal(t, StateDown, a.getState())
+	assert.Equal(t, uint32(0), a.remoteDiscriminator)
+	a.transmitPeriodic()
+	p := a.sent[len(a.sent)-1]
+	assert.Equal(t, DiagnosticControlDetectionTimeExpired, p.Diagnostic)
+	assert.Equal(t, [][2]State{{StateDown, StateUp}, {StateUp, StateDown}}, a.stateChanges)
+}
+
+func TestSessionRemoteDown(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	a := newTestSession(t, "192.168.77.101", 1, fakeClock)
+	b := newTestSession(t, "192.168.77.100", 2, fakeClock)
+	exchange(a, b)
+	exchange(b, a)
+	exchange(a, b)
+	require.Equal(t, StateUp, a.getState())
+	require.Equal(t, StateUp, b.getState())
+
+	// Stopping a session notifies the peer with AdminDown.
+	a.stop()
+	p := a.sent[len(a.sent)-1]
+	assert.Equal(t, StateAdminDown, p.State)
+	assert.Equal(t, DiagnosticAdministrativelyDown, p.Diagnostic)
+	b.handlePacket(p)
+	assert.Equal(t, StateDown, b.getState())
+	b.transmitPeriodic()
+	assert.Equal(t, DiagnosticNeighborSignaledSessionDown, b.sent[len(b.sent)-1].Diagnostic)
+}
+
+func TestSessionNextTransmitInterval(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	s := newTestSession(t, "192.168.77.100", 1, fakeClock)
+	for range 100 {
+		interval := s.nextTransmitInterval()
+		assert.GreaterOrEqual(t, interval, 750*time.Millisecond)
+		assert.LessOrEqual(t, interval, time.Second)
+	}
+	// The interval must not be less than the one required by the peer.
+	s.handlePacket(&ControlPacket{State: StateDown, DetectMultiplier: 3, MyDiscriminator: 2, RequiredMinRxInterval: 2 * time.Second})
+	assert.GreaterOrEqual(t, s.nextTransmitInterval(), 1500*time.Millisecond)
+}
+
+// TestSessionStateMachine checks the state transitions of a session when receiving control packets according to the
+// state machine defined in RFC 5880 section 6.2.
+func TestSessionStateMachine(t *testing.T) {
+	tests := []struct {
+		localState    State
+		remoteState   State
+		expectedState State
+	}{
+		{localState: StateDown, remoteState: StateAdminDown, expectedState: StateDown},
+		{localState: StateDown, remoteState: StateDown, expectedState: StateInit},
+		{localState: StateDown, remoteState: StateInit, expectedState: StateUp},
+		{localState: StateDown, remoteState: StateUp, expectedState: StateDown},
+		{localState: StateInit, remoteState: StateAdminDown, expectedState: StateDown},
+		{localState: StateInit, remoteState: StateDown, expectedState: StateInit},
+		{localState: StateInit, remoteState: StateInit, expectedState: StateUp},
+		{localState: StateInit, remoteState: StateUp, expectedState: StateUp},
+		{localState: StateUp, remoteState: StateAdminDown, expectedState: StateDown},
+		{localState: StateUp, remoteState: StateDown, expectedState: StateDown},
+		{localState: StateUp, remoteState: StateInit, expectedState: StateUp},
+		{localState: StateUp, remoteState: StateUp, expectedState: StateUp},
+		{localState: StateAdminDown, remoteState: StateDown, expectedState: StateAdminDown},
+		{localState: StateAdminDown, remoteState: StateInit, expectedState: StateAdminDown},
+		{localState: StateAdminDown, remoteState: StateUp, expectedState: StateAdminDown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.localState.String()+"-"+tt.remoteState.String(), func(t *testing.T) {
+			s := newTestSession(t, "192.168.77.100", 1, clocktesting.NewFakeClock(time.Now()))
+			s.state = tt.localState
+			p := &ControlPacket{State: tt.remoteState, DetectMultiplier: 3, MyDiscriminator: 2}
+			if tt.remoteState != StateDown && tt.remoteState != StateAdminDown {
+				p.YourDiscriminator = 1
+			}
+			s.handlePacket(p)
+			assert.Equal(t, tt.expectedState, s.getState())
+		})
+	}
+}
+
+// TestSessionPollSequence checks that a received Poll is answered immediately with a packet which has the Final bit
+// set and the Poll bit cleared, according to RFC 5880 section 6.5.
+func TestSessionPollSequence(t *testing.T) {
+	s := newTestSession(t, "192.168.77.100", 1, clocktesting.NewFakeClock(time.Now()))
+	s.state = StateUp
+	s.pollActive = true
+	s.handlePacket(&ControlPacket{State: StateUp, Poll: true, DetectMultiplier: 3, MyDiscriminator: 2, YourDiscriminator: 1})
+	require.Len(t, s.sent, 1)
+	reply := s.sent[0]
+	assert.True(t, reply.Final)
+	assert.False(t, reply.Poll)
+	assert.Equal(t, uint32(1), reply.MyDiscriminator)
+	assert.Equal(t, uint32(2), reply.YourDiscriminator)
+	// The local Poll Sequence is still active as the received packet didn't have the Final bit set.
+	assert.True(t, s.pollActive)
+}
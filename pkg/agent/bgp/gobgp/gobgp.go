@@ -18,24 +18,40 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"sort"
 	"time"
 
 	gobgpapi "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/pkg/server"
 	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/net"
 
 	"antrea.io/antrea/pkg/agent/bgp"
+	"antrea.io/antrea/pkg/agent/bgp/bfd"
 )
 
 const (
 	ipv4AllZero = "0.0.0.0"
 	ipv6AllZero = "::"
+
+	defaultBFDDetectMultiplier = 3
+	defaultBFDMinIntervalMs    = 300
+
+	// globalRIBName is the name used by gobgp for the global RIB when assigning policies.
+	globalRIBName = "global"
+	// exportPolicyName is the name of the gobgp policy applied to the routes exported to all peers.
+	exportPolicyName = "antrea-export"
 )
 
 type Server struct {
 	server       *server.BgpServer
 	globalConfig *gobgpapi.Global
+	// bfdManager manages the BFD sessions with the BGP peers, as gobgp doesn't support BFD.
+	bfdManager *bfd.Manager
+	// exportPolicyAssigned indicates whether the export policy has been assigned to the global RIB. The assignment is
+	// kept by gobgp across the subsequent updates of the policy.
+	exportPolicyAssigned bool
 }
 
 func NewGoBGPServer(globalConfig *bgp.GlobalConfig) *Server {
@@ -47,6 +63,7 @@ func NewGoBGPServer(globalConfig *bgp.GlobalConfig) *Server {
 			ListenPort: globalConfig.ListenPort,
 		},
 	}
+	s.bfdManager = bfd.NewManager(s.onBFDSessionStateChange)
 	if globalConfig.Confederation != nil {
 		s.globalConfig.Confederation = &gobgpapi.Confederation{
 			Enabled:      true,
@@ -66,6 +83,7 @@ func (s *Server) Start(ctx context.Context) error {
 }
 
 func (s *Server) Stop(ctx context.Context) error {
+	s.bfdManager.Stop()
 	if err := s.server.StopBgp(ctx, &gobgpapi.StopBgpRequest{}); err != nil {
 		return err
 	}
@@ -81,7 +99,7 @@ func (s *Server) AddPeer(ctx context.Context, peerConf bgp.PeerConfig) error {
 	if err := s.server.AddPeer(ctx, request); err != nil {
 		return err
 	}
-	return nil
+	return s.syncBFDSession(peerConf)
 }
 
 func (s *Server) UpdatePeer(ctx context.Context, peerConf bgp.PeerConfig) error {
@@ -93,10 +111,11 @@ func (s *Server) UpdatePeer(ctx context.Context, peerConf bgp.PeerConfig) error
 	if _, err := s.server.UpdatePeer(ctx, request); err != nil {
 		return err
 	}
-	return nil
+	return s.syncBFDSession(peerConf)
 }
 
 func (s *Server) RemovePeer(ctx context.Context, peerConf bgp.PeerConfig) error {
+	s.bfdManager.RemoveSession(peerConf.Address)
 	request := &gobgpapi.DeletePeerRequest{Address: peerConf.Address}
 	if err := s.server.DeletePeer(ctx, request); err != nil {
 		return err
@@ -104,11 +123,36 @@ func (s *Server) RemovePeer(ctx context.Context, peerConf bgp.PeerConfig) error
 	return nil
 }
 
+// syncBFDSession creates or updates the BFD session with the peer if BFD is enabled for it, and deletes it otherwise.
+func (s *Server) syncBFDSession(peerConf bgp.PeerConfig) error {
+	if peerConf.BFD == nil {
+		s.bfdManager.RemoveSession(peerConf.Address)
+		return nil
+	}
+	return s.bfdManager.AddOrUpdateSession(peerConf.Address, convertPeerConfigToBFDSessionConfig(peerConf))
+}
+
+// onBFDSessionStateChange resets the BGP session with the peer when the BFD session goes down, so that the routes
+// received from the peer are withdrawn immediately instead of after the hold time expires.
+func (s *Server) onBFDSessionStateChange(peerAddress string, oldState, newState bfd.State) {
+	if oldState != bfd.StateUp || newState != bfd.StateDown {
+		return
+	}
+	klog.InfoS("BFD session is down, resetting BGP session", "peer", peerAddress)
+	request := &gobgpapi.ResetPeerRequest{Address: peerAddress, Communication: "BFD session down"}
+	if err := s.server.ResetPeer(context.TODO(), request); err != nil {
+		klog.ErrorS(err, "Failed to reset BGP session", "peer", peerAddress)
+	}
+}
+
 func (s *Server) GetPeers(ctx context.Context) ([]bgp.PeerStatus, error) {
 	var peerStatuses []bgp.PeerStatus
 	fn := func(peer *gobgpapi.Peer) {
 		peerStatus := convertGoBGPPeerToPeerStatus(peer)
 		if peerStatus != nil {
+			if state, exists := s.bfdManager.GetSessionState(peerStatus.Address); exists {
+				peerStatus.BFDSessionState = convertBFDStateToBFDSessionState(state)
+			}
 			peerStatuses = append(peerStatuses, *peerStatus)
 		}
 	}
@@ -161,6 +205,158 @@ func (s *Server) GetRoutes(ctx context.Context, routeType bgp.RouteType, peerAdd
 	return routes, nil
 }
 
+func (s *Server) SetExportPolicy(ctx context.Context, policy *bgp.ExportPolicy) error {
+	definedSets, goBGPPolicy, err := convertExportPolicyToGoBGPPolicy(policy)
+	if err != nil {
+		return err
+	}
+	request := &gobgpapi.SetPoliciesRequest{DefinedSets: definedSets, Policies: []*gobgpapi.Policy{goBGPPolicy}}
+	if err := s.server.SetPolicies(ctx, request); err != nil {
+		return err
+	}
+	if !s.exportPolicyAssigned {
+		assignmentRequest := &gobgpapi.AddPolicyAssignmentRequest{
+			Assignment: &gobgpapi.PolicyAssignment{
+				Name:          globalRIBName,
+				Direction:     gobgpapi.PolicyDirection_EXPORT,
+				Policies:      []*gobgpapi.Policy{{Name: exportPolicyName}},
+				DefaultAction: gobgpapi.RouteAction_ACCEPT,
+			},
+		}
+		if err := s.server.AddPolicyAssignment(ctx, assignmentRequest); err != nil {
+			return err
+		}
+		s.exportPolicyAssigned = true
+	}
+	// Re-evaluate the routes advertised to the established peers with the new policy.
+	resetRequest := &gobgpapi.ResetPeerRequest{Address: "all", Soft: true, Direction: gobgpapi.ResetPeerRequest_OUT}
+	if err := s.server.ResetPeer(ctx, resetRequest); err != nil {
+		return err
+	}
+	return nil
+}
+
+// convertExportPolicyToGoBGPPolicy converts an ExportPolicy to a gobgp policy and the defined sets referenced by it.
+// The statements rejecting the routes filtered out for peers come first, followed by the statements setting path
+// attributes, which don't have a route action so that all of them are evaluated. The routes which are not rejected
+// are accepted by the default action of the policy assignment.
+func convertExportPolicyToGoBGPPolicy(policy *bgp.ExportPolicy) ([]*gobgpapi.DefinedSet, *gobgpapi.Policy, error) {
+	goBGPPolicy := &gobgpapi.Policy{Name: exportPolicyName}
+	var definedSets []*gobgpapi.DefinedSet
+	if policy == nil {
+		return definedSets, goBGPPolicy, nil
+	}
+
+	peerAddresses := make([]string, 0, len(policy.PeerFilters))
+	for peerAddress := range policy.PeerFilters {
+		peerAddresses = append(peerAddresses, peerAddress)
+	}
+	sort.Strings(peerAddresses)
+	for i, peerAddress := range peerAddresses {
+		if !isValidIPString(peerAddress) {
+			return nil, nil, fmt.Errorf("invalid peer address: %s", peerAddress)
+		}
+		isIPv6 := net.IsIPv6String(peerAddress)
+		neighborSetName := fmt.Sprintf("antrea-peer-%d", i)
+		definedSets = append(definedSets, &gobgpapi.DefinedSet{
+			DefinedType: gobgpapi.DefinedType_NEIGHBOR,
+			Name:        neighborSetName,
+			List:        []string{peerAddress},
+		})
+		conditions := &gobgpapi.Conditions{
+			NeighborSet: &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: neighborSetName},
+		}
+		// Only the prefixes of the same IP family as the peer can be exported to it. Without such prefixes, all routes
+		// are rejected for the peer.
+		prefixes, err := convertPrefixesToGoBGPPrefixes(policy.PeerFilters[peerAddress], isIPv6)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(prefixes) > 0 {
+			prefixSetName := fmt.Sprintf("antrea-peer-%d-prefixes", i)
+			definedSets = append(definedSets, &gobgpapi.DefinedSet{
+				DefinedType: gobgpapi.DefinedType_PREFIX,
+				Name:        prefixSetName,
+				Prefixes:    prefixes,
+			})
+			conditions.PrefixSet = &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_INVERT, Name: prefixSetName}
+		}
+		goBGPPolicy.Statements = append(goBGPPolicy.Statements, &gobgpapi.Statement{
+			Name:       fmt.Sprintf("antrea-peer-%d-filter", i),
+			Conditions: conditions,
+			Actions:    &gobgpapi.Actions{RouteAction: gobgpapi.RouteAction_REJECT},
+		})
+	}
+
+	for i := range policy.RouteAttributes {
+		attributes := &policy.RouteAttributes[i]
+		actions := &gobgpapi.Actions{}
+		if len(attributes.Communities) > 0 {
+			actions.Community = &gobgpapi.CommunityAction{
+				Type:        gobgpapi.CommunityAction_ADD,
+				Communities: attributes.Communities,
+			}
+		}
+		if attributes.LocalPreference != nil {
+			actions.LocalPref = &gobgpapi.LocalPrefAction{Value: *attributes.LocalPreference}
+		}
+		if actions.Community == nil && actions.LocalPref == nil {
+			continue
+		}
+		// A prefix set can only contain the prefixes of a single IP family.
+		for _, isIPv6 := range []bool{false, true} {
+			prefixes, err := convertPrefixesToGoBGPPrefixes(attributes.Prefixes, isIPv6)
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(prefixes) == 0 {
+				continue
+			}
+			name := fmt.Sprintf("antrea-attributes-%d-%s", i, ipFamilyName(isIPv6))
+			definedSets = append(definedSets, &gobgpapi.DefinedSet{
+				DefinedType: gobgpapi.DefinedType_PREFIX,
+				Name:        name,
+				Prefixes:    prefixes,
+			})
+			goBGPPolicy.Statements = append(goBGPPolicy.Statements, &gobgpapi.Statement{
+				Name: name,
+				Conditions: &gobgpapi.Conditions{
+					PrefixSet: &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: name},
+				},
+				Actions: actions,
+			})
+		}
+	}
+	return definedSets, goBGPPolicy, nil
+}
+
+// convertPrefixesToGoBGPPrefixes converts the prefixes of the given IP family to gobgp prefixes matching them exactly.
+func convertPrefixesToGoBGPPrefixes(prefixes []string, isIPv6 bool) ([]*gobgpapi.Prefix, error) {
+	var goBGPPrefixes []*gobgpapi.Prefix
+	for _, p := range prefixes {
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix %s: %w", p, err)
+		}
+		if prefix.Addr().Is6() != isIPv6 {
+			continue
+		}
+		goBGPPrefixes = append(goBGPPrefixes, &gobgpapi.Prefix{
+			IpPrefix:      prefix.Masked().String(),
+			MaskLengthMin: uint32(prefix.Bits()),
+			MaskLengthMax: uint32(prefix.Bits()),
+		})
+	}
+	return goBGPPrefixes, nil
+}
+
+func ipFamilyName(isIPv6 bool) string {
+	if isIPv6 {
+		return "ipv6"
+	}
+	return "ipv4"
+}
+
 func convertGoBGPPeerToPeerStatus(peer *gobgpapi.Peer) *bgp.PeerStatus {
 	if peer == nil {
 		return nil
@@ -199,9 +395,38 @@ func convertGoBGPDestinationToRoute(destination *gobgpapi.Destination) *bgp.Rout
 		return nil
 	}
 	route := &bgp.Route{Prefix: destination.GetPrefix()}
+	for _, path := range destination.GetPaths() {
+		if path.GetIsWithdraw() {
+			continue
+		}
+		route.NextHop = getGoBGPPathNextHop(path)
+		if path.GetBest() {
+			break
+		}
+	}
 	return route
 }
 
+// getGoBGPPathNextHop returns the next hop carried by the NEXT_HOP attribute (IPv4) or the MP_REACH_NLRI attribute
+// (IPv6) of a path, or an empty string if there is none.
+func getGoBGPPathNextHop(path *gobgpapi.Path) string {
+	for _, attr := range path.GetPattrs() {
+		msg, err := attr.UnmarshalNew()
+		if err != nil {
+			continue
+		}
+		switch a := msg.(type) {
+		case *gobgpapi.NextHopAttribute:
+			return a.GetNextHop()
+		case *gobgpapi.MpReachNLRIAttribute:
+			if len(a.GetNextHops()) > 0 {
+				return a.GetNextHops()[0]
+			}
+		}
+	}
+	return ""
+}
+
 func convertRouteTypeToGoBGPTableType(routeType bgp.RouteType) gobgpapi.TableType {
 	if routeType == bgp.RouteAdvertised {
 		return gobgpapi.TableType_ADJ_OUT
@@ -302,9 +527,54 @@ func convertPeerConfigToGoBGPPeer(peerConfig bgp.PeerConfig) (*gobgpapi.Peer, er
 			RestartTime: uint32(*peerConfig.GracefulRestartTimeSeconds),
 		}
 	}
+	// The timers are optional. gobgp uses a hold time of 90 seconds and a keepalive interval of one third of the
+	// hold time when they are not set.
+	if peerConfig.HoldTimeSeconds != nil || peerConfig.KeepaliveTimeSeconds != nil {
+		timersConfig := &gobgpapi.TimersConfig{}
+		if peerConfig.HoldTimeSeconds != nil {
+			timersConfig.HoldTime = uint64(*peerConfig.HoldTimeSeconds)
+		}
+		if peerConfig.KeepaliveTimeSeconds != nil {
+			timersConfig.KeepaliveInterval = uint64(*peerConfig.KeepaliveTimeSeconds)
+		}
+		peer.Timers = &gobgpapi.Timers{Config: timersConfig}
+	}
 	return peer, nil
 }
 
+func convertPeerConfigToBFDSessionConfig(peerConfig bgp.PeerConfig) bfd.SessionConfig {
+	config := bfd.SessionConfig{
+		DetectMultiplier:      defaultBFDDetectMultiplier,
+		DesiredMinTxInterval:  defaultBFDMinIntervalMs * time.Millisecond,
+		RequiredMinRxInterval: defaultBFDMinIntervalMs * time.Millisecond,
+		Multihop:              peerConfig.MultihopTTL != nil && *peerConfig.MultihopTTL > 1,
+	}
+	// The following pointer fields are set to default values when the corresponding BGPPolicy is created.
+	if peerConfig.BFD.DetectMultiplier != nil {
+		config.DetectMultiplier = uint8(*peerConfig.BFD.DetectMultiplier)
+	}
+	if peerConfig.BFD.MinTxIntervalMilliseconds != nil {
+		config.DesiredMinTxInterval = time.Duration(*peerConfig.BFD.MinTxIntervalMilliseconds) * time.Millisecond
+	}
+	if peerConfig.BFD.MinRxIntervalMilliseconds != nil {
+		config.RequiredMinRxInterval = time.Duration(*peerConfig.BFD.MinRxIntervalMilliseconds) * time.Millisecond
+	}
+	return config
+}
+
+func convertBFDStateToBFDSessionState(state bfd.State) bgp.BFDSessionState {
+	switch state {
+	case bfd.StateAdminDown:
+		return bgp.BFDSessionAdminDown
+	case bfd.StateInit:
+		return bgp.BFDSessionInit
+	case bfd.StateUp:
+		return bgp.BFDSessionUp
+	default:
+		return bgp.BFDSessionDown
+	}
+}
+
 func convertGoBGPSessionStateToSessionState(s gobgpapi.PeerState_SessionState) bgp.SessionState {
 	switch s {
 	case gobgpapi.PeerState_UNKNOWN:
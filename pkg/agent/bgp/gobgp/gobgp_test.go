@@ -15,15 +15,21 @@
 package gobgp
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	gobgpapi "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/server"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/bgp"
+	"antrea.io/antrea/pkg/agent/bgp/bfd"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 )
 
@@ -105,6 +111,12 @@ func TestConvertGoBGPPeerToPeerStatus(t *testing.T) {
 	}
 }
 
+func mustNewAny(t *testing.T, msg proto.Message) *anypb.Any {
+	a, err := anypb.New(msg)
+	require.NoError(t, err)
+	return a
+}
+
 func TestConvertGoBGPDestinationToRoute(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -125,6 +137,47 @@ func TestConvertGoBGPDestinationToRoute(t *testing.T) {
 				Prefix: "192.168.1.0/24",
 			},
 		},
+		{
+			name: "IPv4 destination with paths",
+			destination: &gobgpapi.Destination{
+				Prefix: "10.10.0.0/16",
+				Paths: []*gobgpapi.Path{
+					{
+						IsWithdraw: true,
+						Pattrs:     []*anypb.Any{mustNewAny(t, &gobgpapi.NextHopAttribute{NextHop: "192.168.77.1"})},
+					},
+					{
+						Pattrs: []*anypb.Any{
+							mustNewAny(t, &gobgpapi.OriginAttribute{Origin: 0}),
+							mustNewAny(t, &gobgpapi.NextHopAttribute{NextHop: "192.168.77.2"}),
+						},
+					},
+				},
+			},
+			expected: &bgp.Route{
+				Prefix:  "10.10.0.0/16",
+				NextHop: "192.168.77.2",
+			},
+		},
+		{
+			name: "IPv6 destination with paths",
+			destination: &gobgpapi.Destination{
+				Prefix: "fec0:10:10::/64",
+				Paths: []*gobgpapi.Path{
+					{
+						Pattrs: []*anypb.Any{mustNewAny(t, &gobgpapi.MpReachNLRIAttribute{NextHops: []string{"fec0::77:1"}})},
+					},
+					{
+						Best:   true,
+						Pattrs: []*anypb.Any{mustNewAny(t, &gobgpapi.MpReachNLRIAttribute{NextHops: []string{"fec0::77:2", "fe80::1"}})},
+					},
+				},
+			},
+			expected: &bgp.Route{
+				Prefix:  "fec0:10:10::/64",
+				NextHop: "fec0::77:2",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -171,6 +224,8 @@ func TestConvertPeerConfigToGoBGPPeer(t *testing.T) {
 			Port:                       ptr.To(int32(179)),
 			MultihopTTL:                ptr.To(int32(2)),
 			GracefulRestartTimeSeconds: ptr.To(int32(120)),
+			HoldTimeSeconds:            ptr.To(int32(9)),
+			KeepaliveTimeSeconds:       ptr.To(int32(3)),
 		},
 		Password: "password",
 	}
@@ -183,7 +238,224 @@ func TestConvertPeerConfigToGoBGPPeer(t *testing.T) {
 	assert.Equal(t, uint32(179), peer.GetTransport().GetRemotePort())
 	assert.Equal(t, uint32(2), peer.GetEbgpMultihop().GetMultihopTtl())
 	assert.Equal(t, uint32(120), peer.GetGracefulRestart().GetRestartTime())
+	assert.Equal(t, uint64(9), peer.GetTimers().GetConfig().GetHoldTime())
+	assert.Equal(t, uint64(3), peer.GetTimers().GetConfig().GetKeepaliveInterval())
+}
+
+func TestConvertPeerConfigToBFDSessionConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		peer     *v1alpha1.BGPPeer
+		expected bfd.SessionConfig
+	}{
+		{
+			name: "default parameters",
+			peer: &v1alpha1.BGPPeer{
+				Address:     "192.168.0.1",
+				MultihopTTL: ptr.To(int32(1)),
+				BFD:         &v1alpha1.BFDConfig{},
+			},
+			expected: bfd.SessionConfig{
+				DetectMultiplier:      3,
+				DesiredMinTxInterval:  300 * time.Millisecond,
+				RequiredMinRxInterval: 300 * time.Millisecond,
+			},
+		},
+		{
+			name: "multihop with custom parameters",
+			peer: &v1alpha1.BGPPeer{
+				Address:     "192.168.0.1",
+				MultihopTTL: ptr.To(int32(2)),
+				BFD: &v1alpha1.BFDConfig{
+					DetectMultiplier:          ptr.To(int32(5)),
+					MinTxIntervalMilliseconds: ptr.To(int32(100)),
+					MinRxIntervalMilliseconds: ptr.To(int32(200)),
+				},
+			},
+			expected: bfd.SessionConfig{
+				DetectMultiplier:      5,
+				DesiredMinTxInterval:  100 * time.Millisecond,
+				RequiredMinRxInterval: 200 * time.Millisecond,
+				Multihop:              true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, convertPeerConfigToBFDSessionConfig(bgp.PeerConfig{BGPPeer: tt.peer}))
+		})
+	}
+}
+
+func TestConvertExportPolicyToGoBGPPolicy(t *testing.T) {
+	tests := []struct {
+		name                string
+		policy              *bgp.ExportPolicy
+		expectedDefinedSets []*gobgpapi.DefinedSet
+		expectedStatements  []*gobgpapi.Statement
+		expectedErr         string
+	}{
+		{
+			name: "nil policy",
+		},
+		{
+			name: "route attributes",
+			policy: &bgp.ExportPolicy{
+				RouteAttributes: []bgp.RouteAttributes{
+					{
+						Prefixes:        []string{"10.96.10.10/32", "fec0::10:96:10:10/128"},
+						Communities:     []string{"65000:100", "no-export"},
+						LocalPreference: ptr.To(uint32(200)),
+					},
+					{
+						Prefixes: []string{"10.10.0.0/24"},
+					},
+				},
+			},
+			expectedDefinedSets: []*gobgpapi.DefinedSet{
+				{
+					DefinedType: gobgpapi.DefinedType_PREFIX,
+					Name:        "antrea-attributes-0-ipv4",
+					Prefixes:    []*gobgpapi.Prefix{{IpPrefix: "10.96.10.10/32", MaskLengthMin: 32, MaskLengthMax: 32}},
+				},
+				{
+					DefinedType: gobgpapi.DefinedType_PREFIX,
+					Name:        "antrea-attributes-0-ipv6",
+					Prefixes:    []*gobgpapi.Prefix{{IpPrefix: "fec0::10:96:10:10/128", MaskLengthMin: 128, MaskLengthMax: 128}},
+				},
+			},
+			expectedStatements: []*gobgpapi.Statement{
+				{
+					Name:       "antrea-attributes-0-ipv4",
+					Conditions: &gobgpapi.Conditions{PrefixSet: &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: "antrea-attributes-0-ipv4"}},
+					Actions: &gobgpapi.Actions{
+						Community: &gobgpapi.CommunityAction{Type: gobgpapi.CommunityAction_ADD, Communities: []string{"65000:100", "no-export"}},
+						LocalPref: &gobgpapi.LocalPrefAction{Value: 200},
+					},
+				},
+				{
+					Name:       "antrea-attributes-0-ipv6",
+					Conditions: &gobgpapi.Conditions{PrefixSet: &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: "antrea-attributes-0-ipv6"}},
+					Actions: &gobgpapi.Actions{
+						Community: &gobgpapi.CommunityAction{Type: gobgpapi.CommunityAction_ADD, Communities: []string{"65000:100", "no-export"}},
+						LocalPref: &gobgpapi.LocalPrefAction{Value: 200},
+					},
+				},
+			},
+		},
+		{
+			name: "peer filters",
+			policy: &bgp.ExportPolicy{
+				PeerFilters: map[string][]string{
+					"192.168.77.200": {"10.10.0.0/24", "fec0::10:10:0:0/80"},
+					"192.168.77.100": nil,
+				},
+			},
+			expectedDefinedSets: []*gobgpapi.DefinedSet{
+				{
+					DefinedType: gobgpapi.DefinedType_NEIGHBOR,
+					Name:        "antrea-peer-0",
+					List:        []string{"192.168.77.100"},
+				},
+				{
+					DefinedType: gobgpapi.DefinedType_NEIGHBOR,
+					Name:        "antrea-peer-1",
+					List:        []string{"192.168.77.200"},
+				},
+				{
+					DefinedType: gobgpapi.DefinedType_PREFIX,
+					Name:        "antrea-peer-1-prefixes",
+					Prefixes:    []*gobgpapi.Prefix{{IpPrefix: "10.10.0.0/24", MaskLengthMin: 24, MaskLengthMax: 24}},
+				},
+			},
+			expectedStatements: []*gobgpapi.Statement{
+				{
+					Name:       "antrea-peer-0-filter",
+					Conditions: &gobgpapi.Conditions{NeighborSet: &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: "antrea-peer-0"}},
+					Actions:    &gobgpapi.Actions{RouteAction: gobgpapi.RouteAction_REJECT},
+				},
+				{
+					Name: "antrea-peer-1-filter",
+					Conditions: &gobgpapi.Conditions{
+						NeighborSet: &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: "antrea-peer-1"},
+						PrefixSet:   &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_INVERT, Name: "antrea-peer-1-prefixes"},
+					},
+					Actions: &gobgpapi.Actions{RouteAction: gobgpapi.RouteAction_REJECT},
+				},
+			},
+		},
+		{
+			name: "invalid prefix",
+			policy: &bgp.ExportPolicy{
+				RouteAttributes: []bgp.RouteAttributes{{Prefixes: []string{"10.10.0.0"}, Communities: []string{"no-export"}}},
+			},
+			expectedErr: "invalid prefix 10.10.0.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definedSets, policy, err := convertExportPolicyToGoBGPPolicy(tt.policy)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDefinedSets, definedSets)
+			assert.Equal(t, exportPolicyName, policy.Name)
+			assert.Equal(t, tt.expectedStatements, policy.Statements)
+		})
+	}
+}
+
+func TestSetExportPolicy(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{
+		server:       server.NewBgpServer(server.LoggerOption(newGoBGPLogger())),
+		globalConfig: &gobgpapi.Global{Asn: 65000, RouterId: "192.168.77.1", ListenPort: -1},
+		bfdManager:   bfd.NewManager(nil),
+	}
+	require.NoError(t, s.Start(ctx))
+	defer s.Stop(ctx)
+
+	listPolicyStatements := func() []string {
+		var statements []string
+		err := s.server.ListPolicy(ctx, &gobgpapi.ListPolicyRequest{Name: exportPolicyName}, func(policy *gobgpapi.Policy) {
+			for _, statement := range policy.Statements {
+				statements = append(statements, statement.Name)
+			}
+		})
+		require.NoError(t, err)
+		return statements
+	}
+	listAssignedPolicies := func() []string {
+		var policies []string
+		request := &gobgpapi.ListPolicyAssignmentRequest{Name: globalRIBName, Direction: gobgpapi.PolicyDirection_EXPORT}
+		err := s.server.ListPolicyAssignment(ctx, request, func(assignment *gobgpapi.PolicyAssignment) {
+			for _, policy := range assignment.Policies {
+				policies = append(policies, policy.Name)
+			}
+		})
+		require.NoError(t, err)
+		return policies
+	}
+
+	policy := &bgp.ExportPolicy{
+		RouteAttributes: []bgp.RouteAttributes{{Prefixes: []string{"10.96.10.10/32"}, Communities: []string{"65000:100"}}},
+		PeerFilters:     map[string][]string{"192.168.77.200": {"10.96.10.10/32"}},
+	}
+	require.NoError(t, s.SetExportPolicy(ctx, policy))
+	assert.Equal(t, []string{"antrea-peer-0-filter", "antrea-attributes-0-ipv4"}, listPolicyStatements())
+	assert.Equal(t, []string{exportPolicyName}, listAssignedPolicies())
+
+	// The policy should stay assigned after it is updated.
+	policy.PeerFilters = nil
+	require.NoError(t, s.SetExportPolicy(ctx, policy))
+	assert.Equal(t, []string{"antrea-attributes-0-ipv4"}, listPolicyStatements())
+	assert.Equal(t, []string{exportPolicyName}, listAssignedPolicies())
 
+	require.NoError(t, s.SetExportPolicy(ctx, nil))
+	assert.Empty(t, listPolicyStatements())
+	assert.Equal(t, []string{exportPolicyName}, listAssignedPolicies())
 }
 
 func TestConvertGoBGPSessionStateToSessionState(t *testing.T) {
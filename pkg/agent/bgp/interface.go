@@ -50,6 +50,10 @@ type Interface interface {
 
 	// GetRoutes retrieves the advertised / received routes to / from the given peer.
 	GetRoutes(ctx context.Context, routeType RouteType, peerAddress string) ([]Route, error)
+
+	// SetExportPolicy sets the policy applied to the routes advertised to all BGP peers, replacing the previous one.
+	// A nil policy removes the previous one.
+	SetExportPolicy(ctx context.Context, policy *ExportPolicy) error
 }
 
 type Confederation struct {
@@ -80,6 +84,17 @@ const (
 	SessionEstablished SessionState = "Established"
 )
 
+// BFDSessionState is the state of the BFD (Bidirectional Forwarding Detection) session with a BGP peer.
+// For more details see https://datatracker.ietf.org/doc/html/rfc5880#section-4.1.
+type BFDSessionState string
+
+const (
+	BFDSessionAdminDown BFDSessionState = "AdminDown"
+	BFDSessionDown      BFDSessionState = "Down"
+	BFDSessionInit      BFDSessionState = "Init"
+	BFDSessionUp        BFDSessionState = "Up"
+)
+
 type RouteType int
 
 const (
@@ -105,10 +120,31 @@ type PeerStatus struct {
 	GracefulRestartTimeSeconds int32
 	SessionState               SessionState
 	UptimeSeconds              int
+	// BFDSessionState is empty if BFD is not enabled for the BGP peer.
+	BFDSessionState BFDSessionState
 }
 
-// Route represents a BGP route. Currently only prefix (e.g., "192.168.0.0/24") is needed. More attributes might be
-// added later.
+// Route represents a BGP route. Currently only prefix (e.g., "192.168.0.0/24") and next hop are needed. More
+// attributes might be added later.
 type Route struct {
 	Prefix string
+	// NextHop is the next hop of a received route. It is not set for advertised routes.
+	NextHop string
+}
+
+// ExportPolicy customizes the advertised routes exported to BGP peers.
+type ExportPolicy struct {
+	// RouteAttributes lists the path attributes set on the advertised routes.
+	RouteAttributes []RouteAttributes
+	// PeerFilters maps the addresses of BGP peers to the prefixes of the advertised routes allowed to be exported to
+	// them. All advertised routes are exported to the BGP peers not in the map.
+	PeerFilters map[string][]string
+}
+
+// RouteAttributes contains the path attributes set on the advertised routes with the given prefixes.
+type RouteAttributes struct {
+	Prefixes []string
+	// Communities are in the format of "<ASN>:<value>", or the names of well-known communities (e.g., "no-export").
+	Communities     []string
+	LocalPreference *uint32
 }
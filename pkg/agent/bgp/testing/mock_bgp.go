@@ -128,6 +128,20 @@ func (mr *MockInterfaceMockRecorder) RemovePeer(ctx, peerConf any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockInterface)(nil).RemovePeer), ctx, peerConf)
 }
 
+// SetExportPolicy mocks base method.
+func (m *MockInterface) SetExportPolicy(ctx context.Context, policy *bgp.ExportPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExportPolicy", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExportPolicy indicates an expected call of SetExportPolicy.
+func (mr *MockInterfaceMockRecorder) SetExportPolicy(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExportPolicy", reflect.TypeOf((*MockInterface)(nil).SetExportPolicy), ctx, policy)
+}
+
 // Start mocks base method.
 func (m *MockInterface) Start(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -22,6 +22,9 @@ import (
 	"hash/fnv"
 	"net"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -48,6 +51,8 @@ import (
 	"antrea.io/antrea/pkg/agent/bgp"
 	"antrea.io/antrea/pkg/agent/bgp/gobgp"
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/route"
+	"antrea.io/antrea/pkg/agent/servicecidr"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -56,6 +61,7 @@ import (
 	crdlistersv1a1 "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	crdlistersv1b1 "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/util/env"
+	"antrea.io/antrea/pkg/util/k8s"
 )
 
 const (
@@ -65,6 +71,10 @@ const (
 	maxRetryDelay = 300 * time.Second
 	// Disable resyncing.
 	resyncPeriod time.Duration = 0
+	// How often to sync the routes imported from BGP peers.
+	importedRoutesSyncInterval = 10 * time.Second
+	// The default maximum number of routes imported for each IP family.
+	defaultMaxImportedRoutes = 100
 )
 
 const (
@@ -86,6 +96,7 @@ const (
 	ServiceExternalIP     AdvertisedRouteType = "ServiceExternalIP"
 	ServiceClusterIP      AdvertisedRouteType = "ServiceClusterIP"
 	NodeIPAMPodCIDR       AdvertisedRouteType = "NodeIPAMPodCIDR"
+	PodIP                 AdvertisedRouteType = "PodIP"
 )
 
 type RouteMetadata struct {
@@ -116,6 +127,32 @@ type bgpPolicyState struct {
 	// peerConfigs is a map that stores configurations of BGP peers. The map keys are the concatenated strings of BGP
 	// peer IP address and ASN (e.g., "192.168.77.100-65000", "2001::1-65000").
 	peerConfigs map[string]bgp.PeerConfig
+	// exportPolicy stores the policy applied to the routes exported to BGP peers.
+	exportPolicy *bgp.ExportPolicy
+	// importConfig stores the configuration of the routes imported from BGP peers. It's nil if route import is not
+	// enabled.
+	importConfig *importConfig
+}
+
+type prefixFilter struct {
+	cidr      *net.IPNet
+	minLength int
+	maxLength int
+}
+
+type importConfig struct {
+	prefixFilters []prefixFilter
+	// peerAddresses are the addresses of the BGP peers, in the order of the BGPPolicy. When the same prefix is
+	// received from multiple BGP peers, the route received from the first one is imported.
+	peerAddresses       []string
+	toEgressRouteTables bool
+	maxRoutes           int
+}
+
+// importedRoute is a route imported from a BGP peer and installed by the route client.
+type importedRoute struct {
+	gateway             string
+	toEgressRouteTables bool
 }
 
 type Controller struct {
@@ -139,6 +176,14 @@ type Controller struct {
 	endpointSliceLister       discoverylisters.EndpointSliceLister
 	endpointSliceListerSynced cache.InformerSynced
 
+	namespaceInformer     cache.SharedIndexInformer
+	namespaceLister       corelisters.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+
+	podInformer     cache.SharedIndexInformer
+	podLister       corelisters.PodLister
+	podListerSynced cache.InformerSynced
+
 	secretInformer cache.SharedIndexInformer
 
 	bgpPolicyState      *bgpPolicyState
@@ -157,6 +202,18 @@ type Controller struct {
 
 	egressEnabled bool
 
+	routeClient         route.Interface
+	serviceCIDRProvider servicecidr.Interface
+	// gatewayIPs and transportCIDRs are the IPs of the local gateway interface and the subnets of the local transport
+	// interface, which the imported routes must not override.
+	gatewayIPs     []string
+	transportCIDRs []*net.IPNet
+	// importedRoutes stores the routes installed by the route client, keyed by prefix. It's only accessed by
+	// syncImportedRoutes, which is never called concurrently.
+	importedRoutes map[string]importedRoute
+	// importedRoutesReconciled indicates whether the orphaned imported routes have been removed.
+	importedRoutesReconciled bool
+
 	newBGPServerFn func(globalConfig *bgp.GlobalConfig) bgp.Interface
 
 	queue workqueue.TypedRateLimitingInterface[string]
@@ -167,8 +224,12 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 	egressInformer crdinformersv1b1.EgressInformer,
 	bgpPolicyInformer crdinformersv1a1.BGPPolicyInformer,
 	endpointSliceInformer discoveryinformers.EndpointSliceInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
+	podInformer cache.SharedIndexInformer,
 	egressEnabled bool,
 	k8sClient kubernetes.Interface,
+	routeClient route.Interface,
+	serviceCIDRProvider servicecidr.Interface,
 	nodeConfig *config.NodeConfig,
 	networkConfig *config.NetworkConfig) (*Controller, error) {
 	c := &Controller{
@@ -184,6 +245,12 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 		endpointSliceInformer:     endpointSliceInformer.Informer(),
 		endpointSliceLister:       endpointSliceInformer.Lister(),
 		endpointSliceListerSynced: endpointSliceInformer.Informer().HasSynced,
+		namespaceInformer:         namespaceInformer.Informer(),
+		namespaceLister:           namespaceInformer.Lister(),
+		namespaceListerSynced:     namespaceInformer.Informer().HasSynced,
+		podInformer:               podInformer,
+		podLister:                 corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:           podInformer.HasSynced,
 		k8sClient:                 k8sClient,
 		bgpPeerPasswords:          make(map[string]string),
 		nodeName:                  nodeConfig.Name,
@@ -193,6 +260,9 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 		podIPv6CIDR:               nodeConfig.PodIPv6CIDR.String(),
 		nodeIPv4Addr:              nodeConfig.NodeIPv4Addr.IP.String(),
 		egressEnabled:             egressEnabled,
+		routeClient:               routeClient,
+		serviceCIDRProvider:       serviceCIDRProvider,
+		importedRoutes:            make(map[string]importedRoute),
 		newBGPServerFn: func(globalConfig *bgp.GlobalConfig) bgp.Interface {
 			return gobgp.NewGoBGPServer(globalConfig)
 		},
@@ -203,6 +273,18 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 			},
 		),
 	}
+	if nodeConfig.GatewayConfig != nil {
+		for _, ip := range []net.IP{nodeConfig.GatewayConfig.IPv4, nodeConfig.GatewayConfig.IPv6} {
+			if ip != nil {
+				c.gatewayIPs = append(c.gatewayIPs, ip.String())
+			}
+		}
+	}
+	for _, addr := range []*net.IPNet{nodeConfig.NodeTransportIPv4Addr, nodeConfig.NodeTransportIPv6Addr} {
+		if addr != nil {
+			c.transportCIDRs = append(c.transportCIDRs, &net.IPNet{IP: addr.IP.Mask(addr.Mask), Mask: addr.Mask})
+		}
+	}
 	c.bgpPolicyInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.addBGPPolicy,
@@ -227,6 +309,22 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 		},
 		resyncPeriod,
 	)
+	c.namespaceInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    nil,
+			UpdateFunc: c.updateNamespace,
+			DeleteFunc: nil,
+		},
+		resyncPeriod,
+	)
+	c.podInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addPod,
+			UpdateFunc: c.updatePod,
+			DeleteFunc: c.deletePod,
+		},
+		resyncPeriod,
+	)
 	if c.egressEnabled {
 		c.egressInformer = egressInformer.Informer()
 		c.egressLister = egressInformer.Lister()
@@ -279,6 +377,8 @@ func (c *Controller) Run(ctx context.Context) {
 		c.bgpPolicyListerSynced,
 		c.endpointSliceListerSynced,
 		c.serviceListerSynced,
+		c.namespaceListerSynced,
+		c.podListerSynced,
 		c.secretInformer.HasSynced,
 	}
 	if c.egressEnabled {
@@ -290,6 +390,8 @@ func (c *Controller) Run(ctx context.Context) {
 
 	go wait.UntilWithContext(ctx, c.worker, time.Second)
 
+	go wait.UntilWithContext(ctx, c.syncImportedRoutes, importedRoutesSyncInterval)
+
 	<-ctx.Done()
 }
 
@@ -445,16 +547,28 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 		c.bgpPolicyState.bgpPolicyName = bgpPolicyName
 	}
 
+	curRoutes := c.getRoutes(effectivePolicy.Spec.Advertisements)
+
+	// Reconcile the export policy before BGP peers and advertisements, so that the routes are never exported to the
+	// BGP peers that they are filtered out for.
+	if err := c.reconcileExportPolicy(ctx, effectivePolicy.Spec.Advertisements, effectivePolicy.Spec.BGPPeers, curRoutes); err != nil {
+		return err
+	}
+
 	// Reconcile BGP peers.
 	if err := c.reconcileBGPPeers(ctx, effectivePolicy.Spec.BGPPeers); err != nil {
 		return err
 	}
 
 	// Reconcile BGP advertisements.
-	if err := c.reconcileBGPAdvertisements(ctx, effectivePolicy.Spec.Advertisements); err != nil {
+	if err := c.reconcileBGPAdvertisements(ctx, curRoutes); err != nil {
 		return err
 	}
 
+	// The received routes are imported by syncImportedRoutes periodically, as the BGP server doesn't notify the
+	// changes of the received routes.
+	c.bgpPolicyState.importConfig = c.getImportConfig(effectivePolicy.Spec.RouteImport, effectivePolicy.Spec.BGPPeers)
+
 	return nil
 }
 
@@ -501,8 +615,22 @@ func (c *Controller) reconcileBGPPeers(ctx context.Context, bgpPeers []v1alpha1.
 	return nil
 }
 
-func (c *Controller) reconcileBGPAdvertisements(ctx context.Context, bgpAdvertisements v1alpha1.Advertisements) error {
-	curRoutes := c.getRoutes(bgpAdvertisements)
+func (c *Controller) reconcileExportPolicy(ctx context.Context,
+	advertisements v1alpha1.Advertisements,
+	bgpPeers []v1alpha1.BGPPeer,
+	curRoutes map[bgp.Route]RouteMetadata) error {
+	exportPolicy := getExportPolicy(advertisements, bgpPeers, curRoutes)
+	if reflect.DeepEqual(c.bgpPolicyState.exportPolicy, exportPolicy) {
+		return nil
+	}
+	if err := c.bgpPolicyState.bgpServer.SetExportPolicy(ctx, exportPolicy); err != nil {
+		return fmt.Errorf("failed to set export policy: %w", err)
+	}
+	c.bgpPolicyState.exportPolicy = exportPolicy
+	return nil
+}
+
+func (c *Controller) reconcileBGPAdvertisements(ctx context.Context, curRoutes map[bgp.Route]RouteMetadata) error {
 	preRoutes := c.bgpPolicyState.routes
 	currRoutesKeys := sets.KeySet(curRoutes)
 	preRoutesKeys := sets.KeySet(preRoutes)
@@ -600,10 +728,10 @@ func (c *Controller) getRoutes(advertisements v1alpha1.Advertisements) map[bgp.R
 		c.addServiceRoutes(advertisements.Service, allRoutes)
 	}
 	if c.egressEnabled && advertisements.Egress != nil {
-		c.addEgressRoutes(allRoutes)
+		c.addEgressRoutes(advertisements.Egress, allRoutes)
 	}
 	if advertisements.Pod != nil {
-		c.addPodRoutes(allRoutes)
+		c.addPodRoutes(advertisements.Pod, allRoutes)
 	}
 
 	return allRoutes
@@ -611,9 +739,13 @@ func (c *Controller) getRoutes(advertisements v1alpha1.Advertisements) map[bgp.R
 
 func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertisement, allRoutes map[bgp.Route]RouteMetadata) {
 	ipTypes := sets.New(advertisement.IPTypes...)
-	services, _ := c.serviceLister.List(labels.Everything())
+	services, _ := c.serviceLister.List(labelSelectorAsSelector(advertisement.ServiceSelector))
+	namespaceMatcher := c.newNamespaceMatcher(advertisement.NamespaceSelector)
 
 	for _, svc := range services {
+		if !namespaceMatcher(svc.Namespace) {
+			continue
+		}
 		svcRef := svc.Namespace + "/" + svc.Name
 		internalLocal := svc.Spec.InternalTrafficPolicy != nil && *svc.Spec.InternalTrafficPolicy == corev1.ServiceInternalTrafficPolicyLocal
 		externalLocal := svc.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyLocal
@@ -658,8 +790,8 @@ func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertiseme
 	}
 }
 
-func (c *Controller) addEgressRoutes(allRoutes map[bgp.Route]RouteMetadata) {
-	egresses, _ := c.egressLister.List(labels.Everything())
+func (c *Controller) addEgressRoutes(advertisement *v1alpha1.EgressAdvertisement, allRoutes map[bgp.Route]RouteMetadata) {
+	egresses, _ := c.egressLister.List(labelSelectorAsSelector(advertisement.EgressSelector))
 	for _, eg := range egresses {
 		if eg.Status.EgressNode != c.nodeName {
 			continue
@@ -673,12 +805,79 @@ func (c *Controller) addEgressRoutes(allRoutes map[bgp.Route]RouteMetadata) {
 	}
 }
 
-func (c *Controller) addPodRoutes(allRoutes map[bgp.Route]RouteMetadata) {
-	if c.enabledIPv4 {
-		addRoutes(allRoutes, c.podIPv4CIDR, "", NodeIPAMPodCIDR)
+func (c *Controller) addPodRoutes(advertisement *v1alpha1.PodAdvertisement, allRoutes map[bgp.Route]RouteMetadata) {
+	// Without any selector, the NodeIPAM Pod CIDRs are advertised instead of specific Pod IPs.
+	if !hasPodSelectors(advertisement) {
+		if c.enabledIPv4 {
+			addRoutes(allRoutes, c.podIPv4CIDR, "", NodeIPAMPodCIDR)
+		}
+		if c.enabledIPv6 {
+			addRoutes(allRoutes, c.podIPv6CIDR, "", NodeIPAMPodCIDR)
+		}
+		return
 	}
-	if c.enabledIPv6 {
-		addRoutes(allRoutes, c.podIPv6CIDR, "", NodeIPAMPodCIDR)
+
+	// The Pod lister only contains the Pods running on the current Node.
+	pods, _ := c.podLister.List(labelSelectorAsSelector(advertisement.PodSelector))
+	namespaceMatcher := c.newNamespaceMatcher(advertisement.NamespaceSelector)
+	for _, pod := range pods {
+		if !isPodAdvertisable(pod) || !namespaceMatcher(pod.Namespace) {
+			continue
+		}
+		podRef := pod.Namespace + "/" + pod.Name
+		for _, podIP := range pod.Status.PodIPs {
+			if c.enabledIPv4 && utilnet.IsIPv4String(podIP.IP) {
+				addRoutes(allRoutes, podIP.IP+ipv4Suffix, podRef, PodIP)
+			} else if c.enabledIPv6 && utilnet.IsIPv6String(podIP.IP) {
+				addRoutes(allRoutes, podIP.IP+ipv6Suffix, podRef, PodIP)
+			}
+		}
+	}
+}
+
+func hasPodSelectors(advertisement *v1alpha1.PodAdvertisement) bool {
+	return advertisement.PodSelector != nil || advertisement.NamespaceSelector != nil
+}
+
+// isPodAdvertisable returns whether the IPs of the Pod can be advertised. The IPs of hostNetwork Pods are Node IPs,
+// and the IPs of terminated Pods may have been reused by other Pods.
+func isPodAdvertisable(pod *corev1.Pod) bool {
+	return !pod.Spec.HostNetwork &&
+		pod.Status.Phase != corev1.PodSucceeded &&
+		pod.Status.Phase != corev1.PodFailed &&
+		len(pod.Status.PodIPs) != 0
+}
+
+// labelSelectorAsSelector converts a LabelSelector to a Selector. Unlike metav1.LabelSelectorAsSelector, a nil
+// LabelSelector selects everything.
+func labelSelectorAsSelector(labelSelector *metav1.LabelSelector) labels.Selector {
+	if labelSelector == nil {
+		return labels.Everything()
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		klog.ErrorS(err, "Invalid label selector, nothing is selected", "labelSelector", labelSelector)
+		return labels.Nothing()
+	}
+	return selector
+}
+
+// newNamespaceMatcher returns a function which checks whether a Namespace is selected by the given LabelSelector. The
+// Namespaces are cached, as the function is usually called for many objects in the same Namespaces.
+func (c *Controller) newNamespaceMatcher(labelSelector *metav1.LabelSelector) func(namespace string) bool {
+	if labelSelector == nil {
+		return func(string) bool { return true }
+	}
+	selector := labelSelectorAsSelector(labelSelector)
+	matched := make(map[string]bool)
+	return func(namespace string) bool {
+		if m, exists := matched[namespace]; exists {
+			return m
+		}
+		ns, _ := c.namespaceLister.Get(namespace)
+		m := ns != nil && selector.Matches(labels.Set(ns.Labels))
+		matched[namespace] = m
+		return m
 	}
 }
 
@@ -689,6 +888,369 @@ func addRoutes(allRoutes map[bgp.Route]RouteMetadata, prefix, k8sObjRef string,
 	}
 }
 
+// getAdvertisementType returns the type of the advertisement that a route of the given type is advertised for.
+func getAdvertisementType(routeType AdvertisedRouteType) v1alpha1.AdvertisementType {
+	switch routeType {
+	case ServiceClusterIP, ServiceExternalIP, ServiceLoadBalancerIP:
+		return v1alpha1.AdvertisementTypeService
+	case EgressIP:
+		return v1alpha1.AdvertisementTypeEgress
+	default:
+		return v1alpha1.AdvertisementTypePod
+	}
+}
+
+// getExportPolicy generates the policy applied to the routes exported to BGP peers, according to the route attributes
+// of the advertisements and the export filters of the BGP peers. nil is returned when no route needs to be customized
+// or filtered.
+func getExportPolicy(advertisements v1alpha1.Advertisements,
+	bgpPeers []v1alpha1.BGPPeer,
+	routes map[bgp.Route]RouteMetadata) *bgp.ExportPolicy {
+	prefixesByType := make(map[v1alpha1.AdvertisementType][]string)
+	for route, metadata := range routes {
+		advertisementType := getAdvertisementType(metadata.Type)
+		prefixesByType[advertisementType] = append(prefixesByType[advertisementType], route.Prefix)
+	}
+	for _, prefixes := range prefixesByType {
+		sort.Strings(prefixes)
+	}
+
+	exportPolicy := &bgp.ExportPolicy{}
+	addRouteAttributes := func(advertisementType v1alpha1.AdvertisementType, attributes *v1alpha1.RouteAttributes) {
+		routeAttributes := bgp.RouteAttributes{Prefixes: prefixesByType[advertisementType]}
+		for _, community := range attributes.Communities {
+			if !isValidCommunity(community) {
+				klog.InfoS("Ignored invalid BGP community", "advertisement", advertisementType, "community", community)
+				continue
+			}
+			routeAttributes.Communities = append(routeAttributes.Communities, community)
+		}
+		if attributes.LocalPreference != nil {
+			routeAttributes.LocalPreference = ptr.To(uint32(*attributes.LocalPreference))
+		}
+		if len(routeAttributes.Prefixes) == 0 || len(routeAttributes.Communities) == 0 && routeAttributes.LocalPreference == nil {
+			return
+		}
+		exportPolicy.RouteAttributes = append(exportPolicy.RouteAttributes, routeAttributes)
+	}
+	if advertisements.Service != nil {
+		addRouteAttributes(v1alpha1.AdvertisementTypeService, &advertisements.Service.RouteAttributes)
+	}
+	if advertisements.Pod != nil {
+		addRouteAttributes(v1alpha1.AdvertisementTypePod, &advertisements.Pod.RouteAttributes)
+	}
+	if advertisements.Egress != nil {
+		addRouteAttributes(v1alpha1.AdvertisementTypeEgress, &advertisements.Egress.RouteAttributes)
+	}
+
+	for i := range bgpPeers {
+		exportFilter := bgpPeers[i].ExportFilter
+		if exportFilter == nil {
+			continue
+		}
+		if exportPolicy.PeerFilters == nil {
+			exportPolicy.PeerFilters = make(map[string][]string)
+		}
+		advertisementTypes := sets.New(exportFilter.AdvertisementTypes...)
+		var cidrs []*net.IPNet
+		for _, cidr := range exportFilter.CIDRs {
+			if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+				cidrs = append(cidrs, ipNet)
+			}
+		}
+		var allowedPrefixes []string
+		for _, advertisementType := range []v1alpha1.AdvertisementType{
+			v1alpha1.AdvertisementTypeService,
+			v1alpha1.AdvertisementTypePod,
+			v1alpha1.AdvertisementTypeEgress,
+		} {
+			if advertisementTypes.Len() != 0 && !advertisementTypes.Has(advertisementType) {
+				continue
+			}
+			for _, prefix := range prefixesByType[advertisementType] {
+				if len(exportFilter.CIDRs) == 0 || prefixInCIDRs(prefix, cidrs) {
+					allowedPrefixes = append(allowedPrefixes, prefix)
+				}
+			}
+		}
+		exportPolicy.PeerFilters[bgpPeers[i].Address] = allowedPrefixes
+	}
+
+	if len(exportPolicy.RouteAttributes) == 0 && len(exportPolicy.PeerFilters) == 0 {
+		return nil
+	}
+	return exportPolicy
+}
+
+// prefixInCIDRs returns whether the prefix is within any of the CIDRs.
+func prefixInCIDRs(prefix string, cidrs []*net.IPNet) bool {
+	ip, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false
+	}
+	prefixLen, _ := ipNet.Mask.Size()
+	for _, cidr := range cidrs {
+		cidrLen, _ := cidr.Mask.Size()
+		if cidr.Contains(ip) && prefixLen >= cidrLen {
+			return true
+		}
+	}
+	return false
+}
+
+var wellKnownCommunities = sets.New[string]("no-export", "no-advertise", "no-export-subconfed", "no-peer")
+
+// isValidCommunity returns whether the BGP community is either in the format of "<ASN>:<value>", where both ASN and
+// value are 16-bit integers, or the name of a well-known community.
+func isValidCommunity(community string) bool {
+	if wellKnownCommunities.Has(community) {
+		return true
+	}
+	asn, value, found := strings.Cut(community, ":")
+	if !found {
+		return false
+	}
+	if _, err := strconv.ParseUint(asn, 10, 16); err != nil {
+		return false
+	}
+	if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+		return false
+	}
+	return true
+}
+
+func (c *Controller) getImportConfig(routeImport *v1alpha1.RouteImport, bgpPeers []v1alpha1.BGPPeer) *importConfig {
+	if routeImport == nil {
+		return nil
+	}
+	routeImportConfig := &importConfig{
+		toEgressRouteTables: routeImport.RouteTable == v1alpha1.ImportRouteTableEgress,
+		maxRoutes:           defaultMaxImportedRoutes,
+	}
+	if routeImport.MaxRoutes != nil {
+		routeImportConfig.maxRoutes = int(*routeImport.MaxRoutes)
+	}
+	for _, prefix := range routeImport.Prefixes {
+		_, cidr, err := net.ParseCIDR(prefix.CIDR)
+		if err != nil {
+			klog.ErrorS(err, "Ignored invalid prefix filter of route import", "cidr", prefix.CIDR)
+			continue
+		}
+		prefixLength, bits := cidr.Mask.Size()
+		filter := prefixFilter{cidr: cidr, minLength: prefixLength, maxLength: prefixLength}
+		if prefix.MinLength != nil {
+			filter.minLength = int(*prefix.MinLength)
+			filter.maxLength = bits
+		}
+		if prefix.MaxLength != nil {
+			filter.maxLength = int(*prefix.MaxLength)
+		}
+		routeImportConfig.prefixFilters = append(routeImportConfig.prefixFilters, filter)
+	}
+	for _, peer := range bgpPeers {
+		if c.enabledIPv4 && utilnet.IsIPv4String(peer.Address) || c.enabledIPv6 && utilnet.IsIPv6String(peer.Address) {
+			if !slices.Contains(routeImportConfig.peerAddresses, peer.Address) {
+				routeImportConfig.peerAddresses = append(routeImportConfig.peerAddresses, peer.Address)
+			}
+		}
+	}
+	return routeImportConfig
+}
+
+// matchesPrefixFilters returns whether the prefix is within the CIDR of any of the filters, and its length is within
+// the range of the filter.
+func matchesPrefixFilters(prefix *net.IPNet, filters []prefixFilter) bool {
+	prefixLength, bits := prefix.Mask.Size()
+	for _, filter := range filters {
+		cidrLength, cidrBits := filter.cidr.Mask.Size()
+		if bits == cidrBits && prefixLength >= cidrLength && filter.cidr.Contains(prefix.IP) &&
+			prefixLength >= filter.minLength && prefixLength <= filter.maxLength {
+			return true
+		}
+	}
+	return false
+}
+
+// reservedCIDR is a CIDR which the received routes must not overlap with.
+type reservedCIDR struct {
+	cidr *net.IPNet
+	// kind describes the CIDR, e.g. "Pod CIDR".
+	kind string
+}
+
+// getReservedAddresses returns the IPs of all Nodes and of the local gateway interface, and the Pod CIDRs of all Nodes,
+// the Service CIDRs and the subnets of the local transport interface. The received routes must not override the routes
+// to them, otherwise the connectivity of the cluster may be broken. The Pod CIDRs include the subnet of the local
+// gateway interface.
+func (c *Controller) getReservedAddresses() (sets.Set[string], []reservedCIDR, error) {
+	serviceCIDRs, err := c.serviceCIDRProvider.GetServiceCIDRs()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get Service CIDRs: %w", err)
+	}
+	nodeIPs := sets.New[string](c.gatewayIPs...)
+	var reservedCIDRs []reservedCIDR
+	addPodCIDR := func(cidrStr string) {
+		if _, cidr, err := net.ParseCIDR(cidrStr); err == nil {
+			reservedCIDRs = append(reservedCIDRs, reservedCIDR{cidr: cidr, kind: "Pod CIDR"})
+		}
+	}
+	addPodCIDR(c.podIPv4CIDR)
+	addPodCIDR(c.podIPv6CIDR)
+	for _, cidr := range serviceCIDRs {
+		reservedCIDRs = append(reservedCIDRs, reservedCIDR{cidr: cidr, kind: "Service CIDR"})
+	}
+	for _, cidr := range c.transportCIDRs {
+		reservedCIDRs = append(reservedCIDRs, reservedCIDR{cidr: cidr, kind: "transport subnet"})
+	}
+	if c.enabledIPv4 {
+		nodeIPs.Insert(c.nodeIPv4Addr)
+	}
+	nodes, _ := c.nodeLister.List(labels.Everything())
+	for _, node := range nodes {
+		if ips, err := k8s.GetNodeAllAddrs(node); err == nil {
+			nodeIPs.Insert(ips.UnsortedList()...)
+		}
+		for _, podCIDR := range node.Spec.PodCIDRs {
+			addPodCIDR(podCIDR)
+		}
+	}
+	return nodeIPs, reservedCIDRs, nil
+}
+
+// validateImportedRoute checks whether a received route can be installed safely. A route is rejected if its next hop
+// is not a valid unicast IP in the same family as its prefix or is a Node IP, or if its prefix contains its next hop,
+// any Node IP or BGP peer address, or overlaps with any reserved CIDR.
+func validateImportedRoute(prefix *net.IPNet, nextHop net.IP, nodeIPs sets.Set[string], peerAddresses []string, reservedCIDRs []reservedCIDR) error {
+	if nextHop == nil || utilnet.IsIPv6(nextHop) != utilnet.IsIPv6(prefix.IP) || !nextHop.IsGlobalUnicast() {
+		return fmt.Errorf("invalid next hop %v", nextHop)
+	}
+	if nodeIPs.Has(nextHop.String()) {
+		return fmt.Errorf("next hop is a Node IP")
+	}
+	if prefix.Contains(nextHop) {
+		return fmt.Errorf("prefix contains the next hop")
+	}
+	for _, ipStr := range append(nodeIPs.UnsortedList(), peerAddresses...) {
+		if ip := net.ParseIP(ipStr); ip != nil && prefix.Contains(ip) {
+			return fmt.Errorf("prefix contains Node IP or BGP peer address %s", ipStr)
+		}
+	}
+	for _, reserved := range reservedCIDRs {
+		if reserved.cidr.Contains(prefix.IP) || prefix.Contains(reserved.cidr.IP) {
+			return fmt.Errorf("prefix overlaps with %s %s", reserved.kind, reserved.cidr)
+		}
+	}
+	return nil
+}
+
+// getDesiredImportedRoutes returns the received routes which match the prefix filters and pass the safety checks.
+func (c *Controller) getDesiredImportedRoutes(ctx context.Context) (map[string]importedRoute, error) {
+	c.bgpPolicyStateMutex.RLock()
+	if c.bgpPolicyState == nil || c.bgpPolicyState.importConfig == nil {
+		c.bgpPolicyStateMutex.RUnlock()
+		return nil, nil
+	}
+	bgpServer := c.bgpPolicyState.bgpServer
+	routeImportConfig := c.bgpPolicyState.importConfig
+	c.bgpPolicyStateMutex.RUnlock()
+
+	// The received routes cannot be validated without the reserved addresses.
+	nodeIPs, reservedCIDRs, err := c.getReservedAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	desiredRoutes := make(map[string]importedRoute)
+	var ipv4Routes, ipv6Routes int
+	for _, peerAddress := range routeImportConfig.peerAddresses {
+		routes, err := bgpServer.GetRoutes(ctx, bgp.RouteReceived, peerAddress)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get routes received from BGP peer", "peer", peerAddress)
+			continue
+		}
+		sort.Slice(routes, func(i, j int) bool {
+			return routes[i].Prefix < routes[j].Prefix
+		})
+		for _, r := range routes {
+			_, prefix, err := net.ParseCIDR(r.Prefix)
+			if err != nil {
+				continue
+			}
+			if _, exists := desiredRoutes[prefix.String()]; exists {
+				continue
+			}
+			if !matchesPrefixFilters(prefix, routeImportConfig.prefixFilters) {
+				continue
+			}
+			nextHop := net.ParseIP(r.NextHop)
+			// The BGP peer addresses must stay reachable via the existing routes, otherwise the BGP sessions may be
+			// broken.
+			if err := validateImportedRoute(prefix, nextHop, nodeIPs, routeImportConfig.peerAddresses, reservedCIDRs); err != nil {
+				klog.V(2).InfoS("Ignored unsafe route received from BGP peer", "peer", peerAddress, "prefix", r.Prefix, "nextHop", r.NextHop, "reason", err)
+				continue
+			}
+			routeCount := &ipv4Routes
+			if utilnet.IsIPv6(prefix.IP) {
+				routeCount = &ipv6Routes
+			}
+			if *routeCount >= routeImportConfig.maxRoutes {
+				klog.V(2).InfoS("Ignored route received from BGP peer as the maximum number of imported routes is reached", "peer", peerAddress, "prefix", r.Prefix, "maxRoutes", routeImportConfig.maxRoutes)
+				continue
+			}
+			*routeCount++
+			desiredRoutes[prefix.String()] = importedRoute{gateway: nextHop.String(), toEgressRouteTables: routeImportConfig.toEgressRouteTables}
+		}
+	}
+	return desiredRoutes, nil
+}
+
+// syncImportedRoutes installs the received routes which are desired and deletes the ones which are no longer desired.
+func (c *Controller) syncImportedRoutes(ctx context.Context) {
+	desiredRoutes, err := c.getDesiredImportedRoutes(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get desired imported routes")
+		return
+	}
+
+	// Remove the routes installed before the agent restarted which are no longer desired.
+	if !c.importedRoutesReconciled {
+		var dsts []string
+		for prefix, r := range desiredRoutes {
+			if !r.toEgressRouteTables {
+				dsts = append(dsts, prefix)
+			}
+		}
+		if err := c.routeClient.ReconcileImportedRoutes(dsts); err != nil {
+			klog.ErrorS(err, "Failed to reconcile imported routes")
+			return
+		}
+		c.importedRoutesReconciled = true
+	}
+
+	for prefix := range c.importedRoutes {
+		if _, exists := desiredRoutes[prefix]; exists {
+			continue
+		}
+		_, dst, _ := net.ParseCIDR(prefix)
+		if err := c.routeClient.DeleteImportedRoute(dst); err != nil {
+			klog.ErrorS(err, "Failed to delete imported route", "prefix", prefix)
+			continue
+		}
+		delete(c.importedRoutes, prefix)
+	}
+	for prefix, r := range desiredRoutes {
+		if installed, exists := c.importedRoutes[prefix]; exists && installed == r {
+			continue
+		}
+		_, dst, _ := net.ParseCIDR(prefix)
+		if err := c.routeClient.AddImportedRoute(dst, net.ParseIP(r.gateway), r.toEgressRouteTables); err != nil {
+			klog.ErrorS(err, "Failed to install imported route", "prefix", prefix, "gateway", r.gateway)
+			continue
+		}
+		c.importedRoutes[prefix] = r
+	}
+}
+
 func (c *Controller) hasLocalEndpoints(svc *corev1.Service) bool {
 	labelSelector := labels.Set{discovery.LabelServiceName: svc.GetName()}.AsSelector()
 	items, _ := c.endpointSliceLister.EndpointSlices(svc.GetNamespace()).List(labelSelector)
@@ -712,8 +1274,13 @@ func (c *Controller) getPeerConfigs(peers []v1alpha1.BGPPeer) map[string]bgp.Pee
 			c.enabledIPv6 && utilnet.IsIPv6String(peers[i].Address) {
 			peerKey := generateBGPPeerKey(peers[i].Address, peers[i].ASN)
 
+			// The password is stored under the specified key, or under the peer key by default.
+			passwordKey := peerKey
+			if peers[i].PasswordSecretKey != "" {
+				passwordKey = peers[i].PasswordSecretKey
+			}
 			var password string
-			if p, exists := c.bgpPeerPasswords[peerKey]; exists {
+			if p, exists := c.bgpPeerPasswords[passwordKey]; exists {
 				password = p
 			}
 
@@ -799,13 +1366,18 @@ func (c *Controller) hasAffectedPolicyByService(svc *corev1.Service) bool {
 		if policy.Spec.Advertisements.Service == nil || !c.matchesCurrentNode(policy) {
 			continue
 		}
-		if matchesService(svc, policy) {
+		if matchesService(svc, policy) && c.selectsService(svc, policy.Spec.Advertisements.Service) {
 			return true
 		}
 	}
 	return false
 }
 
+func (c *Controller) selectsService(svc *corev1.Service, advertisement *v1alpha1.ServiceAdvertisement) bool {
+	return labelSelectorAsSelector(advertisement.ServiceSelector).Matches(labels.Set(svc.Labels)) &&
+		c.newNamespaceMatcher(advertisement.NamespaceSelector)(svc.Namespace)
+}
+
 func (c *Controller) addService(obj interface{}) {
 	svc := obj.(*corev1.Service)
 	if c.hasAffectedPolicyByService(svc) {
@@ -822,7 +1394,8 @@ func (c *Controller) updateService(oldObj, obj interface{}) {
 		slices.Equal(oldSvc.Spec.ExternalIPs, svc.Spec.ExternalIPs) &&
 		slices.Equal(getIngressIPs(oldSvc), getIngressIPs(svc)) &&
 		oldSvc.Spec.ExternalTrafficPolicy == svc.Spec.ExternalTrafficPolicy &&
-		ptr.Equal(oldSvc.Spec.InternalTrafficPolicy, svc.Spec.InternalTrafficPolicy) {
+		ptr.Equal(oldSvc.Spec.InternalTrafficPolicy, svc.Spec.InternalTrafficPolicy) &&
+		reflect.DeepEqual(oldSvc.GetLabels(), svc.GetLabels()) {
 		return
 	}
 	if c.hasAffectedPolicyByService(oldSvc) || c.hasAffectedPolicyByService(svc) {
@@ -899,13 +1472,13 @@ func (c *Controller) deleteEndpointSlice(obj interface{}) {
 	}
 }
 
-func (c *Controller) hasAffectedPolicyByEgress() bool {
+func (c *Controller) hasAffectedPolicyByEgress(eg *v1beta1.Egress) bool {
 	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
 	for _, policy := range allPolicies {
-		if !c.matchesCurrentNode(policy) {
+		if policy.Spec.Advertisements.Egress == nil || !c.matchesCurrentNode(policy) {
 			continue
 		}
-		if policy.Spec.Advertisements.Egress != nil {
+		if labelSelectorAsSelector(policy.Spec.Advertisements.Egress.EgressSelector).Matches(labels.Set(eg.Labels)) {
 			return true
 		}
 	}
@@ -917,7 +1490,7 @@ func (c *Controller) addEgress(obj interface{}) {
 	if eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress ADD event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
@@ -929,10 +1502,12 @@ func (c *Controller) updateEgress(oldObj, obj interface{}) {
 	if oldEg.Status.EgressNode != c.nodeName && eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if oldEg.Status.EgressIP == eg.Status.EgressIP && oldEg.Status.EgressNode == eg.Status.EgressNode {
+	if oldEg.Status.EgressIP == eg.Status.EgressIP &&
+		oldEg.Status.EgressNode == eg.Status.EgressNode &&
+		reflect.DeepEqual(oldEg.GetLabels(), eg.GetLabels()) {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(oldEg) || c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress UPDATE event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
@@ -943,12 +1518,100 @@ func (c *Controller) deleteEgress(obj interface{}) {
 	if eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress DELETE event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
 }
 
+func (c *Controller) hasAffectedPolicyByPod(pod *corev1.Pod) bool {
+	if !isPodAdvertisable(pod) {
+		return false
+	}
+	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
+	for _, policy := range allPolicies {
+		advertisement := policy.Spec.Advertisements.Pod
+		// The Pods are irrelevant when the NodeIPAM Pod CIDRs are advertised.
+		if advertisement == nil || !hasPodSelectors(advertisement) || !c.matchesCurrentNode(policy) {
+			continue
+		}
+		if labelSelectorAsSelector(advertisement.PodSelector).Matches(labels.Set(pod.Labels)) &&
+			c.newNamespaceMatcher(advertisement.NamespaceSelector)(pod.Namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) addPod(obj interface{}) {
+	pod := obj.(*corev1.Pod)
+	if c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod ADD event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
+func (c *Controller) updatePod(oldObj, obj interface{}) {
+	oldPod := oldObj.(*corev1.Pod)
+	pod := obj.(*corev1.Pod)
+	if reflect.DeepEqual(oldPod.Status.PodIPs, pod.Status.PodIPs) &&
+		oldPod.Status.Phase == pod.Status.Phase &&
+		reflect.DeepEqual(oldPod.GetLabels(), pod.GetLabels()) {
+		return
+	}
+	if c.hasAffectedPolicyByPod(oldPod) || c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod UPDATE event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
+func (c *Controller) deletePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		pod, ok = deletedState.Obj.(*corev1.Pod)
+		if !ok {
+			return
+		}
+	}
+	if c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod DELETE event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
+// hasAffectedPolicyByNamespace returns whether any BGPPolicy applied to the current Node selects Services or Pods by
+// Namespace labels.
+func (c *Controller) hasAffectedPolicyByNamespace() bool {
+	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
+	for _, policy := range allPolicies {
+		if !c.matchesCurrentNode(policy) {
+			continue
+		}
+		advertisements := policy.Spec.Advertisements
+		if advertisements.Service != nil && advertisements.Service.NamespaceSelector != nil ||
+			advertisements.Pod != nil && advertisements.Pod.NamespaceSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) updateNamespace(oldObj, obj interface{}) {
+	oldNamespace := oldObj.(*corev1.Namespace)
+	namespace := obj.(*corev1.Namespace)
+	if reflect.DeepEqual(oldNamespace.GetLabels(), namespace.GetLabels()) {
+		return
+	}
+	if c.hasAffectedPolicyByNamespace() {
+		klog.V(2).InfoS("Processing Namespace UPDATE event", "Namespace", klog.KObj(namespace))
+		c.queue.Add(dummyKey)
+	}
+}
+
 func (c *Controller) hasAffectedPolicyByNode(node *corev1.Node) bool {
 	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
 	for _, policy := range allPolicies {
@@ -17,6 +17,7 @@ package bgp
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"testing"
 	"time"
@@ -41,6 +42,8 @@ import (
 	"antrea.io/antrea/pkg/agent/bgp"
 	bgptest "antrea.io/antrea/pkg/agent/bgp/testing"
 	"antrea.io/antrea/pkg/agent/config"
+	routetest "antrea.io/antrea/pkg/agent/route/testing"
+	servicecidrtest "antrea.io/antrea/pkg/agent/servicecidr/testing"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -225,6 +228,8 @@ type fakeController struct {
 	*Controller
 	mockController     *gomock.Controller
 	mockBGPServer      *bgptest.MockInterface
+	mockRouteClient    *routetest.MockInterface
+	mockServiceCIDRs   *servicecidrtest.MockInterface
 	crdClient          *fakeversioned.Clientset
 	crdInformerFactory crdinformers.SharedInformerFactory
 	client             *fake.Clientset
@@ -241,6 +246,8 @@ func (c *fakeController) startInformers(stopCh chan struct{}) {
 func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runtime.Object, ipv4Enabled, ipv6Enabled bool) *fakeController {
 	ctrl := gomock.NewController(t)
 	mockBGPServer := bgptest.NewMockInterface(ctrl)
+	mockRouteClient := routetest.NewMockInterface(ctrl)
+	mockServiceCIDRs := servicecidrtest.NewMockInterface(ctrl)
 
 	client := fake.NewSimpleClientset(objects...)
 	crdClient := fakeversioned.NewSimpleClientset(crdObjects...)
@@ -253,14 +260,20 @@ func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runt
 	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
 	endpointSliceInformer := informerFactory.Discovery().V1().EndpointSlices()
 	bgpPolicyInformer := crdInformerFactory.Crd().V1alpha1().BGPPolicies()
+	namespaceInformer := informerFactory.Core().V1().Namespaces()
+	podInformer := informerFactory.Core().V1().Pods().Informer()
 
 	bgpController, _ := NewBGPPolicyController(nodeInformer,
 		serviceInformer,
 		egressInformer,
 		bgpPolicyInformer,
 		endpointSliceInformer,
+		namespaceInformer,
+		podInformer,
 		true,
 		client,
+		mockRouteClient,
+		mockServiceCIDRs,
 		testNodeConfig,
 		&config.NetworkConfig{
 			IPv4Enabled: ipv4Enabled,
@@ -275,6 +288,8 @@ func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runt
 		Controller:         bgpController,
 		mockController:     ctrl,
 		mockBGPServer:      mockBGPServer,
+		mockRouteClient:    mockRouteClient,
+		mockServiceCIDRs:   mockServiceCIDRs,
 		crdClient:          crdClient,
 		crdInformerFactory: crdInformerFactory,
 		client:             client,
@@ -1851,6 +1866,335 @@ func TestEgressLifecycle(t *testing.T) {
 	doneDummyEvent(t, c)
 }
 
+func TestPodLifecycle(t *testing.T) {
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		true,
+		[]v1alpha1.BGPPeer{ipv4Peer1},
+		nil)
+	policy.Spec.Advertisements.Pod.PodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	policy.Spec.Advertisements.Pod.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+	namespace := generateNamespace(namespaceDefault, map[string]string{"env": "prod"})
+	c := newFakeController(t, []runtime.Object{node, namespace}, []runtime.Object{policy}, true, false)
+	mockBGPServer := c.mockBGPServer
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	// Wait for the dummy event triggered by BGPPolicy add events. Since the Pod advertisement has selectors, the
+	// NodeIPAM Pod CIDR is not advertised.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Create a Pod which is not selected and a Pod which is selected.
+	_, err := c.client.CoreV1().Pods(namespaceDefault).Create(context.TODO(), generatePod("db", map[string]string{"app": "db"}, "10.10.0.4", false), metav1.CreateOptions{})
+	require.NoError(t, err)
+	webPod := generatePod("web", map[string]string{"app": "web"}, "10.10.0.5", false)
+	_, err = c.client.CoreV1().Pods(namespaceDefault).Create(context.TODO(), webPod, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// Only the IP of the selected Pod will be advertised.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+	routes, err := c.GetBGPRoutes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[bgp.Route]RouteMetadata{{Prefix: "10.10.0.5/32"}: {Type: PodIP, K8sObjRef: "default/web"}}, routes)
+
+	// Update the labels of the Namespace so that it is no longer selected.
+	namespace = generateNamespace(namespaceDefault, map[string]string{"env": "test"})
+	_, err = c.client.CoreV1().Namespaces().Update(context.TODO(), namespace, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Restore the labels of the Namespace.
+	namespace = generateNamespace(namespaceDefault, map[string]string{"env": "prod"})
+	_, err = c.client.CoreV1().Namespaces().Update(context.TODO(), namespace, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Update the labels of the Pod so that it is no longer selected.
+	webPod = generatePod("web", map[string]string{"app": "web-canary"}, "10.10.0.5", false)
+	_, err = c.client.CoreV1().Pods(namespaceDefault).Update(context.TODO(), webPod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// A hostNetwork Pod is never selected.
+	_, err = c.client.CoreV1().Pods(namespaceDefault).Create(context.TODO(), generatePod("web-host", map[string]string{"app": "web"}, "192.168.77.100", true), metav1.CreateOptions{})
+	require.NoError(t, err)
+	// Restore the labels of the Pod.
+	webPod = generatePod("web", map[string]string{"app": "web"}, "10.10.0.5", false)
+	_, err = c.client.CoreV1().Pods(namespaceDefault).Update(context.TODO(), webPod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Delete the Pod.
+	err = c.client.CoreV1().Pods(namespaceDefault).Delete(context.TODO(), webPod.Name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{{Prefix: "10.10.0.5/32"}})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+}
+
+func TestServiceAndEgressSelectors(t *testing.T) {
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		true,
+		false,
+		false,
+		true,
+		false,
+		[]v1alpha1.BGPPeer{ipv4Peer1},
+		nil)
+	policy.Spec.Advertisements.Service.ServiceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"bgp": "true"}}
+	policy.Spec.Advertisements.Egress.EgressSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"bgp": "true"}}
+	c := newFakeController(t, []runtime.Object{node, ipv4ClusterIP1}, []runtime.Object{policy, ipv4Egress1}, true, false)
+	mockBGPServer := c.mockBGPServer
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	// Neither the Service nor the Egress is selected.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Add the label to the Service.
+	svc := ipv4ClusterIP1.DeepCopy()
+	svc.Labels = map[string]string{"bgp": "true"}
+	_, err := c.client.CoreV1().Services(namespaceDefault).Update(context.TODO(), svc, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{clusterIPv4Route1})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Add the label to the Egress.
+	egress := ipv4Egress1.DeepCopy()
+	egress.Labels = map[string]string{"bgp": "true"}
+	_, err = c.crdClient.CrdV1beta1().Egresses().Update(context.TODO(), egress, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{ipv4EgressIP1Route})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Remove the label from the Service.
+	_, err = c.client.CoreV1().Services(namespaceDefault).Update(context.TODO(), ipv4ClusterIP1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{clusterIPv4Route1})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+}
+
+func TestExportPolicy(t *testing.T) {
+	peer1 := ipv4Peer1
+	peer1.ExportFilter = &v1alpha1.BGPPeerExportFilter{
+		AdvertisementTypes: []v1alpha1.AdvertisementType{v1alpha1.AdvertisementTypeService},
+	}
+	peer1Config := generateBGPPeerConfig(&peer1, peer1AuthPassword)
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		true,
+		false,
+		false,
+		true,
+		false,
+		[]v1alpha1.BGPPeer{peer1, ipv4Peer2},
+		nil)
+	policy.Spec.Advertisements.Service.Communities = []string{"65000:100", "no-export"}
+	policy.Spec.Advertisements.Egress.LocalPreference = ptr.To(int32(200))
+	c := newFakeController(t, []runtime.Object{node, ipv4ClusterIP1}, []runtime.Object{policy, ipv4Egress1}, true, false)
+	mockBGPServer := c.mockBGPServer
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	// The export policy is set before the routes are advertised, so that the Egress IP is never exported to peer1.
+	expectedExportPolicy := &bgp.ExportPolicy{
+		RouteAttributes: []bgp.RouteAttributes{
+			{Prefixes: []string{clusterIPv4Route1.Prefix}, Communities: []string{"65000:100", "no-export"}},
+			{Prefixes: []string{ipv4EgressIP1Route.Prefix}, LocalPreference: ptr.To(uint32(200))},
+		},
+		PeerFilters: map[string][]string{ipv4Peer1Addr: {clusterIPv4Route1.Prefix}},
+	}
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	setExportPolicy := mockBGPServer.EXPECT().SetExportPolicy(gomock.Any(), expectedExportPolicy)
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), peer1Config).After(setExportPolicy)
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer2Config).After(setExportPolicy)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{clusterIPv4Route1}).After(setExportPolicy)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{ipv4EgressIP1Route}).After(setExportPolicy)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+	assert.Equal(t, expectedExportPolicy, c.bgpPolicyState.exportPolicy)
+
+	// Delete the Service. The export policy is updated accordingly.
+	err := c.client.CoreV1().Services(namespaceDefault).Delete(context.TODO(), ipv4ClusterIP1.Name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	expectedExportPolicy = &bgp.ExportPolicy{
+		RouteAttributes: []bgp.RouteAttributes{
+			{Prefixes: []string{ipv4EgressIP1Route.Prefix}, LocalPreference: ptr.To(uint32(200))},
+		},
+		PeerFilters: map[string][]string{ipv4Peer1Addr: nil},
+	}
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().SetExportPolicy(gomock.Any(), expectedExportPolicy)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{clusterIPv4Route1})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Delete the Egress. The route attributes are removed from the export policy as there is no route to customize.
+	err = c.crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), ipv4Egress1.Name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	expectedExportPolicy = &bgp.ExportPolicy{
+		PeerFilters: map[string][]string{ipv4Peer1Addr: nil},
+	}
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().SetExportPolicy(gomock.Any(), expectedExportPolicy)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), []bgp.Route{ipv4EgressIP1Route})
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+}
+
+func TestGetExportPolicy(t *testing.T) {
+	routes := map[bgp.Route]RouteMetadata{
+		clusterIPv4Route1:  {Type: ServiceClusterIP},
+		clusterIPv6Route1:  {Type: ServiceClusterIP},
+		externalIPv4Route1: {Type: ServiceExternalIP},
+		ipv4EgressIP1Route: {Type: EgressIP},
+		podIPv4CIDRRoute:   {Type: NodeIPAMPodCIDR},
+	}
+	testCases := []struct {
+		name           string
+		advertisements v1alpha1.Advertisements
+		peers          []v1alpha1.BGPPeer
+		expected       *bgp.ExportPolicy
+	}{
+		{
+			name: "no route attributes or export filters",
+			advertisements: v1alpha1.Advertisements{
+				Service: &v1alpha1.ServiceAdvertisement{},
+				Pod:     &v1alpha1.PodAdvertisement{},
+			},
+			peers:    []v1alpha1.BGPPeer{ipv4Peer1},
+			expected: nil,
+		},
+		{
+			name: "route attributes",
+			advertisements: v1alpha1.Advertisements{
+				Service: &v1alpha1.ServiceAdvertisement{
+					RouteAttributes: v1alpha1.RouteAttributes{Communities: []string{"65000:100", "65536:100", "no-peer"}},
+				},
+				Pod: &v1alpha1.PodAdvertisement{
+					RouteAttributes: v1alpha1.RouteAttributes{LocalPreference: ptr.To(int32(50))},
+				},
+				Egress: &v1alpha1.EgressAdvertisement{},
+			},
+			expected: &bgp.ExportPolicy{
+				RouteAttributes: []bgp.RouteAttributes{
+					{
+						Prefixes:    []string{clusterIPv4Route1.Prefix, externalIPv4Route1.Prefix, clusterIPv6Route1.Prefix},
+						Communities: []string{"65000:100", "no-peer"},
+					},
+					{
+						Prefixes:        []string{podIPv4CIDRRoute.Prefix},
+						LocalPreference: ptr.To(uint32(50)),
+					},
+				},
+			},
+		},
+		{
+			name: "export filters",
+			peers: []v1alpha1.BGPPeer{
+				ipv4Peer1,
+				{
+					Address: ipv4Peer2Addr,
+					ExportFilter: &v1alpha1.BGPPeerExportFilter{
+						AdvertisementTypes: []v1alpha1.AdvertisementType{v1alpha1.AdvertisementTypeService, v1alpha1.AdvertisementTypePod},
+						CIDRs:              []string{"10.96.0.0/16", "10.10.0.0/16"},
+					},
+				},
+				{
+					Address: ipv4Peer3Addr,
+					ExportFilter: &v1alpha1.BGPPeerExportFilter{
+						CIDRs: []string{"192.168.77.0/24"},
+					},
+				},
+			},
+			expected: &bgp.ExportPolicy{
+				PeerFilters: map[string][]string{
+					ipv4Peer2Addr: {clusterIPv4Route1.Prefix, podIPv4CIDRRoute.Prefix},
+					ipv4Peer3Addr: {externalIPv4Route1.Prefix, ipv4EgressIP1Route.Prefix},
+				},
+			},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getExportPolicy(tt.advertisements, tt.peers, routes))
+		})
+	}
+}
+
 func TestBGPPasswordUpdate(t *testing.T) {
 	policy := generateBGPPolicy(bgpPolicyName1,
 		creationTimestamp,
@@ -1954,6 +2298,27 @@ func TestBGPPasswordUpdate(t *testing.T) {
 	doneDummyEvent(t, c)
 }
 
+func TestGetPeerConfigsWithPasswordSecretKey(t *testing.T) {
+	c := newFakeController(t, nil, nil, true, false)
+	c.bgpPeerPasswords = map[string]string{
+		generateBGPPeerKey(ipv4Peer1Addr, peer1ASN): peer1AuthPassword,
+		"shared-password": peer2AuthPassword,
+	}
+	peer1 := generateBGPPeer(ipv4Peer1Addr, peer1ASN, 179, 120)
+	peer2 := generateBGPPeer(ipv4Peer2Addr, peer2ASN, 179, 120)
+	peer2.PasswordSecretKey = "shared-password"
+	peer3 := generateBGPPeer(ipv4Peer3Addr, peer3ASN, 179, 120)
+	peer3.PasswordSecretKey = "missing-password"
+	peers := []v1alpha1.BGPPeer{peer1, peer2, peer3}
+
+	expected := map[string]bgp.PeerConfig{
+		generateBGPPeerKey(ipv4Peer1Addr, peer1ASN): generateBGPPeerConfig(&peers[0], peer1AuthPassword),
+		generateBGPPeerKey(ipv4Peer2Addr, peer2ASN): generateBGPPeerConfig(&peers[1], peer2AuthPassword),
+		generateBGPPeerKey(ipv4Peer3Addr, peer3ASN): generateBGPPeerConfig(&peers[2], ""),
+	}
+	assert.Equal(t, expected, c.getPeerConfigs(peers))
+}
+
 func TestSyncBGPPolicyFailures(t *testing.T) {
 	policy1 := generateBGPPolicy(bgpPolicyName1,
 		creationTimestamp,
@@ -2166,6 +2531,259 @@ func generateBGPPolicyState(bgpPolicyName string,
 	return state
 }
 
+func TestRouteImport(t *testing.T) {
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		false,
+		[]v1alpha1.BGPPeer{ipv4Peer1, ipv4Peer2, ipv6Peer1},
+		nil)
+	policy.Spec.RouteImport = &v1alpha1.RouteImport{
+		Prefixes: []v1alpha1.PrefixFilter{
+			{CIDR: "10.20.0.0/16", MaxLength: ptr.To(int32(24))},
+			{CIDR: "10.0.0.0/8", MinLength: ptr.To(int32(16)), MaxLength: ptr.To(int32(16))},
+			{CIDR: "192.168.77.0/24", MinLength: ptr.To(int32(24))},
+		},
+		RouteTable: v1alpha1.ImportRouteTableMain,
+		MaxRoutes:  ptr.To(int32(3)),
+	}
+	c := newFakeController(t, []runtime.Object{node}, []runtime.Object{policy}, true, false)
+	mockBGPServer := c.mockBGPServer
+	mockRouteClient := c.mockRouteClient
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	// The IPv6 BGP peer is ignored as IPv6 is not enabled.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer2Config)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+	expectedImportConfig := &importConfig{
+		prefixFilters: []prefixFilter{
+			{cidr: ip.MustParseCIDR("10.20.0.0/16"), minLength: 16, maxLength: 24},
+			{cidr: ip.MustParseCIDR("10.0.0.0/8"), minLength: 16, maxLength: 16},
+			{cidr: ip.MustParseCIDR("192.168.77.0/24"), minLength: 24, maxLength: 32},
+		},
+		peerAddresses: []string{ipv4Peer1Addr, ipv4Peer2Addr},
+		maxRoutes:     3,
+	}
+	assert.Equal(t, expectedImportConfig, c.bgpPolicyState.importConfig)
+
+	// The received routes are not imported until the Service CIDRs are discovered.
+	c.mockServiceCIDRs.EXPECT().GetServiceCIDRs().Return(nil, fmt.Errorf("Service CIDR discoverer is not initialized yet"))
+	c.syncImportedRoutes(ctx)
+	assert.Empty(t, c.importedRoutes)
+	c.mockServiceCIDRs.EXPECT().GetServiceCIDRs().Return([]*net.IPNet{ip.MustParseCIDR("10.96.0.0/16")}, nil).AnyTimes()
+
+	// The routes matching the prefix filters are imported until the maximum number is reached, unless they are unsafe.
+	// The route received from the first BGP peer is preferred when the same prefix is received from multiple peers.
+	mockBGPServer.EXPECT().GetRoutes(gomock.Any(), bgp.RouteReceived, ipv4Peer1Addr).Return([]bgp.Route{
+		{Prefix: "10.20.1.0/24", NextHop: ipv4Peer1Addr},
+		{Prefix: "10.20.0.0/16", NextHop: "192.168.77.1"},
+		// The prefix is too long.
+		{Prefix: "10.20.2.128/25", NextHop: "192.168.77.1"},
+		// The prefix doesn't match any filter.
+		{Prefix: "172.16.0.0/16", NextHop: "192.168.77.1"},
+		// The prefix overlaps with the Pod CIDR.
+		{Prefix: "10.10.0.0/16", NextHop: "192.168.77.1"},
+		// The prefix overlaps with the Service CIDR.
+		{Prefix: "10.96.0.0/16", NextHop: "192.168.77.1"},
+		// The prefix contains the address of another BGP peer.
+		{Prefix: "192.168.77.252/32", NextHop: ipv4Peer1Addr},
+		// The prefix contains the Node IP.
+		{Prefix: "192.168.77.100/32", NextHop: "192.168.77.1"},
+		// The next hop is invalid.
+		{Prefix: "10.20.3.0/24", NextHop: ""},
+		// The next hop is the Node IP.
+		{Prefix: "10.20.4.0/24", NextHop: "192.168.77.100"},
+	}, nil)
+	mockBGPServer.EXPECT().GetRoutes(gomock.Any(), bgp.RouteReceived, ipv4Peer2Addr).Return([]bgp.Route{
+		{Prefix: "10.20.0.0/16", NextHop: "192.168.77.2"},
+		{Prefix: "10.30.0.0/16", NextHop: ipv4Peer2Addr},
+		// The maximum number of imported routes is reached.
+		{Prefix: "10.40.0.0/16", NextHop: "192.168.77.2"},
+	}, nil)
+	mockRouteClient.EXPECT().ReconcileImportedRoutes(gomock.InAnyOrder([]string{"10.20.0.0/16", "10.20.1.0/24", "10.30.0.0/16"}))
+	mockRouteClient.EXPECT().AddImportedRoute(ip.MustParseCIDR("10.20.0.0/16"), net.ParseIP("192.168.77.1"), false)
+	mockRouteClient.EXPECT().AddImportedRoute(ip.MustParseCIDR("10.20.1.0/24"), net.ParseIP(ipv4Peer1Addr), false)
+	mockRouteClient.EXPECT().AddImportedRoute(ip.MustParseCIDR("10.30.0.0/16"), net.ParseIP(ipv4Peer2Addr), false)
+	c.syncImportedRoutes(ctx)
+	assert.Equal(t, map[string]importedRoute{
+		"10.20.0.0/16": {gateway: "192.168.77.1"},
+		"10.20.1.0/24": {gateway: ipv4Peer1Addr},
+		"10.30.0.0/16": {gateway: ipv4Peer2Addr},
+	}, c.importedRoutes)
+
+	// The routes which are no longer received are deleted, and the routes whose next hops change are updated.
+	mockBGPServer.EXPECT().GetRoutes(gomock.Any(), bgp.RouteReceived, ipv4Peer1Addr).Return([]bgp.Route{
+		{Prefix: "10.20.0.0/16", NextHop: "192.168.77.3"},
+	}, nil)
+	mockBGPServer.EXPECT().GetRoutes(gomock.Any(), bgp.RouteReceived, ipv4Peer2Addr).Return(nil, fmt.Errorf("peer not found"))
+	mockRouteClient.EXPECT().DeleteImportedRoute(ip.MustParseCIDR("10.20.1.0/24"))
+	mockRouteClient.EXPECT().DeleteImportedRoute(ip.MustParseCIDR("10.30.0.0/16"))
+	mockRouteClient.EXPECT().AddImportedRoute(ip.MustParseCIDR("10.20.0.0/16"), net.ParseIP("192.168.77.3"), false)
+	c.syncImportedRoutes(ctx)
+	assert.Equal(t, map[string]importedRoute{"10.20.0.0/16": {gateway: "192.168.77.3"}}, c.importedRoutes)
+
+	// Install the routes into the Egress route tables instead.
+	updatedPolicy := policy.DeepCopy()
+	updatedPolicy.Spec.RouteImport.RouteTable = v1alpha1.ImportRouteTableEgress
+	updatedPolicy.Generation += 1
+	_, err := c.crdClient.CrdV1alpha1().BGPPolicies().Update(context.TODO(), updatedPolicy, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	waitAndGetDummyEvent(t, c)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+	mockBGPServer.EXPECT().GetRoutes(gomock.Any(), bgp.RouteReceived, ipv4Peer1Addr).Return([]bgp.Route{
+		{Prefix: "10.20.0.0/16", NextHop: "192.168.77.3"},
+	}, nil)
+	mockBGPServer.EXPECT().GetRoutes(gomock.Any(), bgp.RouteReceived, ipv4Peer2Addr).Return(nil, nil)
+	mockRouteClient.EXPECT().AddImportedRoute(ip.MustParseCIDR("10.20.0.0/16"), net.ParseIP("192.168.77.3"), true)
+	c.syncImportedRoutes(ctx)
+	assert.Equal(t, map[string]importedRoute{"10.20.0.0/16": {gateway: "192.168.77.3", toEgressRouteTables: true}}, c.importedRoutes)
+
+	// Delete the BGPPolicy. All imported routes are deleted.
+	err = c.crdClient.CrdV1alpha1().BGPPolicies().Delete(context.TODO(), policy.Name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Stop(gomock.Any())
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+	mockRouteClient.EXPECT().DeleteImportedRoute(ip.MustParseCIDR("10.20.0.0/16"))
+	c.syncImportedRoutes(ctx)
+	assert.Empty(t, c.importedRoutes)
+}
+
+func TestValidateImportedRoute(t *testing.T) {
+	nodeIPs := sets.New[string]("192.168.77.100", "fec0::192:168:77:100")
+	peerAddresses := []string{ipv4Peer1Addr, ipv6Peer1Addr}
+	reservedCIDRs := []reservedCIDR{
+		{cidr: ip.MustParseCIDR("10.10.0.0/24"), kind: "Pod CIDR"},
+		{cidr: ip.MustParseCIDR("fec0:10:10::/64"), kind: "Pod CIDR"},
+		{cidr: ip.MustParseCIDR("10.96.0.0/16"), kind: "Service CIDR"},
+		{cidr: ip.MustParseCIDR("fec0:10:96::/112"), kind: "Service CIDR"},
+		{cidr: ip.MustParseCIDR("192.168.78.0/24"), kind: "transport subnet"},
+	}
+	testCases := []struct {
+		name          string
+		prefix        string
+		nextHop       string
+		expectedError string
+	}{
+		{
+			name:    "valid IPv4 route",
+			prefix:  "10.20.0.0/16",
+			nextHop: "192.168.77.1",
+		},
+		{
+			name:    "valid IPv6 route",
+			prefix:  "fec0:10:20::/64",
+			nextHop: "fec0::192:168:77:1",
+		},
+		{
+			name:    "next hop is BGP peer",
+			prefix:  "10.20.0.0/16",
+			nextHop: ipv4Peer1Addr,
+		},
+		{
+			name:          "prefix containing BGP peer address",
+			prefix:        "fec0::/64",
+			nextHop:       "fec0:10:20::1",
+			expectedError: "prefix contains Node IP or BGP peer address fec0::",
+		},
+		{
+			name:          "next hop in different IP family",
+			prefix:        "10.20.0.0/16",
+			nextHop:       "fec0::192:168:77:1",
+			expectedError: "invalid next hop",
+		},
+		{
+			name:          "link-local next hop",
+			prefix:        "fec0:10:20::/64",
+			nextHop:       "fe80::1",
+			expectedError: "invalid next hop",
+		},
+		{
+			name:          "prefix containing next hop",
+			prefix:        "192.168.77.0/28",
+			nextHop:       "192.168.77.1",
+			expectedError: "prefix contains the next hop",
+		},
+		{
+			name:          "next hop is Node IP",
+			prefix:        "fec0:10:20::/64",
+			nextHop:       "fec0::192:168:77:100",
+			expectedError: "next hop is a Node IP",
+		},
+		{
+			name:          "default route",
+			prefix:        "0.0.0.0/0",
+			nextHop:       "192.168.77.1",
+			expectedError: "prefix contains the next hop",
+		},
+		{
+			name:          "prefix within Pod CIDR",
+			prefix:        "10.10.0.128/25",
+			nextHop:       "192.168.77.1",
+			expectedError: "prefix overlaps with Pod CIDR 10.10.0.0/24",
+		},
+		{
+			name:          "prefix containing Pod CIDR",
+			prefix:        "fec0:10::/32",
+			nextHop:       "fec0::192:168:77:1",
+			expectedError: "prefix overlaps with Pod CIDR fec0:10:10::/64",
+		},
+		{
+			name:          "prefix within IPv4 Service CIDR",
+			prefix:        "10.96.0.0/24",
+			nextHop:       "192.168.77.1",
+			expectedError: "prefix overlaps with Service CIDR 10.96.0.0/16",
+		},
+		{
+			name:          "prefix equal to IPv6 Service CIDR",
+			prefix:        "fec0:10:96::/112",
+			nextHop:       "fec0::192:168:77:1",
+			expectedError: "prefix overlaps with Service CIDR fec0:10:96::/112",
+		},
+		{
+			name:          "prefix containing Service CIDR",
+			prefix:        "10.64.0.0/10",
+			nextHop:       "192.168.77.1",
+			expectedError: "prefix overlaps with Service CIDR 10.96.0.0/16",
+		},
+		{
+			name:          "prefix within transport subnet",
+			prefix:        "192.168.78.128/25",
+			nextHop:       "192.168.77.1",
+			expectedError: "prefix overlaps with transport subnet 192.168.78.0/24",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateImportedRoute(ip.MustParseCIDR(tt.prefix), net.ParseIP(tt.nextHop), nodeIPs, peerAddresses, reservedCIDRs)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
 func deepCopyBGPPolicyState(in *bgpPolicyState) *bgpPolicyState {
 	peerConfigMap := make(map[string]bgp.PeerConfig)
 	for _, peerConfig := range in.peerConfigs {
@@ -2192,6 +2810,8 @@ func deepCopyBGPPolicyState(in *bgpPolicyState) *bgpPolicyState {
 		confederationConfig: confederationConf,
 		routes:              routes,
 		peerConfigs:         peerConfigMap,
+		exportPolicy:        in.exportPolicy,
+		importConfig:        in.importConfig,
 	}
 }
 
@@ -2205,6 +2825,8 @@ func checkBGPPolicyState(t *testing.T, expected, got *bgpPolicyState) {
 		assert.Equal(t, expected.routes, got.routes)
 		assert.Equal(t, expected.peerConfigs, got.peerConfigs)
 		assert.Equal(t, expected.confederationConfig, got.confederationConfig)
+		assert.Equal(t, expected.exportPolicy, got.exportPolicy)
+		assert.Equal(t, expected.importConfig, got.importConfig)
 	}
 }
 
@@ -2319,6 +2941,34 @@ func generateEgress(name string, ip string, nodeName string) *crdv1b1.Egress {
 	}
 }
 
+func generateNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
+func generatePod(name string, labels map[string]string, podIP string, hostNetwork bool) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespaceDefault,
+			Labels:    labels,
+		},
+		Spec: corev1.PodSpec{
+			NodeName:    localNodeName,
+			HostNetwork: hostNetwork,
+		},
+		Status: corev1.PodStatus{
+			Phase:  corev1.PodRunning,
+			PodIP:  podIP,
+			PodIPs: []corev1.PodIP{{IP: podIP}},
+		},
+	}
+}
+
 func generateNode(name string, labels, annotations map[string]string) *corev1.Node {
 	return &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
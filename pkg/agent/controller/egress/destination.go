@@ -0,0 +1,253 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"net"
+	"slices"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	"antrea.io/antrea/pkg/util/ip"
+)
+
+// egressDestinationRule is the SNAT rule an Egress with destinations applies to its Pods.
+type egressDestinationRule struct {
+	cidrs []*net.IPNet
+	// The creation timestamp of the Egress. It decides the precedence between Egresses with identical CIDRs.
+	creationTimestamp metav1.Time
+	// The SNAT IP of the Egress. For an Egress in ActiveActive mode, it is one of its Egress IPs and is only used to
+	// determine the IP family of the rule.
+	snatIP net.IP
+	// The datapath mark of the SNAT IP. 0 if the SNAT IP is not on the local Node.
+	mark uint32
+	// The ID of the select group of an Egress in ActiveActive mode. 0 if the Egress is in ActiveStandby mode.
+	groupID binding.GroupIDType
+}
+
+func (r *egressDestinationRule) equals(other *egressDestinationRule) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	return slices.EqualFunc(r.cidrs, other.cidrs, ip.IPNetEqual) &&
+		r.creationTimestamp.Equal(&other.creationTimestamp) &&
+		r.snatIP.Equal(other.snatIP) &&
+		r.mark == other.mark &&
+		r.groupID == other.groupID
+}
+
+// podDestinationRules keeps the destination rules of the Egresses applying to a Pod.
+type podDestinationRules struct {
+	// The openflow port for which the rules have been installed. 0 if they have not been installed.
+	ofPort int32
+	// The rules keyed by Egress name.
+	rules map[string]*egressDestinationRule
+}
+
+// hasEgressDestinations returns whether the Egress only applies to the traffic destined for specific destinations.
+func hasEgressDestinations(egress *crdv1b1.Egress) bool {
+	return egress.Spec.Destinations != nil && len(egress.Spec.Destinations.CIDRs) > 0
+}
+
+// newEgressDestinationRule creates the destination rule of an Egress. Invalid CIDRs are ignored as they should have
+// been rejected by the validation webhook.
+func newEgressDestinationRule(egress *crdv1b1.Egress, snatIP net.IP, mark uint32, groupID binding.GroupIDType) *egressDestinationRule {
+	var cidrs []*net.IPNet
+	for _, cidr := range egress.Spec.Destinations.CIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			klog.ErrorS(err, "Ignored invalid destination CIDR", "egress", klog.KObj(egress), "cidr", cidr)
+			continue
+		}
+		cidrs = append(cidrs, ipNet)
+	}
+	return &egressDestinationRule{
+		cidrs:             cidrs,
+		creationTimestamp: egress.CreationTimestamp,
+		snatIP:            snatIP,
+		mark:              mark,
+		groupID:           groupID,
+	}
+}
+
+// syncEgressDestinationPods applies the destination rule of an Egress with destinations to its desired Pods, and
+// removes it from the stale Pods. Unlike Egresses without destinations, an Egress with destinations doesn't compete
+// with other Egresses for a Pod. Instead, the rules of all Egresses with destinations applying to a Pod are merged
+// when installing the Pod's flows.
+func (c *EgressController) syncEgressDestinationPods(egressName string, eState *egressState, rule *egressDestinationRule) error {
+	stalePods := eState.pods.Union(nil)
+	for pod := range c.getEgressGroupPods(egressName) {
+		stalePods.Delete(pod)
+		if err := c.updatePodDestinationRule(pod, egressName, rule); err != nil {
+			return err
+		}
+		eState.pods.Insert(pod)
+	}
+	return c.uninstallDestinationPodFlows(egressName, eState, stalePods)
+}
+
+// uninstallDestinationPodFlows removes the destination rule of an Egress with destinations from the provided Pods.
+func (c *EgressController) uninstallDestinationPodFlows(egressName string, eState *egressState, pods sets.Set[string]) error {
+	for pod := range pods {
+		if err := c.updatePodDestinationRule(pod, egressName, nil); err != nil {
+			return err
+		}
+		eState.pods.Delete(pod)
+	}
+	return nil
+}
+
+// updatePodDestinationRule sets the destination rule of an Egress for a Pod, or removes it if rule is nil, and
+// reinstalls the Pod's destination flows if the rules or the Pod's openflow port change.
+func (c *EgressController) updatePodDestinationRule(pod, egressName string, rule *egressDestinationRule) error {
+	c.podDestinationRulesMutex.Lock()
+	defer c.podDestinationRulesMutex.Unlock()
+
+	podRules, exists := c.podDestinationRules[pod]
+	if !exists {
+		if rule == nil {
+			return nil
+		}
+		podRules = &podDestinationRules{rules: map[string]*egressDestinationRule{}}
+		c.podDestinationRules[pod] = podRules
+	}
+
+	var ofPort int32
+	parts := strings.Split(pod, "/")
+	podNamespace, podName := parts[0], parts[1]
+	if ifaces := c.ifaceStore.GetContainerInterfacesByPod(podName, podNamespace); len(ifaces) > 0 {
+		ofPort = ifaces[0].OFPort
+	}
+	if rule.equals(podRules.rules[egressName]) && ofPort == podRules.ofPort {
+		return nil
+	}
+	if rule == nil {
+		delete(podRules.rules, egressName)
+	} else {
+		podRules.rules[egressName] = rule
+	}
+
+	// Uninstall the flows if the Pod has no rule or its openflow port changes.
+	if podRules.ofPort != 0 && (len(podRules.rules) == 0 || podRules.ofPort != ofPort) {
+		if err := c.ofClient.UninstallPodSNATDestinationFlows(uint32(podRules.ofPort)); err != nil {
+			return err
+		}
+		podRules.ofPort = 0
+	}
+	if len(podRules.rules) == 0 {
+		delete(c.podDestinationRules, pod)
+		return nil
+	}
+	if ofPort == 0 {
+		klog.InfoS("Interfaces of Pod not found", "pod", pod)
+		return nil
+	}
+	if err := c.ofClient.InstallPodSNATDestinationFlows(uint32(ofPort), resolvePodDestinationRules(podRules.rules)); err != nil {
+		// Reset the openflow port to make sure the flows will be reinstalled when the Egress is resynced.
+		podRules.ofPort = 0
+		return err
+	}
+	podRules.ofPort = ofPort
+	return nil
+}
+
+// getPodDestinationEgresses returns the Egresses with destinations applying to the Pod.
+func (c *EgressController) getPodDestinationEgresses(pod string) []string {
+	c.podDestinationRulesMutex.Lock()
+	defer c.podDestinationRulesMutex.Unlock()
+	podRules, exists := c.podDestinationRules[pod]
+	if !exists {
+		return nil
+	}
+	egresses := make([]string, 0, len(podRules.rules))
+	for egress := range podRules.rules {
+		egresses = append(egresses, egress)
+	}
+	return egresses
+}
+
+// resolvePodDestinationRules converts the destination rules of the Egresses applying to a Pod into non-overlapping
+// PodSNATDestinationRules. When the CIDRs of multiple Egresses overlap, the longest prefix match takes precedence,
+// which is realized by excluding the more specific CIDRs from the less specific ones. For identical CIDRs, the Egress
+// created earlier takes precedence, and the Egress name is used as the tie-breaker.
+func resolvePodDestinationRules(rules map[string]*egressDestinationRule) []openflow.PodSNATDestinationRule {
+	egressNames := make([]string, 0, len(rules))
+	for egressName := range rules {
+		egressNames = append(egressNames, egressName)
+	}
+	sort.Slice(egressNames, func(i, j int) bool {
+		ruleI, ruleJ := rules[egressNames[i]], rules[egressNames[j]]
+		if !ruleI.creationTimestamp.Equal(&ruleJ.creationTimestamp) {
+			return ruleI.creationTimestamp.Before(&ruleJ.creationTimestamp)
+		}
+		return egressNames[i] < egressNames[j]
+	})
+
+	type destination struct {
+		cidr *net.IPNet
+		rule *egressDestinationRule
+	}
+	var destinations []destination
+	for _, egressName := range egressNames {
+		rule := rules[egressName]
+		isIPv6 := utilnet.IsIPv6(rule.snatIP)
+		for _, cidr := range rule.cidrs {
+			// The traffic can only be SNAT'd with an IP of the same IP family.
+			if utilnet.IsIPv6CIDR(cidr) != isIPv6 {
+				continue
+			}
+			// Skip the CIDR if it has been claimed by an Egress with higher precedence.
+			if slices.ContainsFunc(destinations, func(d destination) bool { return ip.IPNetEqual(d.cidr, cidr) }) {
+				continue
+			}
+			destinations = append(destinations, destination{cidr: cidr, rule: rule})
+		}
+	}
+
+	var snatRules []openflow.PodSNATDestinationRule
+	for _, d := range destinations {
+		var moreSpecificCIDRs []*net.IPNet
+		for _, other := range destinations {
+			if ip.IPNetContains(d.cidr, other.cidr) && !ip.IPNetEqual(d.cidr, other.cidr) {
+				moreSpecificCIDRs = append(moreSpecificCIDRs, other.cidr)
+			}
+		}
+		cidrs := []*net.IPNet{d.cidr}
+		if len(moreSpecificCIDRs) > 0 {
+			var err error
+			if cidrs, err = ip.DiffFromCIDRs(d.cidr, moreSpecificCIDRs); err != nil {
+				klog.ErrorS(err, "Failed to exclude more specific CIDRs", "cidr", d.cidr)
+				continue
+			}
+		}
+		for _, cidr := range cidrs {
+			snatRules = append(snatRules, openflow.PodSNATDestinationRule{
+				DstCIDR:  *cidr,
+				SNATIP:   d.rule.snatIP,
+				SNATMark: d.rule.mark,
+				GroupID:  d.rule.groupID,
+			})
+		}
+	}
+	return snatRules
+}
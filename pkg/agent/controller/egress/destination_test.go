@@ -0,0 +1,238 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	cpv1b2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/util/ip"
+)
+
+func TestResolvePodDestinationRules(t *testing.T) {
+	t1 := metav1.NewTime(time.Unix(1000, 0))
+	t2 := metav1.NewTime(time.Unix(2000, 0))
+	snatIP1 := net.ParseIP("1.1.1.1")
+	snatIP2 := net.ParseIP("1.1.1.2")
+	snatIPv6 := net.ParseIP("2021::1")
+	tests := []struct {
+		name          string
+		rules         map[string]*egressDestinationRule
+		expectedRules []openflow.PodSNATDestinationRule
+	}{
+		{
+			name: "non-overlapping CIDRs",
+			rules: map[string]*egressDestinationRule{
+				"egressA": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.1.0.0/16")}, creationTimestamp: t1, snatIP: snatIP1, mark: 1},
+				"egressB": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.2.0.0/16")}, creationTimestamp: t2, snatIP: snatIP2},
+			},
+			expectedRules: []openflow.PodSNATDestinationRule{
+				{DstCIDR: *ip.MustParseCIDR("10.1.0.0/16"), SNATIP: snatIP1, SNATMark: 1},
+				{DstCIDR: *ip.MustParseCIDR("10.2.0.0/16"), SNATIP: snatIP2},
+			},
+		},
+		{
+			name: "longest prefix takes precedence",
+			rules: map[string]*egressDestinationRule{
+				"egressA": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.0.0.0/14")}, creationTimestamp: t1, snatIP: snatIP1, mark: 1},
+				"egressB": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.1.0.0/16")}, creationTimestamp: t2, snatIP: snatIP2, mark: 2},
+			},
+			expectedRules: []openflow.PodSNATDestinationRule{
+				{DstCIDR: *ip.MustParseCIDR("10.0.0.0/16"), SNATIP: snatIP1, SNATMark: 1},
+				{DstCIDR: *ip.MustParseCIDR("10.2.0.0/15"), SNATIP: snatIP1, SNATMark: 1},
+				{DstCIDR: *ip.MustParseCIDR("10.1.0.0/16"), SNATIP: snatIP2, SNATMark: 2},
+			},
+		},
+		{
+			name: "earlier Egress takes precedence for identical CIDRs",
+			rules: map[string]*egressDestinationRule{
+				"egressA": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.1.0.0/16")}, creationTimestamp: t2, snatIP: snatIP1, mark: 1},
+				"egressB": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.1.0.0/16"), ip.MustParseCIDR("10.2.0.0/16")}, creationTimestamp: t1, snatIP: snatIP2, mark: 2},
+			},
+			expectedRules: []openflow.PodSNATDestinationRule{
+				{DstCIDR: *ip.MustParseCIDR("10.1.0.0/16"), SNATIP: snatIP2, SNATMark: 2},
+				{DstCIDR: *ip.MustParseCIDR("10.2.0.0/16"), SNATIP: snatIP2, SNATMark: 2},
+			},
+		},
+		{
+			name: "Egress name breaks the tie of creation timestamps",
+			rules: map[string]*egressDestinationRule{
+				"egressB": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.1.0.0/16")}, creationTimestamp: t1, snatIP: snatIP2, mark: 2},
+				"egressA": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.1.0.0/16")}, creationTimestamp: t1, snatIP: snatIP1, mark: 1},
+			},
+			expectedRules: []openflow.PodSNATDestinationRule{
+				{DstCIDR: *ip.MustParseCIDR("10.1.0.0/16"), SNATIP: snatIP1, SNATMark: 1},
+			},
+		},
+		{
+			name: "CIDRs of a different IP family are ignored",
+			rules: map[string]*egressDestinationRule{
+				"egressA": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.1.0.0/16"), ip.MustParseCIDR("2021:1::/64")}, creationTimestamp: t1, snatIP: snatIP1, mark: 1},
+				"egressB": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.2.0.0/16"), ip.MustParseCIDR("2021:2::/64")}, creationTimestamp: t2, snatIP: snatIPv6},
+			},
+			expectedRules: []openflow.PodSNATDestinationRule{
+				{DstCIDR: *ip.MustParseCIDR("10.1.0.0/16"), SNATIP: snatIP1, SNATMark: 1},
+				{DstCIDR: *ip.MustParseCIDR("2021:2::/64"), SNATIP: snatIPv6},
+			},
+		},
+		{
+			name: "ActiveActive Egress",
+			rules: map[string]*egressDestinationRule{
+				"egressA": {cidrs: []*net.IPNet{ip.MustParseCIDR("10.1.0.0/16")}, creationTimestamp: t1, snatIP: snatIP1, groupID: 10},
+			},
+			expectedRules: []openflow.PodSNATDestinationRule{
+				{DstCIDR: *ip.MustParseCIDR("10.1.0.0/16"), SNATIP: snatIP1, GroupID: 10},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tt.expectedRules, resolvePodDestinationRules(tt.rules))
+		})
+	}
+}
+
+func TestSyncEgressWithDestinations(t *testing.T) {
+	// egressA applies to all destinations.
+	egressA := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", CreationTimestamp: metav1.NewTime(time.Unix(1000, 0))},
+		Spec:       crdv1b1.EgressSpec{EgressIP: fakeLocalEgressIP1},
+	}
+	egressGroupA := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+			{Pod: &cpv1b2.PodReference{Name: "pod2", Namespace: "ns2"}},
+		},
+	}
+	// egressB applies to 10.0.0.0/8 and its IP is on a remote Node.
+	egressB := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB", CreationTimestamp: metav1.NewTime(time.Unix(2000, 0))},
+		Spec: crdv1b1.EgressSpec{
+			EgressIP:     fakeRemoteEgressIP1,
+			Destinations: &crdv1b1.EgressDestinations{CIDRs: []string{"10.0.0.0/8"}},
+		},
+	}
+	egressGroupB := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+		},
+	}
+	// egressC applies to 10.0.0.0/14, which is more specific than egressB's CIDR.
+	egressC := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressC", UID: "uidC", CreationTimestamp: metav1.NewTime(time.Unix(3000, 0))},
+		Spec: crdv1b1.EgressSpec{
+			EgressIP:     fakeLocalEgressIP2,
+			Destinations: &crdv1b1.EgressDestinations{CIDRs: []string{"10.0.0.0/14"}},
+		},
+	}
+	egressGroupC := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressC", UID: "uidC"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+			{Pod: &cpv1b2.PodReference{Name: "pod2", Namespace: "ns2"}},
+		},
+	}
+	c := newFakeController(t, []runtime.Object{egressA, egressB, egressC})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	c.addEgressGroup(egressGroupA)
+	c.addEgressGroup(egressGroupB)
+	c.addEgressGroup(egressGroupC)
+
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATFlows(uint32(2), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+	require.NoError(t, c.syncEgress(egressA.Name))
+
+	// egressB doesn't compete with egressA for pod1, its destination flows are installed in addition.
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(uint32(1), []openflow.PodSNATDestinationRule{
+		{DstCIDR: *ip.MustParseCIDR("10.0.0.0/8"), SNATIP: net.ParseIP(fakeRemoteEgressIP1)},
+	})
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	require.NoError(t, c.syncEgress(egressB.Name))
+
+	// 10.0.0.0/14 is excluded from egressB's CIDR for pod1.
+	var gotRules []openflow.PodSNATDestinationRule
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP2), uint32(2))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP2), uint32(2))
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(uint32(1), gomock.Any()).Do(func(_ uint32, rules []openflow.PodSNATDestinationRule) {
+		gotRules = rules
+	})
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(uint32(2), []openflow.PodSNATDestinationRule{
+		{DstCIDR: *ip.MustParseCIDR("10.0.0.0/14"), SNATIP: net.ParseIP(fakeLocalEgressIP2), SNATMark: 2},
+	})
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP2)
+	require.NoError(t, c.syncEgress(egressC.Name))
+	var expectedRules []openflow.PodSNATDestinationRule
+	for _, cidr := range []string{"10.4.0.0/14", "10.8.0.0/13", "10.16.0.0/12", "10.32.0.0/11", "10.64.0.0/10", "10.128.0.0/9"} {
+		expectedRules = append(expectedRules, openflow.PodSNATDestinationRule{DstCIDR: *ip.MustParseCIDR(cidr), SNATIP: net.ParseIP(fakeRemoteEgressIP1)})
+	}
+	expectedRules = append(expectedRules, openflow.PodSNATDestinationRule{DstCIDR: *ip.MustParseCIDR("10.0.0.0/14"), SNATIP: net.ParseIP(fakeLocalEgressIP2), SNATMark: 2})
+	assert.ElementsMatch(t, expectedRules, gotRules)
+
+	// Deleting egressB should only leave egressC's rule for pod1.
+	c.crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), egressB.Name, metav1.DeleteOptions{})
+	assert.Eventually(t, func() bool {
+		_, err := c.egressLister.Get(egressB.Name)
+		return err != nil
+	}, time.Second, time.Millisecond*100)
+	c.mockOFClient.EXPECT().InstallPodSNATDestinationFlows(uint32(1), []openflow.PodSNATDestinationRule{
+		{DstCIDR: *ip.MustParseCIDR("10.0.0.0/14"), SNATIP: net.ParseIP(fakeLocalEgressIP2), SNATMark: 2},
+	})
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+	require.NoError(t, c.syncEgress(egressB.Name))
+
+	// Removing egressC's destinations should make it compete with egressA for the Pods.
+	updatedEgressC := egressC.DeepCopy()
+	updatedEgressC.Spec.Destinations = nil
+	c.crdClient.CrdV1beta1().Egresses().Update(context.TODO(), updatedEgressC, metav1.UpdateOptions{})
+	assert.Eventually(t, func() bool {
+		egress, _ := c.egressLister.Get(egressC.Name)
+		return egress != nil && egress.Spec.Destinations == nil
+	}, time.Second, time.Millisecond*100)
+	c.mockOFClient.EXPECT().UninstallPodSNATDestinationFlows(uint32(1))
+	c.mockOFClient.EXPECT().UninstallPodSNATDestinationFlows(uint32(2))
+	c.mockOFClient.EXPECT().UninstallSNATMarkFlows(uint32(2))
+	c.mockRouteClient.EXPECT().DeleteSNATRule(uint32(2))
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP2), uint32(2))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP2), uint32(2))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP2).Times(2)
+	require.NoError(t, c.syncEgress(egressC.Name))
+
+	assert.Empty(t, c.podDestinationRules)
+	eState, exists := c.getEgressState(egressC.Name)
+	require.True(t, exists)
+	assert.False(t, eState.withDestinations)
+	assert.Equal(t, "egressA", c.egressBindings["ns1/pod1"].effectiveEgress)
+}
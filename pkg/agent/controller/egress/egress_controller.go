@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -57,6 +58,7 @@ import (
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/controller/metrics"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -102,6 +104,25 @@ type egressState struct {
 	pods sets.Set[string]
 	// Rate-limit of this Egress.
 	rateLimitMeter *rateLimitMeter
+	// Whether the Egress only applies to the traffic destined for specific destinations. If true, the Egress is
+	// realized with destination rules merged per Pod, and ofPorts is not used.
+	withDestinations bool
+
+	// Whether the Egress is realized in ActiveActive mode. The following fields are only used in this mode.
+	activeActive bool
+	// The Egress IPs that have been realized for the Egress.
+	egressIPs sets.Set[string]
+	// The ID of the select group distributing the Egress's connections across its Egress IPs. 0 if not allocated.
+	groupID binding.GroupIDType
+	// The buckets the select group has been installed with.
+	groupBuckets []egressIPBucket
+}
+
+// egressIPBucket is a bucket of the select group of an ActiveActive Egress. mark is 0 if the Egress IP is not on the
+// local Node.
+type egressIPBucket struct {
+	ip   string
+	mark uint32
 }
 
 type rateLimitMeter struct {
@@ -171,6 +192,8 @@ type EgressController struct {
 	ifaceStore      interfacestore.InterfaceStore
 	nodeName        string
 	markAllocator   *idAllocator
+	// Used to allocate the select group IDs of ActiveActive Egresses.
+	groupIDAllocator openflow.GroupAllocator
 
 	egressGroups      map[string]sets.Set[string]
 	egressGroupsMutex sync.RWMutex
@@ -178,6 +201,10 @@ type EgressController struct {
 	egressBindings      map[string]*egressBinding
 	egressBindingsMutex sync.RWMutex
 
+	// The destination rules of the Egresses with destinations applying to each Pod.
+	podDestinationRules      map[string]*podDestinationRules
+	podDestinationRulesMutex sync.Mutex
+
 	egressStates map[string]*egressState
 	// The mutex is to protect the map, not the egressState items. The workqueue guarantees an Egress will only be
 	// processed by a single worker at any time. So the returned EgressState has no race condition.
@@ -229,6 +256,7 @@ func NewEgressController(
 	trafficShapingEnabled bool,
 	supportSeparateSubnet bool,
 	linkMonitor linkmonitor.Interface,
+	groupIDAllocator openflow.GroupAllocator,
 ) (*EgressController, error) {
 	if trafficShapingEnabled && !openflow.OVSMetersAreSupported() {
 		klog.Info("EgressTrafficShaping feature gate is enabled, but it is ignored because OVS meters are not supported.")
@@ -261,8 +289,10 @@ func NewEgressController(
 		egressStates:         map[string]*egressState{},
 		egressIPStates:       map[string]*egressIPState{},
 		egressBindings:       map[string]*egressBinding{},
+		podDestinationRules:  map[string]*podDestinationRules{},
 		localIPDetector:      ipassigner.NewLocalIPDetector(),
 		markAllocator:        newIDAllocator(minEgressMark, maxEgressMark),
+		groupIDAllocator:     groupIDAllocator,
 		cluster:              cluster,
 		serviceCIDRInterface: serviceCIDRInterface,
 		// One buffer is enough as we just use it to ensure the target handler is executed once.
@@ -395,12 +425,15 @@ func (c *EgressController) updateServiceCIDRs(stopCh <-chan struct{}) {
 }
 
 // processPodUpdate will be called when CNIServer publishes a Pod update event.
-// It triggers reconciling the effective Egress of the Pod.
+// It triggers reconciling the effective Egress and the Egresses with destinations of the Pod.
 func (c *EgressController) processPodUpdate(e interface{}) {
-	c.egressBindingsMutex.Lock()
-	defer c.egressBindingsMutex.Unlock()
 	podEvent := e.(types.PodUpdate)
 	pod := k8s.NamespacedName(podEvent.PodNamespace, podEvent.PodName)
+	for _, egress := range c.getPodDestinationEgresses(pod) {
+		c.queue.Add(egress)
+	}
+	c.egressBindingsMutex.Lock()
+	defer c.egressBindingsMutex.Unlock()
 	binding, exists := c.egressBindings[pod]
 	if !exists {
 		return
@@ -411,7 +444,7 @@ func (c *EgressController) processPodUpdate(e interface{}) {
 // addEgress processes Egress ADD events.
 func (c *EgressController) addEgress(obj interface{}) {
 	egress := obj.(*crdv1b1.Egress)
-	if egress.Spec.EgressIP == "" {
+	if egress.Spec.EgressIP == "" && len(egress.Spec.EgressIPs) == 0 {
 		return
 	}
 	c.queue.Add(egress.Name)
@@ -426,6 +459,10 @@ func (c *EgressController) updateEgress(old, cur interface{}) {
 	if curEgress.Status.EgressNode == c.nodeName && oldEgress.GetGeneration() == curEgress.GetGeneration() {
 		return
 	}
+	// Ignore handling the Egress Status change of ActiveActive Egresses as their realization doesn't depend on it.
+	if isActiveActiveEgress(curEgress) && oldEgress.GetGeneration() == curEgress.GetGeneration() {
+		return
+	}
 	c.queue.Add(curEgress.Name)
 	klog.V(2).InfoS("Processed Egress UPDATE event", "egress", klog.KObj(curEgress))
 }
@@ -538,6 +575,27 @@ func (c *EgressController) replaceEgressIPs() error {
 	desiredLocalEgressIPs := map[string]*crdv1b1.SubnetInfo{}
 	egresses, _ := c.egressLister.List(labels.Everything())
 	for _, egress := range egresses {
+		if isActiveActiveEgress(egress) {
+			var eState *egressState
+			for _, ipStatus := range egress.Status.EgressIPs {
+				if ipStatus.EgressNode != c.nodeName {
+					continue
+				}
+				pool, err := c.getExternalIPPoolOfEgressIP(egress, ipStatus.EgressIP)
+				// Ignore the Egress IP if the ExternalIPPool doesn't exist.
+				if err != nil {
+					continue
+				}
+				desiredLocalEgressIPs[ipStatus.EgressIP] = pool.Spec.SubnetInfo
+				// Record the Egress IPs in the Egress's state to make sure they will be unassigned when the Egress is
+				// deleted.
+				if eState == nil {
+					eState = c.newActiveActiveEgressState(egress.Name)
+				}
+				eState.egressIPs.Insert(ipStatus.EgressIP)
+			}
+			continue
+		}
 		if isEgressSchedulable(egress) && egress.Status.EgressNode == c.nodeName && egress.Status.EgressIP != "" {
 			pool, err := c.externalIPPoolLister.Get(egress.Spec.ExternalIPPool)
 			// Ignore the Egress if the ExternalIPPool doesn't exist.
@@ -842,6 +900,19 @@ func (c *EgressController) deleteEgressState(egressName string) {
 	delete(c.egressStates, egressName)
 }
 
+func (c *EgressController) newActiveActiveEgressState(egressName string) *egressState {
+	c.egressStatesMutex.Lock()
+	defer c.egressStatesMutex.Unlock()
+	state := &egressState{
+		activeActive: true,
+		egressIPs:    sets.New[string](),
+		ofPorts:      sets.New[int32](),
+		pods:         sets.New[string](),
+	}
+	c.egressStates[egressName] = state
+	return state
+}
+
 func (c *EgressController) newEgressState(egressName string, egressIP string) *egressState {
 	c.egressStatesMutex.Lock()
 	defer c.egressStatesMutex.Unlock()
@@ -954,7 +1025,54 @@ func (c *EgressController) updateEgressStatus(egress *crdv1b1.Egress, egressIP s
 		// The Egress IP is assigned to a Node (egressIP != "") but it's not this Node (isLocal == false), do nothing.
 		return nil
 	}
+	return c.updateEgressStatusIfChanged(egress, desiredStatus)
+}
+
+// updateActiveActiveEgressStatus updates the status of an ActiveActive Egress with the Nodes its Egress IPs are
+// assigned to. As the Egress IPs are spread across Nodes, a single Node is selected to update the status to avoid
+// conflicts.
+func (c *EgressController) updateActiveActiveEgressStatus(egress *crdv1b1.Egress, assignments []egressIPAssignment, scheduleErr error) error {
+	nodeToUpdateStatus, err := c.cluster.SelectNodeForIP(egress.Name, "")
+	if err != nil {
+		return err
+	}
+	// Skip if the Node is not the selected one.
+	if nodeToUpdateStatus != c.nodeName {
+		return nil
+	}
+	desiredStatus := &crdv1b1.EgressStatus{}
+	for _, assignment := range assignments {
+		desiredStatus.EgressIPs = append(desiredStatus.EgressIPs, crdv1b1.EgressIPStatus{
+			EgressIP:   assignment.ip,
+			EgressNode: assignment.node,
+		})
+	}
+	if scheduleErr != nil {
+		desiredStatus.Conditions = []crdv1b1.EgressCondition{
+			{
+				Type:               crdv1b1.IPAssigned,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: metav1.Now(),
+				Reason:             "AssignmentError",
+				Message:            fmt.Sprintf("Failed to assign some EgressIPs to EgressNodes: %v", scheduleErr),
+			},
+		}
+	} else if len(assignments) > 0 {
+		desiredStatus.Conditions = []crdv1b1.EgressCondition{
+			{
+				Type:               crdv1b1.IPAssigned,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.Now(),
+				Reason:             "Assigned",
+				Message:            "EgressIPs are successfully assigned to EgressNodes",
+			},
+		}
+	}
+	return c.updateEgressStatusIfChanged(egress, desiredStatus)
+}
 
+// updateEgressStatusIfChanged updates the status of the Egress to desiredStatus if they are different.
+func (c *EgressController) updateEgressStatusIfChanged(egress *crdv1b1.Egress, desiredStatus *crdv1b1.EgressStatus) error {
 	toUpdate := egress.DeepCopy()
 	var updateErr, getErr error
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -1012,6 +1130,10 @@ func (c *EgressController) syncEgress(egressName string) error {
 		return err
 	}
 
+	if isActiveActiveEgress(egress) {
+		return c.syncActiveActiveEgress(egress)
+	}
+
 	var desiredEgressIP string
 	var desiredNode string
 	var scheduleErr error
@@ -1030,8 +1152,9 @@ func (c *EgressController) syncEgress(egressName string) error {
 	}
 
 	eState, exist := c.getEgressState(egressName)
-	// If the EgressIP changes, uninstalls this Egress first.
-	if exist && eState.egressIP != desiredEgressIP {
+	// If the EgressIP changes, the Egress was realized in ActiveActive mode, or its destinations are added or removed,
+	// uninstalls this Egress first.
+	if exist && (eState.activeActive || eState.egressIP != desiredEgressIP || eState.withDestinations != hasEgressDestinations(egress)) {
 		if err := c.uninstallEgress(egressName, eState, egress); err != nil {
 			return err
 		}
@@ -1046,6 +1169,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 	}
 	if !exist {
 		eState = c.newEgressState(egressName, desiredEgressIP)
+		eState.withDestinations = hasEgressDestinations(egress)
 	}
 
 	var subnetInfo *crdv1b1.SubnetInfo
@@ -1102,24 +1226,144 @@ func (c *EgressController) syncEgress(egressName string) error {
 		return fmt.Errorf("update Egress %s status error: %v", egressName, err)
 	}
 
+	egressIP := net.ParseIP(eState.egressIP)
+	if eState.withDestinations {
+		return c.syncEgressDestinationPods(egressName, eState, newEgressDestinationRule(egress, egressIP, mark, 0))
+	}
+	return c.syncEgressPods(egressName, eState, func(ofPort uint32) error {
+		return c.ofClient.InstallPodSNATFlows(ofPort, egressIP, mark)
+	})
+}
+
+// syncActiveActiveEgress realizes an Egress in ActiveActive mode. Each Egress IP is assigned to the Node selected by
+// egressIPScheduler independently, and the connections of the Egress's local Pods are distributed across all assigned
+// Egress IPs by a select group.
+func (c *EgressController) syncActiveActiveEgress(egress *crdv1b1.Egress) error {
+	egressName := egress.Name
+	assignments, scheduleErr, _ := c.egressIPScheduler.GetEgressIPAssignments(egressName)
+
+	eState, exist := c.getEgressState(egressName)
+	// If the Egress was realized in ActiveStandby mode or its destinations are added or removed, uninstalls this Egress
+	// first.
+	if exist && (!eState.activeActive || eState.withDestinations != hasEgressDestinations(egress)) {
+		if err := c.uninstallEgress(egressName, eState, egress); err != nil {
+			return err
+		}
+		exist = false
+	}
+	if !exist {
+		eState = c.newActiveActiveEgressState(egressName)
+		eState.withDestinations = hasEgressDestinations(egress)
+	}
+
+	previousNodes := map[string]string{}
+	for _, ipStatus := range egress.Status.EgressIPs {
+		previousNodes[ipStatus.EgressIP] = ipStatus.EgressNode
+	}
+	desiredEgressIPs := sets.New[string]()
+	var groupBuckets []egressIPBucket
+	for _, assignment := range assignments {
+		var subnetInfo *crdv1b1.SubnetInfo
+		if assignment.node == c.nodeName {
+			if c.supportSeparateSubnet {
+				pool, err := c.getExternalIPPoolOfEgressIP(egress, assignment.ip)
+				if err != nil {
+					return err
+				}
+				subnetInfo = pool.Spec.SubnetInfo
+			}
+			// Force advertising the IP if it was previously assigned to another Node, like ActiveStandby mode does.
+			assigned, err := c.ipAssigner.AssignIP(assignment.ip, subnetInfo, previousNodes[assignment.ip] != c.nodeName)
+			if err != nil {
+				return err
+			}
+			if assigned {
+				c.record.Eventf(egress, corev1.EventTypeNormal, "IPAssigned", "Assigned Egress %s with IP %s on Node %s", egressName, assignment.ip, assignment.node)
+			}
+		} else {
+			unassigned, err := c.ipAssigner.UnassignIP(assignment.ip)
+			if err != nil {
+				return err
+			}
+			if unassigned {
+				c.record.Eventf(egress, corev1.EventTypeNormal, "IPUnassigned", "Unassigned Egress %s with IP %s from Node %s", egressName, assignment.ip, c.nodeName)
+			}
+		}
+		mark, err := c.realizeEgressIP(egressName, assignment.ip, subnetInfo)
+		if err != nil {
+			return err
+		}
+		eState.egressIPs.Insert(assignment.ip)
+		desiredEgressIPs.Insert(assignment.ip)
+		groupBuckets = append(groupBuckets, egressIPBucket{ip: assignment.ip, mark: mark})
+	}
+
+	// Unrealize the Egress IPs that are removed from the Egress or can't be assigned to any Node.
+	for egressIP := range eState.egressIPs.Difference(desiredEgressIPs) {
+		if err := c.unrealizeEgressIP(egressName, egressIP); err != nil {
+			return err
+		}
+		unassigned, err := c.ipAssigner.UnassignIP(egressIP)
+		if err != nil {
+			return err
+		}
+		if unassigned {
+			c.record.Eventf(egress, corev1.EventTypeNormal, "IPUnassigned", "Unassigned Egress %s with IP %s from Node %s", egressName, egressIP, c.nodeName)
+		}
+		eState.egressIPs.Delete(egressIP)
+	}
+
+	if err := c.updateActiveActiveEgressStatus(egress, assignments, scheduleErr); err != nil {
+		return fmt.Errorf("update Egress %s status error: %v", egressName, err)
+	}
+
+	// Do not proceed if no Egress IP is available.
+	if len(groupBuckets) == 0 {
+		if err := c.uninstallPodFlows(egressName, eState, eState.ofPorts, eState.pods); err != nil {
+			return err
+		}
+		return c.uninstallEgressIPGroup(eState)
+	}
+
+	if !slices.Equal(eState.groupBuckets, groupBuckets) {
+		if eState.groupID == 0 {
+			eState.groupID = c.groupIDAllocator.Allocate()
+		}
+		snatIPs := make([]net.IP, 0, len(groupBuckets))
+		snatMarks := make([]uint32, 0, len(groupBuckets))
+		for _, bucket := range groupBuckets {
+			snatIPs = append(snatIPs, net.ParseIP(bucket.ip))
+			snatMarks = append(snatMarks, bucket.mark)
+		}
+		if err := c.ofClient.InstallEgressIPGroup(eState.groupID, snatIPs, snatMarks); err != nil {
+			return err
+		}
+		eState.groupBuckets = groupBuckets
+	}
+
+	// All Egress IPs of the Egress are of the same IP family, which is guaranteed by the validation webhook.
+	ipProtocol := binding.ProtocolIP
+	if net.ParseIP(groupBuckets[0].ip).To4() == nil {
+		ipProtocol = binding.ProtocolIPv6
+	}
+	groupID := eState.groupID
+	if eState.withDestinations {
+		return c.syncEgressDestinationPods(egressName, eState, newEgressDestinationRule(egress, net.ParseIP(groupBuckets[0].ip), 0, groupID))
+	}
+	return c.syncEgressPods(egressName, eState, func(ofPort uint32) error {
+		return c.ofClient.InstallPodSNATGroupFlows(ofPort, groupID, ipProtocol)
+	})
+}
+
+// syncEgressPods installs SNAT flows for the desired Pods of the Egress with installFlows, and uninstalls SNAT flows
+// for the stale Pods.
+func (c *EgressController) syncEgressPods(egressName string, eState *egressState, installFlows func(ofPort uint32) error) error {
 	// Copy the previous ofPorts and Pods. They will be used to identify stale ofPorts and Pods.
 	staleOFPorts := eState.ofPorts.Union(nil)
 	stalePods := eState.pods.Union(nil)
 
-	// Get a copy of the desired Pods.
-	pods := func() sets.Set[string] {
-		c.egressGroupsMutex.RLock()
-		defer c.egressGroupsMutex.RUnlock()
-		pods, exist := c.egressGroups[egressName]
-		if !exist {
-			return nil
-		}
-		return pods.Union(nil)
-	}()
-
-	egressIP := net.ParseIP(eState.egressIP)
 	// Install SNAT flows for desired Pods.
-	for pod := range pods {
+	for pod := range c.getEgressGroupPods(egressName) {
 		eState.pods.Insert(pod)
 		stalePods.Delete(pod)
 
@@ -1142,7 +1386,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 			staleOFPorts.Delete(ofPort)
 			continue
 		}
-		if err := c.ofClient.InstallPodSNATFlows(uint32(ofPort), egressIP, mark); err != nil {
+		if err := installFlows(uint32(ofPort)); err != nil {
 			return err
 		}
 		eState.ofPorts.Insert(ofPort)
@@ -1155,7 +1399,21 @@ func (c *EgressController) syncEgress(egressName string) error {
 	return nil
 }
 
+// getEgressGroupPods returns a copy of the desired Pods of the Egress.
+func (c *EgressController) getEgressGroupPods(egressName string) sets.Set[string] {
+	c.egressGroupsMutex.RLock()
+	defer c.egressGroupsMutex.RUnlock()
+	pods, exist := c.egressGroups[egressName]
+	if !exist {
+		return nil
+	}
+	return pods.Union(nil)
+}
+
 func (c *EgressController) uninstallEgress(egressName string, eState *egressState, egress *crdv1b1.Egress) error {
+	if eState.activeActive {
+		return c.uninstallActiveActiveEgress(egressName, eState, egress)
+	}
 	// Uninstall all of its Pod flows.
 	if err := c.uninstallPodFlows(egressName, eState, eState.ofPorts, eState.pods); err != nil {
 		return err
@@ -1183,7 +1441,59 @@ func (c *EgressController) uninstallEgress(egressName string, eState *egressStat
 	return nil
 }
 
+func (c *EgressController) uninstallActiveActiveEgress(egressName string, eState *egressState, egress *crdv1b1.Egress) error {
+	// Uninstall all of its Pod flows.
+	if err := c.uninstallPodFlows(egressName, eState, eState.ofPorts, eState.pods); err != nil {
+		return err
+	}
+	if err := c.uninstallEgressIPGroup(eState); err != nil {
+		return err
+	}
+	for egressIP := range eState.egressIPs {
+		if err := c.unrealizeEgressIP(egressName, egressIP); err != nil {
+			return err
+		}
+		unassigned, err := c.ipAssigner.UnassignIP(egressIP)
+		if err != nil {
+			return err
+		}
+		if unassigned && egress != nil {
+			c.record.Eventf(egress, corev1.EventTypeNormal, "IPUnassigned", "Unassigned Egress %s with IP %s from Node %s", egressName, egressIP, c.nodeName)
+		}
+		eState.egressIPs.Delete(egressIP)
+	}
+	// Remove the Egress's state.
+	c.deleteEgressState(egressName)
+	return nil
+}
+
+// uninstallEgressIPGroup uninstalls the select group of an ActiveActive Egress and releases its ID.
+func (c *EgressController) uninstallEgressIPGroup(eState *egressState) error {
+	if eState.groupID == 0 {
+		return nil
+	}
+	if err := c.ofClient.UninstallEgressIPGroup(eState.groupID); err != nil {
+		return err
+	}
+	c.groupIDAllocator.Release(eState.groupID)
+	eState.groupID = 0
+	eState.groupBuckets = nil
+	return nil
+}
+
+// getExternalIPPoolOfEgressIP returns the ExternalIPPool an Egress IP of an ActiveActive Egress is allocated from.
+func (c *EgressController) getExternalIPPoolOfEgressIP(egress *crdv1b1.Egress, egressIP string) (*crdv1b1.ExternalIPPool, error) {
+	i := slices.Index(egress.Spec.EgressIPs, egressIP)
+	if i < 0 || i >= len(egress.Spec.ExternalIPPools) {
+		return nil, fmt.Errorf("no ExternalIPPool found for IP %s of Egress %s", egressIP, egress.Name)
+	}
+	return c.externalIPPoolLister.Get(egress.Spec.ExternalIPPools[i])
+}
+
 func (c *EgressController) uninstallPodFlows(egressName string, egressState *egressState, ofPorts sets.Set[int32], pods sets.Set[string]) error {
+	if egressState.withDestinations {
+		return c.uninstallDestinationPodFlows(egressName, egressState, pods)
+	}
 	for ofPort := range ofPorts {
 		if err := c.ofClient.UninstallPodSNATFlows(uint32(ofPort)); err != nil {
 			return err
@@ -1398,6 +1708,11 @@ func isEgressSchedulable(egress *crdv1b1.Egress) bool {
 	return egress.Spec.EgressIP != "" && egress.Spec.ExternalIPPool != ""
 }
 
+// An Egress is in ActiveActive mode if its Egress IPs are allocated from ExternalIPPools and spread across Nodes.
+func isActiveActiveEgress(egress *crdv1b1.Egress) bool {
+	return egress.Spec.Mode == crdv1b1.EgressModeActiveActive && len(egress.Spec.ExternalIPPools) > 0
+}
+
 // compareEgressStatus compares two Egress Statuses, ignoring LastTransitionTime and conditions other than IPAssigned, returns true if they are equal.
 func compareEgressStatus(currentStatus, desiredStatus *crdv1b1.EgressStatus) bool {
 	if currentStatus == nil && desiredStatus == nil {
@@ -1409,6 +1724,9 @@ func compareEgressStatus(currentStatus, desiredStatus *crdv1b1.EgressStatus) boo
 	if currentStatus.EgressIP != desiredStatus.EgressIP || currentStatus.EgressNode != desiredStatus.EgressNode {
 		return false
 	}
+	if !slices.Equal(currentStatus.EgressIPs, desiredStatus.EgressIPs) {
+		return false
+	}
 	currentIPAssignedCondition := crdv1b1.GetEgressCondition(currentStatus.Conditions, crdv1b1.IPAssigned)
 	desiredIPAssignedCondition := crdv1b1.GetEgressCondition(desiredStatus.Conditions, crdv1b1.IPAssigned)
 	if currentIPAssignedCondition == nil && desiredIPAssignedCondition == nil {
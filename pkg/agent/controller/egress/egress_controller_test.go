@@ -42,6 +42,7 @@ import (
 	"antrea.io/antrea/pkg/agent/ipassigner/linkmonitor"
 	ipassignertest "antrea.io/antrea/pkg/agent/ipassigner/testing"
 	"antrea.io/antrea/pkg/agent/memberlist"
+	"antrea.io/antrea/pkg/agent/openflow"
 	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
 	routetest "antrea.io/antrea/pkg/agent/route/testing"
 	servicecidrtest "antrea.io/antrea/pkg/agent/servicecidr/testing"
@@ -53,6 +54,7 @@ import (
 	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
 	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
@@ -205,6 +207,7 @@ func newFakeController(t *testing.T, initObjects []runtime.Object) *fakeControll
 		true,
 		true,
 		nil,
+		openflow.NewGroupAllocator(),
 	)
 	egressController.localIPDetector = localIPDetector
 	return &fakeController{
@@ -1204,6 +1207,89 @@ func TestSyncEgress(t *testing.T) {
 	}
 }
 
+func TestSyncActiveActiveEgress(t *testing.T) {
+	egress := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec: crdv1b1.EgressSpec{
+			EgressIPs:       []string{fakeLocalEgressIP1, fakeLocalEgressIP2},
+			ExternalIPPools: []string{"poolA", "poolB"},
+			Mode:            crdv1b1.EgressModeActiveActive,
+		},
+	}
+	poolA := &crdv1b1.ExternalIPPool{ObjectMeta: metav1.ObjectMeta{Name: "poolA", UID: "pool-uidA"}}
+	poolB := &crdv1b1.ExternalIPPool{ObjectMeta: metav1.ObjectMeta{Name: "poolB", UID: "pool-uidB"}}
+	egressGroup := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+			{Pod: &cpv1b2.PodReference{Name: "pod2", Namespace: "ns2"}},
+		},
+	}
+	c := newFakeController(t, []runtime.Object{egress, poolA, poolB})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	c.addEgressGroup(egressGroup)
+
+	// Both Egress IPs are assigned to the only Node and the Pods' connections are distributed across them.
+	groupID := binding.GroupIDType(1)
+	c.mockIPAssigner.EXPECT().AssignIP(fakeLocalEgressIP1, nil, true).Return(true, nil)
+	c.mockIPAssigner.EXPECT().AssignIP(fakeLocalEgressIP2, nil, true).Return(true, nil)
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP2), uint32(2))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP2), uint32(2))
+	c.mockOFClient.EXPECT().InstallEgressIPGroup(groupID, []net.IP{net.ParseIP(fakeLocalEgressIP1), net.ParseIP(fakeLocalEgressIP2)}, []uint32{1, 2})
+	c.mockOFClient.EXPECT().InstallPodSNATGroupFlows(uint32(1), groupID, binding.ProtocolIP)
+	c.mockOFClient.EXPECT().InstallPodSNATGroupFlows(uint32(2), groupID, binding.ProtocolIP)
+	c.egressIPScheduler.schedule()
+	require.NoError(t, c.syncEgress(egress.Name))
+
+	gotEgress, err := c.crdClient.CrdV1beta1().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []crdv1b1.EgressIPStatus{
+		{EgressIP: fakeLocalEgressIP1, EgressNode: fakeNode},
+		{EgressIP: fakeLocalEgressIP2, EgressNode: fakeNode},
+	}, gotEgress.Status.EgressIPs)
+	assert.Equal(t, v1.ConditionTrue, crdv1b1.GetEgressCondition(gotEgress.Status.Conditions, crdv1b1.IPAssigned).Status)
+
+	// Removing an Egress IP should only remove its bucket from the group.
+	updatedEgress := gotEgress.DeepCopy()
+	updatedEgress.Spec.EgressIPs = []string{fakeLocalEgressIP1}
+	updatedEgress.Spec.ExternalIPPools = []string{"poolA"}
+	c.crdClient.CrdV1beta1().Egresses().Update(context.TODO(), updatedEgress, metav1.UpdateOptions{})
+	assert.Eventually(t, func() bool {
+		egress, _ := c.egressLister.Get(updatedEgress.Name)
+		return reflect.DeepEqual(egress, updatedEgress)
+	}, time.Second, 100*time.Millisecond)
+	c.mockIPAssigner.EXPECT().AssignIP(fakeLocalEgressIP1, nil, false).Return(false, nil)
+	c.mockOFClient.EXPECT().UninstallSNATMarkFlows(uint32(2))
+	c.mockRouteClient.EXPECT().DeleteSNATRule(uint32(2))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP2).Return(true, nil)
+	c.mockOFClient.EXPECT().InstallEgressIPGroup(groupID, []net.IP{net.ParseIP(fakeLocalEgressIP1)}, []uint32{1})
+	c.egressIPScheduler.schedule()
+	require.NoError(t, c.syncEgress(egress.Name))
+
+	// Deleting the Egress should uninstall everything.
+	c.crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), egress.Name, metav1.DeleteOptions{})
+	assert.Eventually(t, func() bool {
+		_, err := c.egressLister.Get(egress.Name)
+		return errors.IsNotFound(err)
+	}, time.Second, 100*time.Millisecond)
+	c.mockOFClient.EXPECT().UninstallPodSNATFlows(uint32(1))
+	c.mockOFClient.EXPECT().UninstallPodSNATFlows(uint32(2))
+	c.mockOFClient.EXPECT().UninstallEgressIPGroup(groupID)
+	c.mockOFClient.EXPECT().UninstallSNATMarkFlows(uint32(1))
+	c.mockRouteClient.EXPECT().DeleteSNATRule(uint32(1))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1).Return(true, nil)
+	require.NoError(t, c.syncEgress(egress.Name))
+	_, exists := c.getEgressState(egress.Name)
+	assert.False(t, exists)
+}
+
 func TestPodUpdateShouldSyncEgress(t *testing.T) {
 	egress := &crdv1b1.Egress{
 		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
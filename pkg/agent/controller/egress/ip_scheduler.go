@@ -15,6 +15,7 @@
 package egress
 
 import (
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
@@ -43,11 +44,20 @@ const (
 // scheduleEventHandler is a callback when an Egress is rescheduled.
 type scheduleEventHandler func(egress string)
 
-// scheduleResult is the schedule result of an Egress, including the effective Egress IP and Node.
-type scheduleResult struct {
+// egressIPAssignment is the Node an Egress IP of an ActiveActive Egress is assigned to.
+type egressIPAssignment struct {
 	ip   string
 	node string
-	err  error
+}
+
+// scheduleResult is the schedule result of an Egress, including the effective Egress IP and Node.
+// For an ActiveActive Egress, assignments holds the Nodes of the Egress IPs that have been scheduled successfully, and
+// err holds the error of the last Egress IP that failed to be scheduled, if any.
+type scheduleResult struct {
+	ip          string
+	node        string
+	assignments []egressIPAssignment
+	err         error
 }
 
 // egressIPScheduler is responsible for scheduling Egress IPs to appropriate Nodes according to the Node selector of the
@@ -172,7 +182,7 @@ func (s *egressIPScheduler) deleteNode(obj interface{}) {
 // addEgress processes Egress ADD events.
 func (s *egressIPScheduler) addEgress(obj interface{}) {
 	egress := obj.(*crdv1b1.Egress)
-	if !isEgressSchedulable(egress) {
+	if !isEgressSchedulable(egress) && !isActiveActiveEgress(egress) {
 		return
 	}
 	s.queue.Add(workItem)
@@ -183,10 +193,14 @@ func (s *egressIPScheduler) addEgress(obj interface{}) {
 func (s *egressIPScheduler) updateEgress(old, cur interface{}) {
 	oldEgress := old.(*crdv1b1.Egress)
 	curEgress := cur.(*crdv1b1.Egress)
-	if !isEgressSchedulable(oldEgress) && !isEgressSchedulable(curEgress) {
+	if !isEgressSchedulable(oldEgress) && !isEgressSchedulable(curEgress) &&
+		!isActiveActiveEgress(oldEgress) && !isActiveActiveEgress(curEgress) {
 		return
 	}
-	if oldEgress.Spec.EgressIP == curEgress.Spec.EgressIP && oldEgress.Spec.ExternalIPPool == curEgress.Spec.ExternalIPPool {
+	if oldEgress.Spec.EgressIP == curEgress.Spec.EgressIP && oldEgress.Spec.ExternalIPPool == curEgress.Spec.ExternalIPPool &&
+		oldEgress.Spec.Mode == curEgress.Spec.Mode &&
+		slices.Equal(oldEgress.Spec.EgressIPs, curEgress.Spec.EgressIPs) &&
+		slices.Equal(oldEgress.Spec.ExternalIPPools, curEgress.Spec.ExternalIPPools) {
 		return
 	}
 	s.queue.Add(workItem)
@@ -208,7 +222,7 @@ func (s *egressIPScheduler) deleteEgress(obj interface{}) {
 			return
 		}
 	}
-	if !isEgressSchedulable(egress) {
+	if !isEgressSchedulable(egress) && !isActiveActiveEgress(egress) {
 		return
 	}
 	s.queue.Add(workItem)
@@ -263,6 +277,19 @@ func (s *egressIPScheduler) GetEgressIPAndNode(egress string) (string, string, e
 	return result.ip, result.node, nil, true
 }
 
+// GetEgressIPAssignments returns the Nodes the Egress IPs of an ActiveActive Egress are assigned to. The returned
+// error is the scheduling error of any Egress IP that couldn't be assigned to a Node.
+func (s *egressIPScheduler) GetEgressIPAssignments(egress string) ([]egressIPAssignment, error, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result, exists := s.scheduleResults[egress]
+	if !exists {
+		return nil, nil, false
+	}
+	return result.assignments, result.err, true
+}
+
 // EgressesByCreationTimestamp sorts a list of Egresses by creation timestamp.
 type EgressesByCreationTimestamp []*crdv1b1.Egress
 
@@ -338,6 +365,10 @@ func (s *egressIPScheduler) schedule() {
 	// when the total capacity is insufficient.
 	sort.Sort(EgressesByCreationTimestamp(egresses))
 	for _, egress := range egresses {
+		if isActiveActiveEgress(egress) {
+			newResults[egress.Name] = s.scheduleActiveActiveEgress(egress, nodeToIPs)
+			continue
+		}
 		// Ignore Egresses that shouldn't be scheduled.
 		if !isEgressSchedulable(egress) {
 			continue
@@ -386,7 +417,8 @@ func (s *egressIPScheduler) schedule() {
 		prevResults := s.scheduleResults
 		for egress, result := range newResults {
 			prevResult, exists := prevResults[egress]
-			if !exists || prevResult.ip != result.ip || prevResult.node != result.node || prevResult.err != result.err {
+			if !exists || prevResult.ip != result.ip || prevResult.node != result.node || prevResult.err != result.err ||
+				!slices.Equal(prevResult.assignments, result.assignments) {
 				egressesToUpdate = append(egressesToUpdate, egress)
 			}
 			delete(prevResults, egress)
@@ -407,3 +439,53 @@ func (s *egressIPScheduler) schedule() {
 
 	s.scheduledOnce.Store(true)
 }
+
+// scheduleActiveActiveEgress selects a Node for each Egress IP of an ActiveActive Egress from the ExternalIPPool the IP
+// is allocated from. Nodes that don't hold other IPs of the same Egress are preferred, so that the Egress's traffic is
+// spread across as many Nodes as possible. Each Egress IP is scheduled independently, which means an Egress IP failing
+// over to another Node doesn't affect the other Egress IPs of the Egress.
+func (s *egressIPScheduler) scheduleActiveActiveEgress(egress *crdv1b1.Egress, nodeToIPs map[string]sets.Set[string]) *scheduleResult {
+	result := &scheduleResult{}
+	nodesInUse := sets.New[string]()
+	for i, egressIP := range egress.Spec.EgressIPs {
+		if egressIP == "" || i >= len(egress.Spec.ExternalIPPools) {
+			continue
+		}
+		pool := egress.Spec.ExternalIPPools[i]
+		maxEgressIPsFilter := func(node string) bool {
+			ipsOnNode := nodeToIPs[node]
+			numIPs := ipsOnNode.Len()
+			if !ipsOnNode.Has(egressIP) {
+				numIPs += 1
+			}
+			return numIPs <= s.getMaxEgressIPsByNode(node)
+		}
+		notInUseFilter := func(node string) bool {
+			return !nodesInUse.Has(node)
+		}
+		node, err := s.cluster.SelectNodeForIP(egressIP, pool, maxEgressIPsFilter, notInUseFilter)
+		if err == memberlist.ErrNoNodeAvailable {
+			// Fall back to the Nodes holding other IPs of the Egress.
+			node, err = s.cluster.SelectNodeForIP(egressIP, pool, maxEgressIPsFilter)
+		}
+		if err != nil {
+			if err == memberlist.ErrNoNodeAvailable {
+				klog.InfoS("No Node is eligible for Egress IP", "egress", klog.KObj(egress), "ip", egressIP)
+			} else {
+				klog.ErrorS(err, "Failed to select Node for Egress IP", "egress", klog.KObj(egress), "ip", egressIP)
+			}
+			result.err = err
+			continue
+		}
+		result.assignments = append(result.assignments, egressIPAssignment{ip: egressIP, node: node})
+		nodesInUse.Insert(node)
+
+		ips, exists := nodeToIPs[node]
+		if !exists {
+			ips = sets.New[string]()
+			nodeToIPs[node] = ips
+		}
+		ips.Insert(egressIP)
+	}
+	return result
+}
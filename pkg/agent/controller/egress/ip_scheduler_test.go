@@ -212,6 +212,83 @@ func TestSchedule(t *testing.T) {
 	}
 }
 
+func TestScheduleActiveActiveEgress(t *testing.T) {
+	egress := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", CreationTimestamp: metav1.NewTime(time.Unix(1, 0))},
+		Spec: crdv1b1.EgressSpec{
+			EgressIPs:       []string{"1.1.1.1", "1.1.2.1", "1.1.3.1"},
+			ExternalIPPools: []string{"pool1", "pool2", "pool3"},
+			Mode:            crdv1b1.EgressModeActiveActive,
+		},
+	}
+	tests := []struct {
+		name                string
+		nodes               []string
+		maxEgressIPsPerNode int
+		expectedNumIPs      int
+		expectedNumNodes    int
+		expectedErr         error
+	}{
+		{
+			name:                "sufficient Nodes",
+			nodes:               []string{"node1", "node2", "node3", "node4"},
+			maxEgressIPsPerNode: 3,
+			expectedNumIPs:      3,
+			expectedNumNodes:    3,
+		},
+		{
+			name:                "insufficient Nodes",
+			nodes:               []string{"node1", "node2"},
+			maxEgressIPsPerNode: 3,
+			expectedNumIPs:      3,
+			expectedNumNodes:    2,
+		},
+		{
+			name:                "insufficient cluster capacity",
+			nodes:               []string{"node1", "node2"},
+			maxEgressIPsPerNode: 1,
+			expectedNumIPs:      2,
+			expectedNumNodes:    2,
+			expectedErr:         memberlist.ErrNoNodeAvailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeCluster := newFakeMemberlistCluster(tt.nodes)
+			crdClient := fakeversioned.NewSimpleClientset(egress)
+			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+			egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+			clientset := fake.NewSimpleClientset()
+			informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+			nodeInformer := informerFactory.Core().V1().Nodes()
+
+			s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, tt.maxEgressIPsPerNode)
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			crdInformerFactory.Start(stopCh)
+			informerFactory.Start(stopCh)
+			crdInformerFactory.WaitForCacheSync(stopCh)
+			informerFactory.WaitForCacheSync(stopCh)
+
+			s.schedule()
+			assignments, err, exists := s.GetEgressIPAssignments(egress.Name)
+			assert.True(t, exists)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Len(t, assignments, tt.expectedNumIPs)
+			nodes := sets.New[string]()
+			for _, assignment := range assignments {
+				nodes.Insert(assignment.node)
+			}
+			assert.Equal(t, tt.expectedNumNodes, nodes.Len())
+
+			// The results should be stable.
+			s.schedule()
+			newAssignments, _, _ := s.GetEgressIPAssignments(egress.Name)
+			assert.Equal(t, assignments, newAssignments)
+		})
+	}
+}
+
 func BenchmarkSchedule(b *testing.B) {
 	var egresses []runtime.Object
 	for i := 0; i < 1000; i++ {
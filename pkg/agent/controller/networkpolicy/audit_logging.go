@@ -15,6 +15,7 @@
 package networkpolicy
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -32,6 +33,7 @@ import (
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/logdir"
@@ -43,12 +45,21 @@ const (
 	nullPlaceholder        = "<nil>"
 )
 
+const (
+	// AuditLogFormatText is the default audit log format, with space-separated fields.
+	AuditLogFormatText = "Text"
+	// AuditLogFormatJSON is the audit log format with one JSON object per record.
+	AuditLogFormatJSON = "JSON"
+)
+
 // AuditLogger is used for network policy audit logging.
 // Includes a lumberjack logger and a map used for log deduplication.
 type AuditLogger struct {
 	bufferLength     time.Duration
 	clock            clock.Clock // enable the use of a "virtual" clock for unit tests
 	npLogger         *log.Logger
+	format           string
+	syslogWriter     *syslogWriter // nil if syslog forwarding is disabled
 	logDeduplication logRecordDedupMap
 }
 
@@ -57,6 +68,11 @@ type AuditLoggerOptions struct {
 	MaxBackups int
 	MaxAge     int
 	Compress   bool
+	// Format is AuditLogFormatText or AuditLogFormatJSON. Defaults to AuditLogFormatText.
+	Format string
+	// Syslog configures the forwarding of audit logs to a remote syslog server. Use nil to
+	// disable it.
+	Syslog *SyslogOptions
 }
 
 // logInfo will be set by retrieving info from packetin and register.
@@ -77,11 +93,36 @@ type logInfo struct {
 	protocolStr  string // protocol of the traffic logged
 }
 
+// jsonLogRecord is the representation of an audit log record with AuditLogFormatJSON.
+type jsonLogRecord struct {
+	Timestamp       string `json:"timestamp"`
+	TableName       string `json:"tableName"`
+	NetworkPolicy   string `json:"networkPolicy"`
+	RuleName        string `json:"ruleName,omitempty"`
+	Direction       string `json:"direction,omitempty"`
+	Action          string `json:"action"`
+	OFPriority      int    `json:"ofPriority,omitempty"`
+	PodNamespace    string `json:"podNamespace,omitempty"`
+	PodName         string `json:"podName,omitempty"`
+	SourceIP        string `json:"sourceIP"`
+	SourcePort      int    `json:"sourcePort,omitempty"`
+	DestinationIP   string `json:"destinationIP"`
+	DestinationPort int    `json:"destinationPort,omitempty"`
+	Protocol        string `json:"protocol"`
+	PacketLength    int    `json:"packetLength"`
+	LogLabel        string `json:"logLabel,omitempty"`
+	// PacketCount and Duration are set for deduplicated records, which stand for all the
+	// packets of the same connection received during Duration.
+	PacketCount int64  `json:"packetCount"`
+	Duration    string `json:"duration,omitempty"`
+}
+
 // logDedupRecord will be used as 1 sec buffer for log deduplication.
 type logDedupRecord struct {
 	count         int64            // record count of duplicate log
 	initTime      time.Time        // initial time upon receiving packet log
 	bufferTimerCh <-chan time.Time // 1 sec buffer for each log
+	ob            *logInfo         // log info of the first packet
 }
 
 // logRecordDedupMap includes a map of log buffers and a r/w mutex for accessing the map.
@@ -109,28 +150,40 @@ func (l *AuditLogger) terminateLogKey(logMsg string) {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	logRecord := l.logDeduplication.logMap[logMsg]
-	if logRecord.count == 1 {
-		l.npLogger.Print(logMsg)
-	} else {
-		l.npLogger.Printf("%s [%d packets in %s]", logMsg, logRecord.count, time.Since(logRecord.initTime))
-	}
+	l.writeLog(logRecord.ob, logMsg, logRecord.count, time.Since(logRecord.initTime))
 	delete(l.logDeduplication.logMap, logMsg)
 }
 
 // updateLogKey initiates record or increases the count in logDeduplication corresponding to given logMsg.
-func (l *AuditLogger) updateLogKey(logMsg string, bufferLength time.Duration) bool {
+func (l *AuditLogger) updateLogKey(logMsg string, ob *logInfo, bufferLength time.Duration) bool {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	_, exists := l.logDeduplication.logMap[logMsg]
 	if exists {
 		l.logDeduplication.logMap[logMsg].count++
 	} else {
-		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength)}
+		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength), ob}
 		l.logDeduplication.logMap[logMsg] = &record
 	}
 	return exists
 }
 
+// writeLog writes the record of ob, which stands for count packets received during duration, to
+// the log file in the configured format, and forwards it to the syslog server if configured.
+// logMsg is the text representation of ob built by buildLogMsg.
+func (l *AuditLogger) writeLog(ob *logInfo, logMsg string, count int64, duration time.Duration) {
+	msg := logMsg
+	if l.format == AuditLogFormatJSON {
+		msg = l.buildJSONLogMsg(ob, count, duration)
+	} else if count > 1 {
+		msg = fmt.Sprintf("%s [%d packets in %s]", logMsg, count, duration)
+	}
+	l.npLogger.Print(msg)
+	if l.syslogWriter != nil {
+		l.syslogWriter.write(syslogSeverity(ob.disposition), msg)
+	}
+}
+
 func buildLogMsg(ob *logInfo) string {
 	return strings.Join([]string{
 		ob.tableName,
@@ -150,15 +203,57 @@ func buildLogMsg(ob *logInfo) string {
 	}, " ")
 }
 
+// buildJSONLogMsg returns the JSON representation of the record of ob. The placeholders of the
+// fields which are not set are omitted.
+func (l *AuditLogger) buildJSONLogMsg(ob *logInfo, count int64, duration time.Duration) string {
+	valueOf := func(v string) string {
+		if v == nullPlaceholder {
+			return ""
+		}
+		return v
+	}
+	intValueOf := func(v string) int {
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+	record := jsonLogRecord{
+		Timestamp:       l.clock.Now().UTC().Format(time.RFC3339Nano),
+		TableName:       ob.tableName,
+		NetworkPolicy:   ob.npRef,
+		RuleName:        valueOf(ob.ruleName),
+		Direction:       valueOf(ob.direction),
+		Action:          ob.disposition,
+		OFPriority:      intValueOf(ob.ofPriority),
+		SourceIP:        ob.srcIP,
+		SourcePort:      intValueOf(ob.srcPort),
+		DestinationIP:   ob.destIP,
+		DestinationPort: intValueOf(ob.destPort),
+		Protocol:        ob.protocolStr,
+		PacketLength:    intValueOf(ob.pktLength),
+		LogLabel:        valueOf(ob.logLabel),
+		PacketCount:     count,
+	}
+	if namespace, name, found := strings.Cut(valueOf(ob.appliedToRef), "/"); found {
+		record.PodNamespace = namespace
+		record.PodName = name
+	}
+	if count > 1 {
+		record.Duration = duration.String()
+	}
+	// Marshaling cannot fail as all the fields are strings or integers.
+	data, _ := json.Marshal(record)
+	return string(data)
+}
+
 // LogDedupPacket logs information in ob based on disposition and duplication conditions.
 func (l *AuditLogger) LogDedupPacket(ob *logInfo) {
 	// Deduplicate non-Allow packet log.
 	logMsg := buildLogMsg(ob)
 	if ob.disposition == openflow.DispositionToString[openflow.DispositionAllow] {
-		l.npLogger.Print(logMsg)
+		l.writeLog(ob, logMsg, 1, 0)
 	} else {
 		// Increase count if duplicated within 1 sec, create buffer otherwise.
-		exists := l.updateLogKey(logMsg, l.bufferLength)
+		exists := l.updateLogKey(logMsg, ob, l.bufferLength)
 		if !exists {
 			// Go routine for logging when buffer timer stops.
 			go l.logAfterTimer(logMsg)
@@ -166,6 +261,13 @@ func (l *AuditLogger) LogDedupPacket(ob *logInfo) {
 	}
 }
 
+// run runs the syslog forwarder of the AuditLogger, if configured, until stopCh is closed.
+func (l *AuditLogger) run(stopCh <-chan struct{}) {
+	if l.syslogWriter != nil {
+		l.syslogWriter.run(stopCh)
+	}
+}
+
 // newAuditLogger is called while newing network policy agent controller.
 // Customize AuditLogger specifically for audit logging through agent configuration.
 func newAuditLogger(options *AuditLoggerOptions) (*AuditLogger, error) {
@@ -187,12 +289,31 @@ func newAuditLogger(options *AuditLoggerOptions) (*AuditLogger, error) {
 		Compress:   options.Compress,
 	}
 
+	format := options.Format
+	if format == "" {
+		format = AuditLogFormatText
+	} else if format != AuditLogFormatText && format != AuditLogFormatJSON {
+		return nil, fmt.Errorf("unsupported audit log format %q", format)
+	}
+	// JSON records include their own timestamp, the log file must only contain JSON objects.
+	logFlags := log.Ldate | log.Lmicroseconds
+	if format == AuditLogFormatJSON {
+		logFlags = 0
+	}
 	auditLogger := &AuditLogger{
 		bufferLength:     time.Second,
 		clock:            clock.RealClock{},
-		npLogger:         log.New(logOutput, "", log.Ldate|log.Lmicroseconds),
+		npLogger:         log.New(logOutput, "", logFlags),
+		format:           format,
 		logDeduplication: logRecordDedupMap{logMap: make(map[string]*logDedupRecord)},
 	}
+	if options.Syslog != nil {
+		syslogWriter, err := newSyslogWriter(options.Syslog, auditLogger.clock)
+		if err != nil {
+			return nil, fmt.Errorf("error creating syslog forwarder for audit logging: %w", err)
+		}
+		auditLogger.syslogWriter = syslogWriter
+	}
 	klog.InfoS("Initialized Antrea-native Policy Logger for audit logging", "logFile", logFile, "options", options)
 	return auditLogger, nil
 }
@@ -281,6 +402,13 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Con
 	ob.ofPriority = ofPriority
 	ob.ruleName = ruleName
 	ob.logLabel = logLabel
+	// The traffic matching a Drop or Reject rule of a NetworkPolicy in Audit mode is allowed in the datapath,
+	// log the action that would have been enforced instead, e.g. "Drop(Audit)".
+	if disposition == openflow.DispositionAllow {
+		if action := c.ruleCache.getAuditOnlyRuleAction(string(npRef.UID), ruleName); action != nil {
+			ob.disposition = fmt.Sprintf("%s(%s)", *action, crdv1beta1.EnforcementModeAudit)
+		}
+	}
 	// Fill in placeholders for Antrea-native policies without log labels,
 	// K8s NetworkPolicies without rule names or log labels.
 	fillLogInfoPlaceholders([]*string{&ob.ruleName, &ob.logLabel, &ob.ofPriority})
@@ -16,6 +16,7 @@ package networkpolicy
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -208,6 +209,77 @@ func TestRedirectPacketLog(t *testing.T) {
 	assert.Contains(t, actual, expected)
 }
 
+func TestJSONPacketLog(t *testing.T) {
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	clock := clocktesting.NewFakeClock(now)
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock)
+	auditLogger.format = AuditLogFormatJSON
+
+	ob, _ := newLogInfo(actionAllow)
+	ob.direction = "Ingress"
+	ob.appliedToRef = "default/web"
+	auditLogger.LogDedupPacket(ob)
+	actual := <-mockNPLogger.logged
+	var record jsonLogRecord
+	require.NoError(t, json.Unmarshal([]byte(actual), &record))
+	assert.Equal(t, jsonLogRecord{
+		Timestamp:       "2026-10-18T10:00:00Z",
+		TableName:       openflow.AntreaPolicyIngressRuleTable.GetName(),
+		NetworkPolicy:   testANNPRef.ToString(),
+		RuleName:        "test-rule",
+		Direction:       "Ingress",
+		Action:          actionAllow,
+		PodNamespace:    "default",
+		PodName:         "web",
+		SourceIP:        "0.0.0.0",
+		SourcePort:      35402,
+		DestinationIP:   "1.1.1.1",
+		DestinationPort: 80,
+		Protocol:        "TCP",
+		PacketLength:    60,
+		LogLabel:        "test-label",
+		PacketCount:     1,
+	}, record)
+
+	// Placeholders are omitted and duplicate packets are counted.
+	ob, _ = newLogInfo(actionDrop)
+	ob.direction = "Ingress"
+	ob.appliedToRef = nullPlaceholder
+	ob.logLabel = nullPlaceholder
+	auditLogger.LogDedupPacket(ob)
+	clock.Step(time.Millisecond)
+	auditLogger.LogDedupPacket(ob)
+	clock.Step(testBufferLength)
+	actual = <-mockNPLogger.logged
+	record = jsonLogRecord{}
+	require.NoError(t, json.Unmarshal([]byte(actual), &record))
+	assert.Equal(t, actionDrop, record.Action)
+	assert.Empty(t, record.PodNamespace)
+	assert.Empty(t, record.PodName)
+	assert.Empty(t, record.LogLabel)
+	assert.Equal(t, int64(2), record.PacketCount)
+	assert.NotEmpty(t, record.Duration)
+}
+
+func TestSyslogForwarding(t *testing.T) {
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock.RealClock{})
+	auditLogger.syslogWriter = &syslogWriter{
+		network:  "udp",
+		facility: 16,
+		hostname: "node1",
+		procID:   "1",
+		clock:    clock.RealClock{},
+		queue:    make(chan syslogMessage, 1),
+	}
+	ob, expected := newLogInfo(actionAllow)
+
+	auditLogger.LogDedupPacket(ob)
+	assert.Contains(t, <-mockNPLogger.logged, expected)
+	m := <-auditLogger.syslogWriter.queue
+	assert.Equal(t, syslogSeverityInformational, m.severity)
+	assert.Equal(t, expected, m.msg)
+}
+
 func TestGetNetworkPolicyInfo(t *testing.T) {
 	prepareMockOFTablesWithCache()
 	generateMatch := func(regID int, data []byte) openflow15.MatchField {
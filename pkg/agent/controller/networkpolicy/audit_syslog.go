@@ -0,0 +1,268 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	SyslogTransportUDP = "UDP"
+	SyslogTransportTCP = "TCP"
+	SyslogTransportTLS = "TLS"
+
+	// syslogAppName and syslogMsgID are the APP-NAME and MSGID fields of the RFC 5424 header.
+	syslogAppName = "antrea-agent"
+	syslogMsgID   = "np-audit"
+	// syslogTimestampFormat is the TIMESTAMP format of RFC 5424, which allows at most 6 digits
+	// of sub-second precision.
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	syslogQueueSize     = 1024
+	syslogDialTimeout   = 5 * time.Second
+	syslogWriteTimeout  = 5 * time.Second
+	syslogRetryInterval = 5 * time.Second
+)
+
+// Syslog severities used for the audit log records, as defined in RFC 5424.
+const (
+	syslogSeverityWarning       = 4
+	syslogSeverityNotice        = 5
+	syslogSeverityInformational = 6
+)
+
+// syslogFacilities maps the names of the supported syslog facilities to their numerical codes.
+var syslogFacilities = map[string]int{
+	"local0": 16,
+	"local1": 17,
+	"local2": 18,
+	"local3": 19,
+	"local4": 20,
+	"local5": 21,
+	"local6": 22,
+	"local7": 23,
+}
+
+// ParseSyslogFacility returns the numerical code of the syslog facility with the provided name.
+func ParseSyslogFacility(name string) (int, error) {
+	facility, ok := syslogFacilities[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported syslog facility %q, must be one of local0 to local7", name)
+	}
+	return facility, nil
+}
+
+// SyslogOptions configures the forwarding of audit logs to a remote syslog server.
+type SyslogOptions struct {
+	// Address of the server, in the "host:port" format.
+	Address string
+	// Transport is one of SyslogTransportUDP, SyslogTransportTCP or SyslogTransportTLS.
+	Transport string
+	// Facility is the name of the syslog facility of the messages.
+	Facility string
+	// CAFile is the path to the CA bundle used to verify the server certificate with
+	// SyslogTransportTLS. The system root CAs are used if it is empty.
+	CAFile string
+	// Hostname is the HOSTNAME field of the messages.
+	Hostname string
+}
+
+// syslogMessage is an audit log record queued to be sent to the syslog server.
+type syslogMessage struct {
+	severity  int
+	timestamp time.Time
+	msg       string
+}
+
+// syslogWriter forwards audit log records to a remote syslog server, formatted according to
+// RFC 5424. With TCP and TLS, the messages are framed with octet counting as described in
+// RFC 6587 and RFC 5425. The records are queued and sent asynchronously, so that a slow or
+// unreachable server never blocks the processing of packet-ins: records are dropped when the
+// queue is full, or when the server cannot be reached.
+type syslogWriter struct {
+	network   string
+	address   string
+	tlsConfig *tls.Config
+	facility  int
+	hostname  string
+	procID    string
+	clock     clock.Clock
+	queue     chan syslogMessage
+
+	// The fields below are only accessed by the goroutine running run.
+	conn           net.Conn
+	lastDialFailed time.Time
+	dropped        int64
+}
+
+func newSyslogWriter(options *SyslogOptions, clock clock.Clock) (*syslogWriter, error) {
+	facility, err := ParseSyslogFacility(options.Facility)
+	if err != nil {
+		return nil, err
+	}
+	w := &syslogWriter{
+		address:  options.Address,
+		facility: facility,
+		hostname: options.Hostname,
+		procID:   strconv.Itoa(os.Getpid()),
+		clock:    clock,
+		queue:    make(chan syslogMessage, syslogQueueSize),
+	}
+	if w.hostname == "" {
+		w.hostname = "-"
+	}
+	switch options.Transport {
+	case SyslogTransportUDP:
+		w.network = "udp"
+	case SyslogTransportTCP:
+		w.network = "tcp"
+	case SyslogTransportTLS:
+		w.network = "tcp"
+		host, _, err := net.SplitHostPort(options.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid syslog server address %q: %w", options.Address, err)
+		}
+		w.tlsConfig = &tls.Config{
+			ServerName: host,
+			MinVersion: tls.VersionTLS12,
+		}
+		if options.CAFile != "" {
+			caBundle, err := os.ReadFile(options.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("error reading syslog CA file: %w", err)
+			}
+			rootCAs := x509.NewCertPool()
+			if !rootCAs.AppendCertsFromPEM(caBundle) {
+				return nil, fmt.Errorf("no valid certificate found in syslog CA file %s", options.CAFile)
+			}
+			w.tlsConfig.RootCAs = rootCAs
+		}
+	default:
+		return nil, fmt.Errorf("unsupported syslog transport %q", options.Transport)
+	}
+	return w, nil
+}
+
+// syslogSeverity returns the syslog severity of an audit log record with the provided disposition.
+func syslogSeverity(disposition string) int {
+	switch {
+	case strings.HasSuffix(disposition, ")"):
+		// Actions which are not enforced, e.g. "Drop(Audit)".
+		return syslogSeverityNotice
+	case disposition == "Drop" || disposition == "Reject":
+		return syslogSeverityWarning
+	default:
+		return syslogSeverityInformational
+	}
+}
+
+// write queues an audit log record to be sent to the syslog server. It never blocks.
+func (w *syslogWriter) write(severity int, msg string) {
+	select {
+	case w.queue <- syslogMessage{severity: severity, timestamp: w.clock.Now(), msg: msg}:
+	default:
+		klog.V(4).InfoS("Syslog queue is full, dropping audit log record")
+	}
+}
+
+// formatMessage returns the RFC 5424 representation of m, framed for the transport of the writer.
+func (w *syslogWriter) formatMessage(m syslogMessage) []byte {
+	line := fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		w.facility*8+m.severity,
+		m.timestamp.UTC().Format(syslogTimestampFormat),
+		w.hostname,
+		syslogAppName,
+		w.procID,
+		syslogMsgID,
+		m.msg)
+	if w.network == "udp" {
+		return []byte(line)
+	}
+	return []byte(fmt.Sprintf("%d %s", len(line), line))
+}
+
+func (w *syslogWriter) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	if w.tlsConfig != nil {
+		return tls.DialWithDialer(dialer, w.network, w.address, w.tlsConfig)
+	}
+	return dialer.Dial(w.network, w.address)
+}
+
+func (w *syslogWriter) closeConn() {
+	if w.conn != nil {
+		w.conn.Close()
+		w.conn = nil
+	}
+}
+
+// send sends m to the syslog server, connecting to it first if needed. If the write fails on
+// an existing connection, which may have been closed by the server, it reconnects once.
+func (w *syslogWriter) send(m syslogMessage) {
+	frame := w.formatMessage(m)
+	for attempt := 0; attempt < 2; attempt++ {
+		if w.conn == nil {
+			// Do not try to reconnect for every record when the server is unreachable.
+			if !w.lastDialFailed.IsZero() && w.clock.Since(w.lastDialFailed) < syslogRetryInterval {
+				w.dropped++
+				return
+			}
+			conn, err := w.dial()
+			if err != nil {
+				klog.ErrorS(err, "Failed to connect to syslog server, dropping audit log records", "address", w.address, "dropped", w.dropped+1)
+				w.lastDialFailed = w.clock.Now()
+				w.dropped++
+				return
+			}
+			w.conn = conn
+			w.lastDialFailed = time.Time{}
+			if w.dropped > 0 {
+				klog.InfoS("Connected to syslog server", "address", w.address, "droppedRecords", w.dropped)
+				w.dropped = 0
+			}
+		}
+		w.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+		if _, err := w.conn.Write(frame); err != nil {
+			klog.V(2).InfoS("Failed to write to syslog server", "address", w.address, "err", err)
+			w.closeConn()
+			continue
+		}
+		return
+	}
+	w.dropped++
+}
+
+// run sends the queued records to the syslog server until stopCh is closed.
+func (w *syslogWriter) run(stopCh <-chan struct{}) {
+	defer w.closeConn()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case m := <-w.queue:
+			w.send(m)
+		}
+	}
+}
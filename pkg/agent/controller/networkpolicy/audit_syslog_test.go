@@ -0,0 +1,192 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestNewSyslogWriter(t *testing.T) {
+	tests := []struct {
+		name             string
+		options          *SyslogOptions
+		expectedErr      string
+		expectedNet      string
+		expectedTLS      bool
+		expectedFacility int
+		expectedHost     string
+		expectedServer   string
+	}{
+		{
+			name:             "udp",
+			options:          &SyslogOptions{Address: "10.0.0.1:514", Transport: SyslogTransportUDP, Facility: "local0", Hostname: "node1"},
+			expectedNet:      "udp",
+			expectedFacility: 16,
+			expectedHost:     "node1",
+		},
+		{
+			name:             "tcp without hostname",
+			options:          &SyslogOptions{Address: "10.0.0.1:514", Transport: SyslogTransportTCP, Facility: "local7"},
+			expectedNet:      "tcp",
+			expectedFacility: 23,
+			expectedHost:     "-",
+		},
+		{
+			name:             "tls",
+			options:          &SyslogOptions{Address: "syslog.example.com:6514", Transport: SyslogTransportTLS, Facility: "local3", Hostname: "node1"},
+			expectedNet:      "tcp",
+			expectedTLS:      true,
+			expectedFacility: 19,
+			expectedHost:     "node1",
+			expectedServer:   "syslog.example.com",
+		},
+		{
+			name:        "invalid facility",
+			options:     &SyslogOptions{Address: "10.0.0.1:514", Transport: SyslogTransportTCP, Facility: "kern"},
+			expectedErr: `unsupported syslog facility "kern"`,
+		},
+		{
+			name:        "invalid transport",
+			options:     &SyslogOptions{Address: "10.0.0.1:514", Transport: "QUIC", Facility: "local0"},
+			expectedErr: `unsupported syslog transport "QUIC"`,
+		},
+		{
+			name:        "missing CA file",
+			options:     &SyslogOptions{Address: "10.0.0.1:6514", Transport: SyslogTransportTLS, Facility: "local0", CAFile: filepath.Join(t.TempDir(), "ca.crt")},
+			expectedErr: "error reading syslog CA file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := newSyslogWriter(tt.options, clock.RealClock{})
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNet, w.network)
+			assert.Equal(t, tt.expectedFacility, w.facility)
+			assert.Equal(t, tt.expectedHost, w.hostname)
+			if tt.expectedTLS {
+				require.NotNil(t, w.tlsConfig)
+				assert.Equal(t, tt.expectedServer, w.tlsConfig.ServerName)
+			} else {
+				assert.Nil(t, w.tlsConfig)
+			}
+		})
+	}
+}
+
+func TestSyslogSeverity(t *testing.T) {
+	assert.Equal(t, syslogSeverityInformational, syslogSeverity("Allow"))
+	assert.Equal(t, syslogSeverityInformational, syslogSeverity("Redirect"))
+	assert.Equal(t, syslogSeverityWarning, syslogSeverity("Drop"))
+	assert.Equal(t, syslogSeverityWarning, syslogSeverity("Reject"))
+	assert.Equal(t, syslogSeverityNotice, syslogSeverity("Drop(Audit)"))
+}
+
+func TestSyslogWriterFormatMessage(t *testing.T) {
+	m := syslogMessage{
+		severity:  syslogSeverityWarning,
+		timestamp: time.Date(2026, 10, 18, 10, 0, 0, 123456789, time.UTC),
+		msg:       "AntreaPolicyIngressRule AntreaNetworkPolicy:default/test test-rule Ingress Drop",
+	}
+	expected := "<132>1 2026-10-18T10:00:00.123456Z node1 antrea-agent 100 np-audit - " + m.msg
+	w := &syslogWriter{network: "udp", facility: 16, hostname: "node1", procID: "100"}
+	assert.Equal(t, expected, string(w.formatMessage(m)))
+	w.network = "tcp"
+	assert.Equal(t, fmt.Sprintf("%d %s", len(expected), expected), string(w.formatMessage(m)))
+}
+
+func TestSyslogWriterTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	w, err := newSyslogWriter(&SyslogOptions{
+		Address:   listener.Addr().String(),
+		Transport: SyslogTransportTCP,
+		Facility:  "local0",
+		Hostname:  "node1",
+	}, clock.RealClock{})
+	require.NoError(t, err)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go w.run(stopCh)
+
+	w.write(syslogSeverityInformational, "msg1")
+	w.write(syslogSeverityWarning, "msg2")
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(conn)
+	readFrame := func() string {
+		length, err := reader.ReadString(' ')
+		require.NoError(t, err)
+		n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+		require.NoError(t, err)
+		frame := make([]byte, n)
+		_, err = io.ReadFull(reader, frame)
+		require.NoError(t, err)
+		return string(frame)
+	}
+	frame := readFrame()
+	assert.True(t, strings.HasPrefix(frame, "<134>1 "), frame)
+	assert.True(t, strings.HasSuffix(frame, " node1 antrea-agent "+w.procID+" np-audit - msg1"), frame)
+	frame = readFrame()
+	assert.True(t, strings.HasPrefix(frame, "<132>1 "), frame)
+	assert.True(t, strings.HasSuffix(frame, " - msg2"), frame)
+}
+
+func TestSyslogWriterUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	// Close the listener so that the address is unreachable.
+	address := listener.Addr().String()
+	listener.Close()
+
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	w, err := newSyslogWriter(&SyslogOptions{Address: address, Transport: SyslogTransportTCP, Facility: "local0"}, fakeClock)
+	require.NoError(t, err)
+
+	w.send(syslogMessage{msg: "msg1"})
+	assert.Nil(t, w.conn)
+	assert.Equal(t, int64(1), w.dropped)
+	assert.Equal(t, fakeClock.Now(), w.lastDialFailed)
+	// Records are dropped without reconnecting until the retry interval has elapsed.
+	fakeClock.Step(time.Second)
+	w.send(syslogMessage{msg: "msg2"})
+	assert.Equal(t, int64(2), w.dropped)
+	assert.Equal(t, fakeClock.Now().Add(-time.Second), w.lastDialFailed)
+	fakeClock.Step(syslogRetryInterval)
+	w.send(syslogMessage{msg: "msg3"})
+	assert.Equal(t, int64(3), w.dropped)
+	assert.Equal(t, fakeClock.Now(), w.lastDialFailed)
+}
@@ -100,6 +100,12 @@ type rule struct {
 	EnableLogging bool
 	// LogLabel is a string associated to the NetworkPolicy rule. Used for logging.
 	LogLabel string
+	// EnforcementMode of the NetworkPolicy to which this rule belongs. Empty for K8s NetworkPolicy.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode
+	// RateLimit of this rule. Only set when Action is RateLimit.
+	RateLimit *v1beta.RateLimit
+	// LogSampling of this rule. Only set for Allow rules with EnableLogging set to true.
+	LogSampling *v1beta.LogSampling
 }
 
 func (r *rule) Less(r2 *rule) bool {
@@ -318,6 +324,28 @@ func (c *ruleCache) getEffectiveRulesByNetworkPolicy(uid string) []*rule {
 	return rules
 }
 
+// getAuditOnlyRuleAction returns the action of the given rule if the rule belongs to a NetworkPolicy in Audit mode
+// and its action is not enforced in the datapath. Otherwise it returns nil.
+func (c *ruleCache) getAuditOnlyRuleAction(policyUID, ruleName string) *crdv1beta1.RuleAction {
+	c.policyMapLock.RLock()
+	defer c.policyMapLock.RUnlock()
+
+	policy, exists := c.policyMap[policyUID]
+	if !exists || policy.EnforcementMode != crdv1beta1.EnforcementModeAudit {
+		return nil
+	}
+	for i := range policy.Rules {
+		r := &policy.Rules[i]
+		if r.Name != ruleName || r.Action == nil {
+			continue
+		}
+		if *r.Action == crdv1beta1.RuleActionDrop || *r.Action == crdv1beta1.RuleActionReject {
+			return r.Action
+		}
+	}
+	return nil
+}
+
 func (c *ruleCache) GetAddressGroups() []v1beta.AddressGroup {
 	var ret []v1beta.AddressGroup
 	c.addressSetLock.RLock()
@@ -717,6 +745,9 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		SourceRef:       policy.SourceRef,
 		EnableLogging:   r.EnableLogging,
 		LogLabel:        r.LogLabel,
+		EnforcementMode: policy.EnforcementMode,
+		RateLimit:       r.RateLimit,
+		LogSampling:     r.LogSampling,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
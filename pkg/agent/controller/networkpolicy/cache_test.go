@@ -24,11 +24,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -983,6 +985,42 @@ func TestRuleCacheGetCompletedRule(t *testing.T) {
 	}
 }
 
+func TestRuleCacheGetAuditOnlyRuleAction(t *testing.T) {
+	dropAction := crdv1beta1.RuleActionDrop
+	allowAction := crdv1beta1.RuleActionAllow
+	newPolicy := func(uid string, mode crdv1beta1.PolicyEnforcementMode) *v1beta2.NetworkPolicy {
+		return &v1beta2.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{UID: k8stypes.UID(uid), Name: uid},
+			Rules: []v1beta2.NetworkPolicyRule{
+				{Name: "drop-rule", Direction: v1beta2.DirectionIn, Action: &dropAction},
+				{Name: "allow-rule", Direction: v1beta2.DirectionIn, Action: &allowAction},
+			},
+			EnforcementMode: mode,
+		}
+	}
+	c, _, _, _ := newFakeRuleCache()
+	c.policyMap["audit"] = newPolicy("audit", crdv1beta1.EnforcementModeAudit)
+	c.policyMap["enforce"] = newPolicy("enforce", crdv1beta1.EnforcementModeEnforce)
+
+	tests := []struct {
+		name           string
+		policyUID      string
+		ruleName       string
+		expectedAction *crdv1beta1.RuleAction
+	}{
+		{"audit-drop-rule", "audit", "drop-rule", &dropAction},
+		{"audit-allow-rule", "audit", "allow-rule", nil},
+		{"audit-unknown-rule", "audit", "foo", nil},
+		{"enforce-drop-rule", "enforce", "drop-rule", nil},
+		{"unknown-policy", "foo", "drop-rule", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedAction, c.getAuditOnlyRuleAction(tt.policyUID, tt.ruleName))
+		})
+	}
+}
+
 func TestRuleCachePatchAppliedToGroup(t *testing.T) {
 	rule1 := &rule{
 		ID:              "rule1",
@@ -50,6 +50,12 @@ const (
 
 	protocolHTTP = "http"
 	protocolTLS  = "tls"
+	protocolGRPC = "grpc"
+	protocolDNS  = "dns"
+	// The protocols of the rules matching DNS query types, which must be generated separately for DNS over UDP and DNS
+	// over TCP.
+	protocolDNSOverUDP = "dns-udp"
+	protocolDNSOverTCP = "dns-tcp"
 
 	scCmdOK = "OK"
 )
@@ -63,6 +69,23 @@ var (
 	// Declared as a variable for testing.
 	defaultFS = afero.NewOsFs()
 
+	// dnsQueryTypes maps the DNS query types which can be matched to their values in DNS messages.
+	dnsQueryTypes = map[string]uint16{
+		"A":     1,
+		"NS":    2,
+		"CNAME": 5,
+		"SOA":   6,
+		"PTR":   12,
+		"MX":    15,
+		"TXT":   16,
+		"AAAA":  28,
+		"SRV":   33,
+		"NAPTR": 35,
+		"SVCB":  64,
+		"HTTPS": 65,
+		"ANY":   255,
+	}
+
 	// Create the config file /etc/suricata/antrea.yaml for Antrea which will be included in the default Suricata config file
 	// /etc/suricata/suricata.yaml. Two event logs in the config serve alert gilogging and http event logging purposes respectively.
 	suricataAntreaConfigData = fmt.Sprintf(`%%YAML 1.1
@@ -85,6 +108,9 @@ outputs:
             extended: yes
         - tls:
             extended: yes
+        - dns:
+            requests: yes
+            responses: yes
   - eve-log:
       enabled: yes
       filetype: unix_stream
@@ -185,9 +211,18 @@ func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Se
 	rulesData.WriteString(rule)
 	sid++
 
-	// Generate rules.
-	for proto, keywordsSet := range protoKeywords {
-		for keywords := range keywordsSet {
+	// The default reject rule above only applies to established flows, while a DNS query over UDP is usually the first
+	// packet of its flow. Generate another default reject rule for DNS queries which are not allowed by any rule.
+	if hasDNSKeywords(protoKeywords) {
+		allKeywords = fmt.Sprintf(`msg: "Reject by %s"; flow: to_server; sid: %d;`, policyName, sid)
+		rule = fmt.Sprintf("reject dns any any -> any any (%s)\n", allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+	}
+
+	// Generate rules. The protocols and keywords are sorted to keep the sids stable.
+	for _, proto := range sets.List(sets.KeySet(protoKeywords)) {
+		for _, keywords := range sets.List(protoKeywords[proto]) {
 			// It is a convention that the sid is provided as the last keyword (or second-to-last if there is a rev)
 			// of a rule.
 			if keywords != "" {
@@ -195,7 +230,7 @@ func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Se
 			} else {
 				allKeywords = fmt.Sprintf(`msg: "Allow %s by %s"; sid: %d;`, proto, policyName, sid)
 			}
-			rule = fmt.Sprintf("pass %s any any -> any any (%s)\n", proto, allKeywords)
+			rule = fmt.Sprintf("pass %s any any -> any any (%s)\n", getAppLayerProtocol(proto), allKeywords)
 			rulesData.WriteString(rule)
 			sid++
 		}
@@ -204,6 +239,30 @@ func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Se
 	return rulesData
 }
 
+func hasDNSKeywords(protoKeywords map[string]sets.Set[string]) bool {
+	for _, proto := range []string{protocolDNS, protocolDNSOverUDP, protocolDNSOverTCP} {
+		if _, ok := protoKeywords[proto]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getAppLayerProtocol returns the Suricata application layer protocol used in the header of the rules for the given L7
+// protocol. gRPC requests are carried over HTTP/2, which is matched by the http protocol of Suricata. The rules matching
+// DNS query types use the transport protocol in the header, and match the dns application layer protocol with keywords.
+func getAppLayerProtocol(proto string) string {
+	switch proto {
+	case protocolGRPC:
+		return protocolHTTP
+	case protocolDNSOverUDP:
+		return "udp"
+	case protocolDNSOverTCP:
+		return "tcp"
+	}
+	return proto
+}
+
 func generateTenantRulesPath(vlanID uint32) string {
 	return fmt.Sprintf("%s/antrea-l7-networkpolicy-%d.rules", tenantRulesDir, vlanID)
 }
@@ -255,6 +314,35 @@ func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
 	if http.Host != "" {
 		keywords = append(keywords, fmt.Sprintf("http.host; %s", convertContent(http.Host)))
 	}
+	for _, header := range http.Headers {
+		keywords = append(keywords, convertHTTPHeader(header))
+	}
+	return strings.Join(keywords, " ")
+}
+
+// convertHTTPHeader generates the keywords matching a request header. The http.request_header buffer of Suricata
+// contains a single header formatted as "<name>: <value>", for both HTTP/1 and HTTP/2. Header names are matched
+// case-insensitively, while header values are matched exactly.
+func convertHTTPHeader(header v1beta.HTTPHeaderMatcher) string {
+	if header.Value == "" {
+		return fmt.Sprintf(`http.request_header; content:"%s: "; startswith; nocase;`, header.Name)
+	}
+	return fmt.Sprintf(`http.request_header; content:"%s: "; startswith; nocase; content:"%s"; distance:0; endswith;`, header.Name, header.Value)
+}
+
+// convertProtocolGRPC generates the keywords matching gRPC requests. A gRPC request is an HTTP/2 POST request of which
+// the path is "/<service>/<method>" and the content type is "application/grpc", optionally followed by a suffix like
+// "+proto".
+func convertProtocolGRPC(grpc *v1beta.GRPCProtocol) string {
+	var keywords []string
+	if grpc.Service != "" && grpc.Method != "" {
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(fmt.Sprintf("/%s/%s", grpc.Service, grpc.Method))))
+	} else if grpc.Service != "" {
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(fmt.Sprintf("/%s/*", grpc.Service))))
+	} else if grpc.Method != "" {
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(fmt.Sprintf("*/%s", grpc.Method))))
+	}
+	keywords = append(keywords, `http.method; content:"POST";`, `http.request_header; content:"content-type: application/grpc"; startswith; nocase;`)
 	return strings.Join(keywords, " ")
 }
 
@@ -266,6 +354,37 @@ func convertProtocolTLS(tls *v1beta.TLSProtocol) string {
 	return strings.Join(keywords, " ")
 }
 
+// convertProtocolDNS generates the keywords matching DNS queries, indexed by the protocol of the rules. As Suricata 7
+// doesn't provide a keyword to match the query type, the type is matched in the question section of the raw DNS message,
+// which follows the 12-byte header and the null-terminated query name. For DNS over TCP, the message is additionally
+// prefixed with a 2-byte length field, so separate rules anchored at the right offset are generated for each transport.
+func convertProtocolDNS(dns *v1beta.DNSProtocol) (map[string]string, error) {
+	var keywords []string
+	if dns.QueryName != "" {
+		keywords = append(keywords, fmt.Sprintf("dns.query; %s nocase;", convertContent(dns.QueryName)))
+	}
+	if len(dns.QueryTypes) == 0 {
+		return map[string]string{protocolDNS: strings.Join(keywords, " ")}, nil
+	}
+	var types []string
+	for _, queryType := range dns.QueryTypes {
+		value, ok := dnsQueryTypes[strings.ToUpper(queryType)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported DNS query type %s", queryType)
+		}
+		types = append(types, fmt.Sprintf(`\x%02x\x%02x`, value>>8, value&0xff))
+	}
+	generateKeywords := func(offset int) string {
+		transportKeywords := append([]string{"app-layer-protocol: dns;"}, keywords...)
+		transportKeywords = append(transportKeywords, fmt.Sprintf(`pkt_data; pcre:"/^.{%d}[^\x00]*\x00(?:%s)/s";`, offset, strings.Join(types, "|")))
+		return strings.Join(transportKeywords, " ")
+	}
+	return map[string]string{
+		protocolDNSOverUDP: generateKeywords(12),
+		protocolDNSOverTCP: generateKeywords(14),
+	}, nil
+}
+
 func (r *Reconciler) StartSuricataOnce() error {
 	return r.startSuricataOnce.Do(r.startSuricata)
 }
@@ -307,6 +426,25 @@ func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protoco
 			}
 			protoKeywords[protocolTLS].Insert(tlsKeywords)
 		}
+		if protocol.GRPC != nil {
+			grpcKeywords := convertProtocolGRPC(protocol.GRPC)
+			if _, ok := protoKeywords[protocolGRPC]; !ok {
+				protoKeywords[protocolGRPC] = sets.New[string]()
+			}
+			protoKeywords[protocolGRPC].Insert(grpcKeywords)
+		}
+		if protocol.DNS != nil {
+			dnsKeywords, err := convertProtocolDNS(protocol.DNS)
+			if err != nil {
+				return fmt.Errorf("failed to convert DNS protocol for L7 rule %s of %s: %w", ruleID, policyName, err)
+			}
+			for proto, keywords := range dnsKeywords {
+				if _, ok := protoKeywords[proto]; !ok {
+					protoKeywords[proto] = sets.New[string]()
+				}
+				protoKeywords[proto].Insert(keywords)
+			}
+		}
 	}
 
 	klog.InfoS("Reconciling L7 rule", "RuleID", ruleID, "PolicyName", policyName)
@@ -87,6 +87,17 @@ func TestConvertProtocolHTTP(t *testing.T) {
 			},
 			expected: `http.host; content:".foo.";`,
 		},
+		{
+			name: "with headers",
+			http: &v1beta.HTTPProtocol{
+				Method: "GET",
+				Headers: []v1beta.HTTPHeaderMatcher{
+					{Name: "X-Env", Value: "prod"},
+					{Name: "Authorization"},
+				},
+			},
+			expected: `http.method; content:"GET"; http.request_header; content:"X-Env: "; startswith; nocase; content:"prod"; distance:0; endswith; http.request_header; content:"Authorization: "; startswith; nocase;`,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -121,6 +132,108 @@ func TestConvertProtocolTLS(t *testing.T) {
 	}
 }
 
+func TestConvertProtocolGRPC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		grpc     *v1beta.GRPCProtocol
+		expected string
+	}{
+		{
+			name:     "without service,method",
+			grpc:     &v1beta.GRPCProtocol{},
+			expected: `http.method; content:"POST"; http.request_header; content:"content-type: application/grpc"; startswith; nocase;`,
+		},
+		{
+			name: "with service,method",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+				Method:  "SayHello",
+			},
+			expected: `http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith; http.method; content:"POST"; http.request_header; content:"content-type: application/grpc"; startswith; nocase;`,
+		},
+		{
+			name: "with service",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+			},
+			expected: `http.uri; content:"/helloworld.Greeter/"; startswith; http.method; content:"POST"; http.request_header; content:"content-type: application/grpc"; startswith; nocase;`,
+		},
+		{
+			name: "with method",
+			grpc: &v1beta.GRPCProtocol{
+				Method: "SayHello",
+			},
+			expected: `http.uri; content:"/SayHello"; endswith; http.method; content:"POST"; http.request_header; content:"content-type: application/grpc"; startswith; nocase;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolGRPC(tc.grpc))
+		})
+	}
+}
+
+func TestConvertProtocolDNS(t *testing.T) {
+	testCases := []struct {
+		name        string
+		dns         *v1beta.DNSProtocol
+		expected    map[string]string
+		expectedErr string
+	}{
+		{
+			name:     "without query name,query types",
+			dns:      &v1beta.DNSProtocol{},
+			expected: map[string]string{protocolDNS: ""},
+		},
+		{
+			name: "with query name suffix",
+			dns: &v1beta.DNSProtocol{
+				QueryName: "*.foo.com",
+			},
+			expected: map[string]string{protocolDNS: `dns.query; content:".foo.com"; endswith; nocase;`},
+		},
+		{
+			name: "with exact query name,query types",
+			dns: &v1beta.DNSProtocol{
+				QueryName:  "www.foo.com",
+				QueryTypes: []string{"A", "AAAA"},
+			},
+			expected: map[string]string{
+				protocolDNSOverUDP: `app-layer-protocol: dns; dns.query; content:"www.foo.com"; startswith; endswith; nocase; pkt_data; pcre:"/^.{12}[^\x00]*\x00(?:\x00\x01|\x00\x1c)/s";`,
+				protocolDNSOverTCP: `app-layer-protocol: dns; dns.query; content:"www.foo.com"; startswith; endswith; nocase; pkt_data; pcre:"/^.{14}[^\x00]*\x00(?:\x00\x01|\x00\x1c)/s";`,
+			},
+		},
+		{
+			name: "with query type ANY",
+			dns: &v1beta.DNSProtocol{
+				QueryTypes: []string{"ANY"},
+			},
+			expected: map[string]string{
+				protocolDNSOverUDP: `app-layer-protocol: dns; pkt_data; pcre:"/^.{12}[^\x00]*\x00(?:\x00\xff)/s";`,
+				protocolDNSOverTCP: `app-layer-protocol: dns; pkt_data; pcre:"/^.{14}[^\x00]*\x00(?:\x00\xff)/s";`,
+			},
+		},
+		{
+			name: "with unsupported query type",
+			dns: &v1beta.DNSProtocol{
+				QueryTypes: []string{"AXFR"},
+			},
+			expectedErr: "unsupported DNS query type AXFR",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keywords, err := convertProtocolDNS(tc.dns)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, keywords)
+			}
+		})
+	}
+}
+
 func TestStartSuricata(t *testing.T) {
 	defaultFS = afero.NewMemMapFs()
 	defer func() {
@@ -177,6 +290,44 @@ func TestRuleLifecycle(t *testing.T) {
 			expectedRules:        `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith; sid: 2;)`,
 			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
 		},
+		{
+			name: "protocol gRPC",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{
+						Service: "helloworld.Greeter",
+						Method:  "SayHello",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{},
+				},
+			},
+			expectedRules:        `pass http any any -> any any (msg: "Allow grpc by AntreaNetworkPolicy:test-l7"; http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith; http.method; content:"POST"; http.request_header; content:"content-type: application/grpc"; startswith; nocase; sid: 2;)`,
+			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow grpc by AntreaNetworkPolicy:test-l7"; http.method; content:"POST"; http.request_header; content:"content-type: application/grpc"; startswith; nocase; sid: 2;)`,
+		},
+		{
+			name: "protocol DNS",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{
+						QueryName:  "*.foo.com",
+						QueryTypes: []string{"A"},
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{},
+				},
+			},
+			expectedRules: `reject dns any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server; sid: 2;)
+pass tcp any any -> any any (msg: "Allow dns-tcp by AntreaNetworkPolicy:test-l7"; app-layer-protocol: dns; dns.query; content:".foo.com"; endswith; nocase; pkt_data; pcre:"/^.{14}[^\x00]*\x00(?:\x00\x01)/s"; sid: 3;)
+pass udp any any -> any any (msg: "Allow dns-udp by AntreaNetworkPolicy:test-l7"; app-layer-protocol: dns; dns.query; content:".foo.com"; endswith; nocase; pkt_data; pcre:"/^.{12}[^\x00]*\x00(?:\x00\x01)/s"; sid: 4;)`,
+			expectedUpdatedRules: `pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; sid: 3;)`,
+		},
 	}
 
 	for _, tc := range testCases {
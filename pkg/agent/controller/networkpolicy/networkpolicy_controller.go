@@ -638,6 +638,10 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		klog.Info("Antrea client is ready")
 	}
 
+	if c.auditLogger != nil {
+		go c.auditLogger.run(stopCh)
+	}
+
 	// Use NonSlidingUntil so that normal reconnection (disconnected after
 	// running a while) can reconnect immediately while abnormal reconnection
 	// won't be too aggressive.
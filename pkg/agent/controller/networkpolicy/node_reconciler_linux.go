@@ -338,19 +338,28 @@ func (r *nodeReconciler) computeIPTRules(rule *CompletedRule) (map[iptables.Prot
 		RulePriority:   rule.Priority,
 	}
 
+	ruleTarget := ruleActionToIPTTarget(rule.Action)
+	// Drop and Reject rules of policies in Audit mode do not decide the fate of the traffic. Only the iptables rules
+	// for logging are generated for them, and as the LOG target is non-terminating, the traffic is still evaluated
+	// against the rules with lower priorities. If logging is not enabled, such rules are not realized at all.
+	if rule.EnforcementMode == secv1beta1.EnforcementModeAudit && (*rule.Action == secv1beta1.RuleActionDrop || *rule.Action == secv1beta1.RuleActionReject) {
+		ruleTarget = ""
+	}
+	realized := ruleTarget != "" || enableLogging
+
 	var serviceIPTChain, serviceIPTRuleTarget, coreIPTRuleTarget string
 	var service *v1beta2.Service
-	if len(rule.Services) > 1 {
+	if len(rule.Services) > 1 && realized {
 		// If a rule has multiple services, create a chain to install iptables rules for these services, with the target
 		// of the services determined by the rule's action. The core iptables rule should target the chain.
 		serviceIPTChain = fmt.Sprintf("%s-%s", config.NodeNetworkPolicyPrefix, strings.ToUpper(ruleID))
-		serviceIPTRuleTarget = ruleActionToIPTTarget(rule.Action)
+		serviceIPTRuleTarget = ruleTarget
 		coreIPTRuleTarget = serviceIPTChain
 		lastRealized.serviceIPTChain = serviceIPTChain
 	} else {
 		// If a rule has no service or a single service, the target is determined by the rule's action, as there is no
 		// need to create a chain for a single-service iptables rule.
-		coreIPTRuleTarget = ruleActionToIPTTarget(rule.Action)
+		coreIPTRuleTarget = ruleTarget
 		// If a rule has a single service, the core iptables rule directly incorporates the service.
 		if len(rule.Services) == 1 {
 			service = &rule.Services[0]
@@ -368,6 +377,14 @@ func (r *nodeReconciler) computeIPTRules(rule *CompletedRule) (map[iptables.Prot
 	nodePolicyRules := make(map[iptables.Protocol]*types.NodePolicyRule)
 	for _, ipProtocol := range r.ipProtocols {
 		isIPv6 := iptables.IsIPv6Protocol(ipProtocol)
+		if !realized {
+			nodePolicyRules[ipProtocol] = &types.NodePolicyRule{
+				Priority:     priority,
+				CoreIPTChain: coreIPTChain,
+				IsIPv6:       isIPv6,
+			}
+			continue
+		}
 
 		var serviceIPTRules []string
 		if serviceIPTChain != "" {
@@ -706,10 +723,13 @@ func buildCoreIPTRules(ipProtocol iptables.Protocol,
 			Done().
 			GetRule())
 	}
-	rules = append(rules, builder.SetTarget(iptRuleTarget).
-		SetComment(iptRuleComment).
-		Done().
-		GetRule())
+	// The target is empty for the Drop and Reject rules of policies in Audit mode, which are only logged.
+	if iptRuleTarget != "" {
+		rules = append(rules, builder.SetTarget(iptRuleTarget).
+			SetComment(iptRuleComment).
+			Done().
+			GetRule())
+	}
 	return rules
 }
 
@@ -743,9 +763,11 @@ func buildServiceIPTRules(ipProtocol iptables.Protocol,
 				Done().
 				GetRule())
 		}
-		rules = append(rules, copiedBuilder.SetTarget(ruleTarget).
-			Done().
-			GetRule())
+		if ruleTarget != "" {
+			rules = append(rules, copiedBuilder.SetTarget(ruleTarget).
+				Done().
+				GetRule())
+		}
 	}
 	return rules
 }
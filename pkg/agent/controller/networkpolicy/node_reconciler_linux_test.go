@@ -32,6 +32,7 @@ import (
 
 var (
 	ruleActionAllow = secv1beta1.RuleActionAllow
+	ruleActionDrop  = secv1beta1.RuleActionDrop
 
 	ipv4Net1 = newCIDR("192.168.1.0/24")
 	ipv6Net1 = newCIDR("fec0::192:168:1:0/124")
@@ -220,6 +221,55 @@ var (
 		FromAddresses: nil,
 		ToAddresses:   nil,
 	}
+	auditedIngressDropRule = &CompletedRule{
+		rule: &rule{
+			ID:              "auditedIngressDropRule",
+			Name:            "ingress-rule-audited-drop",
+			PolicyName:      "ingress-policy",
+			From:            ipBlocksToMatchAny,
+			Direction:       v1beta2.DirectionIn,
+			Services:        []v1beta2.Service{serviceTCP8080},
+			Action:          &ruleActionDrop,
+			Priority:        1,
+			PolicyPriority:  &policyPriority1,
+			TierPriority:    &tierPriority1,
+			SourceRef:       &cnp1,
+			EnableLogging:   true,
+			LogLabel:        "audit",
+			EnforcementMode: secv1beta1.EnforcementModeAudit,
+		},
+	}
+	auditedIngressDropRuleWithoutLogging = &CompletedRule{
+		rule: &rule{
+			ID:              "auditedIngressDropRuleWithoutLogging",
+			Name:            "ingress-rule-audited-drop-no-logging",
+			PolicyName:      "ingress-policy",
+			From:            ipBlocksToMatchAny,
+			Direction:       v1beta2.DirectionIn,
+			Services:        []v1beta2.Service{serviceTCP80, serviceTCP443},
+			Action:          &ruleActionDrop,
+			Priority:        2,
+			PolicyPriority:  &policyPriority1,
+			TierPriority:    &tierPriority1,
+			SourceRef:       &cnp1,
+			EnforcementMode: secv1beta1.EnforcementModeAudit,
+		},
+	}
+	enforcedIngressDropRule = &CompletedRule{
+		rule: &rule{
+			ID:             "enforcedIngressDropRule",
+			Name:           "ingress-rule-enforced-drop",
+			PolicyName:     "ingress-policy",
+			From:           ipBlocksToMatchAny,
+			Direction:      v1beta2.DirectionIn,
+			Services:       []v1beta2.Service{serviceTCP8080},
+			Action:         &ruleActionDrop,
+			Priority:       3,
+			PolicyPriority: &policyPriority1,
+			TierPriority:   &tierPriority1,
+			SourceRef:      &cnp1,
+		},
+	}
 	egressRule1 = &CompletedRule{
 		rule: &rule{
 			ID:             egressRuleID1,
@@ -582,6 +632,40 @@ func TestNodeReconcilerReconcileAndForget(t *testing.T) {
 				ingressRuleID1,
 			},
 		},
+		{
+			name:        "IPv4, add an audited Drop rule above an enforced Drop rule, then forget them",
+			ipv4Enabled: true,
+			ipv6Enabled: false,
+			expectedCalls: func(mockRouteClient *routetest.MockInterfaceMockRecorder) {
+				auditedRules := []string{
+					`-A ANTREA-POL-INGRESS-RULES -p tcp --dport 8080 -j LOG --log-prefix "Antrea:I:Drop:audit:"`,
+				}
+				enforcedRules := []string{
+					`-A ANTREA-POL-INGRESS-RULES -p tcp --dport 8080 -j DROP -m comment --comment "Antrea: for rule ingress-rule-enforced-drop, policy AntreaClusterNetworkPolicy:name1"`,
+				}
+				gomock.InOrder(
+					// The audited rule is only logged, and traffic continues to the next rules.
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, [][]string{auditedRules}, false),
+					// The audited rule without logging is not realized.
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, [][]string{auditedRules}, false),
+					// The enforced rule with a lower priority still drops the traffic.
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, [][]string{append(auditedRules, enforcedRules...)}, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, [][]string{auditedRules}, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, [][]string{auditedRules}, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, [][]string{nil}, false),
+				)
+			},
+			rulesToAdd: []*CompletedRule{
+				auditedIngressDropRule,
+				auditedIngressDropRuleWithoutLogging,
+				enforcedIngressDropRule,
+			},
+			rulesToForget: []string{
+				"enforcedIngressDropRule",
+				"auditedIngressDropRuleWithoutLogging",
+				"auditedIngressDropRule",
+			},
+		},
 		{
 			name:        "IPv4, add an ingress rule, then update it several times, forget it finally",
 			ipv4Enabled: true,
@@ -531,16 +531,19 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 		ofPorts := r.getOFPorts(rule.TargetMembers)
 		lastRealized.podOFPorts[igmpServicesKey] = ofPorts
 		ofRuleByServicesMap[igmpServicesKey] = &types.PolicyRule{
-			Direction:     v1beta2.DirectionIn,
-			To:            ofPortsToOFAddresses(ofPorts),
-			Service:       rule.Services,
-			Action:        rule.Action,
-			Name:          rule.Name,
-			Priority:      ofPriority,
-			TableID:       table,
-			PolicyRef:     rule.SourceRef,
-			EnableLogging: rule.EnableLogging,
-			LogLabel:      rule.LogLabel,
+			Direction:       v1beta2.DirectionIn,
+			To:              ofPortsToOFAddresses(ofPorts),
+			Service:         rule.Services,
+			Action:          rule.Action,
+			Name:            rule.Name,
+			Priority:        ofPriority,
+			TableID:         table,
+			PolicyRef:       rule.SourceRef,
+			EnableLogging:   rule.EnableLogging,
+			LogLabel:        rule.LogLabel,
+			EnforcementMode: rule.EnforcementMode,
+			RateLimit:       rule.RateLimit,
+			LogSampling:     rule.LogSampling,
 		}
 		return ofRuleByServicesMap, lastRealized
 	} else if isIGMP {
@@ -571,19 +574,22 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				lastRealized.podOFPorts[svcKey] = ofPorts
 			}
 			ofRuleByServicesMap[svcKey] = &types.PolicyRule{
-				Direction:     v1beta2.DirectionIn,
-				From:          from,
-				To:            toAddresses,
-				Service:       filterUnresolvablePort(servicesMap[svcKey]),
-				L7Protocols:   rule.L7Protocols,
-				L7RuleVlanID:  rule.L7RuleVlanID,
-				Action:        rule.Action,
-				Name:          rule.Name,
-				Priority:      ofPriority,
-				TableID:       table,
-				PolicyRef:     rule.SourceRef,
-				EnableLogging: rule.EnableLogging,
-				LogLabel:      rule.LogLabel,
+				Direction:       v1beta2.DirectionIn,
+				From:            from,
+				To:              toAddresses,
+				Service:         filterUnresolvablePort(servicesMap[svcKey]),
+				L7Protocols:     rule.L7Protocols,
+				L7RuleVlanID:    rule.L7RuleVlanID,
+				Action:          rule.Action,
+				Name:            rule.Name,
+				Priority:        ofPriority,
+				TableID:         table,
+				PolicyRef:       rule.SourceRef,
+				EnableLogging:   rule.EnableLogging,
+				LogLabel:        rule.LogLabel,
+				EnforcementMode: rule.EnforcementMode,
+				RateLimit:       rule.RateLimit,
+				LogSampling:     rule.LogSampling,
 			}
 		}
 	} else {
@@ -602,19 +608,22 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 		memberByServicesMap, servicesMap := groupMembersByServices(rule.Services, rule.ToAddresses)
 		for svcKey, members := range memberByServicesMap {
 			ofRuleByServicesMap[svcKey] = &types.PolicyRule{
-				Direction:     v1beta2.DirectionOut,
-				From:          from,
-				To:            groupMembersToOFAddresses(members),
-				Service:       filterUnresolvablePort(servicesMap[svcKey]),
-				L7Protocols:   rule.L7Protocols,
-				L7RuleVlanID:  rule.L7RuleVlanID,
-				Action:        rule.Action,
-				Priority:      ofPriority,
-				Name:          rule.Name,
-				TableID:       table,
-				PolicyRef:     rule.SourceRef,
-				EnableLogging: rule.EnableLogging,
-				LogLabel:      rule.LogLabel,
+				Direction:       v1beta2.DirectionOut,
+				From:            from,
+				To:              groupMembersToOFAddresses(members),
+				Service:         filterUnresolvablePort(servicesMap[svcKey]),
+				L7Protocols:     rule.L7Protocols,
+				L7RuleVlanID:    rule.L7RuleVlanID,
+				Action:          rule.Action,
+				Priority:        ofPriority,
+				Name:            rule.Name,
+				TableID:         table,
+				PolicyRef:       rule.SourceRef,
+				EnableLogging:   rule.EnableLogging,
+				LogLabel:        rule.LogLabel,
+				EnforcementMode: rule.EnforcementMode,
+				RateLimit:       rule.RateLimit,
+				LogSampling:     rule.LogSampling,
 			}
 		}
 
@@ -629,17 +638,20 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 			// Create a new Openflow rule if the group doesn't exist.
 			if !exists {
 				ofRule = &types.PolicyRule{
-					Direction:     v1beta2.DirectionOut,
-					From:          from,
-					To:            []types.Address{},
-					Service:       filterUnresolvablePort(rule.Services),
-					Action:        rule.Action,
-					Name:          rule.Name,
-					Priority:      nil,
-					TableID:       table,
-					PolicyRef:     rule.SourceRef,
-					EnableLogging: rule.EnableLogging,
-					LogLabel:      rule.LogLabel,
+					Direction:       v1beta2.DirectionOut,
+					From:            from,
+					To:              []types.Address{},
+					Service:         filterUnresolvablePort(rule.Services),
+					Action:          rule.Action,
+					Name:            rule.Name,
+					Priority:        nil,
+					TableID:         table,
+					PolicyRef:       rule.SourceRef,
+					EnableLogging:   rule.EnableLogging,
+					LogLabel:        rule.LogLabel,
+					EnforcementMode: rule.EnforcementMode,
+					RateLimit:       rule.RateLimit,
+					LogSampling:     rule.LogSampling,
 				}
 				ofRuleByServicesMap[svcKey] = ofRule
 			}
@@ -728,18 +740,21 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 		// Install a new Openflow rule if this group doesn't exist, otherwise do incremental update.
 		if !exists {
 			ofRule := &types.PolicyRule{
-				Direction:     v1beta2.DirectionIn,
-				To:            ofPortsToOFAddresses(newOFPorts),
-				Service:       newRule.Services,
-				L7Protocols:   newRule.L7Protocols,
-				L7RuleVlanID:  newRule.L7RuleVlanID,
-				Action:        newRule.Action,
-				Priority:      ofPriority,
-				FlowID:        ofID,
-				TableID:       table,
-				PolicyRef:     newRule.SourceRef,
-				EnableLogging: newRule.EnableLogging,
-				LogLabel:      newRule.LogLabel,
+				Direction:       v1beta2.DirectionIn,
+				To:              ofPortsToOFAddresses(newOFPorts),
+				Service:         newRule.Services,
+				L7Protocols:     newRule.L7Protocols,
+				L7RuleVlanID:    newRule.L7RuleVlanID,
+				Action:          newRule.Action,
+				Priority:        ofPriority,
+				FlowID:          ofID,
+				TableID:         table,
+				PolicyRef:       newRule.SourceRef,
+				EnableLogging:   newRule.EnableLogging,
+				LogLabel:        newRule.LogLabel,
+				EnforcementMode: newRule.EnforcementMode,
+				RateLimit:       newRule.RateLimit,
+				LogSampling:     newRule.LogSampling,
 			}
 			err := r.idAllocator.allocateForRule(ofRule)
 			if err != nil {
@@ -785,19 +800,22 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 			// Install a new Openflow rule if this group doesn't exist, otherwise do incremental update.
 			if !exists {
 				ofRule := &types.PolicyRule{
-					Direction:     v1beta2.DirectionIn,
-					From:          append(from1, from2...),
-					To:            toAddresses,
-					Service:       filterUnresolvablePort(servicesMap[svcKey]),
-					L7Protocols:   newRule.L7Protocols,
-					L7RuleVlanID:  newRule.L7RuleVlanID,
-					Action:        newRule.Action,
-					Priority:      ofPriority,
-					FlowID:        ofID,
-					TableID:       table,
-					PolicyRef:     newRule.SourceRef,
-					EnableLogging: newRule.EnableLogging,
-					LogLabel:      newRule.LogLabel,
+					Direction:       v1beta2.DirectionIn,
+					From:            append(from1, from2...),
+					To:              toAddresses,
+					Service:         filterUnresolvablePort(servicesMap[svcKey]),
+					L7Protocols:     newRule.L7Protocols,
+					L7RuleVlanID:    newRule.L7RuleVlanID,
+					Action:          newRule.Action,
+					Priority:        ofPriority,
+					FlowID:          ofID,
+					TableID:         table,
+					PolicyRef:       newRule.SourceRef,
+					EnableLogging:   newRule.EnableLogging,
+					LogLabel:        newRule.LogLabel,
+					EnforcementMode: newRule.EnforcementMode,
+					RateLimit:       newRule.RateLimit,
+					LogSampling:     newRule.LogSampling,
 				}
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
@@ -857,19 +875,22 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 			ofID, exists := lastRealized.ofIDs[svcKey]
 			if !exists {
 				ofRule := &types.PolicyRule{
-					Direction:     v1beta2.DirectionOut,
-					From:          from,
-					To:            groupMembersToOFAddresses(members),
-					Service:       filterUnresolvablePort(servicesMap[svcKey]),
-					L7Protocols:   newRule.L7Protocols,
-					L7RuleVlanID:  newRule.L7RuleVlanID,
-					Action:        newRule.Action,
-					Priority:      ofPriority,
-					FlowID:        ofID,
-					TableID:       table,
-					PolicyRef:     newRule.SourceRef,
-					EnableLogging: newRule.EnableLogging,
-					LogLabel:      newRule.LogLabel,
+					Direction:       v1beta2.DirectionOut,
+					From:            from,
+					To:              groupMembersToOFAddresses(members),
+					Service:         filterUnresolvablePort(servicesMap[svcKey]),
+					L7Protocols:     newRule.L7Protocols,
+					L7RuleVlanID:    newRule.L7RuleVlanID,
+					Action:          newRule.Action,
+					Priority:        ofPriority,
+					FlowID:          ofID,
+					TableID:         table,
+					PolicyRef:       newRule.SourceRef,
+					EnableLogging:   newRule.EnableLogging,
+					LogLabel:        newRule.LogLabel,
+					EnforcementMode: newRule.EnforcementMode,
+					RateLimit:       newRule.RateLimit,
+					LogSampling:     newRule.LogSampling,
 				}
 				// If the PolicyRule for the original services doesn't exist and IPBlocks is present, it means the
 				// podReconciler hasn't installed flows for IPBlocks, then it must be added to the new PolicyRule.
@@ -92,6 +92,12 @@ type Connection struct {
 	AppProtocolName                      string
 	HttpVals                             string
 	EgressNodeName                       string
+	// TCP performance metrics, sampled from the kernel socket of the connection when TCP
+	// metrics are enabled. RTT values and RwndLimitedTime are in microseconds.
+	TCPRTT             uint32
+	TCPRTTVar          uint32
+	TCPRetransmissions uint32
+	TCPRwndLimitedTime uint64
 }
 
 // NewConnectionKey creates 5-tuple of flow as connection key
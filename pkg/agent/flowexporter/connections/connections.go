@@ -23,6 +23,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/flowexporter/filter"
 	"antrea.io/antrea/pkg/agent/flowexporter/options"
 	"antrea.io/antrea/pkg/agent/flowexporter/priorityqueue"
 	"antrea.io/antrea/pkg/agent/proxy"
@@ -39,19 +40,22 @@ type connectionStore struct {
 	antreaProxier          proxy.Proxier
 	expirePriorityQueue    *priorityqueue.ExpirePriorityQueue
 	staleConnectionTimeout time.Duration
+	connectionFilter       *filter.ConnectionFilter
 	mutex                  sync.Mutex
 }
 
 func NewConnectionStore(
 	podStore objectstore.PodStore,
 	proxier proxy.Proxier,
-	o *options.FlowExporterOptions) connectionStore {
+	o *options.FlowExporterOptions,
+	connectionFilter *filter.ConnectionFilter) connectionStore {
 	return connectionStore{
 		connections:            make(map[connection.ConnectionKey]*connection.Connection),
 		podStore:               podStore,
 		antreaProxier:          proxier,
 		expirePriorityQueue:    priorityqueue.NewExpirePriorityQueue(o.ActiveFlowTimeout, o.IdleFlowTimeout),
 		staleConnectionTimeout: o.StaleConnectionTimeout,
+		connectionFilter:       connectionFilter,
 	}
 }
 
@@ -98,10 +102,12 @@ func (cs *connectionStore) AddConnToMap(connKey *connection.ConnectionKey, conn
 	cs.connections[*connKey] = conn
 }
 
-func (cs *connectionStore) fillPodInfo(conn *connection.Connection) {
+// fillPodInfo fills the Pod information for the connection, and returns the local source and
+// destination Pods (nil if not found).
+func (cs *connectionStore) fillPodInfo(conn *connection.Connection) (*corev1.Pod, *corev1.Pod) {
 	if cs.podStore == nil {
 		klog.V(4).Info("Pod store is not available to retrieve local Pods information.")
-		return
+		return nil, nil
 	}
 	// sourceIP/destinationIP are mapped only to local pods and not remote pods.
 	srcIP := conn.FlowKey.SourceAddress.String()
@@ -119,6 +125,7 @@ func (cs *connectionStore) fillPodInfo(conn *connection.Connection) {
 		conn.DestinationPodNamespace = dstPod.Namespace
 		conn.DestinationPodUID = string(dstPod.UID)
 	}
+	return srcPod, dstPod
 }
 
 func (cs *connectionStore) fillServiceInfo(conn *connection.Connection, serviceStr string) {
@@ -25,6 +25,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/flowexporter/filter"
 	"antrea.io/antrea/pkg/agent/flowexporter/options"
 	"antrea.io/antrea/pkg/agent/flowexporter/priorityqueue"
 	"antrea.io/antrea/pkg/agent/flowexporter/utils"
@@ -35,6 +36,8 @@ import (
 	"antrea.io/antrea/pkg/util/objectstore"
 )
 
+const tcpProtocol uint8 = 6
+
 var serviceProtocolMap = map[uint8]corev1.Protocol{
 	6:   corev1.ProtocolTCP,
 	17:  corev1.ProtocolUDP,
@@ -49,6 +52,7 @@ type ConntrackConnectionStore struct {
 	pollInterval          time.Duration
 	connectUplinkToBridge bool
 	l7EventMapGetter      L7EventMapGetter
+	tcpInfoDumper         TCPInfoDumper
 	connectionStore
 }
 
@@ -64,7 +68,9 @@ func NewConntrackConnectionStore(
 	podStore objectstore.PodStore,
 	proxier proxy.Proxier,
 	l7EventMapGetterFunc L7EventMapGetter,
+	tcpInfoDumper TCPInfoDumper,
 	o *options.FlowExporterOptions,
+	connectionFilter *filter.ConnectionFilter,
 ) *ConntrackConnectionStore {
 	return &ConntrackConnectionStore{
 		connDumper:            connTrackDumper,
@@ -72,9 +78,10 @@ func NewConntrackConnectionStore(
 		v6Enabled:             v6Enabled,
 		networkPolicyQuerier:  npQuerier,
 		pollInterval:          o.PollInterval,
-		connectionStore:       NewConnectionStore(podStore, proxier, o),
+		connectionStore:       NewConnectionStore(podStore, proxier, o, connectionFilter),
 		connectUplinkToBridge: o.ConnectUplinkToBridge,
 		l7EventMapGetter:      l7EventMapGetterFunc,
+		tcpInfoDumper:         tcpInfoDumper,
 	}
 }
 
@@ -141,6 +148,18 @@ func (cs *ConntrackConnectionStore) Poll() ([]int, error) {
 		connsLens = append(connsLens, len(filteredConnsList))
 	}
 
+	// Sample TCP metrics after dumping the conntrack table, so that metrics are available for
+	// all the connections which have been dumped, including new ones.
+	var tcpMetrics map[connection.Tuple]*TCPMetrics
+	if cs.tcpInfoDumper != nil {
+		var err error
+		tcpMetrics, err = cs.tcpInfoDumper.DumpTCPInfo()
+		if err != nil {
+			// Not failing here, as TCP metrics are best effort.
+			klog.ErrorS(err, "Error when dumping TCP metrics")
+		}
+	}
+
 	// Reset IsPresent flag for all connections in connection map before updating
 	// the dumped flows information in connection map. If the connection does not
 	// exist in conntrack table and has been exported, then we will delete it from
@@ -182,6 +201,9 @@ func (cs *ConntrackConnectionStore) Poll() ([]int, error) {
 	if len(l7EventMap) != 0 {
 		cs.fillL7EventInfo(l7EventMap)
 	}
+	if len(tcpMetrics) != 0 {
+		cs.fillTCPMetrics(tcpMetrics)
+	}
 
 	cs.ReleaseConnStoreLock()
 
@@ -273,7 +295,7 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *connection.Connection)
 		}
 		klog.V(4).InfoS("Antrea flow updated", "connection", existingConn)
 	} else {
-		cs.fillPodInfo(conn)
+		srcPod, dstPod := cs.fillPodInfo(conn)
 		if conn.SourcePodName == "" && conn.DestinationPodName == "" {
 			// We don't add connections to connection map or expirePriorityQueue if we can't find the pod
 			// information for both srcPod and dstPod
@@ -292,6 +314,12 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *connection.Connection)
 			}
 		}
 		cs.addNetworkPolicyMetadata(conn)
+		if !cs.connectionFilter.Allow(conn, srcPod, dstPod) {
+			// The connection will be evaluated again during the next poll cycle, as we
+			// don't add it to the connection map.
+			klog.V(5).InfoS("Skip this connection as it is not selected by the connection filter", "flowKey", conn.FlowKey)
+			return
+		}
 		if conn.StartTime.IsZero() {
 			conn.StartTime = time.Now()
 			conn.StopTime = time.Now()
@@ -370,3 +398,46 @@ func (cs *ConntrackConnectionStore) fillL7EventInfo(l7EventMap map[connection.Tu
 		}
 	}
 }
+
+// fillTCPMetrics updates the TCP metrics of the connections in the connection store, using the
+// metrics of the sockets matching each connection. When both the client and the server sockets
+// are local, the metrics of the client socket are used. Metrics are kept unchanged when no
+// matching socket is found, e.g., after the socket has been closed.
+func (cs *ConntrackConnectionStore) fillTCPMetrics(tcpMetrics map[connection.Tuple]*TCPMetrics) {
+	for _, conn := range cs.connections {
+		if conn.FlowKey.Protocol != tcpProtocol {
+			continue
+		}
+		metrics := lookupTCPMetrics(conn, tcpMetrics)
+		if metrics == nil {
+			continue
+		}
+		conn.TCPRTT = metrics.RTT
+		conn.TCPRTTVar = metrics.RTTVar
+		conn.TCPRetransmissions = metrics.Retransmissions
+		conn.TCPRwndLimitedTime = metrics.RwndLimitedTime
+	}
+}
+
+func lookupTCPMetrics(conn *connection.Connection, tcpMetrics map[connection.Tuple]*TCPMetrics) *TCPMetrics {
+	// The client socket is connected to the original destination of the connection, which is
+	// the ClusterIP in case of Service traffic.
+	clientKey := connection.Tuple{
+		SourceAddress:      conn.FlowKey.SourceAddress,
+		DestinationAddress: conn.OriginalDestinationAddress,
+		Protocol:           conn.FlowKey.Protocol,
+		SourcePort:         conn.FlowKey.SourcePort,
+		DestinationPort:    conn.OriginalDestinationPort,
+	}
+	if metrics, ok := tcpMetrics[clientKey]; ok {
+		return metrics
+	}
+	serverKey := connection.Tuple{
+		SourceAddress:      conn.FlowKey.DestinationAddress,
+		DestinationAddress: conn.FlowKey.SourceAddress,
+		Protocol:           conn.FlowKey.Protocol,
+		SourcePort:         conn.FlowKey.DestinationPort,
+		DestinationPort:    conn.FlowKey.SourcePort,
+	}
+	return tcpMetrics[serverKey]
+}
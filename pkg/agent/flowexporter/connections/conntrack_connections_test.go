@@ -225,7 +225,7 @@ func TestConntrackConnectionStore_AddOrUpdateConn(t *testing.T) {
 	mockProxier := proxytest.NewMockProxier(ctrl)
 	mockConnDumper := connectionstest.NewMockConnTrackDumper(ctrl)
 	npQuerier := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
-	conntrackConnStore := NewConntrackConnectionStore(mockConnDumper, true, false, npQuerier, mockPodStore, mockProxier, nil, testFlowExporterOptions)
+	conntrackConnStore := NewConntrackConnectionStore(mockConnDumper, true, false, npQuerier, mockPodStore, mockProxier, nil, nil, testFlowExporterOptions, nil)
 
 	for _, c := range tc {
 		t.Run(c.name, func(t *testing.T) {
@@ -306,7 +306,7 @@ func TestConnectionStore_DeleteConnectionByKey(t *testing.T) {
 	metrics.TotalAntreaConnectionsInConnTrackTable.Set(float64(len(testFlows)))
 	// Create connectionStore
 	mockPodStore := objectstoretest.NewMockPodStore(ctrl)
-	connStore := NewConntrackConnectionStore(nil, true, false, nil, mockPodStore, nil, nil, testFlowExporterOptions)
+	connStore := NewConntrackConnectionStore(nil, true, false, nil, mockPodStore, nil, nil, nil, testFlowExporterOptions, nil)
 	// Add flows to the connection store.
 	for i, flow := range testFlows {
 		connStore.connections[*testFlowKeys[i]] = flow
@@ -328,7 +328,7 @@ func TestConnectionStore_MetricSettingInPoll(t *testing.T) {
 	// Create connectionStore
 	mockPodStore := objectstoretest.NewMockPodStore(ctrl)
 	mockConnDumper := connectionstest.NewMockConnTrackDumper(ctrl)
-	conntrackConnStore := NewConntrackConnectionStore(mockConnDumper, true, false, nil, mockPodStore, nil, &fakeL7Listener{}, testFlowExporterOptions)
+	conntrackConnStore := NewConntrackConnectionStore(mockConnDumper, true, false, nil, mockPodStore, nil, &fakeL7Listener{}, nil, testFlowExporterOptions, nil)
 	// Hard-coded conntrack occupancy metrics for test
 	TotalConnections := 0
 	MaxConnections := 300000
@@ -341,3 +341,57 @@ func TestConnectionStore_MetricSettingInPoll(t *testing.T) {
 	checkTotalConnectionsMetric(t, TotalConnections)
 	checkMaxConnectionsMetric(t, MaxConnections)
 }
+
+func TestConntrackConnectionStore_FillTCPMetrics(t *testing.T) {
+	podIP := netip.MustParseAddr("10.10.0.2")
+	clusterIP := netip.MustParseAddr("10.96.0.10")
+	endpointIP := netip.MustParseAddr("10.10.1.3")
+	remoteIP := netip.MustParseAddr("10.10.1.4")
+	clientConn := &connection.Connection{
+		FlowKey:                    connection.Tuple{SourceAddress: podIP, DestinationAddress: endpointIP, Protocol: 6, SourcePort: 40000, DestinationPort: 8080},
+		OriginalDestinationAddress: clusterIP,
+		OriginalDestinationPort:    80,
+	}
+	serverConn := &connection.Connection{
+		FlowKey:                    connection.Tuple{SourceAddress: remoteIP, DestinationAddress: podIP, Protocol: 6, SourcePort: 50000, DestinationPort: 443},
+		OriginalDestinationAddress: podIP,
+		OriginalDestinationPort:    443,
+	}
+	unknownConn := &connection.Connection{
+		FlowKey:                    connection.Tuple{SourceAddress: remoteIP, DestinationAddress: podIP, Protocol: 6, SourcePort: 50001, DestinationPort: 443},
+		OriginalDestinationAddress: podIP,
+		OriginalDestinationPort:    443,
+		TCPRTT:                     100,
+	}
+	udpConn := &connection.Connection{
+		FlowKey:                    connection.Tuple{SourceAddress: podIP, DestinationAddress: clusterIP, Protocol: 17, SourcePort: 40000, DestinationPort: 80},
+		OriginalDestinationAddress: clusterIP,
+		OriginalDestinationPort:    80,
+	}
+	tcpMetrics := map[connection.Tuple]*TCPMetrics{
+		// Client socket, connected to the ClusterIP.
+		{SourceAddress: podIP, DestinationAddress: clusterIP, Protocol: 6, SourcePort: 40000, DestinationPort: 80}: {
+			RTT: 1000, RTTVar: 200, Retransmissions: 1, RwndLimitedTime: 5000,
+		},
+		// Server socket.
+		{SourceAddress: podIP, DestinationAddress: remoteIP, Protocol: 6, SourcePort: 443, DestinationPort: 50000}: {
+			RTT: 2000, RTTVar: 400, Retransmissions: 3, RwndLimitedTime: 0,
+		},
+	}
+
+	connStore := NewConntrackConnectionStore(nil, true, false, nil, nil, nil, nil, nil, testFlowExporterOptions, nil)
+	for _, conn := range []*connection.Connection{clientConn, serverConn, unknownConn, udpConn} {
+		connStore.connections[conn.FlowKey] = conn
+	}
+	connStore.fillTCPMetrics(tcpMetrics)
+
+	assert.Equal(t, uint32(1000), clientConn.TCPRTT)
+	assert.Equal(t, uint32(200), clientConn.TCPRTTVar)
+	assert.Equal(t, uint32(1), clientConn.TCPRetransmissions)
+	assert.Equal(t, uint64(5000), clientConn.TCPRwndLimitedTime)
+	assert.Equal(t, uint32(2000), serverConn.TCPRTT)
+	assert.Equal(t, uint32(3), serverConn.TCPRetransmissions)
+	// Metrics are kept unchanged when no socket is found.
+	assert.Equal(t, uint32(100), unknownConn.TCPRTT)
+	assert.Zero(t, udpConn.TCPRTT)
+}
@@ -36,9 +36,9 @@ type DenyConnectionStore struct {
 	protocolFilter filter.ProtocolFilter
 }
 
-func NewDenyConnectionStore(podStore objectstore.PodStore, proxier proxy.Proxier, o *options.FlowExporterOptions, protocolFilter filter.ProtocolFilter) *DenyConnectionStore {
+func NewDenyConnectionStore(podStore objectstore.PodStore, proxier proxy.Proxier, o *options.FlowExporterOptions, protocolFilter filter.ProtocolFilter, connectionFilter *filter.ConnectionFilter) *DenyConnectionStore {
 	return &DenyConnectionStore{
-		connectionStore: NewConnectionStore(podStore, proxier, o),
+		connectionStore: NewConnectionStore(podStore, proxier, o, connectionFilter),
 		protocolFilter:  protocolFilter,
 	}
 }
@@ -104,7 +104,7 @@ func (ds *DenyConnectionStore) AddOrUpdateConn(conn *connection.Connection, time
 		conn.LastExportTime = timeSeen
 		conn.OriginalBytes = bytes
 		conn.OriginalPackets = uint64(1)
-		ds.fillPodInfo(conn)
+		srcPod, dstPod := ds.fillPodInfo(conn)
 		if conn.SourcePodName == "" && conn.DestinationPodName == "" {
 			// We don't add connections to connection map or expirePriorityQueue if we can't find the pod
 			// information for both srcPod and dstPod
@@ -116,6 +116,10 @@ func (ds *DenyConnectionStore) AddOrUpdateConn(conn *connection.Connection, time
 		if conn.Mark&openflow.ServiceCTMark.GetRange().ToNXRange().ToUint32Mask() == openflow.ServiceCTMark.GetValue() {
 			ds.fillServiceInfo(conn, serviceStr)
 		}
+		if !ds.connectionFilter.Allow(conn, srcPod, dstPod) {
+			klog.V(5).InfoS("Skip this connection as it is not selected by the connection filter", "flowKey", conn.FlowKey)
+			return
+		}
 		metrics.TotalDenyConnections.Inc()
 		conn.IsActive = true
 		ds.connections[connKey] = conn
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,6 +31,7 @@ import (
 	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	proxytest "antrea.io/antrea/pkg/agent/proxy/testing"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
 	objectstoretest "antrea.io/antrea/pkg/util/objectstore/testing"
 	k8sproxy "antrea.io/antrea/third_party/proxy"
 )
@@ -53,6 +55,7 @@ func TestDenyConnectionStore_AddOrUpdateConn(t *testing.T) {
 		testFlow                 connection.Connection
 		isSvc                    bool
 		protocolFilter           []string
+		connectionFilter         agentconfig.FlowExporterConnectionFilterConfig
 		expectConnectionNotFound bool
 	}{
 		{
@@ -114,6 +117,41 @@ func TestDenyConnectionStore_AddOrUpdateConn(t *testing.T) {
 			},
 			isSvc:          true,
 			protocolFilter: []string{"TCP"},
+		}, {
+			name: "With connection filter excluding the Pod Namespace",
+			testFlow: connection.Connection{
+				StopTime:                   refTime.Add(-(time.Second * 20)),
+				StartTime:                  refTime.Add(-(time.Second * 20)),
+				FlowKey:                    tuple,
+				OriginalDestinationAddress: tuple.DestinationAddress,
+				OriginalDestinationPort:    tuple.DestinationPort,
+				OriginalBytes:              uint64(60),
+				OriginalPackets:            uint64(1),
+				IsActive:                   true,
+				Mark:                       0,
+			},
+			isSvc: false,
+			connectionFilter: agentconfig.FlowExporterConnectionFilterConfig{
+				Exclude: []agentconfig.FlowExporterConnectionFilterRule{{Namespaces: []string{pod1.Namespace}}},
+			},
+			expectConnectionNotFound: true,
+		}, {
+			name: "With connection filter including the destination port",
+			testFlow: connection.Connection{
+				StopTime:                   refTime.Add(-(time.Second * 20)),
+				StartTime:                  refTime.Add(-(time.Second * 20)),
+				FlowKey:                    tuple,
+				OriginalDestinationAddress: tuple.DestinationAddress,
+				OriginalDestinationPort:    tuple.DestinationPort,
+				OriginalBytes:              uint64(60),
+				OriginalPackets:            uint64(1),
+				IsActive:                   true,
+				Mark:                       0,
+			},
+			isSvc: false,
+			connectionFilter: agentconfig.FlowExporterConnectionFilterConfig{
+				Include: []agentconfig.FlowExporterConnectionFilterRule{{Ports: []int32{int32(tuple.DestinationPort)}}},
+			},
 		},
 	}
 	for _, c := range tc {
@@ -124,7 +162,10 @@ func TestDenyConnectionStore_AddOrUpdateConn(t *testing.T) {
 			mockProxier := proxytest.NewMockProxier(ctrl)
 			protocol, _ := lookupServiceProtocol(tuple.Protocol)
 			serviceStr := fmt.Sprintf("%s:%d/%s", tuple.DestinationAddress.String(), tuple.DestinationPort, protocol)
-			if !c.expectConnectionNotFound {
+			connectionFilter, err := filter.NewConnectionFilter(c.connectionFilter, 0)
+			require.NoError(t, err)
+			// The connection filter is applied after looking up the Pods.
+			if !c.expectConnectionNotFound || connectionFilter != nil {
 				if c.isSvc {
 					mockProxier.EXPECT().GetServiceByIP(serviceStr).Return(servicePortName, true)
 				}
@@ -132,7 +173,7 @@ func TestDenyConnectionStore_AddOrUpdateConn(t *testing.T) {
 				mockPodStore.EXPECT().GetPodByIPAndTime(tuple.DestinationAddress.String(), gomock.Any()).Return(pod1, true)
 			}
 
-			denyConnStore := NewDenyConnectionStore(mockPodStore, mockProxier, testFlowExporterOptions, filter.NewProtocolFilter(c.protocolFilter))
+			denyConnStore := NewDenyConnectionStore(mockPodStore, mockProxier, testFlowExporterOptions, filter.NewProtocolFilter(c.protocolFilter), connectionFilter)
 
 			denyConnStore.AddOrUpdateConn(&c.testFlow, refTime.Add(-(time.Second * 20)), uint64(60))
 			expConn := c.testFlow
@@ -27,3 +27,26 @@ type ConnTrackDumper interface {
 	// GetMaxConnections returns the size of the connection tracking table.
 	GetMaxConnections() (int, error)
 }
+
+// TCPInfoDumper is an interface that is used to sample TCP performance metrics from the kernel sockets
+// (tcp_info) backing the connections. Conntrack does not track such metrics, so they have to be
+// collected from the endpoints of the connections which are visible to the agent.
+type TCPInfoDumper interface {
+	// DumpTCPInfo returns the metrics of all the TCP sockets which can be observed by the dumper,
+	// indexed by socket tuple: the source of the tuple is the local end of the socket, and the
+	// destination of the tuple is the remote end of the socket.
+	DumpTCPInfo() (map[connection.Tuple]*TCPMetrics, error)
+}
+
+// TCPMetrics holds the performance metrics reported by the kernel for a TCP socket.
+type TCPMetrics struct {
+	// Smoothed round-trip time, in microseconds.
+	RTT uint32
+	// Round-trip time variance, in microseconds.
+	RTTVar uint32
+	// Total number of segments retransmitted over the lifetime of the socket.
+	Retransmissions uint32
+	// Cumulative time, in microseconds, during which the sender was limited by the receive window
+	// advertised by the peer, which includes zero-window conditions.
+	RwndLimitedTime uint64
+}
@@ -0,0 +1,128 @@
+//go:build linux
+// +build linux
+
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+)
+
+var (
+	// Declared as variables for unit testing.
+	socketDiagTCPInfo = netlink.SocketDiagTCPInfo
+	withNetNSPath     = ns.WithNetNSPath
+)
+
+// tcpInfoDumper implements TCPInfoDumper using the sock_diag netlink interface. Sockets are dumped
+// in the host network namespace, which covers the Node and hostNetwork Pods, and in the network
+// namespace of each local Pod known to the interface store.
+type tcpInfoDumper struct {
+	ifaceStore interfacestore.InterfaceStore
+	families   []uint8
+}
+
+var _ TCPInfoDumper = new(tcpInfoDumper)
+
+func NewTCPInfoDumper(ifaceStore interfacestore.InterfaceStore, v4Enabled, v6Enabled bool) (TCPInfoDumper, error) {
+	d := &tcpInfoDumper{
+		ifaceStore: ifaceStore,
+	}
+	if v4Enabled {
+		d.families = append(d.families, unix.AF_INET)
+	}
+	if v6Enabled {
+		d.families = append(d.families, unix.AF_INET6)
+	}
+	return d, nil
+}
+
+func (d *tcpInfoDumper) DumpTCPInfo() (map[connection.Tuple]*TCPMetrics, error) {
+	metrics := make(map[connection.Tuple]*TCPMetrics)
+	if err := d.dumpSockets(metrics); err != nil {
+		return nil, fmt.Errorf("error when dumping TCP sockets in host network namespace: %w", err)
+	}
+	netNSPaths := make(map[string]struct{})
+	for _, iface := range d.ifaceStore.GetInterfacesByType(interfacestore.ContainerInterface) {
+		if iface.ContainerInterfaceConfig == nil || iface.NetNS == "" {
+			continue
+		}
+		if _, ok := netNSPaths[iface.NetNS]; ok {
+			continue
+		}
+		netNSPaths[iface.NetNS] = struct{}{}
+		if err := withNetNSPath(iface.NetNS, func(ns.NetNS) error {
+			return d.dumpSockets(metrics)
+		}); err != nil {
+			// The Pod may have been deleted since the interface store was read, so we
+			// do not fail the whole dump.
+			klog.V(4).ErrorS(err, "Error when dumping TCP sockets in Pod network namespace", "Pod", klog.KRef(iface.PodNamespace, iface.PodName), "netns", iface.NetNS)
+		}
+	}
+	return metrics, nil
+}
+
+func (d *tcpInfoDumper) dumpSockets(metrics map[connection.Tuple]*TCPMetrics) error {
+	for _, family := range d.families {
+		resps, err := socketDiagTCPInfo(family)
+		// An interrupted dump may be inconsistent, but the results are still usable, as the
+		// metrics will be refreshed at the next poll.
+		if err != nil && !errors.Is(err, netlink.ErrDumpInterrupted) {
+			return err
+		}
+		for _, resp := range resps {
+			if resp.InetDiagMsg == nil || resp.TCPInfo == nil {
+				continue
+			}
+			key, ok := socketToTuple(&resp.InetDiagMsg.ID)
+			if !ok {
+				continue
+			}
+			metrics[key] = &TCPMetrics{
+				RTT:             resp.TCPInfo.Rtt,
+				RTTVar:          resp.TCPInfo.Rttvar,
+				Retransmissions: resp.TCPInfo.Total_retrans,
+				RwndLimitedTime: resp.TCPInfo.Rwnd_limited,
+			}
+		}
+	}
+	return nil
+}
+
+func socketToTuple(id *netlink.SocketID) (connection.Tuple, bool) {
+	srcAddr, ok1 := netip.AddrFromSlice(id.Source)
+	dstAddr, ok2 := netip.AddrFromSlice(id.Destination)
+	if !ok1 || !ok2 {
+		return connection.Tuple{}, false
+	}
+	return connection.Tuple{
+		SourceAddress:      srcAddr.Unmap(),
+		DestinationAddress: dstAddr.Unmap(),
+		Protocol:           unix.IPPROTO_TCP,
+		SourcePort:         id.SourcePort,
+		DestinationPort:    id.DestinationPort,
+	}, true
+}
@@ -0,0 +1,106 @@
+//go:build linux
+// +build linux
+
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+)
+
+func TestTCPInfoDumper_DumpTCPInfo(t *testing.T) {
+	newResp := func(src, dst string, sport, dport uint16, rtt uint32) *netlink.InetDiagTCPInfoResp {
+		return &netlink.InetDiagTCPInfoResp{
+			InetDiagMsg: &netlink.Socket{
+				ID: netlink.SocketID{
+					Source:          net.ParseIP(src),
+					Destination:     net.ParseIP(dst),
+					SourcePort:      sport,
+					DestinationPort: dport,
+				},
+			},
+			TCPInfo: &netlink.TCPInfo{
+				Rtt:           rtt,
+				Rttvar:        rtt / 2,
+				Total_retrans: 1,
+				Rwnd_limited:  100,
+			},
+		}
+	}
+	// Sockets returned for each network namespace, "" being the host network namespace.
+	sockets := map[string][]*netlink.InetDiagTCPInfoResp{
+		"":           {newResp("192.168.1.1", "192.168.1.2", 22, 50000, 300)},
+		"/var/run/1": {newResp("10.10.0.2", "10.96.0.10", 40000, 80, 1000)},
+		"/var/run/2": {newResp("10.10.0.3", "10.10.1.4", 443, 50000, 2000)},
+	}
+	var currentNetNS string
+	dumpsPerNetNS := make(map[string]int)
+	defer func(originalSocketDiagTCPInfo func(uint8) ([]*netlink.InetDiagTCPInfoResp, error), originalWithNetNSPath func(string, func(ns.NetNS) error) error) {
+		socketDiagTCPInfo = originalSocketDiagTCPInfo
+		withNetNSPath = originalWithNetNSPath
+	}(socketDiagTCPInfo, withNetNSPath)
+	socketDiagTCPInfo = func(family uint8) ([]*netlink.InetDiagTCPInfoResp, error) {
+		assert.Equal(t, uint8(unix.AF_INET), family)
+		dumpsPerNetNS[currentNetNS]++
+		return sockets[currentNetNS], nil
+	}
+	withNetNSPath = func(path string, f func(ns.NetNS) error) error {
+		if _, ok := sockets[path]; !ok {
+			return fmt.Errorf("netns %s does not exist", path)
+		}
+		currentNetNS = path
+		defer func() { currentNetNS = "" }()
+		return f(nil)
+	}
+
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod1-eth0", "c1", "pod1", "default", "eth0", "/var/run/1", nil, nil, 0))
+	// Secondary interface of the same Pod.
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod1-eth1", "c1", "pod1", "default", "eth1", "/var/run/1", nil, nil, 0))
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod2-eth0", "c2", "pod2", "default", "eth0", "/var/run/2", nil, nil, 0))
+	// Pod which has been deleted in the meantime.
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod3-eth0", "c3", "pod3", "default", "eth0", "/var/run/3", nil, nil, 0))
+
+	dumper, err := NewTCPInfoDumper(ifaceStore, true, false)
+	require.NoError(t, err)
+	metrics, err := dumper.DumpTCPInfo()
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int{"": 1, "/var/run/1": 1, "/var/run/2": 1}, dumpsPerNetNS)
+	assert.Equal(t, map[connection.Tuple]*TCPMetrics{
+		{SourceAddress: netip.MustParseAddr("192.168.1.1"), DestinationAddress: netip.MustParseAddr("192.168.1.2"), Protocol: 6, SourcePort: 22, DestinationPort: 50000}: {
+			RTT: 300, RTTVar: 150, Retransmissions: 1, RwndLimitedTime: 100,
+		},
+		{SourceAddress: netip.MustParseAddr("10.10.0.2"), DestinationAddress: netip.MustParseAddr("10.96.0.10"), Protocol: 6, SourcePort: 40000, DestinationPort: 80}: {
+			RTT: 1000, RTTVar: 500, Retransmissions: 1, RwndLimitedTime: 100,
+		},
+		{SourceAddress: netip.MustParseAddr("10.10.0.3"), DestinationAddress: netip.MustParseAddr("10.10.1.4"), Protocol: 6, SourcePort: 443, DestinationPort: 50000}: {
+			RTT: 2000, RTTVar: 1000, Retransmissions: 1, RwndLimitedTime: 100,
+		},
+	}, metrics)
+}
@@ -0,0 +1,28 @@
+//go:build !linux
+// +build !linux
+
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"fmt"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+)
+
+func NewTCPInfoDumper(ifaceStore interfacestore.InterfaceStore, v4Enabled, v6Enabled bool) (TCPInfoDumper, error) {
+	return nil, fmt.Errorf("TCP metrics are not supported on this platform")
+}
@@ -19,8 +19,10 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net"
+	"strconv"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -35,6 +37,7 @@ import (
 	"antrea.io/antrea/pkg/agent/flowexporter/options"
 	"antrea.io/antrea/pkg/agent/flowexporter/priorityqueue"
 	"antrea.io/antrea/pkg/agent/flowexporter/utils"
+	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/proxy"
 	"antrea.io/antrea/pkg/features"
@@ -62,6 +65,7 @@ type FlowExporter struct {
 	collectorAddr          string
 	exporter               exporter.Interface
 	exporterConnected      bool
+	shards                 *collectorShards // only set when sharding is enabled, in which case exporter is nil.
 	conntrackConnStore     *connections.ConntrackConnectionStore
 	denyConnStore          *connections.DenyConnectionStore
 	numConnsExported       uint64 // used for unit tests.
@@ -80,21 +84,32 @@ type FlowExporter struct {
 	obsDomainID            uint32
 }
 
-func NewFlowExporter(podStore objectstore.PodStore, proxier proxy.Proxier, k8sClient kubernetes.Interface, nodeRouteController *noderoute.Controller,
+func NewFlowExporter(podStore objectstore.PodStore, ifaceStore interfacestore.InterfaceStore, proxier proxy.Proxier, k8sClient kubernetes.Interface, nodeRouteController *noderoute.Controller,
 	trafficEncapMode config.TrafficEncapModeType, nodeConfig *config.NodeConfig, v4Enabled, v6Enabled bool, serviceCIDRNet, serviceCIDRNetv6 *net.IPNet,
 	ovsDatapathType ovsconfig.OVSDatapathType, proxyEnabled bool, npQuerier querier.AgentNetworkPolicyInfoQuerier, o *options.FlowExporterOptions,
 	egressQuerier querier.EgressQuerier, podL7FlowExporterAttrGetter connections.PodL7FlowExporterAttrGetter, l7FlowExporterEnabled bool) (*FlowExporter, error) {
 
 	protocolFilter := filter.NewProtocolFilter(o.ProtocolFilter)
+	connectionFilter, err := filter.NewConnectionFilter(o.ConnectionFilter, o.SamplingRate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connection filter: %w", err)
+	}
 	connTrackDumper := connections.InitializeConnTrackDumper(nodeConfig, serviceCIDRNet, serviceCIDRNetv6, ovsDatapathType, proxyEnabled, protocolFilter)
-	denyConnStore := connections.NewDenyConnectionStore(podStore, proxier, o, protocolFilter)
+	denyConnStore := connections.NewDenyConnectionStore(podStore, proxier, o, protocolFilter, connectionFilter)
 	var l7Listener *connections.L7Listener
 	var eventMapGetter connections.L7EventMapGetter
 	if l7FlowExporterEnabled {
 		l7Listener = connections.NewL7Listener(podL7FlowExporterAttrGetter, podStore)
 		eventMapGetter = l7Listener
 	}
-	conntrackConnStore := connections.NewConntrackConnectionStore(connTrackDumper, v4Enabled, v6Enabled, npQuerier, podStore, proxier, eventMapGetter, o)
+	var tcpInfoDumper connections.TCPInfoDumper
+	if o.EnableTCPMetrics {
+		tcpInfoDumper, err = connections.NewTCPInfoDumper(ifaceStore, v4Enabled, v6Enabled)
+		if err != nil {
+			return nil, fmt.Errorf("failed to enable TCP metrics: %w", err)
+		}
+	}
+	conntrackConnStore := connections.NewConntrackConnectionStore(connTrackDumper, v4Enabled, v6Enabled, npQuerier, podStore, proxier, eventMapGetter, tcpInfoDumper, o, connectionFilter)
 	if nodeRouteController == nil {
 		klog.InfoS("NodeRouteController is nil, will not be able to determine flow type for connections")
 	}
@@ -113,23 +128,47 @@ func NewFlowExporter(podStore objectstore.PodStore, proxier proxy.Proxier, k8sCl
 	nodeUID := string(node.UID)
 	klog.InfoS("Retrieved this Node's UID from K8s", "nodeName", nodeName, "nodeUID", nodeUID)
 
-	var exp exporter.Interface
-	if o.FlowCollectorProto == "grpc" {
-		exp = exporter.NewGRPCExporter(nodeName, nodeUID, obsDomainID)
-	} else {
+	newExporter := func() exporter.Interface {
+		if o.FlowCollectorProto == "grpc" {
+			return exporter.NewGRPCExporter(nodeName, nodeUID, obsDomainID)
+		}
 		var collectorProto string
 		if o.FlowCollectorProto == "tls" {
 			collectorProto = "tcp"
 		} else {
 			collectorProto = o.FlowCollectorProto
 		}
-		exp = exporter.NewIPFIXExporter(collectorProto, nodeName, obsDomainID, v4Enabled, v6Enabled)
+		return exporter.NewIPFIXExporter(collectorProto, nodeName, obsDomainID, v4Enabled, v6Enabled)
+	}
+	var exp exporter.Interface
+	var shards *collectorShards
+	if o.EnableSharding {
+		host, port, err := net.SplitHostPort(o.FlowCollectorAddr)
+		if err != nil {
+			return nil, err
+		}
+		ns, name := k8sutil.SplitNamespacedName(host)
+		if ns == "" {
+			return nil, fmt.Errorf("sharding requires the flow collector address to be a Service reference, got %s", host)
+		}
+		portNum, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid flow collector port %s: %w", port, err)
+		}
+		protocol := corev1.ProtocolTCP
+		if o.FlowCollectorProto == "udp" {
+			protocol = corev1.ProtocolUDP
+		}
+		shards = newCollectorShards(k8sClient, ns, name, int32(portNum), protocol, newExporter)
+	} else {
+		exp = newExporter()
 	}
 
 	return &FlowExporter{
 		collectorProto:         o.FlowCollectorProto,
 		collectorAddr:          o.FlowCollectorAddr,
 		exporter:               exp,
+		shards:                 shards,
 		conntrackConnStore:     conntrackConnStore,
 		denyConnStore:          denyConnStore,
 		v4Enabled:              v4Enabled,
@@ -169,6 +208,15 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 	// Start the goroutine to poll conntrack flows.
 	go exp.conntrackConnStore.Run(stopCh)
 
+	if exp.shards != nil {
+		// Discover Flow Aggregator replicas before exporting any record, to avoid sending
+		// records to the wrong replica.
+		exp.shards.run(stopCh)
+		if !cache.WaitForCacheSync(stopCh, exp.shards.hasSynced) {
+			return
+		}
+	}
+
 	if exp.nodeRouteController != nil {
 		// Wait for NodeRouteController to have processed the initial list of Nodes so that
 		// the list of Pod subnets is up-to-date.
@@ -188,7 +236,7 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 			expireTimer.Stop()
 			return
 		case <-expireTimer.C:
-			if !exp.exporterConnected {
+			if !exp.exporterConnected || (exp.shards != nil && exp.shards.needsUpdate()) {
 				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 				err := exp.initFlowExporter(ctx)
 				cancel()
@@ -219,7 +267,11 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 }
 
 func (exp *FlowExporter) resetFlowExporter() {
-	exp.exporter.CloseConnToCollector()
+	if exp.shards != nil {
+		exp.shards.closeAll()
+	} else {
+		exp.exporter.CloseConnToCollector()
+	}
 	exp.exporterConnected = false
 }
 
@@ -274,9 +326,17 @@ func (exp *FlowExporter) resolveCollectorAddress(ctx context.Context) (string, s
 }
 
 func (exp *FlowExporter) initFlowExporter(ctx context.Context) error {
-	addr, name, err := exp.resolveCollectorAddress(ctx)
-	if err != nil {
-		return err
+	var addr, name string
+	if exp.shards != nil {
+		// Replicas are connected to directly, and we don't need to resolve the Service's
+		// ClusterIP.
+		name = exp.shards.serverName()
+	} else {
+		var err error
+		addr, name, err = exp.resolveCollectorAddress(ctx)
+		if err != nil {
+			return err
+		}
 	}
 	var tlsConfig *exporter.TLSConfig
 	if exp.collectorProto == "tls" || exp.collectorProto == "grpc" {
@@ -298,6 +358,18 @@ func (exp *FlowExporter) initFlowExporter(ctx context.Context) error {
 		}
 	}
 
+	if exp.shards != nil {
+		numConnected, err := exp.shards.update(tlsConfig)
+		if err != nil {
+			return err
+		}
+		if numConnected == 0 {
+			return fmt.Errorf("failed to connect to any Flow Aggregator replica")
+		}
+		exp.exporterConnected = true
+		return nil
+	}
+
 	if err := exp.exporter.ConnectToCollector(addr, tlsConfig); err != nil {
 		return err
 	}
@@ -370,7 +442,11 @@ func (exp *FlowExporter) exportConn(conn *connection.Connection) error {
 			return nil
 		}
 	}
-	if err := exp.exporter.Export(conn); err != nil {
+	if exp.shards != nil {
+		if err := exp.shards.export(conn); err != nil {
+			return err
+		}
+	} else if err := exp.exporter.Export(conn); err != nil {
 		return err
 	}
 	exp.numConnsExported += 1
@@ -195,7 +195,11 @@ func (e *grpcExporter) createMessage(conn *connection.Connection) *flowpb.Flow {
 	if conn.TCPState != "" {
 		flow.Transport.Protocol = &flowpb.Transport_TCP{
 			TCP: &flowpb.TCP{
-				StateName: conn.TCPState,
+				StateName:         conn.TCPState,
+				RttUs:             conn.TCPRTT,
+				RttVarUs:          conn.TCPRTTVar,
+				Retransmissions:   conn.TCPRetransmissions,
+				RwndLimitedTimeUs: conn.TCPRwndLimitedTime,
 			},
 		}
 	}
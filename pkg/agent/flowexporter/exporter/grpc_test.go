@@ -55,7 +55,11 @@ func TestGRPCExporterCreateMessage(t *testing.T) {
 			ProtocolNumber:  6,
 			Protocol: &flowpb.Transport_TCP{
 				TCP: &flowpb.TCP{
-					StateName: "ESTABLISHED",
+					StateName:         "ESTABLISHED",
+					RttUs:             1500,
+					RttVarUs:          300,
+					Retransmissions:   2,
+					RwndLimitedTimeUs: 12000,
 				},
 			},
 		},
@@ -76,6 +76,10 @@ var (
 		"appProtocolName",
 		"httpVals",
 		"egressNodeName",
+		"tcpRoundTripTime",
+		"tcpRoundTripTimeVariance",
+		"tcpRetransmissionCount",
+		"tcpReceiveWindowLimitedTime",
 	}
 	AntreaInfoElementsIPv4 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv4"}...)
 	AntreaInfoElementsIPv6 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv6"}...)
@@ -399,6 +403,14 @@ func (e *ipfixExporter) addConnToSet(conn *connection.Connection) error {
 			ie.SetStringValue(conn.HttpVals)
 		case "egressNodeName":
 			ie.SetStringValue(conn.EgressNodeName)
+		case "tcpRoundTripTime":
+			ie.SetUnsigned32Value(conn.TCPRTT)
+		case "tcpRoundTripTimeVariance":
+			ie.SetUnsigned32Value(conn.TCPRTTVar)
+		case "tcpRetransmissionCount":
+			ie.SetUnsigned32Value(conn.TCPRetransmissions)
+		case "tcpReceiveWindowLimitedTime":
+			ie.SetUnsigned64Value(conn.TCPRwndLimitedTime)
 		}
 	}
 	err := e.ipfixSet.AddRecordV2(eL, templateID)
@@ -27,6 +27,7 @@ import (
 
 	"antrea.io/antrea/pkg/agent/flowexporter/connection"
 	flowexportertesting "antrea.io/antrea/pkg/agent/flowexporter/testing"
+	"antrea.io/antrea/pkg/ipfix"
 	ipfixtest "antrea.io/antrea/pkg/ipfix/testing"
 )
 
@@ -38,7 +39,7 @@ const (
 )
 
 func init() {
-	ipfixregistry.LoadRegistry()
+	ipfix.LoadRegistry()
 }
 
 func TestIPFIXExporter_sendTemplateSet(t *testing.T) {
@@ -300,8 +300,8 @@ func runSendFlowRecordTests(t *testing.T, flowExp *FlowExporter, isIPv6 bool) {
 				StaleConnectionTimeout: 1,
 				PollInterval:           1,
 			}
-			flowExp.conntrackConnStore = connections.NewConntrackConnectionStore(mockConnDumper, !isIPv6, isIPv6, nil, nil, nil, nil, o)
-			flowExp.denyConnStore = connections.NewDenyConnectionStore(nil, nil, o, filter.NewProtocolFilter(nil))
+			flowExp.conntrackConnStore = connections.NewConntrackConnectionStore(mockConnDumper, !isIPv6, isIPv6, nil, nil, nil, nil, nil, o, nil)
+			flowExp.denyConnStore = connections.NewDenyConnectionStore(nil, nil, o, filter.NewProtocolFilter(nil), nil)
 			flowExp.conntrackPriorityQueue = flowExp.conntrackConnStore.GetPriorityQueue()
 			flowExp.denyPriorityQueue = flowExp.denyConnStore.GetPriorityQueue()
 			flowExp.numConnsExported = 0
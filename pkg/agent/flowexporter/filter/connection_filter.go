@@ -0,0 +1,218 @@
+// Copyright 2026 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"net/netip"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/flowexporter/utils"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+)
+
+const policyActionNone = "None"
+
+var policyActionMap = map[string]uint8{
+	"Allow":          utils.NetworkPolicyRuleActionAllow,
+	"Drop":           utils.NetworkPolicyRuleActionDrop,
+	"Reject":         utils.NetworkPolicyRuleActionReject,
+	policyActionNone: utils.NetworkPolicyRuleActionNoAction,
+}
+
+// ConnectionFilter selects the connections which should be exported, based on
+// include and exclude rules and on a sampling rate. A nil ConnectionFilter
+// allows all connections.
+type ConnectionFilter struct {
+	include      []connectionFilterRule
+	exclude      []connectionFilterRule
+	samplingRate uint32
+}
+
+type connectionFilterRule struct {
+	namespaces    sets.Set[string]
+	podSelector   labels.Selector
+	cidrs         []netip.Prefix
+	ports         sets.Set[uint16]
+	policyActions sets.Set[uint8]
+}
+
+// NewConnectionFilter returns a new ConnectionFilter for the provided rules and
+// sampling rate. It returns nil if all connections are allowed, and an error if
+// any of the rules is invalid.
+func NewConnectionFilter(config agentconfig.FlowExporterConnectionFilterConfig, samplingRate uint32) (*ConnectionFilter, error) {
+	if len(config.Include) == 0 && len(config.Exclude) == 0 && samplingRate <= 1 {
+		return nil, nil
+	}
+	include, err := newConnectionFilterRules(config.Include)
+	if err != nil {
+		return nil, fmt.Errorf("invalid include rule: %w", err)
+	}
+	exclude, err := newConnectionFilterRules(config.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude rule: %w", err)
+	}
+	return &ConnectionFilter{
+		include:      include,
+		exclude:      exclude,
+		samplingRate: samplingRate,
+	}, nil
+}
+
+func newConnectionFilterRules(rules []agentconfig.FlowExporterConnectionFilterRule) ([]connectionFilterRule, error) {
+	filterRules := make([]connectionFilterRule, 0, len(rules))
+	for _, rule := range rules {
+		filterRule := connectionFilterRule{}
+		if len(rule.Namespaces) > 0 {
+			filterRule.namespaces = sets.New(rule.Namespaces...)
+		}
+		if rule.PodSelector != "" {
+			selector, err := labels.Parse(rule.PodSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid podSelector %q: %w", rule.PodSelector, err)
+			}
+			filterRule.podSelector = selector
+		}
+		for _, cidr := range rule.CIDRs {
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %q: %w", cidr, err)
+			}
+			filterRule.cidrs = append(filterRule.cidrs, prefix.Masked())
+		}
+		if len(rule.Ports) > 0 {
+			filterRule.ports = sets.New[uint16]()
+			for _, port := range rule.Ports {
+				if port < 1 || port > 65535 {
+					return nil, fmt.Errorf("invalid port %d", port)
+				}
+				filterRule.ports.Insert(uint16(port))
+			}
+		}
+		if len(rule.PolicyActions) > 0 {
+			filterRule.policyActions = sets.New[uint8]()
+			for _, action := range rule.PolicyActions {
+				actionValue, ok := policyActionMap[action]
+				if !ok {
+					return nil, fmt.Errorf("unsupported policyAction %q", action)
+				}
+				filterRule.policyActions.Insert(actionValue)
+			}
+		}
+		filterRules = append(filterRules, filterRule)
+	}
+	return filterRules, nil
+}
+
+// Allow returns true if the connection should be exported. srcPod and dstPod
+// are the local Pods for the source and destination of the connection, and can
+// be nil.
+func (f *ConnectionFilter) Allow(conn *connection.Connection, srcPod, dstPod *corev1.Pod) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.include) > 0 && !matchAnyRule(f.include, conn, srcPod, dstPod) {
+		return false
+	}
+	if matchAnyRule(f.exclude, conn, srcPod, dstPod) {
+		return false
+	}
+	return f.sample(conn)
+}
+
+// sample returns true if the connection is selected for the configured sampling
+// rate. The decision only depends on the 5-tuple of the connection, so that the
+// same connection is either exported or ignored by both the source Node and the
+// destination Node, which lets the Flow Aggregator correlate records.
+func (f *ConnectionFilter) sample(conn *connection.Connection) bool {
+	if f.samplingRate <= 1 {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write(conn.FlowKey.SourceAddress.AsSlice())
+	h.Write(conn.FlowKey.DestinationAddress.AsSlice())
+	var b [5]byte
+	b[0] = conn.FlowKey.Protocol
+	binary.BigEndian.PutUint16(b[1:3], conn.FlowKey.SourcePort)
+	binary.BigEndian.PutUint16(b[3:5], conn.FlowKey.DestinationPort)
+	h.Write(b[:])
+	return h.Sum32()%f.samplingRate == 0
+}
+
+func matchAnyRule(rules []connectionFilterRule, conn *connection.Connection, srcPod, dstPod *corev1.Pod) bool {
+	for i := range rules {
+		if rules[i].match(conn, srcPod, dstPod) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *connectionFilterRule) match(conn *connection.Connection, srcPod, dstPod *corev1.Pod) bool {
+	if (r.namespaces != nil || r.podSelector != nil) && !r.matchPod(srcPod) && !r.matchPod(dstPod) {
+		return false
+	}
+	if r.cidrs != nil && !r.matchAddress(conn.FlowKey.SourceAddress) && !r.matchAddress(conn.FlowKey.DestinationAddress) &&
+		!r.matchAddress(conn.OriginalDestinationAddress) {
+		return false
+	}
+	if r.ports != nil && !r.ports.Has(conn.FlowKey.DestinationPort) && !r.ports.Has(conn.OriginalDestinationPort) {
+		return false
+	}
+	if r.policyActions != nil && !r.matchPolicyAction(conn) {
+		return false
+	}
+	return true
+}
+
+func (r *connectionFilterRule) matchPod(pod *corev1.Pod) bool {
+	if pod == nil {
+		return false
+	}
+	if r.namespaces != nil && !r.namespaces.Has(pod.Namespace) {
+		return false
+	}
+	if r.podSelector != nil && !r.podSelector.Matches(labels.Set(pod.Labels)) {
+		return false
+	}
+	return true
+}
+
+func (r *connectionFilterRule) matchAddress(addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	for _, cidr := range r.cidrs {
+		if cidr.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *connectionFilterRule) matchPolicyAction(conn *connection.Connection) bool {
+	ingressAction, egressAction := conn.IngressNetworkPolicyRuleAction, conn.EgressNetworkPolicyRuleAction
+	if ingressAction == utils.NetworkPolicyRuleActionNoAction && egressAction == utils.NetworkPolicyRuleActionNoAction {
+		return r.policyActions.Has(utils.NetworkPolicyRuleActionNoAction)
+	}
+	return (ingressAction != utils.NetworkPolicyRuleActionNoAction && r.policyActions.Has(ingressAction)) ||
+		(egressAction != utils.NetworkPolicyRuleActionNoAction && r.policyActions.Has(egressAction))
+}
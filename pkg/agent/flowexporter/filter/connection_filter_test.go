@@ -0,0 +1,308 @@
+// Copyright 2026 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/flowexporter/utils"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+)
+
+var (
+	testSrcPod = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "client",
+			Labels:    map[string]string{"app": "client"},
+		},
+	}
+	testDstPod = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns2",
+			Name:      "web",
+			Labels:    map[string]string{"app": "web", "tier": "frontend"},
+		},
+	}
+)
+
+func newTestConnection() *connection.Connection {
+	return &connection.Connection{
+		FlowKey: connection.Tuple{
+			SourceAddress:      netip.MustParseAddr("10.10.0.1"),
+			DestinationAddress: netip.MustParseAddr("10.10.1.1"),
+			Protocol:           6,
+			SourcePort:         45678,
+			DestinationPort:    8080,
+		},
+		OriginalDestinationAddress: netip.MustParseAddr("10.96.0.10"),
+		OriginalDestinationPort:    80,
+	}
+}
+
+func TestNewConnectionFilter(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        agentconfig.FlowExporterConnectionFilterConfig
+		samplingRate  uint32
+		expectNil     bool
+		expectedError string
+	}{
+		{
+			name:      "empty",
+			expectNil: true,
+		},
+		{
+			name:         "sampling rate of 1",
+			samplingRate: 1,
+			expectNil:    true,
+		},
+		{
+			name:         "sampling only",
+			samplingRate: 10,
+		},
+		{
+			name: "valid rules",
+			config: agentconfig.FlowExporterConnectionFilterConfig{
+				Include: []agentconfig.FlowExporterConnectionFilterRule{{
+					Namespaces:    []string{"ns1"},
+					PodSelector:   "app in (web,db),tier!=cache",
+					CIDRs:         []string{"10.0.0.0/8", "fd00::/64"},
+					Ports:         []int32{80, 443},
+					PolicyActions: []string{"Allow", "None"},
+				}},
+				Exclude: []agentconfig.FlowExporterConnectionFilterRule{{
+					Namespaces: []string{"kube-system"},
+				}},
+			},
+		},
+		{
+			name: "invalid podSelector",
+			config: agentconfig.FlowExporterConnectionFilterConfig{
+				Include: []agentconfig.FlowExporterConnectionFilterRule{{PodSelector: "app in (web"}},
+			},
+			expectedError: "invalid include rule: invalid podSelector",
+		},
+		{
+			name: "invalid CIDR",
+			config: agentconfig.FlowExporterConnectionFilterConfig{
+				Exclude: []agentconfig.FlowExporterConnectionFilterRule{{CIDRs: []string{"10.0.0.0"}}},
+			},
+			expectedError: "invalid exclude rule: invalid CIDR",
+		},
+		{
+			name: "invalid port",
+			config: agentconfig.FlowExporterConnectionFilterConfig{
+				Include: []agentconfig.FlowExporterConnectionFilterRule{{Ports: []int32{65536}}},
+			},
+			expectedError: "invalid include rule: invalid port 65536",
+		},
+		{
+			name: "unsupported policyAction",
+			config: agentconfig.FlowExporterConnectionFilterConfig{
+				Include: []agentconfig.FlowExporterConnectionFilterRule{{PolicyActions: []string{"Pass"}}},
+			},
+			expectedError: "invalid include rule: unsupported policyAction \"Pass\"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := NewConnectionFilter(tc.config, tc.samplingRate)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectNil {
+				assert.Nil(t, f)
+			} else {
+				assert.NotNil(t, f)
+			}
+		})
+	}
+}
+
+func TestConnectionFilter_Allow(t *testing.T) {
+	rules := func(rules ...agentconfig.FlowExporterConnectionFilterRule) []agentconfig.FlowExporterConnectionFilterRule {
+		return rules
+	}
+	testCases := []struct {
+		name      string
+		config    agentconfig.FlowExporterConnectionFilterConfig
+		mutateFn  func(conn *connection.Connection)
+		noPods    bool
+		wantAllow bool
+	}{
+		{
+			name:      "empty include rule",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{})},
+			wantAllow: true,
+		},
+		{
+			name:      "empty exclude rule",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Exclude: rules(agentconfig.FlowExporterConnectionFilterRule{})},
+			wantAllow: false,
+		},
+		{
+			name:      "include destination Pod Namespace",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{Namespaces: []string{"ns2"}})},
+			wantAllow: true,
+		},
+		{
+			name:      "include Namespace without local Pods",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{Namespaces: []string{"ns2"}})},
+			noPods:    true,
+			wantAllow: false,
+		},
+		{
+			name:      "exclude source Pod Namespace",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Exclude: rules(agentconfig.FlowExporterConnectionFilterRule{Namespaces: []string{"ns1"}})},
+			wantAllow: false,
+		},
+		{
+			name:      "include Pod selector",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{PodSelector: "app=web,tier=frontend"})},
+			wantAllow: true,
+		},
+		{
+			name: "Namespace and Pod selector must match the same Pod",
+			config: agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{
+				Namespaces:  []string{"ns1"},
+				PodSelector: "app=web",
+			})},
+			wantAllow: false,
+		},
+		{
+			name:      "include source CIDR",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{CIDRs: []string{"10.10.0.0/24"}})},
+			wantAllow: true,
+		},
+		{
+			name:      "include Service CIDR",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{CIDRs: []string{"10.96.0.0/12"}})},
+			wantAllow: true,
+		},
+		{
+			name:      "include other CIDR",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{CIDRs: []string{"192.168.0.0/16", "fd00::/64"}})},
+			wantAllow: false,
+		},
+		{
+			name:      "include Service port",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{Ports: []int32{80}})},
+			wantAllow: true,
+		},
+		{
+			name:      "exclude destination port",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Exclude: rules(agentconfig.FlowExporterConnectionFilterRule{Ports: []int32{8080}})},
+			wantAllow: false,
+		},
+		{
+			name:      "include other port",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{Ports: []int32{53}})},
+			wantAllow: false,
+		},
+		{
+			name:      "include no policy action",
+			config:    agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{PolicyActions: []string{"None"}})},
+			wantAllow: true,
+		},
+		{
+			name:   "include no policy action with egress rule",
+			config: agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{PolicyActions: []string{"None"}})},
+			mutateFn: func(conn *connection.Connection) {
+				conn.EgressNetworkPolicyRuleAction = utils.NetworkPolicyRuleActionAllow
+			},
+			wantAllow: false,
+		},
+		{
+			name:   "include denied connections",
+			config: agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{PolicyActions: []string{"Drop", "Reject"}})},
+			mutateFn: func(conn *connection.Connection) {
+				conn.EgressNetworkPolicyRuleAction = utils.NetworkPolicyRuleActionAllow
+				conn.IngressNetworkPolicyRuleAction = utils.NetworkPolicyRuleActionReject
+			},
+			wantAllow: true,
+		},
+		{
+			name: "all fields of a rule must match",
+			config: agentconfig.FlowExporterConnectionFilterConfig{Include: rules(agentconfig.FlowExporterConnectionFilterRule{
+				Namespaces: []string{"ns2"},
+				Ports:      []int32{53},
+			})},
+			wantAllow: false,
+		},
+		{
+			name: "any include rule can match",
+			config: agentconfig.FlowExporterConnectionFilterConfig{Include: rules(
+				agentconfig.FlowExporterConnectionFilterRule{Ports: []int32{53}},
+				agentconfig.FlowExporterConnectionFilterRule{Namespaces: []string{"ns2"}},
+			)},
+			wantAllow: true,
+		},
+		{
+			name: "exclude rules take precedence",
+			config: agentconfig.FlowExporterConnectionFilterConfig{
+				Include: rules(agentconfig.FlowExporterConnectionFilterRule{Namespaces: []string{"ns2"}}),
+				Exclude: rules(agentconfig.FlowExporterConnectionFilterRule{PodSelector: "app=client"}),
+			},
+			wantAllow: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := NewConnectionFilter(tc.config, 0)
+			require.NoError(t, err)
+			conn := newTestConnection()
+			if tc.mutateFn != nil {
+				tc.mutateFn(conn)
+			}
+			srcPod, dstPod := testSrcPod, testDstPod
+			if tc.noPods {
+				srcPod, dstPod = nil, nil
+			}
+			assert.Equal(t, tc.wantAllow, f.Allow(conn, srcPod, dstPod))
+		})
+	}
+}
+
+func TestConnectionFilter_Sampling(t *testing.T) {
+	var nilFilter *ConnectionFilter
+	assert.True(t, nilFilter.Allow(newTestConnection(), nil, nil))
+
+	const samplingRate = 10
+	const numConns = 10000
+	f, err := NewConnectionFilter(agentconfig.FlowExporterConnectionFilterConfig{}, samplingRate)
+	require.NoError(t, err)
+	allowed := 0
+	for i := 0; i < numConns; i++ {
+		conn := newTestConnection()
+		conn.FlowKey.SourcePort = uint16(10000 + i)
+		allow := f.Allow(conn, nil, nil)
+		// The sampling decision is deterministic.
+		assert.Equal(t, allow, f.Allow(conn, nil, nil))
+		if allow {
+			allowed++
+		}
+	}
+	assert.InDelta(t, numConns/samplingRate, allowed, numConns/samplingRate*0.2)
+}
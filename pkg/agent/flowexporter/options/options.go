@@ -14,7 +14,11 @@
 
 package options
 
-import "time"
+import (
+	"time"
+
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+)
 
 type FlowExporterOptions struct {
 	FlowCollectorAddr      string
@@ -25,4 +29,8 @@ type FlowExporterOptions struct {
 	PollInterval           time.Duration
 	ConnectUplinkToBridge  bool
 	ProtocolFilter         []string
+	ConnectionFilter       agentconfig.FlowExporterConnectionFilterConfig
+	SamplingRate           uint32
+	EnableSharding         bool
+	EnableTCPMetrics       bool
 }
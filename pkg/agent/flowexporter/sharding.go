@@ -0,0 +1,291 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowexporter
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/agent/consistenthash"
+	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+const (
+	// Number of virtual nodes for each Flow Aggregator replica in the consistent hash ring.
+	collectorShardVirtualNodes = 50
+	// Timeout for the TLS handshake used to verify the server certificate of a replica.
+	collectorShardVerifyTimeout = 5 * time.Second
+)
+
+type collectorShard struct {
+	exporter  exporter.Interface
+	connected bool
+}
+
+// collectorShards maintains one exporter per Flow Aggregator replica, and assigns each connection
+// to one replica using a consistent hash of its flow key. Because all the Nodes discover the same
+// set of replicas, the records sent by the source and destination Nodes for a given connection are
+// received by the same replica, which can correlate them. Replicas are discovered by watching the
+// EndpointSlices of the Flow Aggregator Service.
+// Except for the informers, collectorShards is only accessed by the FlowExporter goroutine and is
+// not thread-safe.
+type collectorShards struct {
+	namespace             string
+	name                  string
+	port                  int32
+	protocol              corev1.Protocol
+	serviceInformer       cache.SharedIndexInformer
+	endpointSliceInformer cache.SharedIndexInformer
+	newExporter           func() exporter.Interface
+	// verifyServerCertificate can be overridden in tests.
+	verifyServerCertificate func(addr string, tlsConfig *exporter.TLSConfig) error
+	shards                  map[string]*collectorShard
+	hashMap                 *consistenthash.Map
+}
+
+func newCollectorShards(k8sClient kubernetes.Interface, namespace, name string, port int32, protocol corev1.Protocol, newExporter func() exporter.Interface) *collectorShards {
+	serviceInformer := coreinformers.NewFilteredServiceInformer(k8sClient, namespace, 0, cache.Indexers{}, func(options *metav1.ListOptions) {
+		options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+	})
+	endpointSliceInformer := discoveryinformers.NewFilteredEndpointSliceInformer(k8sClient, namespace, 0, cache.Indexers{}, func(options *metav1.ListOptions) {
+		options.LabelSelector = fmt.Sprintf("%s=%s", discovery.LabelServiceName, name)
+	})
+	return &collectorShards{
+		namespace:               namespace,
+		name:                    name,
+		port:                    port,
+		protocol:                protocol,
+		serviceInformer:         serviceInformer,
+		endpointSliceInformer:   endpointSliceInformer,
+		newExporter:             newExporter,
+		verifyServerCertificate: verifyServerCertificate,
+		shards:                  make(map[string]*collectorShard),
+		hashMap:                 consistenthash.New(collectorShardVirtualNodes, nil),
+	}
+}
+
+func (s *collectorShards) run(stopCh <-chan struct{}) {
+	go s.serviceInformer.Run(stopCh)
+	go s.endpointSliceInformer.Run(stopCh)
+}
+
+func (s *collectorShards) hasSynced() bool {
+	return s.serviceInformer.HasSynced() && s.endpointSliceInformer.HasSynced()
+}
+
+// serverName returns the name to use for TLS server certificate verification, which is the same
+// as when connecting to the Service's ClusterIP.
+func (s *collectorShards) serverName() string {
+	return fmt.Sprintf("%s.%s.svc", s.name, s.namespace)
+}
+
+// getEndpoints returns the addresses of the ready Flow Aggregator replicas, for the collector
+// Service port.
+func (s *collectorShards) getEndpoints() sets.Set[string] {
+	endpoints := sets.New[string]()
+	obj, exists, err := s.serviceInformer.GetStore().GetByKey(s.namespace + "/" + s.name)
+	if err != nil || !exists {
+		return endpoints
+	}
+	svc := obj.(*corev1.Service)
+	var portName *string
+	for _, port := range svc.Spec.Ports {
+		if port.Port == s.port && port.Protocol == s.protocol {
+			portName = &port.Name
+			break
+		}
+	}
+	if portName == nil {
+		klog.V(2).InfoS("Port not found in collector Service", "service", klog.KObj(svc), "port", s.port, "protocol", s.protocol)
+		return endpoints
+	}
+	// A dual-stack Service has one EndpointSlice per IP family: we only use the primary IP
+	// family, so that each replica is only included once.
+	addressType := discovery.AddressTypeIPv4
+	if len(svc.Spec.IPFamilies) > 0 && svc.Spec.IPFamilies[0] == corev1.IPv6Protocol {
+		addressType = discovery.AddressTypeIPv6
+	}
+	for _, obj := range s.endpointSliceInformer.GetStore().List() {
+		endpointSlice := obj.(*discovery.EndpointSlice)
+		if endpointSlice.AddressType != addressType {
+			continue
+		}
+		var endpointPort *int32
+		for _, port := range endpointSlice.Ports {
+			if ptr.Deref(port.Name, "") == *portName && ptr.Deref(port.Protocol, corev1.ProtocolTCP) == s.protocol {
+				endpointPort = port.Port
+				break
+			}
+		}
+		if endpointPort == nil {
+			continue
+		}
+		for _, endpoint := range endpointSlice.Endpoints {
+			if !ptr.Deref(endpoint.Conditions.Ready, true) || len(endpoint.Addresses) == 0 {
+				continue
+			}
+			endpoints.Insert(net.JoinHostPort(endpoint.Addresses[0], strconv.Itoa(int(*endpointPort))))
+		}
+	}
+	return endpoints
+}
+
+// needsUpdate returns true if the set of replicas has changed, or if some replicas are not
+// connected.
+func (s *collectorShards) needsUpdate() bool {
+	endpoints := s.getEndpoints()
+	if len(endpoints) != len(s.shards) {
+		return true
+	}
+	for addr, shard := range s.shards {
+		if !shard.connected || !endpoints.Has(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyServerCertificate performs a TLS handshake with a replica, to check that its server
+// certificate is signed by the CA. No application protocol is negotiated, as the handshake is
+// only used to verify the certificate. Connection errors, which are expected while a replica is
+// starting or stopping, are ignored here and reported when connecting the exporter.
+func verifyServerCertificate(addr string, tlsConfig *exporter.TLSConfig) error {
+	config, err := tlsConfig.AsStdConfig()
+	if err != nil {
+		return err
+	}
+	dialer := &net.Dialer{Timeout: collectorShardVerifyTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", addr, config)
+	if err != nil {
+		var verificationErr *tls.CertificateVerificationError
+		if errors.As(err, &verificationErr) {
+			return verificationErr
+		}
+		return nil
+	}
+	return conn.Close()
+}
+
+// update reconciles the exporters with the current set of replicas, and connects the exporters
+// which are not connected yet. It returns the number of connected exporters. An error is
+// returned if the server certificate of a replica cannot be verified: because all the replicas
+// are expected to use the same certificates, this is a configuration error, and records should
+// not be sent to the other replicas instead, as they would not be correlated.
+func (s *collectorShards) update(tlsConfig *exporter.TLSConfig) (int, error) {
+	endpoints := s.getEndpoints()
+	for addr, shard := range s.shards {
+		if endpoints.Has(addr) {
+			continue
+		}
+		klog.InfoS("Removing Flow Aggregator replica", "address", addr)
+		if shard.connected {
+			shard.exporter.CloseConnToCollector()
+		}
+		delete(s.shards, addr)
+		s.hashMap.Remove(addr)
+	}
+	numConnected := 0
+	var errs []error
+	for addr := range endpoints {
+		shard, ok := s.shards[addr]
+		if !ok {
+			klog.InfoS("Adding Flow Aggregator replica", "address", addr)
+			shard = &collectorShard{exporter: s.newExporter()}
+			s.shards[addr] = shard
+			s.hashMap.Add(addr)
+		}
+		if !shard.connected {
+			if tlsConfig != nil && s.protocol == corev1.ProtocolTCP {
+				if err := s.verifyServerCertificate(addr, tlsConfig); err != nil {
+					errs = append(errs, fmt.Errorf("failed to verify server certificate of Flow Aggregator replica %s: %w", addr, err))
+					continue
+				}
+			}
+			if err := shard.exporter.ConnectToCollector(addr, tlsConfig); err != nil {
+				klog.ErrorS(err, "Error when connecting to Flow Aggregator replica", "address", addr)
+				shard.exporter.CloseConnToCollector()
+				continue
+			}
+			shard.connected = true
+			metrics.ReconnectionsToFlowCollector.Inc()
+		}
+		numConnected++
+	}
+	return numConnected, utilerrors.NewAggregate(errs)
+}
+
+// export sends the record for the connection to the replica selected for its flow key. If that
+// replica cannot be reached, the record is sent to the next connected replica in the hash ring.
+func (s *collectorShards) export(conn *connection.Connection) error {
+	key := shardKey(conn.FlowKey)
+	isConnected := func(addr string) bool {
+		return s.shards[addr].connected
+	}
+	for {
+		addr := s.hashMap.GetWithFilters(key, isConnected)
+		if addr == "" {
+			return fmt.Errorf("no Flow Aggregator replica is connected")
+		}
+		shard := s.shards[addr]
+		err := shard.exporter.Export(conn)
+		if err == nil {
+			return nil
+		}
+		klog.ErrorS(err, "Error when sending flow record to Flow Aggregator replica", "address", addr)
+		shard.exporter.CloseConnToCollector()
+		shard.connected = false
+	}
+}
+
+// closeAll closes the connections to all the replicas.
+func (s *collectorShards) closeAll() {
+	for _, shard := range s.shards {
+		if shard.connected {
+			shard.exporter.CloseConnToCollector()
+			shard.connected = false
+		}
+	}
+}
+
+// shardKey returns the key used to select a replica for the provided flow key. The key does not
+// depend on the direction of the connection.
+func shardKey(flowKey connection.Tuple) string {
+	src := netip.AddrPortFrom(flowKey.SourceAddress, flowKey.SourcePort)
+	dst := netip.AddrPortFrom(flowKey.DestinationAddress, flowKey.DestinationPort)
+	if dst.Compare(src) < 0 {
+		src, dst = dst, src
+	}
+	return fmt.Sprintf("%s-%s-%d", src, dst, flowKey.Protocol)
+}
@@ -0,0 +1,370 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowexporter
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/agent/flowexporter/connection"
+	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
+)
+
+const (
+	testCollectorNamespace = "flow-aggregator"
+	testCollectorName      = "flow-aggregator"
+)
+
+type fakeShardExporter struct {
+	addr      string
+	connected bool
+	exportErr error
+	records   []*connection.Connection
+}
+
+func (e *fakeShardExporter) ConnectToCollector(addr string, tlsConfig *exporter.TLSConfig) error {
+	e.addr = addr
+	e.connected = true
+	return nil
+}
+
+func (e *fakeShardExporter) Export(conn *connection.Connection) error {
+	if e.exportErr != nil {
+		return e.exportErr
+	}
+	e.records = append(e.records, conn)
+	return nil
+}
+
+func (e *fakeShardExporter) CloseConnToCollector() {
+	e.connected = false
+}
+
+func newTestCollectorService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testCollectorNamespace, Name: testCollectorName},
+		Spec: corev1.ServiceSpec{
+			IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+			Ports: []corev1.ServicePort{
+				{Name: "ipfix-udp", Port: 4739, Protocol: corev1.ProtocolUDP},
+				{Name: "ipfix-tcp", Port: 4739, Protocol: corev1.ProtocolTCP},
+				{Name: "grpc", Port: 14739, Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+}
+
+func newTestCollectorEndpointSlice(name string, addressType discovery.AddressType, portName string, port int32, readyAddresses []string, notReadyAddresses []string) *discovery.EndpointSlice {
+	endpointSlice := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testCollectorNamespace,
+			Name:      name,
+			Labels:    map[string]string{discovery.LabelServiceName: testCollectorName},
+		},
+		AddressType: addressType,
+		Ports: []discovery.EndpointPort{
+			{Name: ptr.To(portName), Port: ptr.To(port), Protocol: ptr.To(corev1.ProtocolTCP)},
+		},
+	}
+	for _, address := range readyAddresses {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discovery.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discovery.EndpointConditions{Ready: ptr.To(true)},
+		})
+	}
+	for _, address := range notReadyAddresses {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discovery.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discovery.EndpointConditions{Ready: ptr.To(false)},
+		})
+	}
+	return endpointSlice
+}
+
+func newTestCollectorShards(t *testing.T, k8sClient *fake.Clientset) *collectorShards {
+	s := newCollectorShards(k8sClient, testCollectorNamespace, testCollectorName, 14739, corev1.ProtocolTCP, func() exporter.Interface {
+		return &fakeShardExporter{}
+	})
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	s.run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, s.hasSynced))
+	return s
+}
+
+func mustUpdate(t *testing.T, s *collectorShards) int {
+	numConnected, err := s.update(nil)
+	require.NoError(t, err)
+	return numConnected
+}
+
+func getShardExporter(s *collectorShards, addr string) *fakeShardExporter {
+	return s.shards[addr].exporter.(*fakeShardExporter)
+}
+
+func TestCollectorShardsGetEndpoints(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(
+		newTestCollectorService(),
+		newTestCollectorEndpointSlice("fa-1", discovery.AddressTypeIPv4, "grpc", 14739, []string{"10.10.0.1", "10.10.1.1"}, []string{"10.10.2.1"}),
+		newTestCollectorEndpointSlice("fa-2", discovery.AddressTypeIPv4, "grpc", 14739, []string{"10.10.3.1"}, nil),
+		// Port does not match the collector Service port.
+		newTestCollectorEndpointSlice("fa-3", discovery.AddressTypeIPv4, "ipfix-tcp", 4739, []string{"10.10.4.1"}, nil),
+		// Secondary IP family of a dual-stack Service.
+		newTestCollectorEndpointSlice("fa-4", discovery.AddressTypeIPv6, "grpc", 14739, []string{"fd00:10:10::1"}, nil),
+	)
+	s := newTestCollectorShards(t, k8sClient)
+	assert.Equal(t, sets.New("10.10.0.1:14739", "10.10.1.1:14739", "10.10.3.1:14739"), s.getEndpoints())
+}
+
+func TestCollectorShardsUpdate(t *testing.T) {
+	ctx := context.Background()
+	endpointSlice := newTestCollectorEndpointSlice("fa-1", discovery.AddressTypeIPv4, "grpc", 14739, []string{"10.10.0.1", "10.10.1.1"}, nil)
+	k8sClient := fake.NewSimpleClientset(newTestCollectorService(), endpointSlice)
+	s := newTestCollectorShards(t, k8sClient)
+
+	assert.True(t, s.needsUpdate())
+	assert.Equal(t, 2, mustUpdate(t, s))
+	assert.False(t, s.needsUpdate())
+	removedExporter := getShardExporter(s, "10.10.0.1:14739")
+	assert.True(t, removedExporter.connected)
+
+	endpointSlice = newTestCollectorEndpointSlice("fa-1", discovery.AddressTypeIPv4, "grpc", 14739, []string{"10.10.1.1", "10.10.2.1", "10.10.3.1"}, nil)
+	_, err := k8sClient.DiscoveryV1().EndpointSlices(testCollectorNamespace).Update(ctx, endpointSlice, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, s.needsUpdate, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 3, mustUpdate(t, s))
+	assert.False(t, s.needsUpdate())
+	assert.False(t, removedExporter.connected)
+	assert.ElementsMatch(t, []string{"10.10.1.1:14739", "10.10.2.1:14739", "10.10.3.1:14739"}, sets.List(sets.KeySet(s.shards)))
+
+	s.closeAll()
+	assert.True(t, s.needsUpdate())
+	for addr := range s.shards {
+		assert.False(t, getShardExporter(s, addr).connected)
+	}
+}
+
+func TestCollectorShardsUpdateCertificateMismatch(t *testing.T) {
+	endpointSlice := newTestCollectorEndpointSlice("fa-1", discovery.AddressTypeIPv4, "grpc", 14739, []string{"10.10.0.1", "10.10.1.1"}, nil)
+	k8sClient := fake.NewSimpleClientset(newTestCollectorService(), endpointSlice)
+	s := newTestCollectorShards(t, k8sClient)
+	verifyErr := fmt.Errorf("x509: certificate signed by unknown authority")
+	s.verifyServerCertificate = func(addr string, tlsConfig *exporter.TLSConfig) error {
+		if addr == "10.10.1.1:14739" {
+			return verifyErr
+		}
+		return nil
+	}
+	tlsConfig := &exporter.TLSConfig{ServerName: s.serverName()}
+
+	numConnected, err := s.update(tlsConfig)
+	require.ErrorIs(t, err, verifyErr)
+	assert.ErrorContains(t, err, "failed to verify server certificate of Flow Aggregator replica 10.10.1.1:14739")
+	assert.Equal(t, 1, numConnected)
+	assert.False(t, getShardExporter(s, "10.10.1.1:14739").connected)
+
+	// The error is reported until the certificates are fixed.
+	_, err = s.update(tlsConfig)
+	require.ErrorIs(t, err, verifyErr)
+	s.verifyServerCertificate = func(addr string, tlsConfig *exporter.TLSConfig) error {
+		return nil
+	}
+	numConnected, err = s.update(tlsConfig)
+	require.NoError(t, err)
+	assert.Equal(t, 2, numConnected)
+}
+
+func TestVerifyServerCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(nil)
+	defer server.Close()
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	otherCAKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherCATemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "other-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	otherCACert, err := x509.CreateCertificate(rand.Reader, otherCATemplate, otherCATemplate, &otherCAKey.PublicKey, otherCAKey)
+	require.NoError(t, err)
+	otherCAData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: otherCACert})
+	// Reserve a port with no listener.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := listener.Addr().String()
+	listener.Close()
+
+	testCases := []struct {
+		name        string
+		addr        string
+		caData      []byte
+		serverName  string
+		expectedErr bool
+	}{
+		{
+			name:       "valid certificate",
+			addr:       server.Listener.Addr().String(),
+			caData:     caData,
+			serverName: "example.com",
+		},
+		{
+			name:        "unknown CA",
+			addr:        server.Listener.Addr().String(),
+			caData:      otherCAData,
+			serverName:  "example.com",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid server name",
+			addr:        server.Listener.Addr().String(),
+			caData:      caData,
+			serverName:  "flow-aggregator.flow-aggregator.svc",
+			expectedErr: true,
+		},
+		{
+			name:       "connection error",
+			addr:       closedAddr,
+			caData:     caData,
+			serverName: "example.com",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifyServerCertificate(tc.addr, &exporter.TLSConfig{ServerName: tc.serverName, CAData: tc.caData})
+			if tc.expectedErr {
+				var verificationErr *tls.CertificateVerificationError
+				assert.ErrorAs(t, err, &verificationErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCollectorShardsExport(t *testing.T) {
+	addresses := []string{"10.10.0.1", "10.10.1.1", "10.10.2.1", "10.10.3.1"}
+	k8sClient := fake.NewSimpleClientset(
+		newTestCollectorService(),
+		newTestCollectorEndpointSlice("fa-1", discovery.AddressTypeIPv4, "grpc", 14739, addresses, nil),
+	)
+	s1 := newTestCollectorShards(t, k8sClient)
+	require.Equal(t, len(addresses), mustUpdate(t, s1))
+	// Simulate another Node, which discovers the same replicas.
+	s2 := newTestCollectorShards(t, k8sClient)
+	require.Equal(t, len(addresses), mustUpdate(t, s2))
+
+	const numConns = 1000
+	selectedAddrs := make(map[connection.Tuple]string)
+	for i := 0; i < numConns; i++ {
+		flowKey := connection.Tuple{
+			SourceAddress:      netip.MustParseAddr(fmt.Sprintf("10.20.0.%d", i%250+1)),
+			DestinationAddress: netip.MustParseAddr("10.20.1.1"),
+			Protocol:           6,
+			SourcePort:         uint16(30000 + i),
+			DestinationPort:    80,
+		}
+		require.NoError(t, s1.export(&connection.Connection{FlowKey: flowKey}))
+		reverseFlowKey := connection.Tuple{
+			SourceAddress:      flowKey.DestinationAddress,
+			DestinationAddress: flowKey.SourceAddress,
+			Protocol:           flowKey.Protocol,
+			SourcePort:         flowKey.DestinationPort,
+			DestinationPort:    flowKey.SourcePort,
+		}
+		require.NoError(t, s2.export(&connection.Connection{FlowKey: reverseFlowKey}))
+		selectedAddrs[flowKey] = s1.hashMap.Get(shardKey(flowKey))
+	}
+	for _, addr := range sets.List(sets.KeySet(s1.shards)) {
+		records1 := getShardExporter(s1, addr).records
+		records2 := getShardExporter(s2, addr).records
+		// Both Nodes send the records for a given connection to the same replica,
+		// regardless of the direction.
+		require.Len(t, records2, len(records1))
+		for i := range records1 {
+			assert.Equal(t, addr, selectedAddrs[records1[i].FlowKey])
+			assert.Equal(t, records1[i].FlowKey.SourceAddress, records2[i].FlowKey.DestinationAddress)
+		}
+		// Records are spread across all replicas.
+		assert.NotEmpty(t, records1)
+	}
+
+	// When a replica fails, its records are sent to another replica.
+	var failedAddr string
+	for flowKey, addr := range selectedAddrs {
+		failedAddr = addr
+		failedExporter := getShardExporter(s1, failedAddr)
+		failedExporter.exportErr = fmt.Errorf("connection reset")
+		numRecords := len(failedExporter.records)
+		require.NoError(t, s1.export(&connection.Connection{FlowKey: flowKey}))
+		assert.False(t, failedExporter.connected)
+		assert.Len(t, failedExporter.records, numRecords)
+		break
+	}
+	assert.True(t, s1.needsUpdate())
+
+	for addr := range s1.shards {
+		getShardExporter(s1, addr).exportErr = fmt.Errorf("connection reset")
+	}
+	assert.ErrorContains(t, s1.export(&connection.Connection{}), "no Flow Aggregator replica is connected")
+}
+
+func TestShardKey(t *testing.T) {
+	flowKey := connection.Tuple{
+		SourceAddress:      netip.MustParseAddr("10.10.0.1"),
+		DestinationAddress: netip.MustParseAddr("10.10.1.1"),
+		Protocol:           17,
+		SourcePort:         53000,
+		DestinationPort:    53,
+	}
+	reverseFlowKey := connection.Tuple{
+		SourceAddress:      flowKey.DestinationAddress,
+		DestinationAddress: flowKey.SourceAddress,
+		Protocol:           flowKey.Protocol,
+		SourcePort:         flowKey.DestinationPort,
+		DestinationPort:    flowKey.SourcePort,
+	}
+	assert.Equal(t, "10.10.0.1:53000-10.10.1.1:53-17", shardKey(flowKey))
+	assert.Equal(t, shardKey(flowKey), shardKey(reverseFlowKey))
+	otherFlowKey := flowKey
+	otherFlowKey.SourcePort = 53001
+	assert.NotEqual(t, shardKey(flowKey), shardKey(otherFlowKey))
+}
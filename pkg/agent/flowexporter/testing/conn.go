@@ -66,6 +66,12 @@ func GetConnection(isIPv6 bool, isPresent bool, statusFlag uint32, protoID uint8
 		EgressUID:                      uuid.New().String(),
 		EgressNodeName:                 "egress-node",
 	}
+	if protoID == 6 {
+		conn.TCPRTT = 1500
+		conn.TCPRTTVar = 300
+		conn.TCPRetransmissions = 2
+		conn.TCPRwndLimitedTime = 12000
+	}
 	return conn
 }
 
@@ -176,6 +176,39 @@ type Client interface {
 	// UninstallPodSNATFlows removes the SNAT flows for the local Pod.
 	UninstallPodSNATFlows(ofPort uint32) error
 
+	// InstallEgressIPGroup installs or updates the select group used to
+	// distribute connections of an ActiveActive Egress across its SNAT
+	// IPs. A non-zero mark in snatMarks indicates the corresponding SNAT
+	// IP is on the local Node, otherwise packets are tunnelled to the
+	// remote Node using the SNAT IP as the tunnel destination. The SNAT IP
+	// selected for a connection is persisted in conntrack, so that the
+	// established connections keep their SNAT IPs when the other SNAT IPs
+	// are added to or removed from the group.
+	InstallEgressIPGroup(groupID binding.GroupIDType, snatIPs []net.IP, snatMarks []uint32) error
+
+	// UninstallEgressIPGroup removes the select group of an ActiveActive
+	// Egress and the flows installed with it.
+	UninstallEgressIPGroup(groupID binding.GroupIDType) error
+
+	// InstallPodSNATGroupFlows installs the SNAT flows for a local Pod
+	// whose Egress is in ActiveActive mode. The flows send the egress
+	// packets from the ofPort to the Egress's select group. The flows
+	// can be removed with UninstallPodSNATFlows.
+	InstallPodSNATGroupFlows(ofPort uint32, groupID binding.GroupIDType, ipProtocol binding.Protocol) error
+
+	// InstallPodSNATDestinationFlows installs the SNAT flows for a local
+	// Pod selected by Egresses with destinations. Each rule SNATs the
+	// egress packets from the ofPort to its destination CIDR, and takes
+	// precedence over the flows installed by InstallPodSNATFlows and
+	// InstallPodSNATGroupFlows. The destination CIDRs of the rules must
+	// not overlap. Calling it again replaces the existing flows of the
+	// ofPort with the new rules.
+	InstallPodSNATDestinationFlows(ofPort uint32, rules []PodSNATDestinationRule) error
+
+	// UninstallPodSNATDestinationFlows removes the SNAT flows installed
+	// by InstallPodSNATDestinationFlows for the local Pod.
+	UninstallPodSNATDestinationFlows(ofPort uint32) error
+
 	// InstallEgressQoS installs an OF meter with specific meterID, rate
 	// and burst used for QoS of Egress and a QoS flow that direct packets
 	// into the meter.
@@ -973,7 +1006,7 @@ func (c *client) generatePipelines() {
 	c.traceableFeatures = append(c.traceableFeatures, c.featureNetworkPolicy)
 
 	if c.enableEgress {
-		c.featureEgress = newFeatureEgress(c.cookieAllocator, c.ipProtocols, c.nodeConfig, c.egressConfig, c.ovsMetersAreSupported && c.enableEgressTrafficShaping)
+		c.featureEgress = newFeatureEgress(c.cookieAllocator, c.ipProtocols, c.bridge, c.nodeConfig, c.egressConfig, c.connectUplinkToBridge, c.ovsMetersAreSupported && c.enableEgressTrafficShaping)
 		c.activatedFeatures = append(c.activatedFeatures, c.featureEgress)
 	}
 
@@ -1077,6 +1110,60 @@ func (c *client) InstallPodSNATFlows(ofPort uint32, snatIP net.IP, snatMark uint
 	return c.addFlows(c.featureEgress.cachedFlows, cacheKey, flows)
 }
 
+func (c *client) InstallEgressIPGroup(groupID binding.GroupIDType, snatIPs []net.IP, snatMarks []uint32) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	bucketIDs, err := c.featureEgress.allocateBucketIDs(groupID, snatIPs)
+	if err != nil {
+		return fmt.Errorf("error when allocating bucket IDs for Egress IP Group %d: %w", groupID, err)
+	}
+	group := c.featureEgress.egressIPGroup(groupID, bucketIDs, snatIPs, snatMarks)
+	_, installed := c.featureEgress.groupCache.Load(groupID)
+	if !installed {
+		if err := c.ofEntryOperations.AddOFEntries([]binding.OFEntry{group}); err != nil {
+			return fmt.Errorf("error when installing Egress IP Group %d: %w", groupID, err)
+		}
+	} else {
+		if err := c.ofEntryOperations.ModifyOFEntries([]binding.OFEntry{group}); err != nil {
+			return fmt.Errorf("error when modifying Egress IP Group %d: %w", groupID, err)
+		}
+	}
+	c.featureEgress.groupCache.Store(groupID, group)
+	// The flows keep the established connections on the buckets selected by their first packets. The flows of the
+	// removed buckets are deleted, so their connections are redistributed by the group.
+	flows := c.featureEgress.egressIPBucketFlows(bucketIDs, snatIPs, snatMarks)
+	if err := c.modifyFlows(c.featureEgress.cachedFlows, fmt.Sprintf("g%x", groupID), flows); err != nil {
+		return fmt.Errorf("error when installing flows for Egress IP Group %d: %w", groupID, err)
+	}
+	return nil
+}
+
+func (c *client) UninstallEgressIPGroup(groupID binding.GroupIDType) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	if err := c.deleteFlows(c.featureEgress.cachedFlows, fmt.Sprintf("g%x", groupID)); err != nil {
+		return fmt.Errorf("error when deleting flows for Egress IP Group %d: %w", groupID, err)
+	}
+	gCache, ok := c.featureEgress.groupCache.Load(groupID)
+	if ok {
+		if err := c.ofEntryOperations.DeleteOFEntries([]binding.OFEntry{gCache.(binding.Group)}); err != nil {
+			return fmt.Errorf("error when deleting Egress IP Group %d: %w", groupID, err)
+		}
+		c.featureEgress.groupCache.Delete(groupID)
+	}
+	c.featureEgress.releaseBucketIDs(groupID)
+	return nil
+}
+
+func (c *client) InstallPodSNATGroupFlows(ofPort uint32, groupID binding.GroupIDType, ipProtocol binding.Protocol) error {
+	flows := []binding.Flow{c.featureEgress.snatGroupRuleFlow(ofPort, groupID, ipProtocol)}
+	cacheKey := fmt.Sprintf("p%x", ofPort)
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.addFlows(c.featureEgress.cachedFlows, cacheKey, flows)
+}
+
 func (c *client) UninstallPodSNATFlows(ofPort uint32) error {
 	cacheKey := fmt.Sprintf("p%x", ofPort)
 	c.replayMutex.RLock()
@@ -1084,6 +1171,24 @@ func (c *client) UninstallPodSNATFlows(ofPort uint32) error {
 	return c.deleteFlows(c.featureEgress.cachedFlows, cacheKey)
 }
 
+func (c *client) InstallPodSNATDestinationFlows(ofPort uint32, rules []PodSNATDestinationRule) error {
+	flows := make([]binding.Flow, 0, len(rules))
+	for _, rule := range rules {
+		flows = append(flows, c.featureEgress.snatDestinationRuleFlow(ofPort, rule, c.nodeConfig.GatewayConfig.MAC))
+	}
+	cacheKey := fmt.Sprintf("pd%x", ofPort)
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.modifyFlows(c.featureEgress.cachedFlows, cacheKey, flows)
+}
+
+func (c *client) UninstallPodSNATDestinationFlows(ofPort uint32) error {
+	cacheKey := fmt.Sprintf("pd%x", ofPort)
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.deleteFlows(c.featureEgress.cachedFlows, cacheKey)
+}
+
 func (c *client) InstallEgressQoS(meterID, rate, burst uint32) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
@@ -1740,6 +1740,190 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 	}
 }
 
+func Test_client_InstallPodSNATGroupFlows(t *testing.T) {
+	ofPort := uint32(100)
+	groupID := binding.GroupIDType(100)
+	expectedFlows := []string{
+		"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ip,in_port=100 actions=group:100",
+	}
+
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+
+	m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+	cacheKey := fmt.Sprintf("p%x", ofPort)
+
+	assert.NoError(t, fc.InstallPodSNATGroupFlows(ofPort, groupID, binding.ProtocolIP))
+	fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	assert.ElementsMatch(t, expectedFlows, getFlowStrings(fCacheI))
+
+	assert.NoError(t, fc.UninstallPodSNATFlows(ofPort))
+	_, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+	require.False(t, ok)
+}
+
+func Test_client_InstallPodSNATDestinationFlows(t *testing.T) {
+	ofPort := uint32(100)
+	rules := []PodSNATDestinationRule{
+		{DstCIDR: *utilip.MustParseCIDR("10.20.0.0/16"), SNATIP: net.ParseIP("192.168.77.100"), SNATMark: 100},
+		{DstCIDR: *utilip.MustParseCIDR("10.30.0.0/16"), SNATIP: net.ParseIP("192.168.77.101")},
+		{DstCIDR: *utilip.MustParseCIDR("10.40.0.0/16"), GroupID: binding.GroupIDType(10)},
+	}
+	expectedFlows := []string{
+		"cookie=0x1040000000000, table=EgressMark, priority=201,ct_state=+trk,ip,in_port=100,nw_dst=10.20.0.0/16 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+		"cookie=0x1040000000000, table=EgressMark, priority=201,ip,in_port=100,nw_dst=10.30.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+		"cookie=0x1040000000000, table=EgressMark, priority=201,ct_state=+trk,ip,in_port=100,nw_dst=10.40.0.0/16 actions=group:10",
+	}
+
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+
+	m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().BundleOps(gomock.Len(0), gomock.Len(1), gomock.Len(2)).Return(nil).Times(1)
+	m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+	cacheKey := fmt.Sprintf("pd%x", ofPort)
+
+	assert.NoError(t, fc.InstallPodSNATDestinationFlows(ofPort, rules))
+	fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	assert.ElementsMatch(t, expectedFlows, getFlowStrings(fCacheI))
+
+	// Installing the flows again with fewer rules should delete the stale flows.
+	assert.NoError(t, fc.InstallPodSNATDestinationFlows(ofPort, rules[:1]))
+	fCacheI, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	assert.ElementsMatch(t, expectedFlows[:1], getFlowStrings(fCacheI))
+
+	assert.NoError(t, fc.UninstallPodSNATDestinationFlows(ofPort))
+	_, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+	require.False(t, ok)
+}
+
+func Test_client_InstallEgressIPGroup(t *testing.T) {
+	groupID := binding.GroupIDType(100)
+	snatIPs := []net.IP{net.ParseIP("192.168.77.101"), net.ParseIP("192.168.77.102")}
+
+	testCases := []struct {
+		name                  string
+		trafficShapingEnabled bool
+		snatMarks             []uint32
+		expectedGroup         string
+		expectedFlows         []string
+	}{
+		{
+			name:      "local and remote SNAT IPs",
+			snatMarks: []uint32{100, 0},
+			expectedGroup: "group_id=100,type=select," +
+				"bucket=bucket_id:0,weight:100,actions=set_field:0x1/0xffff->reg10,set_field:0x10000/0x10000->reg10,set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,resubmit:L2ForwardingCalc," +
+				"bucket=bucket_id:1,weight:100,actions=set_field:0x2/0xffff->reg10,set_field:0x10000/0x10000->reg10,set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.102->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,resubmit:L2ForwardingCalc",
+			expectedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=202,ct_state=-new+trk,ct_label=0x10000000000000000000/0xffff0000000000000000000,ip actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+				"cookie=0x1040000000000, table=EgressMark, priority=202,ct_state=-new+trk,ct_label=0x20000000000000000000/0xffff0000000000000000000,ip actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.102->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+			},
+		},
+		{
+			name:                  "local SNAT IPs with trafficShaping",
+			trafficShapingEnabled: true,
+			snatMarks:             []uint32{100, 101},
+			expectedGroup: "group_id=100,type=select," +
+				"bucket=bucket_id:0,weight:100,actions=set_field:0x1/0xffff->reg10,set_field:0x10000/0x10000->reg10,set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,resubmit:EgressQoS," +
+				"bucket=bucket_id:1,weight:100,actions=set_field:0x2/0xffff->reg10,set_field:0x10000/0x10000->reg10,set_field:0x65/0xff->pkt_mark,set_field:0x20/0xf0->reg0,resubmit:EgressQoS",
+			expectedFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=202,ct_state=-new+trk,ct_label=0x10000000000000000000/0xffff0000000000000000000,ip actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:EgressQoS",
+				"cookie=0x1040000000000, table=EgressMark, priority=202,ct_state=-new+trk,ct_label=0x20000000000000000000/0xffff0000000000000000000,ip actions=set_field:0x65/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:EgressQoS",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := opstest.NewMockOFEntryOperations(ctrl)
+			fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap, setEnableEgressTrafficShaping(tc.trafficShapingEnabled))
+			defer resetPipelines()
+
+			cacheKey := fmt.Sprintf("g%x", groupID)
+			m.EXPECT().AddOFEntries(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().ModifyOFEntries(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().BundleOps(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().DeleteOFEntries(gomock.Any()).Return(nil).Times(1)
+			assert.NoError(t, fc.InstallEgressIPGroup(groupID, snatIPs, tc.snatMarks))
+			gCacheI, ok := fc.featureEgress.groupCache.Load(groupID)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedGroup, getGroupFromCache(gCacheI.(binding.Group)))
+			fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+
+			// Installing the group again should modify it.
+			assert.NoError(t, fc.InstallEgressIPGroup(groupID, snatIPs, tc.snatMarks))
+
+			assert.NoError(t, fc.UninstallEgressIPGroup(groupID))
+			_, ok = fc.featureEgress.groupCache.Load(groupID)
+			require.False(t, ok)
+			_, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+			require.False(t, ok)
+			assert.Empty(t, fc.featureEgress.bucketIDs)
+		})
+	}
+}
+
+func Test_client_InstallEgressIPGroupBucketChange(t *testing.T) {
+	groupID := binding.GroupIDType(100)
+	cacheKey := fmt.Sprintf("g%x", groupID)
+	ip1 := net.ParseIP("192.168.77.101")
+	ip2 := net.ParseIP("192.168.77.102")
+	ip3 := net.ParseIP("192.168.77.103")
+
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+
+	m.EXPECT().AddOFEntries(gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+	assert.NoError(t, fc.InstallEgressIPGroup(groupID, []net.IP{ip1, ip2}, []uint32{100, 0}))
+	fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	assert.ElementsMatch(t, []string{
+		"cookie=0x1040000000000, table=EgressMark, priority=202,ct_state=-new+trk,ct_label=0x10000000000000000000/0xffff0000000000000000000,ip actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+		"cookie=0x1040000000000, table=EgressMark, priority=202,ct_state=-new+trk,ct_label=0x20000000000000000000/0xffff0000000000000000000,ip actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.102->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+	}, getFlowStrings(fCacheI))
+
+	// Replacing 192.168.77.101 with 192.168.77.103 should keep the bucket ID of 192.168.77.102 and its flow, so that
+	// the established connections SNAT'd with 192.168.77.102 are not redistributed. The bucket ID released by
+	// 192.168.77.101 should not be reused immediately.
+	m.EXPECT().ModifyOFEntries(gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().BundleOps(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(adds, mods, dels []*openflow15.FlowMod) error {
+			assert.Len(t, adds, 1)
+			assert.Len(t, mods, 1)
+			assert.Len(t, dels, 1)
+			return nil
+		}).Times(1)
+	assert.NoError(t, fc.InstallEgressIPGroup(groupID, []net.IP{ip2, ip3}, []uint32{0, 0}))
+	gCacheI, ok := fc.featureEgress.groupCache.Load(groupID)
+	require.True(t, ok)
+	assert.Equal(t, "group_id=100,type=select,"+
+		"bucket=bucket_id:0,weight:100,actions=set_field:0x2/0xffff->reg10,set_field:0x10000/0x10000->reg10,set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.102->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,resubmit:L2ForwardingCalc,"+
+		"bucket=bucket_id:1,weight:100,actions=set_field:0x3/0xffff->reg10,set_field:0x10000/0x10000->reg10,set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.103->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,resubmit:L2ForwardingCalc",
+		getGroupFromCache(gCacheI.(binding.Group)))
+	fCacheI, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	assert.ElementsMatch(t, []string{
+		"cookie=0x1040000000000, table=EgressMark, priority=202,ct_state=-new+trk,ct_label=0x20000000000000000000/0xffff0000000000000000000,ip actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.102->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+		"cookie=0x1040000000000, table=EgressMark, priority=202,ct_state=-new+trk,ct_label=0x30000000000000000000/0xffff0000000000000000000,ip actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.103->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+	}, getFlowStrings(fCacheI))
+}
+
 func Test_client_InstallEgressQoS(t *testing.T) {
 	meterID := uint32(100)
 	meterRate := uint32(100)
@@ -2768,6 +2952,10 @@ func Test_client_ReplayFlows(t *testing.T) {
 		"group_id=4,type=all,bucket=bucket_id:0,actions=resubmit:IngressMetric,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
 		"group_id=5,type=all,bucket=bucket_id:0,actions=resubmit:MulticastEgressMetric,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
 		"group_id=6,type=all,bucket=bucket_id:0,actions=resubmit:MulticastIngressMetric,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
+		"group_id=7,type=all,bucket=bucket_id:0,actions=resubmit:AntreaPolicyEgressRule,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
+		"group_id=8,type=all,bucket=bucket_id:0,actions=resubmit:EgressDefaultRule,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
+		"group_id=9,type=all,bucket=bucket_id:0,actions=resubmit:AntreaPolicyIngressRule,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
+		"group_id=10,type=all,bucket=bucket_id:0,actions=resubmit:IngressDefaultRule,bucket=bucket_id:1,actions=set_field:0x400000/0x600000->reg0,resubmit:Output",
 	}
 	ruleID := uint32(15)
 	priority200 = uint16(200)
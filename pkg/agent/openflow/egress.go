@@ -15,6 +15,8 @@
 package openflow
 
 import (
+	"fmt"
+	"math"
 	"net"
 	"sync"
 
@@ -25,12 +27,38 @@ import (
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
+// PodSNATDestinationRule describes how the egress traffic from a local Pod to a destination CIDR is SNAT'd.
+type PodSNATDestinationRule struct {
+	DstCIDR net.IPNet
+	// SNATIP and SNATMark have the same semantics as the arguments of InstallPodSNATFlows.
+	SNATIP   net.IP
+	SNATMark uint32
+	// GroupID is the ID of the select group of an ActiveActive Egress. If it is not 0, the traffic is sent to the
+	// group, and SNATIP and SNATMark are ignored.
+	GroupID binding.GroupIDType
+}
+
 type featureEgress struct {
 	cookieAllocator cookie.Allocator
 	ipProtocols     []binding.Protocol
 
+	bridge binding.Bridge
+
 	cachedFlows *flowCategoryCache
 	cachedMeter sync.Map
+	// groupCache caches the select groups of ActiveActive Egresses.
+	groupCache sync.Map
+	// bucketIDs stores the IDs of the buckets of the select groups of ActiveActive Egresses, keyed by group ID and
+	// SNAT IP. The IDs are unique on the Node and are committed to the connections, so that a bucket must keep its ID
+	// as long as its SNAT IP is in the group.
+	bucketIDs map[binding.GroupIDType]map[string]uint16
+	// lastBucketID is the last allocated bucket ID. IDs are allocated in a round-robin way, so that a released ID is not
+	// reused immediately while there may still be connections committed with it.
+	lastBucketID   uint16
+	bucketIDsMutex sync.Mutex
+
+	ctZones        map[binding.Protocol]int
+	ctZoneSrcField *binding.RegField
 
 	exceptCIDRs map[binding.Protocol][]net.IPNet
 	nodeIPs     map[binding.Protocol]net.IP
@@ -46,8 +74,10 @@ func (f *featureEgress) getFeatureName() string {
 
 func newFeatureEgress(cookieAllocator cookie.Allocator,
 	ipProtocols []binding.Protocol,
+	bridge binding.Bridge,
 	nodeConfig *config.NodeConfig,
 	egressConfig *config.EgressConfig,
+	connectUplinkToBridge bool,
 	enableEgressTrafficShaping bool) *featureEgress {
 	exceptCIDRs := make(map[binding.Protocol][]net.IPNet)
 	for _, cidr := range egressConfig.ExceptCIDRs {
@@ -59,15 +89,19 @@ func newFeatureEgress(cookieAllocator cookie.Allocator,
 	}
 
 	nodeIPs := make(map[binding.Protocol]net.IP)
+	ctZones := make(map[binding.Protocol]int)
 	for _, ipProtocol := range ipProtocols {
 		switch ipProtocol {
 		case binding.ProtocolIP:
 			nodeIPs[ipProtocol] = nodeConfig.NodeIPv4Addr.IP
+			ctZones[ipProtocol] = CtZone
 		case binding.ProtocolIPv6:
 			nodeIPs[ipProtocol] = nodeConfig.NodeIPv6Addr.IP
+			ctZones[ipProtocol] = CtZoneV6
 		}
 	}
 	return &featureEgress{
+		bridge:                     bridge,
 		cachedFlows:                newFlowCategoryCache(),
 		cachedMeter:                sync.Map{},
 		cookieAllocator:            cookieAllocator,
@@ -75,6 +109,9 @@ func newFeatureEgress(cookieAllocator cookie.Allocator,
 		ipProtocols:                ipProtocols,
 		nodeIPs:                    nodeIPs,
 		gatewayMAC:                 nodeConfig.GatewayConfig.MAC,
+		bucketIDs:                  make(map[binding.GroupIDType]map[string]uint16),
+		ctZones:                    ctZones,
+		ctZoneSrcField:             getZoneSrcField(connectUplinkToBridge),
 		category:                   cookie.Egress,
 		enableEgressTrafficShaping: enableEgressTrafficShaping,
 	}
@@ -99,7 +136,14 @@ func (f *featureEgress) initGroups() []binding.OFEntry {
 }
 
 func (f *featureEgress) replayGroups() []binding.OFEntry {
-	return nil
+	var groups []binding.OFEntry
+	f.groupCache.Range(func(id, value interface{}) bool {
+		group := value.(binding.Group)
+		group.Reset()
+		groups = append(groups, group)
+		return true
+	})
+	return groups
 }
 
 func (f *featureEgress) replayMeters() []binding.OFEntry {
@@ -112,3 +156,64 @@ func (f *featureEgress) replayMeters() []binding.OFEntry {
 	})
 	return meters
 }
+
+// allocateBucketIDs returns the IDs of the buckets of the select group for the given SNAT IPs. A SNAT IP that is
+// already in the group keeps its bucket ID, while a new SNAT IP is allocated the next ID that is not used by any group.
+// The IDs of the SNAT IPs that are no longer in the group are released.
+func (f *featureEgress) allocateBucketIDs(groupID binding.GroupIDType, snatIPs []net.IP) ([]uint16, error) {
+	f.bucketIDsMutex.Lock()
+	defer f.bucketIDsMutex.Unlock()
+
+	usedIDs := make(map[uint16]struct{})
+	for id, groupBucketIDs := range f.bucketIDs {
+		if id == groupID {
+			continue
+		}
+		for _, bucketID := range groupBucketIDs {
+			usedIDs[bucketID] = struct{}{}
+		}
+	}
+	oldBucketIDs := f.bucketIDs[groupID]
+	newBucketIDs := make(map[string]uint16, len(snatIPs))
+	for _, snatIP := range snatIPs {
+		if bucketID, ok := oldBucketIDs[snatIP.String()]; ok {
+			newBucketIDs[snatIP.String()] = bucketID
+			usedIDs[bucketID] = struct{}{}
+		}
+	}
+	lastBucketID := f.lastBucketID
+	bucketIDs := make([]uint16, 0, len(snatIPs))
+	for _, snatIP := range snatIPs {
+		bucketID, ok := newBucketIDs[snatIP.String()]
+		if !ok {
+			if len(usedIDs) >= math.MaxUint16 {
+				return nil, fmt.Errorf("no bucket ID available for Egress IP %s", snatIP)
+			}
+			for {
+				// ID 0 is reserved for the connections without a selected bucket.
+				if lastBucketID == math.MaxUint16 {
+					lastBucketID = 1
+				} else {
+					lastBucketID++
+				}
+				if _, used := usedIDs[lastBucketID]; !used {
+					break
+				}
+			}
+			bucketID = lastBucketID
+			newBucketIDs[snatIP.String()] = bucketID
+			usedIDs[bucketID] = struct{}{}
+		}
+		bucketIDs = append(bucketIDs, bucketID)
+	}
+	f.bucketIDs[groupID] = newBucketIDs
+	f.lastBucketID = lastBucketID
+	return bucketIDs, nil
+}
+
+// releaseBucketIDs releases the IDs of the buckets of the select group.
+func (f *featureEgress) releaseBucketIDs(groupID binding.GroupIDType) {
+	f.bucketIDsMutex.Lock()
+	defer f.bucketIDsMutex.Unlock()
+	delete(f.bucketIDs, groupID)
+}
@@ -30,6 +30,7 @@ func egressInitFlows(isIPv4 bool) []string {
 			"cookie=0x1040000000000, table=EgressMark, priority=210,ip,nw_dst=192.168.78.0/24 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			"cookie=0x1040000000000, table=EgressMark, priority=210,ip,nw_dst=192.168.77.100 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			"cookie=0x1040000000000, table=EgressMark, priority=190,ct_state=+new+trk,ip,reg0=0x1/0xf actions=drop",
+			"cookie=0x1040000000000, table=ConntrackCommit, priority=210,ct_state=+new+trk-snat,ct_mark=0x0/0x10,ip,reg10=0x10000/0x10000 actions=ct(commit,table=Output,zone=65520,exec(move:NXM_NX_REG0[0..3]->NXM_NX_CT_MARK[0..3],move:NXM_NX_REG10[0..15]->NXM_NX_CT_LABEL[76..91]))",
 			"cookie=0x1040000000000, table=EgressMark, priority=0 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 		}
 	}
@@ -39,6 +40,7 @@ func egressInitFlows(isIPv4 bool) []string {
 		"cookie=0x1040000000000, table=EgressMark, priority=210,ipv6,ipv6_dst=fec0:192:168:78::/80 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 		"cookie=0x1040000000000, table=EgressMark, priority=210,ipv6,ipv6_dst=fec0:192:168:77::100 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 		"cookie=0x1040000000000, table=EgressMark, priority=190,ct_state=+new+trk,ipv6,reg0=0x1/0xf actions=drop",
+		"cookie=0x1040000000000, table=ConntrackCommit, priority=210,ct_state=+new+trk-snat,ct_mark=0x0/0x10,ipv6,reg10=0x10000/0x10000 actions=ct(commit,table=Output,zone=65510,exec(move:NXM_NX_REG0[0..3]->NXM_NX_CT_MARK[0..3],move:NXM_NX_REG10[0..15]->NXM_NX_CT_LABEL[76..91]))",
 		"cookie=0x1040000000000, table=EgressMark, priority=0 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 	}
 }
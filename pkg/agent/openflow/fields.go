@@ -77,6 +77,14 @@ var (
 	GeneratedRejectPacketOutRegMark = binding.NewOneBitRegMark(0, 13)
 	// reg0[14]: Mark to indicate a Service without any Endpoints (used by Proxy)
 	SvcNoEpRegMark = binding.NewOneBitRegMark(0, 14)
+	// reg0[15]: Mark to indicate the packet has matched a Drop or Reject rule of an Antrea-native policy in Audit mode
+	// in the egress security stage. Such rules are ignored when the packet is resubmitted to the same table.
+	EgressAuditedRegMark    = binding.NewOneBitRegMark(0, 15)
+	NotEgressAuditedRegMark = binding.NewOneBitZeroRegMark(0, 15)
+	// reg0[16]: Mark to indicate the packet has matched a Drop or Reject rule of an Antrea-native policy in Audit mode
+	// in the ingress security stage. Such rules are ignored when the packet is resubmitted to the same table.
+	IngressAuditedRegMark    = binding.NewOneBitRegMark(0, 16)
+	NotIngressAuditedRegMark = binding.NewOneBitZeroRegMark(0, 16)
 	// reg0[19]: Mark to indicate remote SNAT for Egress.
 	RemoteSNATRegMark = binding.NewOneBitRegMark(0, 19)
 	// reg0[20]: Field to indicate redirect action of layer 7 NetworkPolicy.
@@ -183,6 +191,12 @@ var (
 	// reg9(NXM_NX_REG9)
 	// Field to cache the ofPort of the OVS interface to output traffic control packets.
 	TrafficControlTargetOFPortField = binding.NewRegField(9, 0, 31)
+
+	// reg10(NXM_NX_REG10)
+	// reg10[0..15]: Field to store the ID of the Egress IP bucket selected for a connection of an ActiveActive Egress.
+	EgressIPBucketIDField = binding.NewRegField(10, 0, 15)
+	// reg10[16]: Mark to indicate an Egress IP bucket has been selected for the packet.
+	EgressIPBucketSelectedRegMark = binding.NewOneBitRegMark(10, 16)
 )
 
 // Fields using xxreg.
@@ -234,4 +248,8 @@ var (
 
 	// Field to store the VLAN ID allocated for a L7 NetworkPolicy rule.
 	L7NPRuleVlanIDCTLabel = binding.NewCTLabel(64, 75)
+
+	// Field to store the ID of the Egress IP bucket selected for a connection of an ActiveActive Egress, so that the
+	// subsequent packets of the connection are sent with the same Egress IP.
+	EgressIPBucketCTLabel = binding.NewCTLabel(76, 91)
 )
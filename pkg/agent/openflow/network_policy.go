@@ -22,7 +22,9 @@ import (
 	"sync"
 
 	"antrea.io/libOpenflow/openflow15"
+	"antrea.io/ofnet/ofctrl"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
@@ -674,6 +676,31 @@ type policyRuleConjunction struct {
 	ruleName     string
 	ruleTableID  uint8
 	ruleLogLabel string
+	// meter polices the traffic matching the rule if its action is RateLimit, it is nil otherwise.
+	meter binding.Meter
+	// logSamplingMeter and logSamplingGroup sample the connections sent to the antrea-agent for logging if the
+	// logs of the rule are sampled, with MaxPerSecond and Ratio respectively. They are nil otherwise.
+	logSamplingMeter binding.Meter
+	logSamplingGroup binding.Group
+	// auditOnly is true if the rule is a Drop or Reject rule of a NetworkPolicy in Audit mode. The traffic matching
+	// such a rule is counted by its action flow instead of metric flows.
+	auditOnly bool
+}
+
+// ofEntries returns the OF Meters and Groups referenced by the flows of the rule. They must be installed before the
+// flows, and uninstalled after them.
+func (c *policyRuleConjunction) ofEntries() []binding.OFEntry {
+	var entries []binding.OFEntry
+	if c.meter != nil {
+		entries = append(entries, c.meter)
+	}
+	if c.logSamplingMeter != nil {
+		entries = append(entries, c.logSamplingMeter)
+	}
+	if c.logSamplingGroup != nil {
+		entries = append(entries, c.logSamplingGroup)
+	}
+	return entries
 }
 
 // clause groups conjunctive match flows. Matches in a clause represent source addresses(for fromClause), or destination
@@ -1168,12 +1195,21 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
 	ctxChanges := c.featureNetworkPolicy.calculateMatchFlowChangesForRule(conj, rule)
 
+	// The meters and groups must exist before the flows referencing them are installed.
+	for _, entry := range conj.ofEntries() {
+		if err := entry.Add(); err != nil {
+			c.featureNetworkPolicy.releaseOFEntries(conj)
+			return fmt.Errorf("error when adding OF %s for rule %d: %w", entry.Type(), conj.id, err)
+		}
+	}
 	var flowMessages []*openflow15.FlowMod
 	flowMessages = append(flowMessages, append(conj.metricFlows, conj.actionFlows...)...)
 	if err := c.ofEntryOperations.AddAll(flowMessages); err != nil {
+		c.featureNetworkPolicy.releaseOFEntries(conj)
 		return err
 	}
 	if err := c.featureNetworkPolicy.applyConjunctiveMatchFlows(ctxChanges); err != nil {
+		c.featureNetworkPolicy.releaseOFEntries(conj)
 		return err
 	}
 	// Add the policyRuleConjunction into policyCache
@@ -1196,6 +1232,9 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		ruleName:     rule.Name,
 		ruleLogLabel: rule.LogLabel,
 	}
+	if rule.IsRateLimited() && !f.ovsMetersAreSupported {
+		klog.InfoS("RateLimit action of the NetworkPolicy rule is realized as Allow because OVS meters are not supported", "rule", rule.Name, "policy", rule.PolicyRef.ToString())
+	}
 	nClause, ruleTable, dropTable := conj.calculateClauses(rule)
 	conj.ruleTableID = rule.TableID
 	_, isEgress := f.egressTables[rule.TableID]
@@ -1208,7 +1247,13 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		// Install action flows.
 		var actionFlows []binding.Flow
 		var metricFlows []binding.Flow
-		if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionDrop {
+		if rule.IsAuditOnly() {
+			// The traffic matching a Drop or Reject rule of a NetworkPolicy in Audit mode is not dropped. It is
+			// counted by the action flow, logged if logging is enabled, and then evaluated against the rules with
+			// lower priorities, which are still enforced.
+			conj.auditOnly = true
+			actionFlows = append(actionFlows, f.conjunctionActionAuditFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
+		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionDrop {
 			metricFlows = append(metricFlows, f.denyRuleMetricFlow(ruleOfID, isIngress, rule.TableID))
 			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionDrop, rule.EnableLogging))
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionReject {
@@ -1216,9 +1261,17 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionRej, rule.EnableLogging))
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionPass {
 			actionFlows = append(actionFlows, f.conjunctionActionPassFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
+		} else if rule.IsRateLimited() && f.ovsMetersAreSupported {
+			// The traffic matching a RateLimit rule is committed like the traffic matching an Allow rule. As the
+			// action flow is only hit by the first packet of a connection, the meter is applied by the metric flows,
+			// which are hit by all the packets of the connections admitted by the rule, in both directions.
+			conj.meter = f.rateLimitMeter(ruleOfID, rule.RateLimit)
+			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID, ruleMeterID(ruleOfID))...)
+			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, nil, nil)...)
 		} else {
-			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID)...)
-			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, rule.L7RuleVlanID)...)
+			sampling := f.calculateLogSampling(conj, rule)
+			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID, 0)...)
+			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, rule.L7RuleVlanID, sampling)...)
 		}
 		conj.actionFlows = GetFlowModMessages(actionFlows, binding.AddMessage)
 		conj.metricFlows = GetFlowModMessages(metricFlows, binding.AddMessage)
@@ -1226,6 +1279,67 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 	return conj
 }
 
+// releaseLogSampling deletes the log sampling meter or group of a policyRuleConjunction which failed to be installed,
+// in case it was already added, and releases the ID of the group, so that the ID can be allocated again.
+func (f *featureNetworkPolicy) releaseLogSampling(conj *policyRuleConjunction) {
+	if conj.logSamplingMeter != nil {
+		if err := conj.logSamplingMeter.Delete(); err != nil {
+			klog.ErrorS(err, "Failed to delete log sampling meter", "rule", conj.id)
+		}
+	}
+	if conj.logSamplingGroup == nil {
+		return
+	}
+	if err := conj.logSamplingGroup.Delete(); err != nil {
+		klog.ErrorS(err, "Failed to delete log sampling group", "rule", conj.id, "group", conj.logSamplingGroup.GetID())
+	}
+	f.groupAllocator.Release(conj.logSamplingGroup.GetID())
+	conj.logSamplingGroup = nil
+}
+
+// releaseOFEntries deletes the OF Meters and Groups of policyRuleConjunctions which failed to be installed, in case
+// they were already added, and releases the IDs allocated for them. Otherwise, adding them again would fail when the
+// installation is retried.
+func (f *featureNetworkPolicy) releaseOFEntries(conjunctions ...*policyRuleConjunction) {
+	for _, conj := range conjunctions {
+		if conj.meter != nil {
+			if err := conj.meter.Delete(); err != nil {
+				klog.ErrorS(err, "Failed to delete rate limit meter", "rule", conj.id)
+			}
+		}
+		f.releaseLogSampling(conj)
+	}
+}
+
+// logSampling describes how the connections matching a rule are sampled before being sent to the antrea-agent for
+// logging. Only one of meterID and groupID is set.
+type logSampling struct {
+	meterID uint32
+	groupID binding.GroupIDType
+}
+
+// calculateLogSampling generates the OF Meter or Group sampling the connections logged for an Allow rule, and returns
+// how they are referenced by the conjunction action flow. It returns nil if all the connections should be logged.
+func (f *featureNetworkPolicy) calculateLogSampling(conj *policyRuleConjunction, rule *types.PolicyRule) *logSampling {
+	if !rule.EnableLogging || rule.LogSampling == nil {
+		return nil
+	}
+	if rule.LogSampling.Ratio > 1 {
+		groupID := f.groupAllocator.Allocate()
+		conj.logSamplingGroup = f.logSamplingGroup(groupID, rule.LogSampling.Ratio)
+		return &logSampling{groupID: groupID}
+	}
+	if rule.LogSampling.MaxPerSecond > 0 {
+		if !f.ovsMetersAreSupported {
+			klog.InfoS("All the connections of the NetworkPolicy rule are logged because OVS meters are not supported", "rule", rule.Name, "policy", rule.PolicyRef.ToString())
+			return nil
+		}
+		conj.logSamplingMeter = f.logSamplingMeter(conj.id, rule.LogSampling.MaxPerSecond)
+		return &logSampling{meterID: ruleMeterID(conj.id)}
+	}
+	return nil
+}
+
 // calculateMatchFlowChangesForRule calculates the contextChanges for the policyRule, and updates the context status in case of batch install.
 func (f *featureNetworkPolicy) calculateMatchFlowChangesForRule(conj *policyRuleConjunction, rule *types.PolicyRule) []*conjMatchFlowContextChange {
 	// Calculate the conjMatchFlowContext changes. The changed Openflow entries are included in the conjMatchFlowContext change.
@@ -1316,8 +1430,16 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 	for _, rule := range ofPolicyRules {
 		conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
 		c.featureNetworkPolicy.addRuleToConjunctiveMatch(conj, rule)
-		allFlowMessages = append(allFlowMessages, append(conj.actionFlows, conj.metricFlows...)...)
 		conjunctions = append(conjunctions, conj)
+		// Openflow bundle message doesn't support meter, the meters and groups are added individually before the flows.
+		for _, entry := range conj.ofEntries() {
+			if err := entry.Add(); err != nil {
+				c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+				c.featureNetworkPolicy.releaseOFEntries(conjunctions...)
+				return fmt.Errorf("error when adding OF %s for rule %d: %w", entry.Type(), conj.id, err)
+			}
+		}
+		allFlowMessages = append(allFlowMessages, append(conj.actionFlows, conj.metricFlows...)...)
 	}
 
 	for _, ctx := range c.featureNetworkPolicy.globalConjMatchFlowCache {
@@ -1342,6 +1464,7 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 		// Reset the global conjunctive match flow cache since the OpenFlow bundle, which contains
 		// all the match flows to be installed, was not applied successfully.
 		c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+		c.featureNetworkPolicy.releaseOFEntries(conjunctions...)
 		return err
 	}
 	// Update conjMatchFlowContexts as the expected status.
@@ -1586,6 +1709,15 @@ func (c *client) UninstallPolicyRuleFlows(ruleID uint32) ([]string, error) {
 	if err := c.featureNetworkPolicy.applyConjunctiveMatchFlows(ctxChanges); err != nil {
 		return nil, err
 	}
+	// The meters and groups can only be deleted after the flows referencing them.
+	for _, entry := range conj.ofEntries() {
+		if err := entry.Delete(); err != nil {
+			return nil, fmt.Errorf("error when deleting OF %s for rule %d: %w", entry.Type(), conj.id, err)
+		}
+	}
+	if conj.logSamplingGroup != nil {
+		c.featureNetworkPolicy.groupAllocator.Release(conj.logSamplingGroup.GetID())
+	}
 
 	c.featureNetworkPolicy.policyCache.Delete(conj)
 	return staleOFPriorities, nil
@@ -2029,6 +2161,13 @@ func (c *client) MulticastEgressPodMetricsByIP(ip net.IP) *types.RuleMetric {
 
 func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	result := map[uint32]*types.RuleMetric{}
+	addMetric := func(ruleID uint32, metric types.RuleMetric) {
+		if accMetric, ok := result[ruleID]; ok {
+			accMetric.Merge(&metric)
+		} else {
+			result[ruleID] = &metric
+		}
+	}
 	collectMetricsFromFlows := func(table *Table, getMetricAndID func(flowMap map[string]string) (uint32, types.RuleMetric)) {
 		dumpedFlows, _ := c.ovsctlClient.DumpTableFlows(table.ofTable.GetID())
 		for _, flow := range dumpedFlows {
@@ -2036,12 +2175,7 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 				continue
 			}
 			flowMap := parseFlowToMap(flow)
-			ruleID, metric := getMetricAndID(flowMap)
-			if accMetric, ok := result[ruleID]; ok {
-				accMetric.Merge(&metric)
-			} else {
-				result[ruleID] = &metric
-			}
+			addMetric(getMetricAndID(flowMap))
 		}
 	}
 	if c.enableMulticast {
@@ -2057,6 +2191,112 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	// flows to get the correct number of total packets.
 	collectMetricsFromFlows(EgressMetricTable, parseMetricFlow)
 	collectMetricsFromFlows(IngressMetricTable, parseMetricFlow)
+	// The traffic matching the Drop or Reject rules of NetworkPolicies in Audit mode does not go through the metric
+	// tables, it is counted by the action flows of the rules. Only the first packet of each connection hits them.
+	for _, tableID := range c.featureNetworkPolicy.auditOnlyRuleTables() {
+		dumpedFlows, _ := c.ovsctlClient.DumpTableFlows(tableID)
+		for _, flow := range dumpedFlows {
+			if ruleID, metric, ok := c.featureNetworkPolicy.parseAuditActionFlow(parseFlowToMap(flow)); ok {
+				addMetric(ruleID, metric)
+			}
+		}
+	}
+	// The packets dropped by the meter of a RateLimit rule are reported as the dropped packets of the rule. Note
+	// that they are also included in the packet count of the rule, as the metric flows apply the meter.
+	if c.featureNetworkPolicy.hasRateLimitMeters() {
+		meterStats, err := c.ovsctlClient.RunOfctlCmd("meter-stats")
+		if err != nil {
+			klog.ErrorS(err, "Failed to get OVS meter stats")
+		}
+		for meterID, droppedPackets := range parseMeterStats(string(meterStats)) {
+			if meterID <= RuleMeterIDBase {
+				continue
+			}
+			ruleID := meterID - RuleMeterIDBase
+			// The meters sampling the logs of Allow rules do not drop the traffic.
+			if conj := c.featureNetworkPolicy.getPolicyRuleConjunction(ruleID); conj == nil || conj.meter == nil {
+				continue
+			}
+			if metric, ok := result[ruleID]; ok {
+				metric.DroppedPackets += droppedPackets
+			} else {
+				result[ruleID] = &types.RuleMetric{DroppedPackets: droppedPackets}
+			}
+		}
+	}
+	return result
+}
+
+// auditOnlyRuleTables returns the IDs of the tables in which Drop or Reject rules of NetworkPolicies in Audit mode are
+// installed.
+func (f *featureNetworkPolicy) auditOnlyRuleTables() []uint8 {
+	tableIDs := sets.New[uint8]()
+	for _, obj := range f.policyCache.List() {
+		if conj := obj.(*policyRuleConjunction); conj.auditOnly {
+			tableIDs.Insert(conj.ruleTableID)
+		}
+	}
+	return sets.List(tableIDs)
+}
+
+// parseAuditActionFlow returns the metric of a Drop or Reject rule of a NetworkPolicy in Audit mode from its action
+// flow. It returns false if the flow is not such an action flow.
+func (f *featureNetworkPolicy) parseAuditActionFlow(flowMap map[string]string) (uint32, types.RuleMetric, bool) {
+	// example audit action flow format:
+	// table=AntreaPolicyIngressRule, n_packets=2, n_bytes=148, priority=14900,conj_id=3,reg0=0/0x10000 actions=set_field:0x3->reg6,set_field:0x10000/0x10000->reg0,resubmit(,AntreaPolicyIngressRule)
+	conjID, ok := flowMap["conj_id"]
+	if !ok {
+		return 0, types.RuleMetric{}, false
+	}
+	id, err := strconv.ParseUint(conjID, 0, 32)
+	if err != nil {
+		return 0, types.RuleMetric{}, false
+	}
+	if conj := f.getPolicyRuleConjunction(uint32(id)); conj == nil || !conj.auditOnly {
+		return 0, types.RuleMetric{}, false
+	}
+	m := parseFlowMetric(flowMap)
+	m.Sessions = m.Packets
+	return uint32(id), m, true
+}
+
+// hasRateLimitMeters returns whether any installed rule polices its traffic with a meter.
+func (f *featureNetworkPolicy) hasRateLimitMeters() bool {
+	for _, obj := range f.policyCache.List() {
+		if obj.(*policyRuleConjunction).meter != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// parseMeterStats returns the number of packets dropped by the first band of each meter, from the output of
+// "ovs-ofctl meter-stats", e.g.
+// OFPST_METER reply (OF1.5) (xid=0x2):
+// meter:1025 flow_count:2 packet_in_count:120 byte_in_count:7680 duration:30.125s bands:
+// 0: packet_count:20 byte_count:1280
+func parseMeterStats(meterStats string) map[uint32]uint64 {
+	result := map[uint32]uint64{}
+	var meterID uint64
+	var err error
+	for _, line := range strings.Split(meterStats, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "meter:") {
+			fields := strings.Fields(line)
+			if meterID, err = strconv.ParseUint(strings.TrimPrefix(fields[0], "meter:"), 10, 32); err != nil {
+				meterID = 0
+			}
+			continue
+		}
+		if meterID == 0 || !strings.HasPrefix(line, "0: packet_count:") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if packetCount, err := strconv.ParseUint(strings.TrimPrefix(fields[1], "packet_count:"), 10, 64); err == nil {
+			result[uint32(meterID)] = packetCount
+		}
+		meterID = 0
+	}
 	return result
 }
 
@@ -2353,6 +2593,12 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 	if f.enableMulticast {
 		candidateTables = append(candidateTables, MulticastEgressMetricTable, MulticastIngressMetricTable)
 	}
+	// The packets matching the Drop or Reject rules of Antrea-native policies in Audit mode are resubmitted to the
+	// table of the rule after being logged.
+	if f.enableAntreaPolicy {
+		candidateTables = append(candidateTables, GetAntreaPolicyEgressTables()...)
+		candidateTables = append(candidateTables, GetAntreaPolicyIngressTables()...)
+	}
 	for _, nextTable := range candidateTables {
 		groupKey := fmt.Sprintf("%d", nextTable.GetID())
 		obj, ok := f.loggingGroupCache.Load(groupKey)
@@ -2380,7 +2626,43 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 }
 
 func (f *featureNetworkPolicy) replayMeters() []binding.OFEntry {
-	return nil
+	var meters []binding.OFEntry
+	for _, obj := range f.policyCache.List() {
+		conj := obj.(*policyRuleConjunction)
+		if conj.meter != nil {
+			conj.meter.Reset()
+			meters = append(meters, conj.meter)
+		}
+		if conj.logSamplingMeter != nil {
+			conj.logSamplingMeter.Reset()
+			meters = append(meters, conj.logSamplingMeter)
+		}
+	}
+	return meters
+}
+
+// ruleMeterID returns the ID of the OF Meter used by the rule with the given conjunction ID. A rule uses at most one
+// meter: either to police its traffic if its action is RateLimit, or to sample its logs if it is an Allow rule.
+func ruleMeterID(conjunctionID uint32) uint32 {
+	return RuleMeterIDBase + conjunctionID
+}
+
+// rateLimitMeter generates the OF Meter used to police the traffic matching a RateLimit rule. The packets exceeding
+// the rate are dropped. A rate in bits per second is converted to kilobits per second as required by OVS, rounding up
+// so that a non-zero rate never results in a meter dropping all packets.
+func (f *featureNetworkPolicy) rateLimitMeter(conjunctionID uint32, rateLimit *v1beta2.RateLimit) binding.Meter {
+	flags := ofctrl.MeterBurst | ofctrl.MeterPktps
+	rate, burst := rateLimit.Rate, rateLimit.Burst
+	if rateLimit.Unit == crdv1beta1.RateLimitUnitBitsPerSecond {
+		flags = ofctrl.MeterBurst | ofctrl.MeterKbps
+		rate, burst = (rate+999)/1000, (burst+999)/1000
+	}
+	return f.bridge.NewMeter(binding.MeterIDType(ruleMeterID(conjunctionID)), flags).
+		MeterBand().
+		MeterType(ofctrl.MeterDrop).
+		Rate(uint32(rate)).
+		Burst(uint32(burst)).
+		Done()
 }
 
 func (f *featureNetworkPolicy) getLoggingAndResubmitGroupID(nextTable uint8) binding.GroupIDType {
@@ -2390,5 +2672,33 @@ func (f *featureNetworkPolicy) getLoggingAndResubmitGroupID(nextTable uint8) bin
 }
 
 func (f *featureNetworkPolicy) replayGroups() []binding.OFEntry {
-	return nil
+	var groups []binding.OFEntry
+	for _, obj := range f.policyCache.List() {
+		conj := obj.(*policyRuleConjunction)
+		if conj.logSamplingGroup != nil {
+			conj.logSamplingGroup.Reset()
+			groups = append(groups, conj.logSamplingGroup)
+		}
+	}
+	return groups
+}
+
+// logSamplingGroup generates the OF Group selecting 1 of every ratio connections matching a rule to be sent to the
+// antrea-agent for logging. The selected packets are resubmitted to OutputTable, the other ones hit the bucket without
+// action and are dropped. The bucket is selected with a hash of the packet fields.
+func (f *featureNetworkPolicy) logSamplingGroup(groupID binding.GroupIDType, ratio int32) binding.Group {
+	return f.bridge.NewGroup(groupID).
+		Bucket().Weight(1).ResubmitToTable(OutputTable.GetID()).Done().
+		Bucket().Weight(uint16(ratio - 1)).Done()
+}
+
+// logSamplingMeter generates the OF Meter limiting the rate of the connections matching a rule which are sent to the
+// antrea-agent for logging. The packets exceeding the rate are dropped.
+func (f *featureNetworkPolicy) logSamplingMeter(conjunctionID uint32, maxPerSecond int32) binding.Meter {
+	return f.bridge.NewMeter(binding.MeterIDType(ruleMeterID(conjunctionID)), ofctrl.MeterBurst|ofctrl.MeterPktps).
+		MeterBand().
+		MeterType(ofctrl.MeterDrop).
+		Rate(uint32(maxPerSecond)).
+		Burst(uint32(maxPerSecond)).
+		Done()
 }
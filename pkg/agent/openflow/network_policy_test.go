@@ -475,6 +475,49 @@ func TestBatchInstallPolicyRuleFlows(t *testing.T) {
 				"cookie=0x1020000000000, table=IngressMetric, priority=200,reg0=0x400/0x400,reg3=0xe actions=drop",
 			},
 		},
+		{
+			name: "Antrea NetworkPolicy Drop rule in Audit mode with higher priority than an enforced Drop rule",
+			rules: []*types.PolicyRule{
+				{
+					Direction:       v1beta2.DirectionIn,
+					From:            parseAddresses([]string{"192.168.1.40"}),
+					Action:          &actionDrop,
+					Priority:        &priority201,
+					To:              []types.Address{NewOFPortAddress(1)},
+					FlowID:          uint32(20),
+					EnforcementMode: crdv1beta1.EnforcementModeAudit,
+					PolicyRef: &v1beta2.NetworkPolicyReference{
+						Type: v1beta2.AntreaClusterNetworkPolicy,
+						Name: "acnp-audit",
+						UID:  "id-audit",
+					},
+				},
+				{
+					Direction: v1beta2.DirectionIn,
+					From:      parseAddresses([]string{"192.168.1.40"}),
+					Action:    &actionDrop,
+					Priority:  &priority200,
+					To:        []types.Address{NewOFPortAddress(1)},
+					FlowID:    uint32(21),
+					PolicyRef: &v1beta2.NetworkPolicyReference{
+						Type: v1beta2.AntreaClusterNetworkPolicy,
+						Name: "acnp-enforced",
+						UID:  "id-enforced",
+					},
+				},
+			},
+			expectedFlows: []string{
+				// The audited packet is not dropped, but resubmitted to the same table, where the action flow of
+				// the audited rule no longer matches, so that the enforced Drop rule with lower priority applies.
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=201,conj_id=20,reg0=0x0/0x10000 actions=set_field:0x14->reg6,set_field:0x10000/0x10000->reg0,resubmit:AntreaPolicyIngressRule",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=200,conj_id=21 actions=set_field:0x15->reg3,set_field:0x400/0x400->reg0,goto_table:IngressMetric",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=201,ip,nw_src=192.168.1.40 actions=conjunction(20,1/2)",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=201,reg1=0x1 actions=conjunction(20,2/2)",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=200,ip,nw_src=192.168.1.40 actions=conjunction(21,1/2)",
+				"cookie=0x1020000000000, table=AntreaPolicyIngressRule, priority=200,reg1=0x1 actions=conjunction(21,2/2)",
+				"cookie=0x1020000000000, table=IngressMetric, priority=200,reg0=0x400/0x400,reg3=0x15 actions=drop",
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -520,6 +563,163 @@ func TestBatchInstallPolicyRuleFlows(t *testing.T) {
 	}
 }
 
+func TestBatchInstallPolicyRuleFlowsReleaseLogSamplingGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOperations := opstest.NewMockOFEntryOperations(ctrl)
+	c := newFakeClient(mockOperations, true, false, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+	c.featureNetworkPolicy.egressTables = map[uint8]struct{}{EgressRuleTable.GetID(): {}, EgressDefaultTable.GetID(): {}, AntreaPolicyEgressRuleTable.GetID(): {}}
+	c.featureNetworkPolicy.globalConjMatchFlowCache = make(map[string]*conjMatchFlowContext)
+	c.featureNetworkPolicy.policyCache = cache.NewIndexer(policyConjKeyFunc, cache.Indexers{priorityIndex: priorityIndexFunc})
+	mockBridge := mocks.NewMockBridge(ctrl)
+	c.featureNetworkPolicy.bridge = mockBridge
+
+	groupAllocator := c.featureNetworkPolicy.groupAllocator
+	groupID1 := groupAllocator.Next()
+	groupID2 := groupID1 + 1
+	newMockGroup := func(groupID binding.GroupIDType, addErr error) {
+		group := mocks.NewMockGroup(ctrl)
+		bucketBuilder := mocks.NewMockBucketBuilder(ctrl)
+		mockBridge.EXPECT().NewGroup(groupID).Return(group)
+		group.EXPECT().Bucket().Return(bucketBuilder).Times(2)
+		bucketBuilder.EXPECT().Weight(gomock.Any()).Return(bucketBuilder).Times(2)
+		bucketBuilder.EXPECT().ResubmitToTable(OutputTable.GetID()).Return(bucketBuilder)
+		bucketBuilder.EXPECT().Done().Return(group).Times(2)
+		group.EXPECT().GetID().Return(groupID).AnyTimes()
+		group.EXPECT().Type().Return(binding.GroupEntry).AnyTimes()
+		group.EXPECT().Add().Return(addErr)
+		group.EXPECT().Delete().Return(nil)
+	}
+	newMockGroup(groupID1, nil)
+	newMockGroup(groupID2, errors.New("group add error"))
+
+	var rules []*types.PolicyRule
+	for _, flowID := range []uint32{10, 11} {
+		rules = append(rules, &types.PolicyRule{
+			Direction:     v1beta2.DirectionOut,
+			From:          parseAddresses([]string{"192.168.1.40"}),
+			To:            parseAddresses([]string{"0.0.0.0/0"}),
+			FlowID:        flowID,
+			TableID:       EgressRuleTable.GetID(),
+			EnableLogging: true,
+			LogSampling:   &v1beta2.LogSampling{Ratio: 10},
+			PolicyRef: &v1beta2.NetworkPolicyReference{
+				Type:      v1beta2.K8sNetworkPolicy,
+				Namespace: "ns1",
+				Name:      "np1",
+				UID:       "id1",
+			},
+		})
+	}
+	err := c.BatchInstallPolicyRuleFlows(rules)
+	assert.ErrorContains(t, err, "group add error")
+	// The group IDs allocated for both rules should be released and allocated again.
+	assert.ElementsMatch(t, []binding.GroupIDType{groupID1, groupID2}, []binding.GroupIDType{groupAllocator.Allocate(), groupAllocator.Allocate()})
+}
+
+func TestBatchInstallPolicyRuleFlowsDeleteLogSamplingMeters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOperations := opstest.NewMockOFEntryOperations(ctrl)
+	c := newFakeClient(mockOperations, true, false, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+	c.featureNetworkPolicy.egressTables = map[uint8]struct{}{EgressRuleTable.GetID(): {}, EgressDefaultTable.GetID(): {}, AntreaPolicyEgressRuleTable.GetID(): {}}
+	c.featureNetworkPolicy.globalConjMatchFlowCache = make(map[string]*conjMatchFlowContext)
+	c.featureNetworkPolicy.policyCache = cache.NewIndexer(policyConjKeyFunc, cache.Indexers{priorityIndex: priorityIndexFunc})
+	c.featureNetworkPolicy.ovsMetersAreSupported = true
+	mockBridge := mocks.NewMockBridge(ctrl)
+	c.featureNetworkPolicy.bridge = mockBridge
+
+	for _, flowID := range []uint32{10, 11} {
+		meter := mocks.NewMockMeter(ctrl)
+		meterBandBuilder := mocks.NewMockMeterBandBuilder(ctrl)
+		mockBridge.EXPECT().NewMeter(binding.MeterIDType(ruleMeterID(flowID)), ofctrl.MeterBurst|ofctrl.MeterPktps).Return(meter)
+		meter.EXPECT().MeterBand().Return(meterBandBuilder)
+		meterBandBuilder.EXPECT().MeterType(ofctrl.MeterDrop).Return(meterBandBuilder)
+		meterBandBuilder.EXPECT().Rate(uint32(5)).Return(meterBandBuilder)
+		meterBandBuilder.EXPECT().Burst(uint32(5)).Return(meterBandBuilder)
+		meterBandBuilder.EXPECT().Done().Return(meter)
+		meter.EXPECT().Add().Return(nil)
+		meter.EXPECT().Delete().Return(nil)
+	}
+	mockOperations.EXPECT().AddAll(gomock.Any()).Return(errors.New("flow add error"))
+
+	var rules []*types.PolicyRule
+	for _, flowID := range []uint32{10, 11} {
+		rules = append(rules, &types.PolicyRule{
+			Direction:     v1beta2.DirectionOut,
+			From:          parseAddresses([]string{"192.168.1.40"}),
+			To:            parseAddresses([]string{"0.0.0.0/0"}),
+			FlowID:        flowID,
+			TableID:       EgressRuleTable.GetID(),
+			EnableLogging: true,
+			LogSampling:   &v1beta2.LogSampling{MaxPerSecond: 5},
+			PolicyRef: &v1beta2.NetworkPolicyReference{
+				Type:      v1beta2.K8sNetworkPolicy,
+				Namespace: "ns1",
+				Name:      "np1",
+				UID:       "id1",
+			},
+		})
+	}
+	err := c.BatchInstallPolicyRuleFlows(rules)
+	// The log sampling meters of both rules should be deleted, so that they can be added again when the installation
+	// is retried.
+	assert.ErrorContains(t, err, "flow add error")
+}
+
+func TestBatchInstallPolicyRuleFlowsDeleteMeters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOperations := opstest.NewMockOFEntryOperations(ctrl)
+	c := newFakeClient(mockOperations, true, false, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+	c.featureNetworkPolicy.egressTables = map[uint8]struct{}{EgressRuleTable.GetID(): {}, EgressDefaultTable.GetID(): {}, AntreaPolicyEgressRuleTable.GetID(): {}}
+	c.featureNetworkPolicy.globalConjMatchFlowCache = make(map[string]*conjMatchFlowContext)
+	c.featureNetworkPolicy.policyCache = cache.NewIndexer(policyConjKeyFunc, cache.Indexers{priorityIndex: priorityIndexFunc})
+	c.featureNetworkPolicy.ovsMetersAreSupported = true
+	mockBridge := mocks.NewMockBridge(ctrl)
+	c.featureNetworkPolicy.bridge = mockBridge
+
+	newMockMeter := func(flowID uint32, addErr error) {
+		meter := mocks.NewMockMeter(ctrl)
+		meterBandBuilder := mocks.NewMockMeterBandBuilder(ctrl)
+		mockBridge.EXPECT().NewMeter(binding.MeterIDType(ruleMeterID(flowID)), ofctrl.MeterBurst|ofctrl.MeterKbps).Return(meter)
+		meter.EXPECT().MeterBand().Return(meterBandBuilder)
+		meterBandBuilder.EXPECT().MeterType(ofctrl.MeterDrop).Return(meterBandBuilder)
+		// Rates in bits per second are rounded up to kilobits per second.
+		meterBandBuilder.EXPECT().Rate(uint32(2)).Return(meterBandBuilder)
+		meterBandBuilder.EXPECT().Burst(uint32(1)).Return(meterBandBuilder)
+		meterBandBuilder.EXPECT().Done().Return(meter)
+		meter.EXPECT().Type().Return(binding.MeterEntry).AnyTimes()
+		meter.EXPECT().Add().Return(addErr)
+		meter.EXPECT().Delete().Return(nil)
+	}
+	newMockMeter(10, nil)
+	newMockMeter(11, errors.New("meter add error"))
+
+	actionRateLimit := crdv1beta1.RuleActionRateLimit
+	var rules []*types.PolicyRule
+	for _, flowID := range []uint32{10, 11} {
+		rules = append(rules, &types.PolicyRule{
+			Direction: v1beta2.DirectionOut,
+			From:      parseAddresses([]string{"192.168.1.40"}),
+			To:        parseAddresses([]string{"0.0.0.0/0"}),
+			Action:    &actionRateLimit,
+			RateLimit: &v1beta2.RateLimit{Unit: crdv1beta1.RateLimitUnitBitsPerSecond, Rate: 1500, Burst: 500},
+			Priority:  &priority100,
+			FlowID:    flowID,
+			TableID:   AntreaPolicyEgressRuleTable.GetID(),
+			PolicyRef: &v1beta2.NetworkPolicyReference{
+				Type: v1beta2.AntreaClusterNetworkPolicy,
+				Name: "acnp1",
+				UID:  "id1",
+			},
+		})
+	}
+	err := c.BatchInstallPolicyRuleFlows(rules)
+	// The meters of both rules should be deleted, so that they can be added again when the installation is retried.
+	assert.ErrorContains(t, err, "meter add error")
+}
+
 type flowModIgnoreTxIDMatcher struct {
 	flowMods []string
 }
@@ -1194,6 +1394,34 @@ func TestNetworkPolicyMetrics(t *testing.T) {
 	}
 }
 
+func TestNetworkPolicyMetricsAuditOnlyRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	preparePipelines()
+	defer resetPipelines()
+	c = prepareClient(ctrl, false)
+	mockOVSClient := ovsctltest.NewMockOVSCtlClient(ctrl)
+	c.ovsctlClient = mockOVSClient
+	require.NoError(t, c.featureNetworkPolicy.policyCache.Add(&policyRuleConjunction{id: 3, ruleTableID: AntreaPolicyIngressRuleTable.GetID(), auditOnly: true}))
+	require.NoError(t, c.featureNetworkPolicy.policyCache.Add(&policyRuleConjunction{id: 4, ruleTableID: AntreaPolicyIngressRuleTable.GetID()}))
+
+	gomock.InOrder(
+		mockOVSClient.EXPECT().DumpTableFlows(EgressMetricTable.ofTable.GetID()).Return(nil, nil),
+		mockOVSClient.EXPECT().DumpTableFlows(IngressMetricTable.ofTable.GetID()).Return([]string{
+			"table=101, n_packets=0, n_bytes=0, priority=200,reg0=0x100000/0x100000,reg3=0x4 actions=drop",
+		}, nil),
+		mockOVSClient.EXPECT().DumpTableFlows(AntreaPolicyIngressRuleTable.ofTable.GetID()).Return([]string{
+			"table=90, n_packets=2, n_bytes=148, priority=14900,conj_id=3,reg0=0/0x10000 actions=set_field:0x3->reg6,set_field:0x10000/0x10000->reg0,resubmit(,90)",
+			"table=90, n_packets=5, n_bytes=370, priority=14899,conj_id=4 actions=set_field:0x4->reg3,set_field:0x400/0x400->reg0,goto_table:101",
+			"table=90, n_packets=7, n_bytes=518, priority=14900,ip,nw_src=192.168.1.40 actions=conjunction(3,1/2)",
+		}, nil),
+	)
+	got := c.NetworkPolicyMetrics()
+	assert.Equal(t, map[uint32]*types.RuleMetric{
+		3: {Bytes: 148, Sessions: 2, Packets: 2},
+		4: {Bytes: 0, Sessions: 0, Packets: 0},
+	}, got)
+}
+
 func TestGetMatchFlowUpdates(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	preparePipelines()
@@ -1547,3 +1775,23 @@ func Test_NewDNSPacketInConjunction(t *testing.T) {
 	t.Run("With OVS meters", func(t *testing.T) { runTests(t, true) })
 	t.Run("Without OVS meters", func(t *testing.T) { runTests(t, false) })
 }
+
+func TestParseMeterStats(t *testing.T) {
+	meterStats := `OFPST_METER reply (OF1.5) (xid=0x2):
+meter:1 flow_count:3 packet_in_count:120 byte_in_count:7200 duration:30.123s bands:
+0: packet_count:20 byte_count:1200
+
+meter:1025 flow_count:2 packet_in_count:500 byte_in_count:30000 duration:10.001s bands:
+0: packet_count:42 byte_count:2520
+
+meter:1027 flow_count:2 packet_in_count:0 byte_in_count:0 duration:1.5s bands:
+0: packet_count:0 byte_count:0
+`
+	expected := map[uint32]uint64{
+		1:    20,
+		1025: 42,
+		1027: 0,
+	}
+	assert.Equal(t, expected, parseMeterStats(meterStats))
+	assert.Empty(t, parseMeterStats(""))
+}
@@ -84,6 +84,11 @@ const (
 	PacketInMeterIDNP  = 256
 	PacketInMeterIDTF  = 257
 	PacketInMeterIDDNS = 258
+	// Meter IDs starting from 1024 are reserved for NetworkPolicy rules with the
+	// RateLimit action, or whose logs are sampled with a maximum rate. The meter
+	// ID of such a rule is the sum of this value and the conjunction ID of the
+	// rule.
+	RuleMeterIDBase = 1024
 )
 
 // RegisterPacketInHandler stores controller handler in a map with category as keys.
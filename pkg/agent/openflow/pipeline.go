@@ -1604,7 +1604,9 @@ func (f *featurePodConnectivity) arpNormalFlow() binding.Flow {
 		Done()
 }
 
-func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8) []binding.Flow {
+// allowRulesMetricFlows generates the flows collecting the stats of the traffic allowed by a rule. If meterID is not 0,
+// the traffic is also policed by the meter.
+func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8, meterID uint32) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	metricTable := IngressMetricTable
 	offset := 0
@@ -1623,12 +1625,15 @@ func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingre
 		metricTable = MulticastIngressMetricTable
 	}
 	metricFlow := func(isCTNew bool, protocol binding.Protocol) binding.Flow {
-		return metricTable.ofTable.BuildFlow(priorityNormal).
+		fb := metricTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
 			MatchProtocol(protocol).
 			MatchCTStateNew(isCTNew).
-			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field).
-			Action().NextTable().
+			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field)
+		if meterID != 0 {
+			fb = fb.Action().Meter(meterID)
+		}
+		return fb.Action().NextTable().
 			Done()
 	}
 	var flows []binding.Flow
@@ -1718,7 +1723,9 @@ func (f *featurePodConnectivity) ipv6Flows() []binding.Flow {
 
 // For normal traffic, conjunctionActionFlow generates the flow to jump to a specific table if policyRuleConjunction ID is matched. Priority of
 // conjunctionActionFlow is created at priorityLow for k8s network policies, and *priority assigned by PriorityAssigner for AntreaPolicy.
-func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table binding.Table, nextTable uint8, priority *uint16, enableLogging bool, l7RuleVlanID *uint32) []binding.Flow {
+// If enableLogging is true, the connections are also sent to the antrea-agent for logging, or only a sample of them if
+// sampling is not nil.
+func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table binding.Table, nextTable uint8, priority *uint16, enableLogging bool, l7RuleVlanID *uint32, sampling *logSampling) []binding.Flow {
 	tableID := table.GetID()
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	var ofPriority uint16
@@ -1743,31 +1750,35 @@ func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table
 			fb := table.BuildFlow(ofPriority).MatchProtocol(proto).
 				MatchConjID(conjunctionID)
 			if l7RuleVlanID != nil {
-				return fb.
+				fb = fb.
 					Action().LoadToRegField(conjReg, conjunctionID).        // Traceflow.
 					Action().CT(true, nextTable, ctZone, f.ctZoneSrcField). // CT action requires commit flag if actions other than NAT without arguments are specified.
 					LoadToLabelField(uint64(conjunctionID), labelField).
 					LoadToCtMark(L7NPRedirectCTMark).                               // Mark the packets of the connection should be redirected to an application-aware engine.
 					LoadToLabelField(uint64(*l7RuleVlanID), L7NPRuleVlanIDCTLabel). // Load the VLAN ID allocated for L7 NetworkPolicy rule to CT mark field L7NPRuleVlanIDCTMarkField.
 					CTDone().
-					Action().LoadRegMark(DispositionAllowRegMark, L7NPRedirectRegMark, OutputToControllerRegMark). // AntreaPolicy.
-					Action().LoadToRegField(PacketInOperationField, PacketInNPLoggingOperation).
-					Action().LoadToRegField(PacketInTableField, uint32(tableID)).
-					Action().GotoTable(OutputTable.GetID()).
-					Cookie(cookieID).
-					Done()
+					Action().LoadRegMark(DispositionAllowRegMark, L7NPRedirectRegMark, OutputToControllerRegMark) // AntreaPolicy.
+			} else {
+				fb = fb.
+					Action().LoadToRegField(conjReg, conjunctionID).        // Traceflow.
+					Action().CT(true, nextTable, ctZone, f.ctZoneSrcField). // CT action requires commit flag if actions other than NAT without arguments are specified.
+					LoadToLabelField(uint64(conjunctionID), labelField).
+					CTDone().
+					Action().LoadRegMark(DispositionAllowRegMark, OutputToControllerRegMark) // AntreaPolicy.
 			}
-			return fb.
-				Action().LoadToRegField(conjReg, conjunctionID).        // Traceflow.
-				Action().CT(true, nextTable, ctZone, f.ctZoneSrcField). // CT action requires commit flag if actions other than NAT without arguments are specified.
-				LoadToLabelField(uint64(conjunctionID), labelField).
-				CTDone().
-				Action().LoadRegMark(DispositionAllowRegMark, OutputToControllerRegMark). // AntreaPolicy.
-				Action().LoadToRegField(PacketInOperationField, PacketInNPLoggingOperation).
-				Action().LoadToRegField(PacketInTableField, uint32(tableID)).
-				Action().GotoTable(OutputTable.GetID()).
-				Cookie(cookieID).
-				Done()
+			fb = fb.Action().LoadToRegField(PacketInOperationField, PacketInNPLoggingOperation).
+				Action().LoadToRegField(PacketInTableField, uint32(tableID))
+			// The packets of the connections which are not sampled for logging are dropped by the meter or by the
+			// group, after the original packets have been committed by the CT action.
+			if sampling != nil && sampling.meterID != 0 {
+				fb = fb.Action().Meter(sampling.meterID)
+			}
+			if sampling != nil && sampling.groupID != 0 {
+				fb = fb.Action().Group(sampling.groupID)
+			} else {
+				fb = fb.Action().GotoTable(OutputTable.GetID())
+			}
+			return fb.Cookie(cookieID).Done()
 		}
 		if l7RuleVlanID != nil {
 			return table.BuildFlow(ofPriority).MatchProtocol(proto).
@@ -1861,6 +1872,45 @@ func (f *featureNetworkPolicy) conjunctionActionDenyFlow(conjunctionID uint32, t
 		Done()
 }
 
+// conjunctionActionAuditFlow generates the flow for a Drop or Reject rule of an Antrea-native policy in Audit mode if
+// policyRuleConjunction ID is matched. The packet is not denied: it is marked as audited and resubmitted to the same
+// table. As the flow only matches packets which are not marked, OVS ignores the conjunction when the packet is looked
+// up again, and the flows with lower priorities are evaluated as if the rule did not exist. Only the first audited rule
+// matched by a packet is counted in each direction. If enableLogging is true, the packet is also sent to the
+// antrea-agent for logging.
+func (f *featureNetworkPolicy) conjunctionActionAuditFlow(conjunctionID uint32, table binding.Table, priority *uint16, enableLogging bool) binding.Flow {
+	ofPriority := *priority
+	tableID := table.GetID()
+	conjReg := TFIngressConjIDField
+	auditedRegMark := IngressAuditedRegMark
+	notAuditedRegMark := NotIngressAuditedRegMark
+	if _, ok := f.egressTables[tableID]; ok {
+		conjReg = TFEgressConjIDField
+		auditedRegMark = EgressAuditedRegMark
+		notAuditedRegMark = NotEgressAuditedRegMark
+	}
+	flowBuilder := table.BuildFlow(ofPriority).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchConjID(conjunctionID).
+		MatchRegMark(notAuditedRegMark).
+		Action().LoadToRegField(conjReg, conjunctionID).
+		Action().LoadRegMark(auditedRegMark)
+
+	// Like for Allow rules, logging is not supported for IGMP and multicast rules.
+	isMulticast := f.enableMulticast && (tableID == MulticastEgressRuleTable.GetID() || tableID == MulticastIngressRuleTable.GetID())
+	if enableLogging && !isMulticast {
+		groupID := f.getLoggingAndResubmitGroupID(tableID)
+		return flowBuilder.
+			Action().LoadRegMark(DispositionAllowRegMark).
+			Action().LoadToRegField(PacketInOperationField, PacketInNPLoggingOperation).
+			Action().LoadToRegField(PacketInTableField, uint32(tableID)).
+			Action().Group(groupID).
+			Done()
+	}
+	return flowBuilder.Action().ResubmitToTables(tableID).
+		Done()
+}
+
 func (f *featureNetworkPolicy) conjunctionActionPassFlow(conjunctionID uint32, table binding.Table, priority *uint16, enableLogging bool) binding.Flow {
 	ofPriority := *priority
 	conjReg := TFIngressConjIDField
@@ -2232,11 +2282,29 @@ func (f *featureEgress) snatIPFromTunnelFlow(snatIP net.IP, mark uint32) binding
 // it sets the packet mark with the ID of the SNAT IP, for the traffic from local Pods to external; if the SNAT IP is
 // on a remote Node, it tunnels the packets to the remote Node.
 func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint32, localGatewayMAC net.HardwareAddr) binding.Flow {
+	return f.snatRuleFlowBuilder(priorityNormal, ofPort, getIPProtocol(snatIP), snatIP, snatMark, localGatewayMAC).Done()
+}
+
+// snatDestinationRuleFlow generates the flow that applies the SNAT rule of an Egress with destinations for a local
+// Pod. It has a higher priority than the flows generated by snatRuleFlow and snatGroupRuleFlow, so that the traffic to
+// the destination CIDR is SNAT'd by the Egress with destinations, while it still has a lower priority than the flows
+// skipping SNAT for exceptCIDRs, Service CIDRs and Node IPs.
+func (f *featureEgress) snatDestinationRuleFlow(ofPort uint32, rule PodSNATDestinationRule, localGatewayMAC net.HardwareAddr) binding.Flow {
+	ipProtocol := getIPProtocol(rule.DstCIDR.IP)
+	var fb binding.FlowBuilder
+	if rule.GroupID != 0 {
+		fb = f.snatGroupRuleFlowBuilder(priorityNormal+1, ofPort, rule.GroupID, ipProtocol)
+	} else {
+		fb = f.snatRuleFlowBuilder(priorityNormal+1, ofPort, ipProtocol, rule.SNATIP, rule.SNATMark, localGatewayMAC)
+	}
+	return fb.MatchDstIPNet(rule.DstCIDR).Done()
+}
+
+func (f *featureEgress) snatRuleFlowBuilder(priority uint16, ofPort uint32, ipProtocol binding.Protocol, snatIP net.IP, snatMark uint32, localGatewayMAC net.HardwareAddr) binding.FlowBuilder {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
-	ipProtocol := getIPProtocol(snatIP)
 	if snatMark != 0 {
 		// Local SNAT IP.
-		fb := EgressMarkTable.ofTable.BuildFlow(priorityNormal).
+		fb := EgressMarkTable.ofTable.BuildFlow(priority).
 			Cookie(cookieID).
 			MatchProtocol(ipProtocol).
 			MatchCTStateTrk(true).
@@ -2245,14 +2313,12 @@ func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint
 			Action().LoadRegMark(ToGatewayRegMark)
 		if f.enableEgressTrafficShaping {
 			// To apply rate-limit on all traffic.
-			fb = fb.Action().GotoTable(EgressQoSTable.GetID())
-		} else {
-			fb = fb.Action().GotoStage(stageSwitching)
+			return fb.Action().GotoTable(EgressQoSTable.GetID())
 		}
-		return fb.Done()
+		return fb.Action().GotoStage(stageSwitching)
 	}
 	// SNAT IP should be on a remote Node.
-	return EgressMarkTable.ofTable.BuildFlow(priorityNormal).
+	return EgressMarkTable.ofTable.BuildFlow(priority).
 		Cookie(cookieID).
 		MatchProtocol(ipProtocol).
 		MatchInPort(ofPort).
@@ -2260,8 +2326,95 @@ func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint
 		Action().SetDstMAC(GlobalVirtualMAC).
 		Action().SetTunnelDst(snatIP). // Set tunnel destination to the SNAT IP.
 		Action().LoadRegMark(ToTunnelRegMark, RemoteSNATRegMark).
-		Action().GotoStage(stageSwitching).
-		Done()
+		Action().GotoStage(stageSwitching)
+}
+
+// snatGroupRuleFlow generates the flow that applies the SNAT rule for a local Pod whose Egress is in ActiveActive mode.
+// The packets are sent to the Egress's select group, which picks one of the SNAT IPs based on the hash of the packet.
+func (f *featureEgress) snatGroupRuleFlow(ofPort uint32, groupID binding.GroupIDType, ipProtocol binding.Protocol) binding.Flow {
+	return f.snatGroupRuleFlowBuilder(priorityNormal, ofPort, groupID, ipProtocol).Done()
+}
+
+func (f *featureEgress) snatGroupRuleFlowBuilder(priority uint16, ofPort uint32, groupID binding.GroupIDType, ipProtocol binding.Protocol) binding.FlowBuilder {
+	return EgressMarkTable.ofTable.BuildFlow(priority).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchCTStateTrk(true).
+		MatchInPort(ofPort).
+		Action().Group(groupID)
+}
+
+// egressIPGroup generates the select group of an ActiveActive Egress. Each bucket corresponds to a SNAT IP and has the
+// same actions as snatRuleFlow: for a local SNAT IP, it sets the packet mark with the ID of the SNAT IP; for a remote
+// SNAT IP, it tunnels the packets to the remote Node. Each bucket also loads its ID to EgressIPBucketIDField, which is
+// committed to the connection with the first packet.
+func (f *featureEgress) egressIPGroup(groupID binding.GroupIDType, bucketIDs []uint16, snatIPs []net.IP, snatMarks []uint32) binding.Group {
+	group := f.bridge.NewGroup(groupID)
+	for i, snatIP := range snatIPs {
+		bucketBuilder := group.Bucket().Weight(100).
+			LoadToRegField(EgressIPBucketIDField, uint32(bucketIDs[i])).
+			LoadRegMark(EgressIPBucketSelectedRegMark)
+		if snatMarks[i] != 0 {
+			// Local SNAT IP.
+			bucketBuilder = bucketBuilder.
+				LoadPktMarkRange(snatMarks[i], snatPktMarkRange).
+				LoadRegMark(ToGatewayRegMark)
+			if f.enableEgressTrafficShaping {
+				bucketBuilder = bucketBuilder.ResubmitToTable(EgressQoSTable.GetID())
+			} else {
+				bucketBuilder = bucketBuilder.ResubmitToTable(L2ForwardingCalcTable.GetID())
+			}
+		} else {
+			// SNAT IP should be on a remote Node.
+			bucketBuilder = bucketBuilder.
+				SetSrcMAC(f.gatewayMAC).
+				SetDstMAC(GlobalVirtualMAC).
+				SetTunnelDst(snatIP).
+				LoadRegMark(ToTunnelRegMark).
+				LoadRegMark(RemoteSNATRegMark).
+				ResubmitToTable(L2ForwardingCalcTable.GetID())
+		}
+		group = bucketBuilder.Done()
+	}
+	return group
+}
+
+// egressIPBucketFlows generates the flows that send the subsequent packets of the connections of an ActiveActive Egress
+// with the bucket selected by the first packet, which is persisted in EgressIPBucketCTLabel. The flows have the same
+// actions as the buckets, so the established connections keep their SNAT IPs when the buckets of the select group
+// change. They have a higher priority than the flows generated by snatGroupRuleFlow and snatDestinationRuleFlow, while
+// still having a lower priority than the flows skipping SNAT.
+func (f *featureEgress) egressIPBucketFlows(bucketIDs []uint16, snatIPs []net.IP, snatMarks []uint32) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	labelOffset := EgressIPBucketCTLabel.GetRange()[0] - 64
+	var flows []binding.Flow
+	for i, snatIP := range snatIPs {
+		fb := EgressMarkTable.ofTable.BuildFlow(priorityNormal+2).
+			Cookie(cookieID).
+			MatchProtocol(getIPProtocol(snatIP)).
+			MatchCTStateNew(false).
+			MatchCTStateTrk(true).
+			MatchCTLabelField(uint64(bucketIDs[i])<<labelOffset, 0, EgressIPBucketCTLabel)
+		if snatMarks[i] != 0 {
+			// Local SNAT IP.
+			fb = fb.Action().LoadPktMarkRange(snatMarks[i], snatPktMarkRange).
+				Action().LoadRegMark(ToGatewayRegMark)
+			if f.enableEgressTrafficShaping {
+				fb = fb.Action().GotoTable(EgressQoSTable.GetID())
+			} else {
+				fb = fb.Action().GotoStage(stageSwitching)
+			}
+		} else {
+			// SNAT IP should be on a remote Node.
+			fb = fb.Action().SetSrcMAC(f.gatewayMAC).
+				Action().SetDstMAC(GlobalVirtualMAC).
+				Action().SetTunnelDst(snatIP).
+				Action().LoadRegMark(ToTunnelRegMark, RemoteSNATRegMark).
+				Action().GotoStage(stageSwitching)
+		}
+		flows = append(flows, fb.Done())
+	}
+	return flows
 }
 
 func (f *featureEgress) egressQoSFlow(mark uint32) binding.Flow {
@@ -2660,6 +2813,22 @@ func (f *featureEgress) externalFlows() []binding.Flow {
 				Done(),
 			// This generates the flow to bypass the packets destined for local Node.
 			f.snatSkipNodeFlow(f.nodeIPs[ipProtocol]),
+			// This generates the flow to commit the first packet of a connection sent to the select group of an
+			// ActiveActive Egress. Besides the source of the connection, it persists the ID of the selected bucket to
+			// EgressIPBucketCTLabel, which is used by the flows generated by egressIPBucketFlows.
+			ConntrackCommitTable.ofTable.BuildFlow(priorityHigh).
+				Cookie(cookieID).
+				MatchProtocol(ipProtocol).
+				MatchCTStateNew(true).
+				MatchCTStateTrk(true).
+				MatchCTStateSNAT(false).
+				MatchCTMark(NotServiceCTMark).
+				MatchRegMark(EgressIPBucketSelectedRegMark).
+				Action().CT(true, ConntrackCommitTable.GetNext(), f.ctZones[ipProtocol], f.ctZoneSrcField).
+				MoveToCtMarkField(PktSourceField, ConnSourceCTMarkField).
+				MoveToLabel(EgressIPBucketIDField.GetNXFieldName(), EgressIPBucketIDField.GetRange(), EgressIPBucketCTLabel.GetRange()).
+				CTDone().
+				Done(),
 		)
 		// This generates the flows to bypass the packets sourced from local Pods and destined for the except CIDRs for Egress.
 		for _, cidr := range f.exceptCIDRs[ipProtocol] {
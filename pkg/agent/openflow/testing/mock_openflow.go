@@ -268,6 +268,20 @@ func (mr *MockClientMockRecorder) Initialize(roundInfo, arg1, networkConfig, egr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockClient)(nil).Initialize), roundInfo, arg1, networkConfig, egressConfig, serviceConfig, l7NetworkPolicyConfig)
 }
 
+// InstallEgressIPGroup mocks base method.
+func (m *MockClient) InstallEgressIPGroup(groupID openflow0.GroupIDType, snatIPs []net.IP, snatMarks []uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallEgressIPGroup", groupID, snatIPs, snatMarks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallEgressIPGroup indicates an expected call of InstallEgressIPGroup.
+func (mr *MockClientMockRecorder) InstallEgressIPGroup(groupID, snatIPs, snatMarks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallEgressIPGroup", reflect.TypeOf((*MockClient)(nil).InstallEgressIPGroup), groupID, snatIPs, snatMarks)
+}
+
 // InstallEgressQoS mocks base method.
 func (m *MockClient) InstallEgressQoS(meterID, rate, burst uint32) error {
 	m.ctrl.T.Helper()
@@ -450,6 +464,20 @@ func (mr *MockClientMockRecorder) InstallPodFlows(interfaceName, podInterfaceIPs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodFlows", reflect.TypeOf((*MockClient)(nil).InstallPodFlows), interfaceName, podInterfaceIPs, podInterfaceMAC, ofPort, vlanID, labelID)
 }
 
+// InstallPodSNATDestinationFlows mocks base method.
+func (m *MockClient) InstallPodSNATDestinationFlows(ofPort uint32, rules []openflow.PodSNATDestinationRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallPodSNATDestinationFlows", ofPort, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallPodSNATDestinationFlows indicates an expected call of InstallPodSNATDestinationFlows.
+func (mr *MockClientMockRecorder) InstallPodSNATDestinationFlows(ofPort, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodSNATDestinationFlows", reflect.TypeOf((*MockClient)(nil).InstallPodSNATDestinationFlows), ofPort, rules)
+}
+
 // InstallPodSNATFlows mocks base method.
 func (m *MockClient) InstallPodSNATFlows(ofPort uint32, snatIP net.IP, snatMark uint32) error {
 	m.ctrl.T.Helper()
@@ -464,6 +492,20 @@ func (mr *MockClientMockRecorder) InstallPodSNATFlows(ofPort, snatIP, snatMark a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodSNATFlows", reflect.TypeOf((*MockClient)(nil).InstallPodSNATFlows), ofPort, snatIP, snatMark)
 }
 
+// InstallPodSNATGroupFlows mocks base method.
+func (m *MockClient) InstallPodSNATGroupFlows(ofPort uint32, groupID openflow0.GroupIDType, ipProtocol openflow0.Protocol) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallPodSNATGroupFlows", ofPort, groupID, ipProtocol)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallPodSNATGroupFlows indicates an expected call of InstallPodSNATGroupFlows.
+func (mr *MockClientMockRecorder) InstallPodSNATGroupFlows(ofPort, groupID, ipProtocol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodSNATGroupFlows", reflect.TypeOf((*MockClient)(nil).InstallPodSNATGroupFlows), ofPort, groupID, ipProtocol)
+}
+
 // InstallPolicyBypassFlows mocks base method.
 func (m *MockClient) InstallPolicyBypassFlows(arg0 openflow0.Protocol, ipNet *net.IPNet, port uint16, isIngress bool) error {
 	m.ctrl.T.Helper()
@@ -890,6 +932,20 @@ func (mr *MockClientMockRecorder) SubscribePacketIn(reason, pktInQueue any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribePacketIn", reflect.TypeOf((*MockClient)(nil).SubscribePacketIn), reason, pktInQueue)
 }
 
+// UninstallEgressIPGroup mocks base method.
+func (m *MockClient) UninstallEgressIPGroup(groupID openflow0.GroupIDType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallEgressIPGroup", groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallEgressIPGroup indicates an expected call of UninstallEgressIPGroup.
+func (mr *MockClientMockRecorder) UninstallEgressIPGroup(groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallEgressIPGroup", reflect.TypeOf((*MockClient)(nil).UninstallEgressIPGroup), groupID)
+}
+
 // UninstallEgressQoS mocks base method.
 func (m *MockClient) UninstallEgressQoS(meterID uint32) error {
 	m.ctrl.T.Helper()
@@ -1002,6 +1058,20 @@ func (mr *MockClientMockRecorder) UninstallPodFlows(interfaceName any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPodFlows", reflect.TypeOf((*MockClient)(nil).UninstallPodFlows), interfaceName)
 }
 
+// UninstallPodSNATDestinationFlows mocks base method.
+func (m *MockClient) UninstallPodSNATDestinationFlows(ofPort uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallPodSNATDestinationFlows", ofPort)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallPodSNATDestinationFlows indicates an expected call of UninstallPodSNATDestinationFlows.
+func (mr *MockClientMockRecorder) UninstallPodSNATDestinationFlows(ofPort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPodSNATDestinationFlows", reflect.TypeOf((*MockClient)(nil).UninstallPodSNATDestinationFlows), ofPort)
+}
+
 // UninstallPodSNATFlows mocks base method.
 func (m *MockClient) UninstallPodSNATFlows(ofPort uint32) error {
 	m.ctrl.T.Helper()
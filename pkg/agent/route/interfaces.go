@@ -76,6 +76,19 @@ type Interface interface {
 	// DeleteEgressRule deletes the IP rule installed by AddEgressRule.
 	DeleteEgressRule(tableID uint32, mark uint32) error
 
+	// AddImportedRoute installs a route received from BGP peers, which routes traffic to the destination via the
+	// provided gateway. If toEgressRouteTables is true, the route is installed into the route tables created by
+	// AddEgressRoutes whose subnets contain the gateway, instead of the main route table.
+	// It should override the route if it already exists, without error.
+	AddImportedRoute(dst *net.IPNet, gateway net.IP, toEgressRouteTables bool) error
+
+	// DeleteImportedRoute deletes the route installed by AddImportedRoute.
+	// It should do nothing if the route doesn't exist, without error.
+	DeleteImportedRoute(dst *net.IPNet) error
+
+	// ReconcileImportedRoutes should remove orphaned imported routes based on the desired destinations.
+	ReconcileImportedRoutes(dsts []string) error
+
 	// AddNodePortConfigs adds routing configurations for redirecting traffic to OVS when a NodePort Service is created.
 	AddNodePortConfigs(nodePortAddresses []net.IP, port uint16, protocol binding.Protocol) error
 
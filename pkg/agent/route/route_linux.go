@@ -92,6 +92,14 @@ const (
 
 	preNodeNetworkPolicyIngressRulesChain = "ANTREA-POL-PRE-INGRESS-RULES"
 	preNodeNetworkPolicyEgressRulesChain  = "ANTREA-POL-PRE-EGRESS-RULES"
+
+	// importedRouteProtocol is the protocol of the ip routes imported from BGP peers. It's used to identify such
+	// routes when removing orphaned ones.
+	importedRouteProtocol netlink.RouteProtocol = 200
+	// importedRouteMetric is the metric of the ip routes imported from BGP peers. As it's higher than the metrics of the
+	// routes installed by Antrea and of the routes installed by default, an imported route never replaces an existing
+	// route to the same destination, e.g. the route for the Service CIDR, which keeps precedence over it.
+	importedRouteMetric = 4096
 )
 
 // Client implements Interface.
@@ -156,6 +164,11 @@ type Client struct {
 	clusterNodeIP6s sync.Map
 	// egressRoutes caches ip routes about Egresses.
 	egressRoutes sync.Map
+	// importedRoutes caches the routes imported from BGP peers. It's a map of destination CIDR to *importedRoute.
+	importedRoutes sync.Map
+	// importedRoutesMutex serializes the updates of egressRoutes and importedRoutes, as imported routes may be
+	// installed into the route tables created for Egresses.
+	importedRoutesMutex sync.Mutex
 	// ipRules caches ip rules.
 	ipRules sync.Map
 	// The latest calculated Service CIDRs can be got from serviceCIDRProvider.
@@ -332,6 +345,15 @@ func (c *Client) syncIPInfra() {
 	klog.V(3).Info("Successfully synced iptables, ipset, route and neighbor")
 }
 
+// importedRoute describes a route imported from BGP peers. It's immutable once stored in the cache.
+type importedRoute struct {
+	dst                 *net.IPNet
+	gateway             net.IP
+	toEgressRouteTables bool
+	// routes are the ip routes installed for the imported route.
+	routes []*netlink.Route
+}
+
 type routeKey struct {
 	linkIndex int
 	dst       string
@@ -394,6 +416,14 @@ func (c *Client) syncRoute() error {
 		}
 		return true
 	})
+	c.importedRoutes.Range(func(_, v any) bool {
+		for _, route := range v.(*importedRoute).routes {
+			if !restoreRoute(route) {
+				return false
+			}
+		}
+		return true
+	})
 	// These routes are installed automatically by the kernel when the address is configured on
 	// the interface (with "proto kernel"). If these routes are deleted manually by mistake, we
 	// restore them as part of this sync (without "proto kernel"). An alternative would be to
@@ -1977,6 +2007,12 @@ func (c *Client) AddEgressRoutes(tableID uint32, dev int, gateway net.IP, prefix
 		Gw:        gateway,
 		Table:     int(tableID),
 	}
+	c.importedRoutesMutex.Lock()
+	defer c.importedRoutesMutex.Unlock()
+	// The subnet of the table may change, remove the imported routes installed for the previous subnet.
+	if err := c.deleteImportedRoutesInTable(int(tableID)); err != nil {
+		return err
+	}
 	if err := c.netlink.RouteReplace(localRoute); err != nil {
 		return err
 	}
@@ -1984,14 +2020,19 @@ func (c *Client) AddEgressRoutes(tableID uint32, dev int, gateway net.IP, prefix
 		return err
 	}
 	c.egressRoutes.Store(tableID, []*netlink.Route{localRoute, defaultRoute})
-	return nil
+	return c.addImportedRoutesToTable(localRoute)
 }
 
 func (c *Client) DeleteEgressRoutes(tableID uint32) error {
+	c.importedRoutesMutex.Lock()
+	defer c.importedRoutesMutex.Unlock()
 	value, exists := c.egressRoutes.Load(tableID)
 	if !exists {
 		return nil
 	}
+	if err := c.deleteImportedRoutesInTable(int(tableID)); err != nil {
+		return err
+	}
 	routes := value.([]*netlink.Route)
 	for _, route := range routes {
 		if err := c.netlink.RouteDel(route); err != nil {
@@ -2004,6 +2045,158 @@ func (c *Client) DeleteEgressRoutes(tableID uint32) error {
 	return nil
 }
 
+// newImportedEgressRoute returns the ip route for an imported route in the Egress route table of the provided subnet
+// route, for example:
+// $ ip route show table 101
+// 172.20.10.0/24 dev eth0.10 table 101
+// default via 172.20.10.1 dev eth0.10 table 101
+// 10.10.0.0/16 via 172.20.10.254 dev eth0.10 table 101 proto 200
+func newImportedEgressRoute(dst *net.IPNet, gateway net.IP, subnetRoute *netlink.Route) *netlink.Route {
+	return &netlink.Route{
+		Dst:       dst,
+		Gw:        gateway,
+		LinkIndex: subnetRoute.LinkIndex,
+		Table:     subnetRoute.Table,
+		Protocol:  importedRouteProtocol,
+		Priority:  importedRouteMetric,
+	}
+}
+
+// addImportedRoutesToTable installs the imported routes whose gateways are in the subnet of the provided Egress
+// subnet route into the Egress route table. importedRoutesMutex must be held by the caller.
+func (c *Client) addImportedRoutesToTable(subnetRoute *netlink.Route) error {
+	var err error
+	c.importedRoutes.Range(func(k, v any) bool {
+		r := v.(*importedRoute)
+		if !r.toEgressRouteTables || !subnetRoute.Dst.Contains(r.gateway) {
+			return true
+		}
+		route := newImportedEgressRoute(r.dst, r.gateway, subnetRoute)
+		if replaceErr := c.netlink.RouteReplace(route); replaceErr != nil {
+			err = fmt.Errorf("error installing imported route %v: %w", route, replaceErr)
+			return false
+		}
+		routes := append(append([]*netlink.Route{}, r.routes...), route)
+		c.importedRoutes.Store(k, &importedRoute{dst: r.dst, gateway: r.gateway, toEgressRouteTables: true, routes: routes})
+		return true
+	})
+	return err
+}
+
+// deleteImportedRoutesInTable deletes the imported routes installed into the provided route table.
+// importedRoutesMutex must be held by the caller.
+func (c *Client) deleteImportedRoutesInTable(tableID int) error {
+	var err error
+	c.importedRoutes.Range(func(k, v any) bool {
+		r := v.(*importedRoute)
+		var routes []*netlink.Route
+		for _, route := range r.routes {
+			if route.Table != tableID {
+				routes = append(routes, route)
+				continue
+			}
+			if delErr := c.netlink.RouteDel(route); delErr != nil && delErr != unix.ESRCH {
+				err = fmt.Errorf("error deleting imported route %v: %w", route, delErr)
+				return false
+			}
+		}
+		if len(routes) != len(r.routes) {
+			c.importedRoutes.Store(k, &importedRoute{dst: r.dst, gateway: r.gateway, toEgressRouteTables: r.toEgressRouteTables, routes: routes})
+		}
+		return true
+	})
+	return err
+}
+
+func (c *Client) AddImportedRoute(dst *net.IPNet, gateway net.IP, toEgressRouteTables bool) error {
+	c.importedRoutesMutex.Lock()
+	defer c.importedRoutesMutex.Unlock()
+	key := dst.String()
+	if value, exists := c.importedRoutes.Load(key); exists {
+		r := value.(*importedRoute)
+		if r.gateway.Equal(gateway) && r.toEgressRouteTables == toEgressRouteTables {
+			return nil
+		}
+		if err := c.deleteImportedRoute(r); err != nil {
+			return err
+		}
+		c.importedRoutes.Delete(key)
+	}
+	var routes []*netlink.Route
+	if toEgressRouteTables {
+		c.egressRoutes.Range(func(_, v any) bool {
+			subnetRoute := v.([]*netlink.Route)[0]
+			if subnetRoute.Dst.Contains(gateway) {
+				routes = append(routes, newImportedEgressRoute(dst, gateway, subnetRoute))
+			}
+			return true
+		})
+	} else {
+		routes = append(routes, &netlink.Route{
+			Dst:      dst,
+			Gw:       gateway,
+			Protocol: importedRouteProtocol,
+			Priority: importedRouteMetric,
+		})
+	}
+	for i, route := range routes {
+		if err := c.netlink.RouteReplace(route); err != nil {
+			// Roll back the routes installed so far, which are not tracked by the cache yet.
+			c.deleteImportedRoute(&importedRoute{routes: routes[:i]})
+			return fmt.Errorf("error installing imported route %v: %w", route, err)
+		}
+	}
+	c.importedRoutes.Store(key, &importedRoute{dst: dst, gateway: gateway, toEgressRouteTables: toEgressRouteTables, routes: routes})
+	return nil
+}
+
+func (c *Client) DeleteImportedRoute(dst *net.IPNet) error {
+	c.importedRoutesMutex.Lock()
+	defer c.importedRoutesMutex.Unlock()
+	key := dst.String()
+	value, exists := c.importedRoutes.Load(key)
+	if !exists {
+		return nil
+	}
+	if err := c.deleteImportedRoute(value.(*importedRoute)); err != nil {
+		return err
+	}
+	c.importedRoutes.Delete(key)
+	return nil
+}
+
+func (c *Client) deleteImportedRoute(r *importedRoute) error {
+	for _, route := range r.routes {
+		if err := c.netlink.RouteDel(route); err != nil && err != unix.ESRCH {
+			return fmt.Errorf("error deleting imported route %v: %w", route, err)
+		}
+	}
+	return nil
+}
+
+// ReconcileImportedRoutes removes the imported routes in the main route table whose destinations are not desired,
+// e.g. the routes installed before the agent restarted. The imported routes in the Egress route tables don't need to
+// be reconciled as the tables are cleaned up by RestoreEgressRoutesAndRules on startup.
+func (c *Client) ReconcileImportedRoutes(dsts []string) error {
+	desiredDsts := sets.New[string](dsts...)
+	// Only the routes in the main route table are returned when the table is not specified in the filter.
+	routes, err := c.netlink.RouteListFiltered(netlink.FAMILY_ALL, &netlink.Route{Protocol: importedRouteProtocol}, netlink.RT_FILTER_PROTOCOL)
+	if err != nil {
+		return fmt.Errorf("error listing imported routes: %w", err)
+	}
+	for i := range routes {
+		route := routes[i]
+		if route.Dst == nil || desiredDsts.Has(route.Dst.String()) {
+			continue
+		}
+		klog.InfoS("Deleting orphaned imported route", "route", route)
+		if err := c.netlink.RouteDel(&route); err != nil && err != unix.ESRCH {
+			return fmt.Errorf("error deleting imported route %v: %w", route, err)
+		}
+	}
+	return nil
+}
+
 func (c *Client) AddEgressRule(tableID uint32, mark uint32) error {
 	rule := netlink.NewRule()
 	rule.Table = int(tableID)
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vishvananda/netlink"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/sys/unix"
@@ -73,10 +74,13 @@ func TestSyncRoutes(t *testing.T) {
 	serviceRoute2 := &netlink.Route{Dst: ip.MustParseCIDR("169.254.0.252/32"), Gw: net.ParseIP("169.254.0.253")}
 	egressRoute1 := &netlink.Route{Scope: netlink.SCOPE_LINK, Dst: ip.MustParseCIDR("10.10.10.0/24"), LinkIndex: 10, Table: 101}
 	egressRoute2 := &netlink.Route{Gw: net.ParseIP("10.10.10.1"), LinkIndex: 10, Table: 101}
-	mockNetlink.EXPECT().RouteList(nil, netlink.FAMILY_ALL).Return([]netlink.Route{*nodeRoute1, *serviceRoute1, *egressRoute1}, nil)
+	importedRoute1 := &netlink.Route{Dst: ip.MustParseCIDR("10.20.0.0/16"), Gw: net.ParseIP("1.1.1.254"), Protocol: importedRouteProtocol, Priority: importedRouteMetric}
+	importedRoute2 := &netlink.Route{Dst: ip.MustParseCIDR("10.30.0.0/16"), Gw: net.ParseIP("10.10.10.254"), LinkIndex: 10, Table: 101, Protocol: importedRouteProtocol, Priority: importedRouteMetric}
+	mockNetlink.EXPECT().RouteList(nil, netlink.FAMILY_ALL).Return([]netlink.Route{*nodeRoute1, *serviceRoute1, *egressRoute1, *importedRoute1}, nil)
 	mockNetlink.EXPECT().RouteReplace(nodeRoute2)
 	mockNetlink.EXPECT().RouteReplace(serviceRoute2)
 	mockNetlink.EXPECT().RouteReplace(egressRoute2)
+	mockNetlink.EXPECT().RouteReplace(importedRoute2)
 	mockNetlink.EXPECT().RouteReplace(&netlink.Route{
 		LinkIndex: 10,
 		Dst:       ip.MustParseCIDR("192.168.0.0/24"),
@@ -111,6 +115,8 @@ func TestSyncRoutes(t *testing.T) {
 	c.serviceRoutes.Store("169.254.0.253/32", serviceRoute1)
 	c.serviceRoutes.Store("169.254.0.252/32", serviceRoute2)
 	c.egressRoutes.Store(101, []*netlink.Route{egressRoute1, egressRoute2})
+	c.importedRoutes.Store("10.20.0.0/16", &importedRoute{routes: []*netlink.Route{importedRoute1}})
+	c.importedRoutes.Store("10.30.0.0/16", &importedRoute{toEgressRouteTables: true, routes: []*netlink.Route{importedRoute2}})
 
 	assert.NoError(t, c.syncRoute())
 }
@@ -2284,6 +2290,82 @@ func TestEgressRoutes(t *testing.T) {
 	}
 }
 
+func TestImportedRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNetlink := netlinktest.NewMockInterface(ctrl)
+	c := &Client{
+		netlink:    mockNetlink,
+		nodeConfig: nodeConfig,
+	}
+
+	dst1 := ip.MustParseCIDR("10.20.0.0/16")
+	dst2 := ip.MustParseCIDR("10.30.0.0/16")
+	dst3 := ip.MustParseCIDR("1122:5566::/64")
+	mainRoute1 := &netlink.Route{Dst: dst1, Gw: net.ParseIP("192.168.77.1"), Protocol: importedRouteProtocol, Priority: importedRouteMetric}
+	mainRoute1Updated := &netlink.Route{Dst: dst1, Gw: net.ParseIP("192.168.77.2"), Protocol: importedRouteProtocol, Priority: importedRouteMetric}
+	egressRoute2 := &netlink.Route{Dst: dst2, Gw: net.ParseIP("1.1.1.254"), LinkIndex: 10, Table: 101, Protocol: importedRouteProtocol, Priority: importedRouteMetric}
+	egressRoute3 := &netlink.Route{Dst: dst3, Gw: net.ParseIP("1122:3344::1"), LinkIndex: 11, Table: 102, Protocol: importedRouteProtocol, Priority: importedRouteMetric}
+
+	// The route to the main route table is installed immediately.
+	mockNetlink.EXPECT().RouteReplace(mainRoute1)
+	require.NoError(t, c.AddImportedRoute(dst1, net.ParseIP("192.168.77.1"), false))
+	// Adding the same route again is a no-op.
+	require.NoError(t, c.AddImportedRoute(dst1, net.ParseIP("192.168.77.1"), false))
+	// Updating the gateway replaces the route.
+	mockNetlink.EXPECT().RouteDel(mainRoute1)
+	mockNetlink.EXPECT().RouteReplace(mainRoute1Updated)
+	require.NoError(t, c.AddImportedRoute(dst1, net.ParseIP("192.168.77.2"), false))
+
+	// The routes to the Egress route tables are installed when the tables whose subnets contain the gateways exist.
+	require.NoError(t, c.AddImportedRoute(dst2, net.ParseIP("1.1.1.254"), true))
+	mockNetlink.EXPECT().RouteReplace(&netlink.Route{Dst: ip.MustParseCIDR("1.1.1.0/24"), Scope: netlink.SCOPE_LINK, LinkIndex: 10, Table: 101})
+	mockNetlink.EXPECT().RouteReplace(&netlink.Route{Gw: net.ParseIP("1.1.1.1"), LinkIndex: 10, Table: 101})
+	mockNetlink.EXPECT().RouteReplace(egressRoute2)
+	require.NoError(t, c.AddEgressRoutes(101, 10, net.ParseIP("1.1.1.1"), 24))
+	mockNetlink.EXPECT().RouteReplace(&netlink.Route{Dst: ip.MustParseCIDR("1122:3344::/80"), Scope: netlink.SCOPE_LINK, LinkIndex: 11, Table: 102})
+	mockNetlink.EXPECT().RouteReplace(&netlink.Route{Gw: net.ParseIP("1122:3344::5566"), LinkIndex: 11, Table: 102})
+	require.NoError(t, c.AddEgressRoutes(102, 11, net.ParseIP("1122:3344::5566"), 80))
+	mockNetlink.EXPECT().RouteReplace(egressRoute3)
+	require.NoError(t, c.AddImportedRoute(dst3, net.ParseIP("1122:3344::1"), true))
+
+	// Deleting the Egress route table deletes the imported routes in it.
+	mockNetlink.EXPECT().RouteDel(egressRoute2)
+	mockNetlink.EXPECT().RouteDel(&netlink.Route{Dst: ip.MustParseCIDR("1.1.1.0/24"), Scope: netlink.SCOPE_LINK, LinkIndex: 10, Table: 101})
+	mockNetlink.EXPECT().RouteDel(&netlink.Route{Gw: net.ParseIP("1.1.1.1"), LinkIndex: 10, Table: 101})
+	require.NoError(t, c.DeleteEgressRoutes(101))
+	value, exists := c.importedRoutes.Load(dst2.String())
+	require.True(t, exists)
+	assert.Empty(t, value.(*importedRoute).routes)
+
+	mockNetlink.EXPECT().RouteDel(mainRoute1Updated)
+	require.NoError(t, c.DeleteImportedRoute(dst1))
+	require.NoError(t, c.DeleteImportedRoute(dst2))
+	mockNetlink.EXPECT().RouteDel(egressRoute3)
+	require.NoError(t, c.DeleteImportedRoute(dst3))
+	// Deleting a non-existing route is a no-op.
+	require.NoError(t, c.DeleteImportedRoute(dst3))
+	c.importedRoutes.Range(func(key, value any) bool {
+		t.Errorf("The importedRoutes should be empty but contains %v:%v", key, value)
+		return true
+	})
+}
+
+func TestReconcileImportedRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNetlink := netlinktest.NewMockInterface(ctrl)
+	c := &Client{
+		netlink:    mockNetlink,
+		nodeConfig: nodeConfig,
+	}
+
+	route1 := netlink.Route{Dst: ip.MustParseCIDR("10.20.0.0/16"), Gw: net.ParseIP("192.168.77.1"), Protocol: importedRouteProtocol, Priority: importedRouteMetric}
+	route2 := netlink.Route{Dst: ip.MustParseCIDR("10.30.0.0/16"), Gw: net.ParseIP("192.168.77.1"), Protocol: importedRouteProtocol, Priority: importedRouteMetric}
+	mockNetlink.EXPECT().RouteListFiltered(netlink.FAMILY_ALL, &netlink.Route{Protocol: importedRouteProtocol}, netlink.RT_FILTER_PROTOCOL).Return([]netlink.Route{route1, route2}, nil)
+	mockNetlink.EXPECT().RouteDel(&route2)
+
+	assert.NoError(t, c.ReconcileImportedRoutes([]string{"10.20.0.0/16", "10.40.0.0/16"}))
+}
+
 func TestEgressRule(t *testing.T) {
 	tests := []struct {
 		name          string
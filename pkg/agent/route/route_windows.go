@@ -634,6 +634,18 @@ func (c *Client) DeleteEgressRule(tableID uint32, mark uint32) error {
 	return errors.New("DeleteEgressRule is not implemented on Windows")
 }
 
+func (c *Client) AddImportedRoute(dst *net.IPNet, gateway net.IP, toEgressRouteTables bool) error {
+	return errors.New("AddImportedRoute is not implemented on Windows")
+}
+
+func (c *Client) DeleteImportedRoute(dst *net.IPNet) error {
+	return errors.New("DeleteImportedRoute is not implemented on Windows")
+}
+
+func (c *Client) ReconcileImportedRoutes(dsts []string) error {
+	return errors.New("ReconcileImportedRoutes is not implemented on Windows")
+}
+
 func (c *Client) AddOrUpdateNodeNetworkPolicyIPSet(ipsetName string, ipsetEntries sets.Set[string], isIPv6 bool) error {
 	return errors.New("AddOrUpdateNodeNetworkPolicyIPSet is not implemented on Windows")
 }
@@ -100,6 +100,20 @@ func (mr *MockInterfaceMockRecorder) AddExternalIPConfigs(svcInfoStr, externalIP
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExternalIPConfigs", reflect.TypeOf((*MockInterface)(nil).AddExternalIPConfigs), svcInfoStr, externalIP)
 }
 
+// AddImportedRoute mocks base method.
+func (m *MockInterface) AddImportedRoute(dst *net.IPNet, gateway net.IP, toEgressRouteTables bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImportedRoute", dst, gateway, toEgressRouteTables)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddImportedRoute indicates an expected call of AddImportedRoute.
+func (mr *MockInterfaceMockRecorder) AddImportedRoute(dst, gateway, toEgressRouteTables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImportedRoute", reflect.TypeOf((*MockInterface)(nil).AddImportedRoute), dst, gateway, toEgressRouteTables)
+}
+
 // AddLocalAntreaFlexibleIPAMPodRule mocks base method.
 func (m *MockInterface) AddLocalAntreaFlexibleIPAMPodRule(podAddresses []net.IP) error {
 	m.ctrl.T.Helper()
@@ -254,6 +268,20 @@ func (mr *MockInterfaceMockRecorder) DeleteExternalIPConfigs(svcInfoStr, externa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalIPConfigs", reflect.TypeOf((*MockInterface)(nil).DeleteExternalIPConfigs), svcInfoStr, externalIP)
 }
 
+// DeleteImportedRoute mocks base method.
+func (m *MockInterface) DeleteImportedRoute(dst *net.IPNet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImportedRoute", dst)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImportedRoute indicates an expected call of DeleteImportedRoute.
+func (mr *MockInterfaceMockRecorder) DeleteImportedRoute(dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImportedRoute", reflect.TypeOf((*MockInterface)(nil).DeleteImportedRoute), dst)
+}
+
 // DeleteLocalAntreaFlexibleIPAMPodRule mocks base method.
 func (m *MockInterface) DeleteLocalAntreaFlexibleIPAMPodRule(podAddresses []net.IP) error {
 	m.ctrl.T.Helper()
@@ -394,6 +422,20 @@ func (mr *MockInterfaceMockRecorder) Reconcile(podCIDRs any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockInterface)(nil).Reconcile), podCIDRs)
 }
 
+// ReconcileImportedRoutes mocks base method.
+func (m *MockInterface) ReconcileImportedRoutes(dsts []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileImportedRoutes", dsts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileImportedRoutes indicates an expected call of ReconcileImportedRoutes.
+func (mr *MockInterfaceMockRecorder) ReconcileImportedRoutes(dsts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileImportedRoutes", reflect.TypeOf((*MockInterface)(nil).ReconcileImportedRoutes), dsts)
+}
+
 // RestoreEgressRoutesAndRules mocks base method.
 func (m *MockInterface) RestoreEgressRoutesAndRules(minTableID, maxTableID int) error {
 	m.ctrl.T.Helper()
@@ -175,6 +175,7 @@ func addUp(stats *statsv1alpha1.TrafficStats, inc *agenttypes.RuleMetric) {
 	stats.Sessions += int64(inc.Sessions)
 	stats.Packets += int64(inc.Packets)
 	stats.Bytes += int64(inc.Bytes)
+	stats.DroppedPackets += int64(inc.DroppedPackets)
 }
 
 func isIdenticalMulticastGroupMap(a, b map[string][]cpv1beta.PodReference) bool {
@@ -328,9 +329,10 @@ func calculateRuleDiff(curStatsMap, lastStatsMap map[types.UID]map[string]*stats
 					ruleTrafficStats := statsv1alpha1.RuleTrafficStats{
 						Name: name,
 						TrafficStats: statsv1alpha1.TrafficStats{
-							Bytes:    curRuleStats.Bytes - lastRuleStats.Bytes,
-							Sessions: curRuleStats.Sessions - lastRuleStats.Sessions,
-							Packets:  curRuleStats.Packets - lastRuleStats.Packets,
+							Bytes:          curRuleStats.Bytes - lastRuleStats.Bytes,
+							Sessions:       curRuleStats.Sessions - lastRuleStats.Sessions,
+							Packets:        curRuleStats.Packets - lastRuleStats.Packets,
+							DroppedPackets: curRuleStats.DroppedPackets - lastRuleStats.DroppedPackets,
 						},
 					}
 					stats = append(stats, ruleTrafficStats)
@@ -364,9 +366,10 @@ func calculateDiff(curStatsMap, lastStatsMap map[types.UID]*statsv1alpha1.Traffi
 			stats = curStats
 		} else {
 			stats = &statsv1alpha1.TrafficStats{
-				Packets:  curStats.Packets - lastStats.Packets,
-				Sessions: curStats.Sessions - lastStats.Sessions,
-				Bytes:    curStats.Bytes - lastStats.Bytes,
+				Packets:        curStats.Packets - lastStats.Packets,
+				Sessions:       curStats.Sessions - lastStats.Sessions,
+				Bytes:          curStats.Bytes - lastStats.Bytes,
+				DroppedPackets: curStats.DroppedPackets - lastStats.DroppedPackets,
 			}
 		}
 		// If the statistics of the NetworkPolicy remain unchanged, no need to report it.
@@ -750,6 +750,45 @@ func TestCalculateRuleDiff(t *testing.T) {
 			},
 			expectedStatsList: []cpv1beta.NetworkPolicyStats{},
 		},
+		{
+			name: "rate-limited rule",
+			lastStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{
+				"uid1": {
+					"rule1": {
+						Bytes:          1000,
+						Packets:        10,
+						Sessions:       1,
+						DroppedPackets: 2,
+					},
+				},
+			},
+			curStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{
+				"uid1": {
+					"rule1": {
+						Bytes:          3000,
+						Packets:        30,
+						Sessions:       1,
+						DroppedPackets: 12,
+					},
+				},
+			},
+			expectedStatsList: []cpv1beta.NetworkPolicyStats{
+				{
+					NetworkPolicy: cpv1beta.NetworkPolicyReference{UID: "uid1"},
+					RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
+						{
+							Name: "rule1",
+							TrafficStats: statsv1alpha1.TrafficStats{
+								Bytes:          2000,
+								Packets:        20,
+								Sessions:       0,
+								DroppedPackets: 10,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "negative statistic",
 			lastStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{
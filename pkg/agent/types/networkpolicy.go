@@ -113,6 +113,12 @@ type PolicyRule struct {
 	PolicyRef     *v1beta2.NetworkPolicyReference
 	EnableLogging bool
 	LogLabel      string
+	// EnforcementMode of the NetworkPolicy to which this rule belongs. Empty for K8s NetworkPolicy.
+	EnforcementMode secv1beta1.PolicyEnforcementMode
+	// RateLimit of this rule. Only set when Action is RateLimit.
+	RateLimit *v1beta2.RateLimit
+	// LogSampling of this rule. Only set for Allow rules with EnableLogging set to true.
+	LogSampling *v1beta2.LogSampling
 }
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
@@ -120,6 +126,20 @@ func (r *PolicyRule) IsAntreaNetworkPolicyRule() bool {
 	return r.PolicyRef.Type != v1beta2.K8sNetworkPolicy
 }
 
+// IsAuditOnly returns if the Drop or Reject action of the PolicyRule should not be enforced,
+// in which case the matching traffic is only counted and logged.
+func (r *PolicyRule) IsAuditOnly() bool {
+	if r.EnforcementMode != secv1beta1.EnforcementModeAudit || r.Action == nil {
+		return false
+	}
+	return *r.Action == secv1beta1.RuleActionDrop || *r.Action == secv1beta1.RuleActionReject
+}
+
+// IsRateLimited returns if the traffic matching the PolicyRule should be policed to the rate of the rule.
+func (r *PolicyRule) IsRateLimited() bool {
+	return r.Action != nil && *r.Action == secv1beta1.RuleActionRateLimit && r.RateLimit != nil
+}
+
 // Priority is a struct that is composed of Antrea NetworkPolicy priority, rule priority and Tier priority.
 // It is used as the basic unit for priority sorting.
 type Priority struct {
@@ -165,12 +185,15 @@ func (bp ByPriority) Less(i, j int) bool { return bp[i].Less(bp[j]) }
 
 type RuleMetric struct {
 	Bytes, Packets, Sessions uint64
+	// DroppedPackets is the number of packets dropped because they exceeded the rate of a RateLimit rule.
+	DroppedPackets uint64
 }
 
 func (m *RuleMetric) Merge(m1 *RuleMetric) {
 	m.Bytes += m1.Bytes
 	m.Packets += m1.Packets
 	m.Sessions += m1.Sessions
+	m.DroppedPackets += m1.DroppedPackets
 }
 
 // A BitRange is a representation of a range of values from base value with a
@@ -24,6 +24,7 @@ import (
 	"antrea.io/antrea/pkg/antctl/raw/featuregates"
 	"antrea.io/antrea/pkg/antctl/raw/multicluster"
 	"antrea.io/antrea/pkg/antctl/raw/packetcapture"
+	"antrea.io/antrea/pkg/antctl/raw/policyevaluation"
 	"antrea.io/antrea/pkg/antctl/raw/proxy"
 	"antrea.io/antrea/pkg/antctl/raw/set"
 	"antrea.io/antrea/pkg/antctl/raw/supportbundle"
@@ -32,11 +33,14 @@ import (
 	"antrea.io/antrea/pkg/antctl/transform/addressgroup"
 	"antrea.io/antrea/pkg/antctl/transform/appliedtogroup"
 	"antrea.io/antrea/pkg/antctl/transform/controllerinfo"
+	"antrea.io/antrea/pkg/antctl/transform/flowgraph"
 	"antrea.io/antrea/pkg/antctl/transform/networkpolicy"
 	"antrea.io/antrea/pkg/antctl/transform/ovstracing"
+	"antrea.io/antrea/pkg/antctl/transform/unusedrule"
 	"antrea.io/antrea/pkg/antctl/transform/version"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	systemv1beta1 "antrea.io/antrea/pkg/apis/system/v1beta1"
 	controllerapis "antrea.io/antrea/pkg/apiserver/apis"
 	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
@@ -311,6 +315,31 @@ $ antctl get podmulticaststats pod -n namespace`,
 			commandGroup:        get,
 			transformedResponse: reflect.TypeOf(controllerinfo.Response{}),
 		},
+		{
+			use:     "unusedrules",
+			aliases: []string{"unusedrule", "ur"},
+			short:   "Print Antrea-native policy rules which have not matched any traffic",
+			long:    "Print the rules of Antrea ClusterNetworkPolicies and Antrea NetworkPolicies which have not matched any traffic in a given time window. The NetworkPolicyStats feature must be enabled. Rule stats are kept in memory by the Antrea Controller, so rules are only reported once their stats cover the whole window.",
+			example: `  Get the rules which have not matched any traffic in the last 24 hours
+  $ antctl get unusedrules
+  Get the rules which have not matched any traffic in the last 30 days
+  $ antctl get unusedrules --since 30d`,
+			commandGroup: get,
+			controllerEndpoint: &endpoint{
+				resourceEndpoint: &resourceEndpoint{
+					groupVersionResource: &statsv1alpha1.UnusedRuleVersionResource,
+					params: []flagInfo{
+						{
+							name:          "since",
+							usage:         "The time window in which the rules have not matched any traffic, e.g. 12h or 30d. Defaults to 24h.",
+							fieldSelector: true,
+						},
+					},
+				},
+				addonTransform: unusedrule.Transform,
+			},
+			transformedResponse: reflect.TypeOf(unusedrule.Response{}),
+		},
 		{
 			use:     "agentinfo",
 			aliases: []string{"agentinfos", "ai"},
@@ -546,6 +575,25 @@ $ antctl get podmulticaststats pod -n namespace`,
 			},
 			transformedResponse: reflect.TypeOf(networkpolicy.EvaluationResponse{}),
 		},
+		{
+			use:     "policies",
+			aliases: []string{"policy", "networkpolicies", "netpol"},
+			short:   "Analyze the rules of all NetworkPolicies.",
+			long:    "Analyze the rules of all NetworkPolicies in the cluster and report the rules which are shadowed by, redundant with, or conflicting with other rules, based on the GroupMembers currently selected by the rules.",
+			example: `  Report shadowed, redundant and conflicting NetworkPolicy rules
+  $ antctl analyze policies
+`,
+			commandGroup: analyze,
+			controllerEndpoint: &endpoint{
+				resourceEndpoint: &resourceEndpoint{
+					groupVersionResource: &cpv1beta.NetworkPolicyAnalysisVersionResource,
+					parameterTransform:   networkpolicy.NewNetworkPolicyAnalysis,
+					restMethod:           restPost,
+				},
+				addonTransform: networkpolicy.AnalysisTransform,
+			},
+			transformedResponse: reflect.TypeOf(networkpolicy.AnalysisResponse{}),
+		},
 		{
 			use:   "flowrecords",
 			short: "Print the matching flow records in the flow aggregator",
@@ -600,6 +648,43 @@ $ antctl get podmulticaststats pod -n namespace`,
 			},
 			transformedResponse: reflect.TypeOf(aggregatorapis.RecordMetricsResponse{}),
 		},
+		{
+			use:   "flowgraph",
+			short: "Print the flow graph of the flow aggregator",
+			long:  "Print the flow graph of the flow aggregator. Each edge of the graph corresponds to the traffic from a workload (or external endpoint) to another workload, a Service or an external endpoint, with the number of bytes, packets and connections observed over the requested window. The flow graph must be enabled in the flow aggregator configuration.",
+			example: `  Get the flow graph over the default window
+  $ antctl get flowgraph
+  Get the flow graph over the last 5 minutes, for edges with at least one endpoint in Namespace default
+  $ antctl get flowgraph --window 5m -n default
+  Get the flow graph in the Graphviz DOT format, and render it as an SVG image
+  $ antctl get flowgraph --format dot | dot -Tsvg > flowgraph.svg`,
+			commandGroup: get,
+			flowAggregatorEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/flowgraph",
+					params: []flagInfo{
+						{
+							name:  "window",
+							usage: "Duration over which traffic is accounted for, e.g. 5m. Defaults to the window configured for the flow graph, which is also the maximum supported value.",
+						},
+						{
+							name:      "namespace",
+							usage:     "Only get the edges with at least one endpoint in the provided Namespace.",
+							shorthand: "n",
+						},
+						{
+							name:            "format",
+							usage:           "Format of the graph: json or dot. When dot is used, the graph is printed in the Graphviz DOT language, regardless of the output format.",
+							defaultValue:    "json",
+							supportedValues: []string{"json", "dot"},
+						},
+					},
+					outputType: multiple,
+				},
+				addonTransform: flowgraph.Transform,
+			},
+			transformedResponse: reflect.TypeOf(aggregatorapis.FlowGraphEdgeResponse{}),
+		},
 		{
 			use:          "serviceexternalip",
 			short:        "Print Service external IP status",
@@ -657,7 +742,7 @@ $ antctl get podmulticaststats pod -n namespace`,
 			use:     "bgppeers",
 			aliases: []string{"bgppeer"},
 			short:   "Print the current status of bgp peers of effective bgppolicy",
-			long:    "Print the current status of bgp peers of effective bgppolicy which includes peer IP address with port, asn and state",
+			long:    "Print the current status of bgp peers of effective bgppolicy which includes peer IP address with port, asn, state and BFD session state",
 			example: `  Get the list of all bgp peers with their current status
   $ antctl get bgppeers
   Get the list of IPv4 bgp peers with their current status
@@ -717,8 +802,8 @@ $ antctl get podmulticaststats pod -n namespace`,
 						{
 							name:            "type",
 							shorthand:       "T",
-							usage:           "Get advertised bgp routes of a specific type. Valid types are EgressIP, ServiceLoadBalancerIP, ServiceExternalIP, ServiceClusterIP or NodeIPAMPodCIDR.",
-							supportedValues: []string{"EgressIP", "ServiceLoadBalancerIP", "ServiceExternalIP", "ServiceClusterIP", "NodeIPAMPodCIDR"},
+							usage:           "Get advertised bgp routes of a specific type. Valid types are EgressIP, ServiceLoadBalancerIP, ServiceExternalIP, ServiceClusterIP, NodeIPAMPodCIDR or PodIP.",
+							supportedValues: []string{"EgressIP", "ServiceLoadBalancerIP", "ServiceExternalIP", "ServiceClusterIP", "NodeIPAMPodCIDR", "PodIP"},
 						},
 					},
 					outputType: multiple,
@@ -782,6 +867,12 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportAgent:      true,
 			supportController: true,
 		},
+		{
+			cobraCommand:      policyevaluation.Command,
+			supportAgent:      false,
+			supportController: false,
+			commandGroup:      query,
+		},
 		{
 			cobraCommand:      proxy.Command,
 			supportAgent:      false,
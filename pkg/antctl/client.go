@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/rest"
 
@@ -175,7 +176,20 @@ func (c *client) resourceRequest(e *resourceEndpoint, opt *requestOption) (io.Re
 		restRequest = restRequest.Name(name)
 	}
 
+	fieldSet := fields.Set{}
+	for _, f := range e.params {
+		if val, ok := opt.args[f.name]; ok && f.fieldSelector {
+			fieldSet[f.name] = val
+		}
+	}
+	if len(fieldSet) > 0 {
+		restRequest = restRequest.Param("fieldSelector", fields.SelectorFromSet(fieldSet).String())
+	}
+
 	for arg, val := range opt.args {
+		if _, ok := fieldSet[arg]; ok {
+			continue
+		}
 		if arg != "name" && arg != "namespace" {
 			restRequest = restRequest.Param(arg, val)
 		}
@@ -71,6 +71,7 @@ const (
 	mc
 	upgrade
 	check
+	analyze
 )
 
 var groupCommands = map[commandGroup]*cobra.Command{
@@ -98,6 +99,11 @@ var groupCommands = map[commandGroup]*cobra.Command{
 		Use:   "check",
 		Short: "Performs pre and post installation checks",
 	},
+	analyze: {
+		Use:   "analyze",
+		Short: "Analyze resources of the cluster",
+		Long:  "Analyze resources of the cluster",
+	},
 }
 
 type endpointResponder interface {
@@ -200,6 +206,9 @@ type flagInfo struct {
 	arg             bool
 	usage           string
 	isBool          bool
+	// fieldSelector indicates that the flag is sent to resourceEndpoints as a field
+	// selector instead of a query parameter.
+	fieldSelector bool
 }
 
 // rawCommand defines a full function cobra.Command which lets developers
@@ -456,6 +465,8 @@ func (cd *commandDefinition) output(resp io.Reader, writer io.Writer, ft formatt
 				return output.TableOutputForQueryEndpoint(obj, writer)
 			}
 			return output.TableOutputForGetCommands(obj, writer)
+		case analyze:
+			return output.TableOutputForGetCommands(obj, writer)
 		default:
 			return output.TableOutput(obj, writer)
 		}
@@ -588,6 +599,8 @@ func (cd *commandDefinition) applyFlagsToCommand(cmd *cobra.Command) {
 		cmd.Flags().StringP("output", "o", "table", "output format: json|table|yaml|raw")
 	case query:
 		cmd.Flags().StringP("output", "o", "table", "output format: json|table|yaml|raw")
+	case analyze:
+		cmd.Flags().StringP("output", "o", "table", "output format: json|table|yaml|raw")
 	default:
 		cmd.Flags().StringP("output", "o", "yaml", "output format: json|table|yaml|raw")
 	}
@@ -64,7 +64,7 @@ func TestGetDebugCommands(t *testing.T) {
 		{
 			name:     "Antctl running against controller mode",
 			mode:     "controller",
-			expected: [][]string{{"version"}, {"get", "networkpolicy"}, {"get", "appliedtogroup"}, {"get", "addressgroup"}, {"get", "controllerinfo"}, {"supportbundle"}, {"traceflow"}, {"get", "featuregates"}},
+			expected: [][]string{{"version"}, {"get", "networkpolicy"}, {"get", "appliedtogroup"}, {"get", "addressgroup"}, {"get", "controllerinfo"}, {"get", "unusedrules"}, {"analyze", "policies"}, {"supportbundle"}, {"traceflow"}, {"get", "featuregates"}},
 		},
 		{
 			name:     "Antctl running against agent mode",
@@ -74,7 +74,7 @@ func TestGetDebugCommands(t *testing.T) {
 		{
 			name:     "Antctl running against flow-aggregator mode",
 			mode:     "flowaggregator",
-			expected: [][]string{{"version"}, {"log-level"}, {"get", "flowrecords"}, {"get", "recordmetrics"}},
+			expected: [][]string{{"version"}, {"log-level"}, {"get", "flowrecords"}, {"get", "recordmetrics"}, {"get", "flowgraph"}},
 		},
 	}
 	for _, tt := range tc {
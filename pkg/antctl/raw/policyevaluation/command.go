@@ -0,0 +1,278 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyevaluation
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	k8sscheme "k8s.io/client-go/kubernetes/scheme"
+	policyscheme "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/scheme"
+
+	"antrea.io/antrea/pkg/antctl/output"
+	"antrea.io/antrea/pkg/antctl/transform/networkpolicy"
+	"antrea.io/antrea/pkg/apis/controlplane"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	antreascheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	npcontroller "antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+const defaultTimeout = time.Minute
+
+var (
+	Command *cobra.Command
+	option  = &struct {
+		files       []string
+		source      string
+		destination string
+		protocol    string
+		port        int32
+		outputType  string
+	}{}
+	newEvaluator = newOfflineEvaluator
+
+	scheme       = runtime.NewScheme()
+	deserializer runtime.Decoder
+)
+
+// evaluator evaluates the effective NetworkPolicy rule between two endpoints.
+type evaluator interface {
+	QueryNetworkPolicyEvaluation(entities *controlplane.NetworkPolicyEvaluationRequest) (*controlplane.NetworkPolicyEvaluationResponse, error)
+	QueryNetworkPolicyEvaluationForPort(entities *controlplane.NetworkPolicyEvaluationRequest, protocol controlplane.Protocol, port int32) (*controlplane.NetworkPolicyEvaluationResponse, error)
+}
+
+func newOfflineEvaluator(ctx context.Context, objects []runtime.Object) (evaluator, error) {
+	return npcontroller.NewOfflinePolicyRuleQuerier(ctx, objects)
+}
+
+func init() {
+	utilruntime.Must(k8sscheme.AddToScheme(scheme))
+	utilruntime.Must(antreascheme.AddToScheme(scheme))
+	utilruntime.Must(policyscheme.AddToScheme(scheme))
+	deserializer = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	Command = &cobra.Command{
+		Use:     "offlinenetworkpolicyevaluation",
+		Aliases: []string{"offlinenetworkpolicyeval", "offlinenetpoleval"},
+		Short:   "Analyze effective NetworkPolicy rules using resources loaded from files.",
+		Long: `Analyze the NetworkPolicies defined in the provided files and return the rule expected to be effective on the source and destination Pods provided.
+The files must include all the resources relevant to the evaluation: Namespaces, Pods, Services, Nodes, ExternalEntities, Tiers, ClusterGroups, Groups, and K8s NetworkPolicies, Antrea-native policies, AdminNetworkPolicies and BaselineAdminNetworkPolicies.
+Namespaces referenced by other resources and the system generated Tiers are created automatically when missing. No access to a K8s cluster is required.`,
+		Example: `  Query the effective NetworkPolicy rule between two Pods defined in a file
+  $ antctl query offlinenetworkpolicyevaluation -f snapshot.yaml -S ns1/pod1 -D ns2/pod2
+  Query the effective NetworkPolicy rule for TCP traffic to port 8080, using all the manifests in a directory
+  $ antctl query offlinenetworkpolicyevaluation -f manifests/ -S ns1/pod1 -D ns2/pod2 --port 8080
+`,
+		RunE: runE,
+		Args: cobra.NoArgs,
+	}
+	Command.Flags().StringSliceVarP(&option.files, "file", "f", nil, "file or directory containing the resources in YAML or JSON format, can be repeated")
+	Command.Flags().StringVarP(&option.source, "source", "S", "", "source Pod, specified by <Namespace>/<name>")
+	Command.Flags().StringVarP(&option.destination, "destination", "D", "", "destination Pod, specified by <Namespace>/<name>")
+	Command.Flags().StringVar(&option.protocol, "protocol", string(controlplane.ProtocolTCP), "protocol of the traffic sent to the destination port: TCP, UDP or SCTP")
+	Command.Flags().Int32Var(&option.port, "port", 0, "destination port of the traffic, if not set the rules are evaluated regardless of their ports")
+	Command.Flags().StringVarP(&option.outputType, "output", "o", "table", "output type: table (default), yaml, json")
+}
+
+func runE(cmd *cobra.Command, _ []string) error {
+	if len(option.files) == 0 {
+		return fmt.Errorf("at least one file must be provided with --file")
+	}
+	if option.source == "" || option.destination == "" {
+		return fmt.Errorf("both --source and --destination must be provided")
+	}
+	protocol := controlplane.Protocol(strings.ToUpper(option.protocol))
+	switch protocol {
+	case controlplane.ProtocolTCP, controlplane.ProtocolUDP, controlplane.ProtocolSCTP:
+	default:
+		return fmt.Errorf("unsupported protocol %s", option.protocol)
+	}
+	if option.port < 0 || option.port > 65535 {
+		return fmt.Errorf("invalid port %d", option.port)
+	}
+	var objects []runtime.Object
+	for _, file := range option.files {
+		fileObjects, err := loadObjects(file)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, fileObjects...)
+	}
+
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+	defer cancel()
+	e, err := newEvaluator(ctx, objects)
+	if err != nil {
+		return fmt.Errorf("error when loading resources: %w", err)
+	}
+	request := &controlplane.NetworkPolicyEvaluationRequest{
+		Source:      controlplane.Entity{Pod: parsePod(option.source)},
+		Destination: controlplane.Entity{Pod: parsePod(option.destination)},
+	}
+	var response *controlplane.NetworkPolicyEvaluationResponse
+	if option.port == 0 {
+		response, err = e.QueryNetworkPolicyEvaluation(request)
+	} else {
+		response, err = e.QueryNetworkPolicyEvaluationForPort(request, protocol, option.port)
+	}
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		fmt.Fprintln(cmd.OutOrStdout(), "No NetworkPolicy rule applies to the traffic")
+		return nil
+	}
+	return outputResponse(request, response, cmd.OutOrStdout())
+}
+
+// parsePod parses a Pod specified by <Namespace>/<name> or <name>, in which case the default Namespace is used.
+func parsePod(str string) *controlplane.PodReference {
+	if namespace, name, found := strings.Cut(str, "/"); found {
+		return &controlplane.PodReference{Namespace: namespace, Name: name}
+	}
+	return &controlplane.PodReference{Namespace: "default", Name: str}
+}
+
+// loadObjects loads all the objects defined in a file, or in the .yaml, .yml and .json files of a directory.
+// A file can contain multiple YAML documents, and v1 Lists are expanded.
+func loadObjects(path string) ([]runtime.Object, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return loadObjectsFromFile(path)
+	}
+	var objects []runtime.Object
+	err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		fileObjects, err := loadObjectsFromFile(p)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, fileObjects...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func loadObjectsFromFile(path string) ([]runtime.Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var objects []runtime.Object
+	reader := yamlutil.NewYAMLReader(bufio.NewReader(f))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error when reading %s: %w", path, err)
+		}
+		data, err := yamlutil.ToJSON(doc)
+		if err != nil {
+			return nil, fmt.Errorf("error when decoding %s: %w", path, err)
+		}
+		// Documents which are empty or only contain comments are skipped.
+		if bytes.Equal(data, []byte("null")) {
+			continue
+		}
+		docObjects, err := decodeObject(data)
+		if err != nil {
+			return nil, fmt.Errorf("error when decoding %s: %w", path, err)
+		}
+		objects = append(objects, docObjects...)
+	}
+	return objects, nil
+}
+
+func decodeObject(data []byte) ([]runtime.Object, error) {
+	obj, _, err := deserializer.Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !meta.IsListType(obj) {
+		return []runtime.Object{obj}, nil
+	}
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		return nil, err
+	}
+	var objects []runtime.Object
+	for _, item := range items {
+		// Items of a v1 List are not decoded, since their type is not known in advance.
+		if unknown, ok := item.(*runtime.Unknown); ok {
+			itemObjects, err := decodeObject(unknown.Raw)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, itemObjects...)
+		} else {
+			objects = append(objects, item)
+		}
+	}
+	return objects, nil
+}
+
+func outputResponse(request *controlplane.NetworkPolicyEvaluationRequest, response *controlplane.NetworkPolicyEvaluationResponse, writer io.Writer) error {
+	evaluation := &controlplane.NetworkPolicyEvaluation{Request: request, Response: response}
+	var result cpv1beta.NetworkPolicyEvaluation
+	if err := cpv1beta.Convert_controlplane_NetworkPolicyEvaluation_To_v1beta2_NetworkPolicyEvaluation(evaluation, &result, nil); err != nil {
+		return err
+	}
+	switch option.outputType {
+	case "json":
+		return output.JsonOutput(result, writer)
+	case "yaml":
+		return output.YamlOutput(result, writer)
+	case "table":
+		return output.TableOutputForGetCommands(networkpolicy.EvaluationResponse{NetworkPolicyEvaluation: &result}, writer)
+	default:
+		return fmt.Errorf("unsupported output type %s", option.outputType)
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyevaluation
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+const (
+	namespaceYAML = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: ns1
+---
+# Empty document
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: pod1
+  namespace: ns1
+`
+	listYAML = `apiVersion: v1
+kind: List
+items:
+- apiVersion: crd.antrea.io/v1beta1
+  kind: ClusterNetworkPolicy
+  metadata:
+    name: acnp1
+- apiVersion: policy.networking.k8s.io/v1alpha1
+  kind: AdminNetworkPolicy
+  metadata:
+    name: anp1
+`
+	podJSON = `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "pod2", "namespace": "ns2"}}`
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func objectNames(objects []runtime.Object) []string {
+	var names []string
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *corev1.Namespace:
+			names = append(names, "Namespace:"+o.Name)
+		case *corev1.Pod:
+			names = append(names, "Pod:"+o.Namespace+"/"+o.Name)
+		case *crdv1beta1.ClusterNetworkPolicy:
+			names = append(names, "ClusterNetworkPolicy:"+o.Name)
+		case *policyv1alpha1.AdminNetworkPolicy:
+			names = append(names, "AdminNetworkPolicy:"+o.Name)
+		}
+	}
+	return names
+}
+
+func TestLoadObjects(t *testing.T) {
+	dir := t.TempDir()
+	namespaceFile := writeFile(t, dir, "namespace.yaml", namespaceYAML)
+	writeFile(t, dir, "list.yml", listYAML)
+	writeFile(t, dir, "pod.json", podJSON)
+	writeFile(t, dir, "README.md", "not a manifest")
+	invalidDir := t.TempDir()
+	invalidFile := writeFile(t, invalidDir, "invalid.yaml", "apiVersion: v1\nkind: Foo\n")
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedNames []string
+		expectedErr   string
+	}{
+		{
+			name:          "multiple documents",
+			path:          namespaceFile,
+			expectedNames: []string{"Namespace:ns1", "Pod:ns1/pod1"},
+		},
+		{
+			name:          "directory",
+			path:          dir,
+			expectedNames: []string{"ClusterNetworkPolicy:acnp1", "AdminNetworkPolicy:anp1", "Namespace:ns1", "Pod:ns1/pod1", "Pod:ns2/pod2"},
+		},
+		{
+			name:        "unknown kind",
+			path:        invalidFile,
+			expectedErr: "error when decoding",
+		},
+		{
+			name:        "missing file",
+			path:        filepath.Join(dir, "missing.yaml"),
+			expectedErr: "no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects, err := loadObjects(tt.path)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNames, objectNames(objects))
+		})
+	}
+}
+
+type fakeEvaluator struct {
+	request  *controlplane.NetworkPolicyEvaluationRequest
+	protocol controlplane.Protocol
+	port     int32
+	response *controlplane.NetworkPolicyEvaluationResponse
+}
+
+func (e *fakeEvaluator) QueryNetworkPolicyEvaluation(entities *controlplane.NetworkPolicyEvaluationRequest) (*controlplane.NetworkPolicyEvaluationResponse, error) {
+	e.request = entities
+	return e.response, nil
+}
+
+func (e *fakeEvaluator) QueryNetworkPolicyEvaluationForPort(entities *controlplane.NetworkPolicyEvaluationRequest, protocol controlplane.Protocol, port int32) (*controlplane.NetworkPolicyEvaluationResponse, error) {
+	e.request = entities
+	e.protocol = protocol
+	e.port = port
+	return e.response, nil
+}
+
+func TestRunE(t *testing.T) {
+	dir := t.TempDir()
+	file := writeFile(t, dir, "namespace.yaml", namespaceYAML)
+	response := &controlplane.NetworkPolicyEvaluationResponse{
+		NetworkPolicy: controlplane.NetworkPolicyReference{
+			Type:      controlplane.AntreaClusterNetworkPolicy,
+			Name:      "acnp1",
+			Namespace: "",
+		},
+		RuleIndex: 0,
+		Rule: controlplane.RuleRef{
+			Direction: controlplane.DirectionIn,
+			Action:    ptr.To(crdv1beta1.RuleActionDrop),
+		},
+	}
+	tests := []struct {
+		name             string
+		files            []string
+		source           string
+		destination      string
+		protocol         string
+		port             int32
+		outputType       string
+		response         *controlplane.NetworkPolicyEvaluationResponse
+		expectedRequest  *controlplane.NetworkPolicyEvaluationRequest
+		expectedProtocol controlplane.Protocol
+		expectedPort     int32
+		expectedOutput   string
+		expectedErr      string
+	}{
+		{
+			name:        "rule without port",
+			files:       []string{file},
+			source:      "ns1/pod1",
+			destination: "pod2",
+			protocol:    "TCP",
+			outputType:  "table",
+			response:    response,
+			expectedRequest: &controlplane.NetworkPolicyEvaluationRequest{
+				Source:      controlplane.Entity{Pod: &controlplane.PodReference{Namespace: "ns1", Name: "pod1"}},
+				Destination: controlplane.Entity{Pod: &controlplane.PodReference{Namespace: "default", Name: "pod2"}},
+			},
+			expectedOutput: "NAME  NAMESPACE POLICY-TYPE                RULE-INDEX DIRECTION ACTION\nacnp1 <NONE>    AntreaClusterNetworkPolicy 0          In        Drop  \n",
+		},
+		{
+			name:        "rule with port",
+			files:       []string{file},
+			source:      "ns1/pod1",
+			destination: "ns1/pod2",
+			protocol:    "udp",
+			port:        53,
+			outputType:  "table",
+			expectedRequest: &controlplane.NetworkPolicyEvaluationRequest{
+				Source:      controlplane.Entity{Pod: &controlplane.PodReference{Namespace: "ns1", Name: "pod1"}},
+				Destination: controlplane.Entity{Pod: &controlplane.PodReference{Namespace: "ns1", Name: "pod2"}},
+			},
+			expectedProtocol: controlplane.ProtocolUDP,
+			expectedPort:     53,
+			expectedOutput:   "No NetworkPolicy rule applies to the traffic\n",
+		},
+		{
+			name:        "missing file",
+			source:      "ns1/pod1",
+			destination: "ns1/pod2",
+			protocol:    "TCP",
+			expectedErr: "at least one file must be provided",
+		},
+		{
+			name:        "missing destination",
+			files:       []string{file},
+			source:      "ns1/pod1",
+			protocol:    "TCP",
+			expectedErr: "both --source and --destination must be provided",
+		},
+		{
+			name:        "invalid protocol",
+			files:       []string{file},
+			source:      "ns1/pod1",
+			destination: "ns1/pod2",
+			protocol:    "ICMP",
+			expectedErr: "unsupported protocol ICMP",
+		},
+		{
+			name:        "invalid port",
+			files:       []string{file},
+			source:      "ns1/pod1",
+			destination: "ns1/pod2",
+			protocol:    "TCP",
+			port:        65536,
+			expectedErr: "invalid port 65536",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &fakeEvaluator{response: tt.response}
+			defer func(f func(context.Context, []runtime.Object) (evaluator, error)) {
+				newEvaluator = f
+			}(newEvaluator)
+			newEvaluator = func(_ context.Context, objects []runtime.Object) (evaluator, error) {
+				assert.Equal(t, []string{"Namespace:ns1", "Pod:ns1/pod1"}, objectNames(objects))
+				return e, nil
+			}
+			option.files = tt.files
+			option.source = tt.source
+			option.destination = tt.destination
+			option.protocol = tt.protocol
+			option.port = tt.port
+			option.outputType = tt.outputType
+
+			buf := new(bytes.Buffer)
+			Command.SetOut(buf)
+			Command.SetContext(context.Background())
+			err := runE(Command, nil)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRequest, e.request)
+			assert.Equal(t, tt.expectedProtocol, e.protocol)
+			assert.Equal(t, tt.expectedPort, e.port)
+			assert.Equal(t, tt.expectedOutput, buf.String())
+		})
+	}
+}
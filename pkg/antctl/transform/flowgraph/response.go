@@ -0,0 +1,40 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowgraph
+
+import (
+	"encoding/json"
+	"io"
+
+	"antrea.io/antrea/pkg/flowaggregator/apis"
+)
+
+// Transform is the AddonTransform for the flowgraph command. When the graph is requested in the
+// DOT format, the response is output as is, regardless of the output format. Otherwise, the
+// response is decoded as a list of edges.
+func Transform(reader io.Reader, _ bool, opts map[string]string) (interface{}, error) {
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if opts["format"] == "dot" {
+		return b, nil
+	}
+	var edges []apis.FlowGraphEdgeResponse
+	if err := json.Unmarshal(b, &edges); err != nil {
+		return nil, err
+	}
+	return edges, nil
+}
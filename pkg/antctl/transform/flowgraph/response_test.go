@@ -0,0 +1,46 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowgraph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/flowaggregator/apis"
+)
+
+func TestTransform(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		resp := `[{"source":{"kind":"Deployment","namespace":"default","name":"client"},"destination":{"kind":"Service","namespace":"default","name":"web"},"bytes":1000,"packets":10,"connections":1}]`
+		obj, err := Transform(strings.NewReader(resp), false, map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, []apis.FlowGraphEdgeResponse{{
+			Source:      apis.FlowGraphNode{Kind: "Deployment", Namespace: "default", Name: "client"},
+			Destination: apis.FlowGraphNode{Kind: "Service", Namespace: "default", Name: "web"},
+			Bytes:       1000,
+			Packets:     10,
+			Connections: 1,
+		}}, obj)
+	})
+	t.Run("dot", func(t *testing.T) {
+		resp := "digraph flowgraph {\n}\n"
+		obj, err := Transform(strings.NewReader(resp), false, map[string]string{"format": "dot"})
+		require.NoError(t, err)
+		assert.Equal(t, []byte(resp), obj)
+	})
+}
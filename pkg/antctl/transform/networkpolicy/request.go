@@ -57,3 +57,9 @@ func NewNetworkPolicyEvaluation(args map[string]string) (runtime.Object, error)
 		},
 	}, nil
 }
+
+// NewNetworkPolicyAnalysis creates a new NetworkPolicyAnalysis resource. The analysis covers all the
+// NetworkPolicies in the cluster and does not take any parameter.
+func NewNetworkPolicyAnalysis(_ map[string]string) (runtime.Object, error) {
+	return &cpv1beta.NetworkPolicyAnalysis{}, nil
+}
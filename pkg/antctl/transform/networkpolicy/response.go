@@ -215,3 +215,64 @@ func (r EvaluationResponse) GetTableRow(_ int) []string {
 func (r EvaluationResponse) SortRows() bool {
 	return false
 }
+
+// AnalysisResponse stores a finding from the NetworkPolicyAnalysis command.
+type AnalysisResponse struct {
+	*cpv1beta.NetworkPolicyAnalysisFinding
+}
+
+func AnalysisTransform(reader io.Reader, _ bool, _ map[string]string) (interface{}, error) {
+	var analysis cpv1beta.NetworkPolicyAnalysis
+	if err := json.NewDecoder(reader).Decode(&analysis); err != nil {
+		return nil, err
+	}
+	if len(analysis.Findings) == 0 {
+		return "", nil
+	}
+	result := make([]AnalysisResponse, 0, len(analysis.Findings))
+	for i := range analysis.Findings {
+		result = append(result, AnalysisResponse{&analysis.Findings[i]})
+	}
+	return result, nil
+}
+
+var _ common.TableOutput = new(AnalysisResponse)
+
+func (r AnalysisResponse) GetTableHeader() []string {
+	return []string{"TYPE", "DIRECTION", "POLICY", "RULE", "ACTION", "RELATED-POLICY", "RELATED-RULE", "RELATED-ACTION"}
+}
+
+// ruleToString returns the name of the rule, or its index if it has no name, e.g. for K8s NetworkPolicy rules.
+func ruleToString(r *cpv1beta.PolicyRuleReference) string {
+	if r.Rule.Name != "" {
+		return r.Rule.Name
+	}
+	return "#" + strconv.Itoa(int(r.RuleIndex))
+}
+
+func actionToString(r *cpv1beta.PolicyRuleReference) string {
+	if r.Rule.Action == nil {
+		return string(v1beta1.RuleActionAllow)
+	}
+	return string(*r.Rule.Action)
+}
+
+func (r AnalysisResponse) GetTableRow(_ int) []string {
+	if r.NetworkPolicyAnalysisFinding == nil {
+		return make([]string, len(r.GetTableHeader()))
+	}
+	return []string{
+		string(r.Type),
+		string(r.Rule.Rule.Direction),
+		r.Rule.NetworkPolicy.ToString(),
+		ruleToString(&r.Rule),
+		actionToString(&r.Rule),
+		r.RelatedRule.NetworkPolicy.ToString(),
+		ruleToString(&r.RelatedRule),
+		actionToString(&r.RelatedRule),
+	}
+}
+
+func (r AnalysisResponse) SortRows() bool {
+	return false
+}
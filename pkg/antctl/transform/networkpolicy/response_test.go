@@ -15,6 +15,8 @@
 package networkpolicy
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
 	"testing"
@@ -250,3 +252,39 @@ func TestEvaluationResponseTransform(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalysisTransform(t *testing.T) {
+	dropAction := crdv1beta1.RuleActionDrop
+	analysis := cpv1beta.NetworkPolicyAnalysis{
+		Findings: []cpv1beta.NetworkPolicyAnalysisFinding{
+			{
+				Type: cpv1beta.NetworkPolicyAnalysisFindingShadowed,
+				Rule: cpv1beta.PolicyRuleReference{
+					NetworkPolicy: cpv1beta.NetworkPolicyReference{Type: cpv1beta.K8sNetworkPolicy, Namespace: "ns", Name: "testK8s"},
+					RuleIndex:     1,
+					Rule:          cpv1beta.RuleRef{Direction: cpv1beta.DirectionIn},
+				},
+				RelatedRule: cpv1beta.PolicyRuleReference{
+					NetworkPolicy: cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaClusterNetworkPolicy, Name: "testACNP"},
+					RuleIndex:     2,
+					Rule:          cpv1beta.RuleRef{Direction: cpv1beta.DirectionIn, Name: "drop-all", Action: &dropAction},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(analysis)
+	require.NoError(t, err)
+	result, err := AnalysisTransform(bytes.NewReader(data), false, nil)
+	require.NoError(t, err)
+	responses := result.([]AnalysisResponse)
+	require.Len(t, responses, 1)
+	assert.Equal(t, []string{"TYPE", "DIRECTION", "POLICY", "RULE", "ACTION", "RELATED-POLICY", "RELATED-RULE", "RELATED-ACTION"}, responses[0].GetTableHeader())
+	assert.False(t, responses[0].SortRows())
+	assert.Equal(t, []string{"Shadowed", "In", "K8sNetworkPolicy:ns/testK8s", "#1", "Allow", "AntreaClusterNetworkPolicy:testACNP", "drop-all", "Drop"}, responses[0].GetTableRow(32))
+
+	data, err = json.Marshal(cpv1beta.NetworkPolicyAnalysis{})
+	require.NoError(t, err)
+	result, err = AnalysisTransform(bytes.NewReader(data), false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", result)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unusedrule
+
+import (
+	"io"
+	"reflect"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/antctl/transform"
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+type Response struct {
+	PolicyType      string       `json:"policyType" yaml:"policyType"`
+	PolicyNamespace string       `json:"policyNamespace,omitempty" yaml:"policyNamespace,omitempty"`
+	PolicyName      string       `json:"policyName" yaml:"policyName"`
+	RuleName        string       `json:"ruleName" yaml:"ruleName"`
+	LastHitTime     *metav1.Time `json:"lastHitTime,omitempty" yaml:"lastHitTime,omitempty"`
+	// TrackedSince is the time since which the hits of the rule have been tracked.
+	TrackedSince metav1.Time `json:"trackedSince" yaml:"trackedSince"`
+}
+
+func listTransform(l interface{}, opts map[string]string) (interface{}, error) {
+	rules := l.(*statsv1alpha1.UnusedRuleList)
+	if len(rules.Items) == 0 {
+		return "", nil
+	}
+	result := make([]Response, 0, len(rules.Items))
+	for i := range rules.Items {
+		o, _ := objectTransform(&rules.Items[i], opts)
+		result = append(result, o.(Response))
+	}
+	return result, nil
+}
+
+func objectTransform(o interface{}, _ map[string]string) (interface{}, error) {
+	rule := o.(*statsv1alpha1.UnusedRule)
+	return Response{
+		PolicyType:      rule.PolicyType,
+		PolicyNamespace: rule.PolicyNamespace,
+		PolicyName:      rule.PolicyName,
+		RuleName:        rule.RuleName,
+		LastHitTime:     rule.LastHitTime,
+		TrackedSince:    rule.CreationTimestamp,
+	}, nil
+}
+
+func Transform(reader io.Reader, single bool, opts map[string]string) (interface{}, error) {
+	return transform.GenericFactory(
+		reflect.TypeOf(statsv1alpha1.UnusedRule{}),
+		reflect.TypeOf(statsv1alpha1.UnusedRuleList{}),
+		objectTransform,
+		listTransform,
+		opts,
+	)(reader, single)
+}
+
+var _ common.TableOutput = new(Response)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"POLICY-TYPE", "NAMESPACE", "POLICY", "RULE", "LAST-HIT", "TRACKED-SINCE"}
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "<never>"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func (r Response) GetTableRow(_ int) []string {
+	return []string{r.PolicyType, r.PolicyNamespace, r.PolicyName, r.RuleName, formatTime(r.LastHitTime), formatTime(&r.TrackedSince)}
+}
+
+func (r Response) SortRows() bool {
+	return true
+}
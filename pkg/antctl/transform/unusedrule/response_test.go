@@ -0,0 +1,91 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unusedrule
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+func TestTransform(t *testing.T) {
+	trackedSince := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Local())
+	lastHitTime := metav1.NewTime(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC).Local())
+	list := &statsv1alpha1.UnusedRuleList{
+		Items: []statsv1alpha1.UnusedRule{
+			{
+				ObjectMeta:  metav1.ObjectMeta{Name: "uid1.rule1", CreationTimestamp: trackedSince},
+				PolicyType:  "AntreaClusterNetworkPolicy",
+				PolicyName:  "acnp1",
+				RuleName:    "rule1",
+				LastHitTime: &lastHitTime,
+			},
+			{
+				ObjectMeta:      metav1.ObjectMeta{Name: "uid2.rule2", CreationTimestamp: trackedSince},
+				PolicyType:      "AntreaNetworkPolicy",
+				PolicyNamespace: "ns1",
+				PolicyName:      "annp1",
+				RuleName:        "rule2",
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		list             *statsv1alpha1.UnusedRuleList
+		expectedResponse interface{}
+		expectedRows     [][]string
+	}{
+		{
+			name: "multiple rules",
+			list: list,
+			expectedResponse: []Response{
+				{PolicyType: "AntreaClusterNetworkPolicy", PolicyName: "acnp1", RuleName: "rule1", LastHitTime: &lastHitTime, TrackedSince: trackedSince},
+				{PolicyType: "AntreaNetworkPolicy", PolicyNamespace: "ns1", PolicyName: "annp1", RuleName: "rule2", TrackedSince: trackedSince},
+			},
+			expectedRows: [][]string{
+				{"AntreaClusterNetworkPolicy", "", "acnp1", "rule1", "2026-01-02T00:00:00Z", "2026-01-01T00:00:00Z"},
+				{"AntreaNetworkPolicy", "ns1", "annp1", "rule2", "<never>", "2026-01-01T00:00:00Z"},
+			},
+		},
+		{
+			name:             "no rule",
+			list:             &statsv1alpha1.UnusedRuleList{},
+			expectedResponse: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.list)
+			require.NoError(t, err)
+			result, err := Transform(bytes.NewReader(b), false, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResponse, result)
+			if tt.expectedRows != nil {
+				responses := result.([]Response)
+				for i := range responses {
+					assert.Equal(t, tt.expectedRows[i], responses[i].GetTableRow(0))
+				}
+			}
+		})
+	}
+}
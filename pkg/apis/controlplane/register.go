@@ -55,6 +55,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkPolicyList{},
 		&NetworkPolicyStatus{},
 		&NetworkPolicyEvaluation{},
+		&NetworkPolicyAnalysis{},
 		&NodeStatsSummary{},
 		&ClusterGroupMembers{},
 		&GroupMembers{},
@@ -234,6 +234,9 @@ type NetworkPolicy struct {
 	TierPriority *int32
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference
+	// EnforcementMode specifies whether the Drop and Reject actions of the rules are enforced.
+	// It is empty for K8s NetworkPolicy, which means the rules are enforced.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -277,6 +280,31 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string
+	// RateLimit specifies the rate to which the traffic matching the rule is policed.
+	// It is only set for rules whose action is RateLimit.
+	RateLimit *RateLimit
+	// LogSampling specifies how the connections logged for the rule are sampled.
+	// It is only set for Allow rules with EnableLogging set to true.
+	LogSampling *LogSampling
+}
+
+// LogSampling describes how the connections logged for a rule are sampled.
+// Exactly one of Ratio and MaxPerSecond is set.
+type LogSampling struct {
+	// Ratio logs 1 of every Ratio connections.
+	Ratio int32
+	// MaxPerSecond logs at most MaxPerSecond connections per second.
+	MaxPerSecond int32
+}
+
+// RateLimit describes the rate to which the traffic matching a rule is policed.
+type RateLimit struct {
+	// Unit of Rate and Burst.
+	Unit crdv1beta1.RateLimitUnit
+	// Rate is the maximum rate of the traffic, in packets per second or bits per second.
+	Rate int64
+	// Burst is the maximum burst size, in packets or bits.
+	Burst int64
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -330,6 +358,8 @@ type Service struct {
 type L7Protocol struct {
 	HTTP *HTTPProtocol
 	TLS  *TLSProtocol
+	GRPC *GRPCProtocol
+	DNS  *DNSProtocol
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All
@@ -344,6 +374,39 @@ type HTTPProtocol struct {
 	Method string
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string
+	// Headers is a list of HTTP request headers to match. A request is matched
+	// only if all the headers are matched.
+	Headers []HTTPHeaderMatcher
+}
+
+// HTTPHeaderMatcher matches an HTTP request header with a specific name and,
+// optionally, a specific value.
+type HTTPHeaderMatcher struct {
+	// Name is the name of the header to match. It is case-insensitive.
+	Name string
+	// Value is the exact value of the header to match. If it is not provided,
+	// any request with the header is matched.
+	Value string
+}
+
+// GRPCProtocol matches gRPC requests with specific service and method. All
+// fields could be used alone or together. If all fields are not provided, this
+// matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match.
+	Service string
+	// Method represents the name of the gRPC method to match.
+	Method string
+}
+
+// DNSProtocol matches DNS queries with specific query name and query types. All
+// fields could be used alone or together. If all fields are not provided, this
+// matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match.
+	QueryName string
+	// QueryTypes represents the types of DNS query to match.
+	QueryTypes []string
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -485,6 +548,49 @@ type NetworkPolicyEvaluationResponse struct {
 	Rule RuleRef
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkPolicyAnalysis contains the result of the analysis of the rules of all NetworkPolicies.
+type NetworkPolicyAnalysis struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	// Findings is the list of shadowed, redundant and conflicting rules.
+	Findings []NetworkPolicyAnalysisFinding
+}
+
+// NetworkPolicyAnalysisFindingType is the type of a NetworkPolicyAnalysisFinding.
+type NetworkPolicyAnalysisFindingType string
+
+const (
+	// NetworkPolicyAnalysisFindingShadowed means that the rule is fully covered by a rule with a higher
+	// precedence and a different action, so it never takes effect.
+	NetworkPolicyAnalysisFindingShadowed NetworkPolicyAnalysisFindingType = "Shadowed"
+	// NetworkPolicyAnalysisFindingRedundant means that the rule is fully covered by a rule with a higher or
+	// equal precedence and the same action, so it can be removed without changing the behavior.
+	NetworkPolicyAnalysisFindingRedundant NetworkPolicyAnalysisFindingType = "Redundant"
+	// NetworkPolicyAnalysisFindingConflicting means that the rule overlaps with a rule with the same precedence
+	// and a different action, so the action applied to the overlapping traffic is undefined.
+	NetworkPolicyAnalysisFindingConflicting NetworkPolicyAnalysisFindingType = "Conflicting"
+)
+
+// NetworkPolicyAnalysisFinding describes an issue detected between two rules.
+type NetworkPolicyAnalysisFinding struct {
+	Type NetworkPolicyAnalysisFindingType
+	// The rule the finding is about.
+	Rule PolicyRuleReference
+	// The rule which shadows Rule, makes it redundant, or conflicts with it.
+	RelatedRule PolicyRuleReference
+}
+
+// PolicyRuleReference references a rule of a NetworkPolicy.
+type PolicyRuleReference struct {
+	// The reference of the original NetworkPolicy.
+	NetworkPolicy NetworkPolicyReference
+	// The index of the rule among the rules of the same direction in the original NetworkPolicy.
+	RuleIndex int32
+	Rule      RuleRef
+}
+
 type GroupReference struct {
 	// Namespace of the Group. Empty for ClusterGroup.
 	Namespace string
@@ -328,10 +328,38 @@ func (m *ClusterGroupMembers) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterGroupMembers proto.InternalMessageInfo
 
+func (m *DNSProtocol) Reset()      { *m = DNSProtocol{} }
+func (*DNSProtocol) ProtoMessage() {}
+func (*DNSProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+}
+func (m *DNSProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DNSProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DNSProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DNSProtocol.Merge(m, src)
+}
+func (m *DNSProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *DNSProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_DNSProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DNSProtocol proto.InternalMessageInfo
+
 func (m *EgressGroup) Reset()      { *m = EgressGroup{} }
 func (*EgressGroup) ProtoMessage() {}
 func (*EgressGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+	return fileDescriptor_fbaa7d016762fa1d, []int{11}
 }
 func (m *EgressGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +387,7 @@ var xxx_messageInfo_EgressGroup proto.InternalMessageInfo
 func (m *EgressGroupList) Reset()      { *m = EgressGroupList{} }
 func (*EgressGroupList) ProtoMessage() {}
 func (*EgressGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{11}
+	return fileDescriptor_fbaa7d016762fa1d, []int{12}
 }
 func (m *EgressGroupList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +415,7 @@ var xxx_messageInfo_EgressGroupList proto.InternalMessageInfo
 func (m *EgressGroupPatch) Reset()      { *m = EgressGroupPatch{} }
 func (*EgressGroupPatch) ProtoMessage() {}
 func (*EgressGroupPatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{12}
+	return fileDescriptor_fbaa7d016762fa1d, []int{13}
 }
 func (m *EgressGroupPatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +443,7 @@ var xxx_messageInfo_EgressGroupPatch proto.InternalMessageInfo
 func (m *Entity) Reset()      { *m = Entity{} }
 func (*Entity) ProtoMessage() {}
 func (*Entity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{13}
+	return fileDescriptor_fbaa7d016762fa1d, []int{14}
 }
 func (m *Entity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +471,7 @@ var xxx_messageInfo_Entity proto.InternalMessageInfo
 func (m *ExternalEntityReference) Reset()      { *m = ExternalEntityReference{} }
 func (*ExternalEntityReference) ProtoMessage() {}
 func (*ExternalEntityReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{14}
+	return fileDescriptor_fbaa7d016762fa1d, []int{15}
 }
 func (m *ExternalEntityReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,10 +496,38 @@ func (m *ExternalEntityReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExternalEntityReference proto.InternalMessageInfo
 
+func (m *GRPCProtocol) Reset()      { *m = GRPCProtocol{} }
+func (*GRPCProtocol) ProtoMessage() {}
+func (*GRPCProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{16}
+}
+func (m *GRPCProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCProtocol.Merge(m, src)
+}
+func (m *GRPCProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCProtocol proto.InternalMessageInfo
+
 func (m *GroupAssociation) Reset()      { *m = GroupAssociation{} }
 func (*GroupAssociation) ProtoMessage() {}
 func (*GroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+	return fileDescriptor_fbaa7d016762fa1d, []int{17}
 }
 func (m *GroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +555,7 @@ var xxx_messageInfo_GroupAssociation proto.InternalMessageInfo
 func (m *GroupMember) Reset()      { *m = GroupMember{} }
 func (*GroupMember) ProtoMessage() {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{16}
+	return fileDescriptor_fbaa7d016762fa1d, []int{18}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +583,7 @@ var xxx_messageInfo_GroupMember proto.InternalMessageInfo
 func (m *GroupMembers) Reset()      { *m = GroupMembers{} }
 func (*GroupMembers) ProtoMessage() {}
 func (*GroupMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{17}
+	return fileDescriptor_fbaa7d016762fa1d, []int{19}
 }
 func (m *GroupMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +611,7 @@ var xxx_messageInfo_GroupMembers proto.InternalMessageInfo
 func (m *GroupReference) Reset()      { *m = GroupReference{} }
 func (*GroupReference) ProtoMessage() {}
 func (*GroupReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{18}
+	return fileDescriptor_fbaa7d016762fa1d, []int{20}
 }
 func (m *GroupReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,10 +636,38 @@ func (m *GroupReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupReference proto.InternalMessageInfo
 
+func (m *HTTPHeaderMatcher) Reset()      { *m = HTTPHeaderMatcher{} }
+func (*HTTPHeaderMatcher) ProtoMessage() {}
+func (*HTTPHeaderMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+}
+func (m *HTTPHeaderMatcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeaderMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeaderMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderMatcher.Merge(m, src)
+}
+func (m *HTTPHeaderMatcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeaderMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeaderMatcher proto.InternalMessageInfo
+
 func (m *HTTPProtocol) Reset()      { *m = HTTPProtocol{} }
 func (*HTTPProtocol) ProtoMessage() {}
 func (*HTTPProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{19}
+	return fileDescriptor_fbaa7d016762fa1d, []int{22}
 }
 func (m *HTTPProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +695,7 @@ var xxx_messageInfo_HTTPProtocol proto.InternalMessageInfo
 func (m *IPBlock) Reset()      { *m = IPBlock{} }
 func (*IPBlock) ProtoMessage() {}
 func (*IPBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{20}
+	return fileDescriptor_fbaa7d016762fa1d, []int{23}
 }
 func (m *IPBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +723,7 @@ var xxx_messageInfo_IPBlock proto.InternalMessageInfo
 func (m *IPGroupAssociation) Reset()      { *m = IPGroupAssociation{} }
 func (*IPGroupAssociation) ProtoMessage() {}
 func (*IPGroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+	return fileDescriptor_fbaa7d016762fa1d, []int{24}
 }
 func (m *IPGroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +751,7 @@ var xxx_messageInfo_IPGroupAssociation proto.InternalMessageInfo
 func (m *IPNet) Reset()      { *m = IPNet{} }
 func (*IPNet) ProtoMessage() {}
 func (*IPNet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+	return fileDescriptor_fbaa7d016762fa1d, []int{25}
 }
 func (m *IPNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +779,7 @@ var xxx_messageInfo_IPNet proto.InternalMessageInfo
 func (m *L7Protocol) Reset()      { *m = L7Protocol{} }
 func (*L7Protocol) ProtoMessage() {}
 func (*L7Protocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+	return fileDescriptor_fbaa7d016762fa1d, []int{26}
 }
 func (m *L7Protocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -720,10 +804,38 @@ func (m *L7Protocol) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_L7Protocol proto.InternalMessageInfo
 
+func (m *LogSampling) Reset()      { *m = LogSampling{} }
+func (*LogSampling) ProtoMessage() {}
+func (*LogSampling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+}
+func (m *LogSampling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogSampling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogSampling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSampling.Merge(m, src)
+}
+func (m *LogSampling) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogSampling) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSampling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSampling proto.InternalMessageInfo
+
 func (m *MulticastGroupInfo) Reset()      { *m = MulticastGroupInfo{} }
 func (*MulticastGroupInfo) ProtoMessage() {}
 func (*MulticastGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+	return fileDescriptor_fbaa7d016762fa1d, []int{28}
 }
 func (m *MulticastGroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +863,7 @@ var xxx_messageInfo_MulticastGroupInfo proto.InternalMessageInfo
 func (m *NamedPort) Reset()      { *m = NamedPort{} }
 func (*NamedPort) ProtoMessage() {}
 func (*NamedPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{25}
+	return fileDescriptor_fbaa7d016762fa1d, []int{29}
 }
 func (m *NamedPort) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +891,7 @@ var xxx_messageInfo_NamedPort proto.InternalMessageInfo
 func (m *NetworkPolicy) Reset()      { *m = NetworkPolicy{} }
 func (*NetworkPolicy) ProtoMessage() {}
 func (*NetworkPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+	return fileDescriptor_fbaa7d016762fa1d, []int{30}
 }
 func (m *NetworkPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,10 +916,66 @@ func (m *NetworkPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetworkPolicy proto.InternalMessageInfo
 
+func (m *NetworkPolicyAnalysis) Reset()      { *m = NetworkPolicyAnalysis{} }
+func (*NetworkPolicyAnalysis) ProtoMessage() {}
+func (*NetworkPolicyAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+}
+func (m *NetworkPolicyAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkPolicyAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NetworkPolicyAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkPolicyAnalysis.Merge(m, src)
+}
+func (m *NetworkPolicyAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkPolicyAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkPolicyAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkPolicyAnalysis proto.InternalMessageInfo
+
+func (m *NetworkPolicyAnalysisFinding) Reset()      { *m = NetworkPolicyAnalysisFinding{} }
+func (*NetworkPolicyAnalysisFinding) ProtoMessage() {}
+func (*NetworkPolicyAnalysisFinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{32}
+}
+func (m *NetworkPolicyAnalysisFinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkPolicyAnalysisFinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NetworkPolicyAnalysisFinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkPolicyAnalysisFinding.Merge(m, src)
+}
+func (m *NetworkPolicyAnalysisFinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkPolicyAnalysisFinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkPolicyAnalysisFinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkPolicyAnalysisFinding proto.InternalMessageInfo
+
 func (m *NetworkPolicyEvaluation) Reset()      { *m = NetworkPolicyEvaluation{} }
 func (*NetworkPolicyEvaluation) ProtoMessage() {}
 func (*NetworkPolicyEvaluation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+	return fileDescriptor_fbaa7d016762fa1d, []int{33}
 }
 func (m *NetworkPolicyEvaluation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1003,7 @@ var xxx_messageInfo_NetworkPolicyEvaluation proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationRequest) Reset()      { *m = NetworkPolicyEvaluationRequest{} }
 func (*NetworkPolicyEvaluationRequest) ProtoMessage() {}
 func (*NetworkPolicyEvaluationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+	return fileDescriptor_fbaa7d016762fa1d, []int{34}
 }
 func (m *NetworkPolicyEvaluationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1031,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationRequest proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationResponse) Reset()      { *m = NetworkPolicyEvaluationResponse{} }
 func (*NetworkPolicyEvaluationResponse) ProtoMessage() {}
 func (*NetworkPolicyEvaluationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+	return fileDescriptor_fbaa7d016762fa1d, []int{35}
 }
 func (m *NetworkPolicyEvaluationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1059,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationResponse proto.InternalMessageInfo
 func (m *NetworkPolicyList) Reset()      { *m = NetworkPolicyList{} }
 func (*NetworkPolicyList) ProtoMessage() {}
 func (*NetworkPolicyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{30}
+	return fileDescriptor_fbaa7d016762fa1d, []int{36}
 }
 func (m *NetworkPolicyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1087,7 @@ var xxx_messageInfo_NetworkPolicyList proto.InternalMessageInfo
 func (m *NetworkPolicyNodeStatus) Reset()      { *m = NetworkPolicyNodeStatus{} }
 func (*NetworkPolicyNodeStatus) ProtoMessage() {}
 func (*NetworkPolicyNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+	return fileDescriptor_fbaa7d016762fa1d, []int{37}
 }
 func (m *NetworkPolicyNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1115,7 @@ var xxx_messageInfo_NetworkPolicyNodeStatus proto.InternalMessageInfo
 func (m *NetworkPolicyPeer) Reset()      { *m = NetworkPolicyPeer{} }
 func (*NetworkPolicyPeer) ProtoMessage() {}
 func (*NetworkPolicyPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{32}
+	return fileDescriptor_fbaa7d016762fa1d, []int{38}
 }
 func (m *NetworkPolicyPeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1143,7 @@ var xxx_messageInfo_NetworkPolicyPeer proto.InternalMessageInfo
 func (m *NetworkPolicyReference) Reset()      { *m = NetworkPolicyReference{} }
 func (*NetworkPolicyReference) ProtoMessage() {}
 func (*NetworkPolicyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{33}
+	return fileDescriptor_fbaa7d016762fa1d, []int{39}
 }
 func (m *NetworkPolicyReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1171,7 @@ var xxx_messageInfo_NetworkPolicyReference proto.InternalMessageInfo
 func (m *NetworkPolicyRule) Reset()      { *m = NetworkPolicyRule{} }
 func (*NetworkPolicyRule) ProtoMessage() {}
 func (*NetworkPolicyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{34}
+	return fileDescriptor_fbaa7d016762fa1d, []int{40}
 }
 func (m *NetworkPolicyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1199,7 @@ var xxx_messageInfo_NetworkPolicyRule proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{35}
+	return fileDescriptor_fbaa7d016762fa1d, []int{41}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1227,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatus) Reset()      { *m = NetworkPolicyStatus{} }
 func (*NetworkPolicyStatus) ProtoMessage() {}
 func (*NetworkPolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{36}
+	return fileDescriptor_fbaa7d016762fa1d, []int{42}
 }
 func (m *NetworkPolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1255,7 @@ var xxx_messageInfo_NetworkPolicyStatus proto.InternalMessageInfo
 func (m *NodeReference) Reset()      { *m = NodeReference{} }
 func (*NodeReference) ProtoMessage() {}
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{37}
+	return fileDescriptor_fbaa7d016762fa1d, []int{43}
 }
 func (m *NodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1283,7 @@ var xxx_messageInfo_NodeReference proto.InternalMessageInfo
 func (m *NodeStatsSummary) Reset()      { *m = NodeStatsSummary{} }
 func (*NodeStatsSummary) ProtoMessage() {}
 func (*NodeStatsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{38}
+	return fileDescriptor_fbaa7d016762fa1d, []int{44}
 }
 func (m *NodeStatsSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1311,7 @@ var xxx_messageInfo_NodeStatsSummary proto.InternalMessageInfo
 func (m *PaginationGetOptions) Reset()      { *m = PaginationGetOptions{} }
 func (*PaginationGetOptions) ProtoMessage() {}
 func (*PaginationGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{39}
+	return fileDescriptor_fbaa7d016762fa1d, []int{45}
 }
 func (m *PaginationGetOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1339,7 @@ var xxx_messageInfo_PaginationGetOptions proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{40}
+	return fileDescriptor_fbaa7d016762fa1d, []int{46}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1196,10 +1364,66 @@ func (m *PodReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodReference proto.InternalMessageInfo
 
+func (m *PolicyRuleReference) Reset()      { *m = PolicyRuleReference{} }
+func (*PolicyRuleReference) ProtoMessage() {}
+func (*PolicyRuleReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{47}
+}
+func (m *PolicyRuleReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyRuleReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PolicyRuleReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyRuleReference.Merge(m, src)
+}
+func (m *PolicyRuleReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyRuleReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyRuleReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyRuleReference proto.InternalMessageInfo
+
+func (m *RateLimit) Reset()      { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage() {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{48}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
 func (m *RuleRef) Reset()      { *m = RuleRef{} }
 func (*RuleRef) ProtoMessage() {}
 func (*RuleRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{41}
+	return fileDescriptor_fbaa7d016762fa1d, []int{49}
 }
 func (m *RuleRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1451,7 @@ var xxx_messageInfo_RuleRef proto.InternalMessageInfo
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{42}
+	return fileDescriptor_fbaa7d016762fa1d, []int{50}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1479,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{43}
+	return fileDescriptor_fbaa7d016762fa1d, []int{51}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1507,7 @@ var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 func (m *SupportBundleCollection) Reset()      { *m = SupportBundleCollection{} }
 func (*SupportBundleCollection) ProtoMessage() {}
 func (*SupportBundleCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{44}
+	return fileDescriptor_fbaa7d016762fa1d, []int{52}
 }
 func (m *SupportBundleCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1535,7 @@ var xxx_messageInfo_SupportBundleCollection proto.InternalMessageInfo
 func (m *SupportBundleCollectionList) Reset()      { *m = SupportBundleCollectionList{} }
 func (*SupportBundleCollectionList) ProtoMessage() {}
 func (*SupportBundleCollectionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{45}
+	return fileDescriptor_fbaa7d016762fa1d, []int{53}
 }
 func (m *SupportBundleCollectionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1563,7 @@ var xxx_messageInfo_SupportBundleCollectionList proto.InternalMessageInfo
 func (m *SupportBundleCollectionNodeStatus) Reset()      { *m = SupportBundleCollectionNodeStatus{} }
 func (*SupportBundleCollectionNodeStatus) ProtoMessage() {}
 func (*SupportBundleCollectionNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{46}
+	return fileDescriptor_fbaa7d016762fa1d, []int{54}
 }
 func (m *SupportBundleCollectionNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1591,7 @@ var xxx_messageInfo_SupportBundleCollectionNodeStatus proto.InternalMessageInfo
 func (m *SupportBundleCollectionStatus) Reset()      { *m = SupportBundleCollectionStatus{} }
 func (*SupportBundleCollectionStatus) ProtoMessage() {}
 func (*SupportBundleCollectionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{47}
+	return fileDescriptor_fbaa7d016762fa1d, []int{55}
 }
 func (m *SupportBundleCollectionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1619,7 @@ var xxx_messageInfo_SupportBundleCollectionStatus proto.InternalMessageInfo
 func (m *TLSProtocol) Reset()      { *m = TLSProtocol{} }
 func (*TLSProtocol) ProtoMessage() {}
 func (*TLSProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{48}
+	return fileDescriptor_fbaa7d016762fa1d, []int{56}
 }
 func (m *TLSProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1431,23 +1655,29 @@ func init() {
 	proto.RegisterType((*BundleFileServer)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleFileServer")
 	proto.RegisterType((*BundleServerAuthConfiguration)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleServerAuthConfiguration")
 	proto.RegisterType((*ClusterGroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ClusterGroupMembers")
+	proto.RegisterType((*DNSProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.DNSProtocol")
 	proto.RegisterType((*EgressGroup)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroup")
 	proto.RegisterType((*EgressGroupList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupList")
 	proto.RegisterType((*EgressGroupPatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupPatch")
 	proto.RegisterType((*Entity)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Entity")
 	proto.RegisterType((*ExternalEntityReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ExternalEntityReference")
+	proto.RegisterType((*GRPCProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GRPCProtocol")
 	proto.RegisterType((*GroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupAssociation")
 	proto.RegisterType((*GroupMember)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMember")
 	proto.RegisterType((*GroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMembers")
 	proto.RegisterType((*GroupReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupReference")
+	proto.RegisterType((*HTTPHeaderMatcher)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPHeaderMatcher")
 	proto.RegisterType((*HTTPProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPProtocol")
 	proto.RegisterType((*IPBlock)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPBlock")
 	proto.RegisterType((*IPGroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPGroupAssociation")
 	proto.RegisterType((*IPNet)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPNet")
 	proto.RegisterType((*L7Protocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.L7Protocol")
+	proto.RegisterType((*LogSampling)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.LogSampling")
 	proto.RegisterType((*MulticastGroupInfo)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.MulticastGroupInfo")
 	proto.RegisterType((*NamedPort)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NamedPort")
 	proto.RegisterType((*NetworkPolicy)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicy")
+	proto.RegisterType((*NetworkPolicyAnalysis)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyAnalysis")
+	proto.RegisterType((*NetworkPolicyAnalysisFinding)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyAnalysisFinding")
 	proto.RegisterType((*NetworkPolicyEvaluation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyEvaluation")
 	proto.RegisterType((*NetworkPolicyEvaluationRequest)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyEvaluationRequest")
 	proto.RegisterType((*NetworkPolicyEvaluationResponse)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyEvaluationResponse")
@@ -1462,6 +1692,8 @@ func init() {
 	proto.RegisterType((*NodeStatsSummary)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NodeStatsSummary")
 	proto.RegisterType((*PaginationGetOptions)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PaginationGetOptions")
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PodReference")
+	proto.RegisterType((*PolicyRuleReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PolicyRuleReference")
+	proto.RegisterType((*RateLimit)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RateLimit")
 	proto.RegisterType((*RuleRef)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RuleRef")
 	proto.RegisterType((*Service)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Service")
 	proto.RegisterType((*ServiceReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ServiceReference")
@@ -1477,200 +1709,220 @@ func init() {
 }
 
 var fileDescriptor_fbaa7d016762fa1d = []byte{
-	// 3077 bytes of a gzipped FileDescriptorProto
+	// 3398 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x1c, 0xc7,
-	0xb1, 0x9a, 0xfd, 0x90, 0xdc, 0xda, 0x25, 0x45, 0x35, 0x6d, 0x8b, 0xcf, 0xb6, 0x48, 0x79, 0xfc,
-	0x9e, 0xa1, 0x17, 0x38, 0x4b, 0x8b, 0xb1, 0x2d, 0xc5, 0x3f, 0x84, 0x4b, 0x51, 0xf4, 0x26, 0x14,
-	0xb5, 0x6e, 0xd2, 0x36, 0x62, 0xc7, 0x8e, 0x87, 0x33, 0xbd, 0xcb, 0xb1, 0x66, 0x67, 0x46, 0xdd,
-	0x3d, 0xb4, 0xe4, 0x43, 0xe0, 0x20, 0xc9, 0xc1, 0xf9, 0x39, 0xc8, 0x25, 0xf0, 0x2d, 0xb7, 0x5c,
-	0x72, 0xcb, 0xcd, 0xa7, 0xf8, 0x10, 0xc0, 0x47, 0x07, 0x41, 0x10, 0x9f, 0x88, 0x98, 0x41, 0x1c,
-	0xf8, 0x60, 0x04, 0xc8, 0x2d, 0x0a, 0x02, 0x04, 0xfd, 0x99, 0xdf, 0x2e, 0x57, 0xd4, 0x92, 0x14,
-	0x13, 0xc4, 0x3a, 0x71, 0xa7, 0xaa, 0xba, 0xaa, 0xba, 0xab, 0xba, 0xeb, 0xd3, 0x4d, 0x78, 0xc6,
-	0xf2, 0x39, 0x25, 0x56, 0xdd, 0x0d, 0xe6, 0xd4, 0xaf, 0xb9, 0xf0, 0x4a, 0x67, 0xce, 0x0a, 0x5d,
-	0x36, 0x67, 0x07, 0x3e, 0xa7, 0x81, 0x17, 0x7a, 0x96, 0x4f, 0xe6, 0xb6, 0xce, 0x6e, 0x10, 0x6e,
-	0xcd, 0xcf, 0x75, 0x88, 0x4f, 0xa8, 0xc5, 0x89, 0x53, 0x0f, 0x69, 0xc0, 0x03, 0x54, 0x57, 0xa3,
-	0xbe, 0xe9, 0x06, 0xfa, 0x57, 0x3d, 0xbc, 0xd2, 0xa9, 0x8b, 0xf1, 0xf5, 0xec, 0xf8, 0xba, 0x1e,
-	0x7f, 0xef, 0xf9, 0xc1, 0xf2, 0x18, 0xb7, 0x38, 0x9b, 0xdb, 0x3a, 0x6b, 0x79, 0xe1, 0xa6, 0x75,
-	0xb6, 0x57, 0xd2, 0xbd, 0x5f, 0xec, 0xb8, 0x7c, 0x33, 0xda, 0xa8, 0xdb, 0x41, 0x77, 0xae, 0x13,
-	0x74, 0x82, 0x39, 0x09, 0xde, 0x88, 0xda, 0xf2, 0x4b, 0x7e, 0xc8, 0x5f, 0x9a, 0xfc, 0xd1, 0x2b,
-	0xe7, 0x99, 0x94, 0x12, 0xba, 0x5d, 0xcb, 0xde, 0x74, 0x7d, 0x42, 0xaf, 0xa7, 0xb2, 0xba, 0x84,
-	0x5b, 0x73, 0x5b, 0xfd, 0x42, 0xe6, 0x06, 0x8d, 0xa2, 0x91, 0xcf, 0xdd, 0x2e, 0xe9, 0x1b, 0xf0,
-	0xf8, 0x5e, 0x03, 0x98, 0xbd, 0x49, 0xba, 0x56, 0xdf, 0xb8, 0x2f, 0x0d, 0x1a, 0x17, 0x71, 0xd7,
-	0x9b, 0x73, 0x7d, 0xce, 0x38, 0xed, 0x1d, 0x64, 0xfe, 0xc5, 0x80, 0xda, 0x82, 0xe3, 0x50, 0xc2,
-	0xd8, 0x32, 0x0d, 0xa2, 0x10, 0xbd, 0x06, 0x63, 0x62, 0x26, 0x8e, 0xc5, 0xad, 0x69, 0xe3, 0xb4,
-	0x71, 0xa6, 0x3a, 0xff, 0x48, 0x5d, 0x31, 0xae, 0x67, 0x19, 0xa7, 0x36, 0x11, 0xd4, 0xf5, 0xad,
-	0xb3, 0xf5, 0xcb, 0x1b, 0xaf, 0x13, 0x9b, 0x5f, 0x22, 0xdc, 0x6a, 0xa0, 0x0f, 0xb6, 0x67, 0x8f,
-	0xed, 0x6c, 0xcf, 0x42, 0x0a, 0xc3, 0x09, 0x57, 0x14, 0x41, 0xad, 0x23, 0x44, 0x5d, 0x22, 0xdd,
-	0x0d, 0x42, 0xd9, 0x74, 0xe1, 0x74, 0xf1, 0x4c, 0x75, 0xfe, 0xc9, 0x21, 0xcd, 0x5e, 0x5f, 0x4e,
-	0x79, 0x34, 0xee, 0xd2, 0x02, 0x6b, 0x19, 0x20, 0xc3, 0x39, 0x31, 0xe6, 0xef, 0x0c, 0x98, 0xcc,
-	0xce, 0x74, 0xc5, 0x65, 0x1c, 0x7d, 0xa3, 0x6f, 0xb6, 0xf5, 0x5b, 0x9b, 0xad, 0x18, 0x2d, 0xe7,
-	0x3a, 0xa9, 0x45, 0x8f, 0xc5, 0x90, 0xcc, 0x4c, 0x2d, 0x28, 0xbb, 0x9c, 0x74, 0xe3, 0x29, 0x3e,
-	0x35, 0xec, 0x14, 0xb3, 0xea, 0x36, 0xc6, 0xb5, 0xa0, 0x72, 0x53, 0xb0, 0xc4, 0x8a, 0xb3, 0xf9,
-	0x76, 0x11, 0x4e, 0x64, 0xc9, 0x5a, 0x16, 0xb7, 0x37, 0x8f, 0xc0, 0x88, 0xdf, 0x35, 0xe0, 0x84,
-	0xe5, 0x38, 0xc4, 0x59, 0x3e, 0x64, 0x53, 0xfe, 0x8f, 0x16, 0x2b, 0x66, 0x95, 0xe7, 0x8e, 0xfb,
-	0x05, 0xa2, 0xef, 0x1b, 0x30, 0x45, 0x49, 0x37, 0xd8, 0xea, 0x51, 0xa4, 0x78, 0x70, 0x45, 0xee,
-	0xd3, 0x8a, 0x4c, 0xe1, 0x7e, 0xfe, 0x78, 0x37, 0xa1, 0xe6, 0xa7, 0x06, 0x4c, 0x2c, 0x84, 0xa1,
-	0xe7, 0x12, 0x67, 0x3d, 0xf8, 0x2f, 0xdf, 0x4d, 0x7f, 0x30, 0x00, 0xe5, 0xe7, 0x7a, 0x04, 0xfb,
-	0xc9, 0xce, 0xef, 0xa7, 0x67, 0x86, 0xde, 0x4f, 0x39, 0x85, 0x07, 0xec, 0xa8, 0x1f, 0x14, 0x61,
-	0x2a, 0x4f, 0x78, 0x67, 0x4f, 0xfd, 0xfb, 0xf6, 0xd4, 0x55, 0x98, 0x6a, 0x58, 0xcc, 0xb5, 0x17,
-	0x22, 0xbe, 0x49, 0x7c, 0xee, 0xda, 0x16, 0x77, 0x03, 0x1f, 0x3d, 0x0c, 0x63, 0x11, 0x23, 0xd4,
-	0xb7, 0xba, 0x44, 0x1a, 0xa3, 0x92, 0xfa, 0xcd, 0xf3, 0x1a, 0x8e, 0x13, 0x0a, 0x41, 0x1d, 0x5a,
-	0x8c, 0xbd, 0x11, 0x50, 0x67, 0xba, 0x90, 0xa7, 0x6e, 0x69, 0x38, 0x4e, 0x28, 0xcc, 0xd7, 0x61,
-	0xb2, 0x11, 0xf9, 0x8e, 0x47, 0x2e, 0xba, 0x1e, 0x59, 0x23, 0x74, 0x8b, 0x50, 0x74, 0x0a, 0x8a,
-	0x11, 0xf5, 0xb4, 0xa8, 0xaa, 0x1e, 0x5c, 0x7c, 0x1e, 0xaf, 0x60, 0x01, 0x47, 0xe7, 0x60, 0x7c,
-	0x33, 0x60, 0xbc, 0x15, 0x6d, 0x78, 0xae, 0xfd, 0x35, 0x72, 0x5d, 0x4a, 0xa9, 0x35, 0x4e, 0xec,
-	0x6c, 0xcf, 0x8e, 0x3f, 0x9b, 0x45, 0xe0, 0x3c, 0x9d, 0xf9, 0x4e, 0x01, 0x4e, 0x29, 0x61, 0x4a,
-	0x90, 0x98, 0xe6, 0x62, 0xe0, 0xb7, 0xdd, 0x4e, 0x44, 0xd5, 0x4c, 0x1f, 0x83, 0xea, 0x06, 0xb1,
-	0x28, 0xa1, 0xeb, 0xc1, 0x15, 0xe2, 0x6b, 0x0d, 0xa6, 0xb4, 0x06, 0xd5, 0x46, 0x8a, 0xc2, 0x59,
-	0x3a, 0xf4, 0x10, 0x8c, 0x58, 0xa1, 0x1b, 0xab, 0x52, 0x69, 0x4c, 0xe8, 0x11, 0x23, 0x0b, 0xad,
-	0xa6, 0xd0, 0x43, 0x63, 0xd1, 0x8f, 0x0d, 0x98, 0xda, 0xe8, 0x5f, 0xe0, 0xe9, 0xa2, 0xf4, 0xf0,
-	0xc5, 0x61, 0x8d, 0xbd, 0x8b, 0xad, 0x1a, 0x27, 0x85, 0xc1, 0x77, 0x41, 0xe0, 0xdd, 0x04, 0x9b,
-	0x3f, 0x2f, 0xc1, 0xd4, 0xa2, 0x17, 0x31, 0x4e, 0x68, 0xce, 0x2b, 0x6f, 0xff, 0xf6, 0xfb, 0xb6,
-	0x01, 0x93, 0xa4, 0xdd, 0x26, 0x36, 0x77, 0xb7, 0xc8, 0x21, 0xee, 0xbe, 0x69, 0x2d, 0x75, 0x72,
-	0xa9, 0x87, 0x39, 0xee, 0x13, 0x87, 0xbe, 0x05, 0x27, 0x12, 0x58, 0xb3, 0xd5, 0xf0, 0x02, 0xfb,
-	0x4a, 0xbc, 0xf1, 0x1e, 0x1b, 0x56, 0x87, 0x66, 0x6b, 0x95, 0xf0, 0x74, 0xef, 0x2f, 0xf5, 0xf2,
-	0xc5, 0xfd, 0xa2, 0xd0, 0x79, 0xa8, 0xf1, 0x80, 0x5b, 0x5e, 0x3c, 0xfd, 0xd2, 0x69, 0xe3, 0x4c,
-	0x31, 0x0d, 0x08, 0xeb, 0x19, 0x1c, 0xce, 0x51, 0xa2, 0x79, 0x00, 0xf9, 0xdd, 0xb2, 0x3a, 0x84,
-	0x4d, 0x97, 0xe5, 0xb8, 0x64, 0xbd, 0xd7, 0x13, 0x0c, 0xce, 0x50, 0x09, 0xdf, 0xb6, 0x23, 0x4a,
-	0x89, 0xcf, 0xc5, 0xf7, 0xf4, 0x88, 0x1c, 0x94, 0xf8, 0xf6, 0x62, 0x8a, 0xc2, 0x59, 0x3a, 0xf3,
-	0x13, 0x03, 0xaa, 0x4b, 0x9d, 0xcf, 0x41, 0xca, 0xfa, 0x5b, 0x03, 0x8e, 0x67, 0x26, 0x7a, 0x04,
-	0x11, 0xf6, 0xb5, 0x7c, 0x84, 0x1d, 0x7a, 0x86, 0x19, 0x6d, 0x07, 0x84, 0xd7, 0x1f, 0x16, 0x61,
-	0x32, 0x43, 0xa5, 0x62, 0xab, 0x03, 0x10, 0x24, 0xeb, 0x7e, 0xa8, 0x36, 0xcc, 0xf0, 0xbd, 0x13,
-	0x5f, 0x77, 0x89, 0xaf, 0x16, 0x8c, 0x2c, 0xf9, 0xdc, 0xe5, 0xd7, 0xd1, 0x8b, 0x50, 0x0c, 0x03,
-	0x47, 0x2f, 0xfe, 0xd0, 0xa5, 0x4a, 0x2b, 0x70, 0x30, 0x69, 0x13, 0x4a, 0x7c, 0x9b, 0x34, 0x46,
-	0x45, 0x70, 0x14, 0x10, 0xc1, 0xd1, 0xf4, 0xe0, 0xe4, 0xd2, 0x35, 0x2e, 0x42, 0xb1, 0xa7, 0x44,
-	0x25, 0x84, 0xe8, 0x34, 0x94, 0x32, 0x21, 0xbc, 0xa6, 0xb5, 0x2f, 0xad, 0x8a, 0xf0, 0x2d, 0x31,
-	0x68, 0x0e, 0x2a, 0xe2, 0x2f, 0x0b, 0x2d, 0x9b, 0xe8, 0x50, 0x76, 0x42, 0x93, 0x55, 0x56, 0x63,
-	0x04, 0x4e, 0x69, 0xcc, 0x7f, 0x18, 0x30, 0x29, 0x67, 0xb8, 0xc0, 0x58, 0x60, 0xbb, 0x2a, 0x88,
-	0x1e, 0x49, 0xee, 0x36, 0x69, 0x69, 0x89, 0x7a, 0x89, 0xf7, 0x9d, 0xa6, 0xca, 0xd1, 0xe9, 0x6a,
-	0x26, 0xf1, 0x63, 0xa1, 0x87, 0x3f, 0xee, 0x93, 0x68, 0xbe, 0x57, 0x82, 0x6a, 0xc6, 0xbe, 0xb7,
-	0xcd, 0xa8, 0xe8, 0x3b, 0x06, 0x4c, 0x90, 0x9c, 0x55, 0xa5, 0x75, 0xaa, 0xf3, 0xcb, 0x43, 0x1f,
-	0x19, 0xbb, 0xfb, 0x46, 0x03, 0xed, 0x6c, 0xcf, 0x4e, 0xf4, 0x20, 0x7b, 0x44, 0xa2, 0x87, 0xa0,
-	0xe8, 0x86, 0x6a, 0xe7, 0xd4, 0x1a, 0x77, 0x09, 0x05, 0x9b, 0x2d, 0x76, 0x63, 0x7b, 0xb6, 0xd2,
-	0x6c, 0xe9, 0xa2, 0x18, 0x0b, 0x02, 0xf4, 0x2a, 0x94, 0xc3, 0x80, 0x72, 0x11, 0xcf, 0x84, 0x45,
-	0xbe, 0x3c, 0xac, 0x8e, 0xc2, 0xd3, 0x9c, 0x56, 0x40, 0x79, 0x7a, 0xa8, 0x89, 0x2f, 0x86, 0x15,
-	0x5b, 0xf4, 0x32, 0x94, 0xfc, 0xc0, 0x21, 0x32, 0xec, 0x55, 0xe7, 0x9f, 0x1e, 0x9a, 0x7d, 0xe0,
-	0x90, 0x74, 0xe2, 0x63, 0x72, 0x0b, 0x08, 0x90, 0x64, 0x8a, 0x3a, 0x30, 0xca, 0x08, 0xdd, 0x72,
-	0x6d, 0x15, 0x21, 0xab, 0xf3, 0x5f, 0x19, 0x96, 0xff, 0x9a, 0x1a, 0x9e, 0x8a, 0xa8, 0xee, 0x6c,
-	0xcf, 0x8e, 0xc6, 0xd0, 0x98, 0xbb, 0xf9, 0x6e, 0x09, 0x6a, 0x77, 0x72, 0xae, 0x3b, 0x39, 0xd7,
-	0x6e, 0x39, 0xd7, 0x2f, 0x0c, 0x98, 0xc8, 0x9f, 0x4b, 0xf9, 0xa3, 0xd9, 0xd8, 0xfb, 0x68, 0x4e,
-	0x4e, 0xfb, 0xc2, 0xc0, 0xd3, 0xbe, 0x01, 0xc5, 0xc8, 0x75, 0x64, 0xf1, 0x51, 0x69, 0x3c, 0x92,
-	0x94, 0x59, 0xcd, 0x0b, 0x37, 0xb6, 0x67, 0x1f, 0x18, 0xd4, 0xde, 0xe4, 0xd7, 0x43, 0xc2, 0xea,
-	0xcf, 0x37, 0x2f, 0x60, 0x31, 0xd8, 0x7c, 0x13, 0x6a, 0xcf, 0xae, 0xaf, 0xb7, 0x5a, 0x34, 0xe0,
-	0x81, 0x1d, 0x78, 0x42, 0xaa, 0xa8, 0xb9, 0x7a, 0x63, 0x8c, 0x28, 0xcb, 0xb0, 0xc4, 0x88, 0x5a,
-	0xa9, 0x4b, 0xf8, 0x66, 0xe0, 0xf4, 0xd6, 0x4a, 0x97, 0x24, 0x14, 0x6b, 0xac, 0xe0, 0x14, 0x5a,
-	0x7c, 0x53, 0xab, 0x97, 0x70, 0x6a, 0x59, 0x7c, 0x13, 0x4b, 0x8c, 0xf9, 0xbe, 0x01, 0xa3, 0xda,
-	0xae, 0xe8, 0x45, 0x28, 0xd9, 0xae, 0x43, 0xf5, 0xc6, 0xd9, 0xa7, 0x27, 0x25, 0x42, 0x16, 0x9b,
-	0x17, 0x30, 0x96, 0x0c, 0xd1, 0x2b, 0x30, 0x42, 0xae, 0xd9, 0x24, 0xe4, 0x7a, 0xa3, 0xec, 0x93,
-	0x75, 0x32, 0xcb, 0x25, 0xc9, 0x0c, 0x6b, 0xa6, 0xe6, 0x3f, 0x0d, 0x40, 0xcd, 0xd6, 0xe7, 0x37,
-	0x84, 0xb6, 0xa1, 0x2c, 0x17, 0x08, 0x3d, 0x08, 0x05, 0x37, 0x94, 0x73, 0xad, 0x35, 0xa6, 0x76,
-	0xb6, 0x67, 0x0b, 0xcd, 0x56, 0x3e, 0xb4, 0x14, 0xdc, 0x50, 0x6c, 0xde, 0x90, 0x92, 0xb6, 0x7b,
-	0x6d, 0x85, 0xf8, 0x1d, 0xbe, 0x29, 0x3d, 0xa8, 0x9c, 0x6e, 0xde, 0x56, 0x06, 0x87, 0x73, 0x94,
-	0xe6, 0xaf, 0x0d, 0x80, 0x95, 0x73, 0x89, 0x9b, 0xbe, 0x04, 0xa5, 0x4d, 0xce, 0xc3, 0xfd, 0x86,
-	0xea, 0xac, 0xcb, 0xab, 0x08, 0x22, 0x20, 0x58, 0xf2, 0x44, 0x2f, 0x40, 0x91, 0x7b, 0x4c, 0x07,
-	0xe8, 0xa1, 0xcf, 0xd5, 0xf5, 0x95, 0xb5, 0x84, 0xb3, 0x4c, 0x02, 0xd6, 0x57, 0xd6, 0xb0, 0x60,
-	0x68, 0xbe, 0x6b, 0x00, 0xba, 0x14, 0x79, 0xa2, 0x76, 0x67, 0x5c, 0x2e, 0x5f, 0xd3, 0x6f, 0x07,
-	0xe8, 0x41, 0x28, 0xcb, 0x32, 0x46, 0x6f, 0xb9, 0x24, 0x64, 0x2a, 0xa3, 0x28, 0x1c, 0x7a, 0x15,
-	0x4a, 0x61, 0xe0, 0xec, 0xbb, 0x35, 0x9e, 0x4b, 0x4d, 0xd2, 0xad, 0x18, 0x38, 0x0c, 0x4b, 0xbe,
-	0xe6, 0xdb, 0x06, 0x54, 0x92, 0xb0, 0x2d, 0xb7, 0x6e, 0x40, 0xd5, 0x21, 0x50, 0xce, 0xd2, 0x53,
-	0x8e, 0x25, 0xe6, 0x16, 0x0e, 0xa7, 0xf3, 0x30, 0x16, 0xea, 0x75, 0xd0, 0x47, 0xc0, 0xfd, 0x49,
-	0x17, 0x49, 0xc3, 0x6f, 0x64, 0x7e, 0xe3, 0x84, 0xda, 0xfc, 0xac, 0x08, 0xe3, 0xab, 0x84, 0xbf,
-	0x11, 0xd0, 0x2b, 0xad, 0xc0, 0x73, 0xed, 0xeb, 0x47, 0xb0, 0x9b, 0xda, 0x50, 0xa6, 0x91, 0x47,
-	0xe2, 0x05, 0x5e, 0x18, 0x3a, 0x27, 0xc9, 0xea, 0x8b, 0x23, 0x8f, 0xa4, 0x76, 0x14, 0x5f, 0x0c,
-	0x2b, 0xf6, 0xe8, 0x69, 0x38, 0x6e, 0xe5, 0xba, 0xa5, 0x2a, 0x76, 0x56, 0xe4, 0x96, 0x39, 0x9e,
-	0x6f, 0xa4, 0x32, 0xdc, 0x4b, 0x8b, 0xce, 0x88, 0x45, 0x75, 0x03, 0x2a, 0x12, 0x48, 0x11, 0xf8,
-	0x8c, 0x46, 0x4d, 0x2d, 0xa8, 0x82, 0xe1, 0x04, 0x8b, 0x1e, 0x85, 0x1a, 0x77, 0x09, 0x8d, 0x31,
-	0x32, 0xdc, 0x95, 0x1b, 0x93, 0x32, 0x44, 0x66, 0xe0, 0x38, 0x47, 0x85, 0x18, 0x54, 0x58, 0x10,
-	0x51, 0x99, 0xfc, 0xe8, 0xf4, 0xe9, 0xe2, 0xc1, 0x96, 0x22, 0xf1, 0xba, 0x71, 0x11, 0xe8, 0xd6,
-	0x62, 0xe6, 0x38, 0x95, 0x63, 0x7e, 0x56, 0x80, 0x93, 0xb9, 0x41, 0x4b, 0x5b, 0x96, 0x17, 0xf5,
-	0x9f, 0xa3, 0xc5, 0xdb, 0xd4, 0xac, 0x18, 0xa5, 0xe4, 0x6a, 0x44, 0x74, 0xcc, 0xab, 0xce, 0xaf,
-	0x1e, 0x68, 0xc2, 0xa9, 0xee, 0x58, 0x71, 0x55, 0xd9, 0xa3, 0xfe, 0xc0, 0xb1, 0x2c, 0x74, 0x1d,
-	0xc6, 0x28, 0x61, 0x61, 0xe0, 0x33, 0xa2, 0x4f, 0x9a, 0xcb, 0x87, 0x26, 0x57, 0xb1, 0x55, 0xae,
-	0x11, 0x7f, 0xe1, 0x44, 0x9c, 0xf9, 0x57, 0x03, 0x66, 0x6e, 0xae, 0x33, 0x7a, 0x15, 0x46, 0x94,
-	0x7d, 0xf4, 0x9a, 0x3c, 0x3e, 0x74, 0x99, 0x22, 0x2b, 0x8e, 0x34, 0x6a, 0x6a, 0xc3, 0x6b, 0xae,
-	0xa8, 0x0b, 0x55, 0x87, 0x30, 0xee, 0xfa, 0xaa, 0x7d, 0x5a, 0x38, 0x90, 0x90, 0x24, 0x1d, 0xbb,
-	0x90, 0xb2, 0xc4, 0x59, 0xfe, 0xe6, 0xaf, 0x0a, 0x30, 0xbb, 0xc7, 0x6a, 0x89, 0x12, 0x6d, 0xdc,
-	0xcf, 0xd2, 0xe8, 0xa9, 0x1f, 0x96, 0xff, 0xdf, 0xad, 0xb5, 0xcc, 0x1f, 0x6d, 0x38, 0x2f, 0x53,
-	0x64, 0x89, 0xe2, 0xa0, 0x68, 0xfa, 0x0e, 0xb9, 0xa6, 0xa3, 0x63, 0x92, 0x25, 0xe2, 0x18, 0x81,
-	0x53, 0x1a, 0xf4, 0x75, 0x28, 0x89, 0x0f, 0xbd, 0x39, 0xce, 0x0d, 0xab, 0xac, 0xe0, 0x89, 0x49,
-	0x3b, 0x3d, 0xc1, 0x25, 0x40, 0xb2, 0x34, 0x7f, 0x6f, 0xc0, 0x89, 0x9c, 0xb2, 0x47, 0xd0, 0x51,
-	0xdb, 0xc8, 0x77, 0xd4, 0x9e, 0x3e, 0xd0, 0xe2, 0x0f, 0xe8, 0xa9, 0xfd, 0xcd, 0xe8, 0x39, 0x6f,
-	0x44, 0xf5, 0xb8, 0xc6, 0x2d, 0x1e, 0x31, 0xf4, 0x30, 0x8c, 0x89, 0x2a, 0x72, 0x75, 0x97, 0x9b,
-	0x92, 0x55, 0x0d, 0xc7, 0x09, 0x85, 0xa8, 0x28, 0xf4, 0x0b, 0x81, 0xd8, 0x8b, 0x33, 0x15, 0xc5,
-	0x72, 0x82, 0xc1, 0x19, 0x2a, 0xf4, 0x55, 0x40, 0x94, 0x58, 0x9e, 0xfb, 0xa6, 0xfc, 0xbc, 0x68,
-	0xb9, 0x5e, 0x44, 0x95, 0xf9, 0xc6, 0x1a, 0xf7, 0xea, 0xb1, 0x08, 0xf7, 0x51, 0xe0, 0x5d, 0x46,
-	0xa1, 0xff, 0x87, 0xd1, 0x2e, 0x61, 0x4c, 0x54, 0x26, 0x25, 0xa9, 0xec, 0x71, 0xcd, 0x60, 0xf4,
-	0x92, 0x02, 0xe3, 0x18, 0x2f, 0x6f, 0xbe, 0x73, 0x93, 0x6e, 0x11, 0x42, 0xd1, 0x39, 0x18, 0xb7,
-	0x32, 0xd7, 0xe1, 0x6c, 0xda, 0x90, 0xc1, 0x48, 0xde, 0xc4, 0x64, 0xef, 0xc9, 0x19, 0xce, 0xd3,
-	0x21, 0x02, 0x63, 0x6e, 0xa8, 0x8b, 0x3f, 0x65, 0xaa, 0x73, 0xc3, 0xe7, 0xd5, 0x72, 0x7c, 0xba,
-	0xc0, 0x49, 0xd5, 0x97, 0xb0, 0x46, 0xb3, 0x50, 0x6e, 0x5f, 0x75, 0xfc, 0x38, 0x48, 0x56, 0x84,
-	0x2d, 0x2f, 0x3e, 0x77, 0x61, 0x95, 0x61, 0x05, 0x47, 0x5c, 0xd4, 0x74, 0xba, 0x34, 0x8f, 0xfb,
-	0x15, 0x07, 0x2f, 0xf8, 0x33, 0x55, 0x61, 0xcc, 0x1b, 0x67, 0xe4, 0x88, 0x28, 0xee, 0x59, 0x1b,
-	0xc4, 0x6b, 0x3a, 0x44, 0x1c, 0x41, 0xae, 0x2c, 0x27, 0x8b, 0x67, 0xc6, 0x55, 0x14, 0x5f, 0xc9,
-	0xa3, 0x70, 0x2f, 0xad, 0xf9, 0x89, 0x01, 0xf7, 0xec, 0x7e, 0x4a, 0xa0, 0xc7, 0xa0, 0x24, 0x0a,
-	0x34, 0xed, 0x7b, 0x0f, 0xc4, 0xbb, 0x72, 0xfd, 0x7a, 0x48, 0x6e, 0x6c, 0xcf, 0xe6, 0x2d, 0x28,
-	0x80, 0x58, 0x92, 0x0f, 0xdd, 0xf7, 0x4b, 0xf2, 0xb7, 0xe2, 0x5e, 0xc5, 0x65, 0xe9, 0x20, 0xc5,
-	0xe5, 0xfb, 0x23, 0x3d, 0x4e, 0x27, 0x4e, 0x17, 0xf4, 0x14, 0x54, 0x1c, 0x97, 0x8a, 0xb2, 0x3e,
-	0x88, 0x6f, 0xe8, 0x66, 0x62, 0x65, 0x2f, 0xc4, 0x88, 0x1b, 0xd9, 0x0f, 0x9c, 0x0e, 0x40, 0x36,
-	0x94, 0xda, 0x34, 0xe8, 0xea, 0x98, 0x71, 0xb0, 0x44, 0x4d, 0xec, 0x81, 0x74, 0xf2, 0x17, 0x69,
-	0xd0, 0xc5, 0x92, 0x39, 0x7a, 0x05, 0x0a, 0x3c, 0xd0, 0x67, 0xea, 0x21, 0x88, 0x00, 0x2d, 0xa2,
-	0xb0, 0x1e, 0xe0, 0x02, 0x0f, 0xc4, 0xee, 0x61, 0x79, 0x9f, 0x3d, 0xb7, 0x4f, 0x9f, 0x4d, 0x77,
-	0x4f, 0xe2, 0xa8, 0x09, 0x6b, 0x79, 0x91, 0xdb, 0x93, 0xff, 0xa5, 0x29, 0x78, 0x5f, 0xc6, 0xf8,
-	0x02, 0x8c, 0x58, 0xca, 0x26, 0x23, 0xd2, 0x26, 0xcf, 0xc8, 0xfb, 0xcf, 0xd8, 0x18, 0x8f, 0xdc,
-	0xe4, 0x99, 0x1a, 0x75, 0xf4, 0xeb, 0xb4, 0xb3, 0x32, 0x9e, 0xa8, 0x31, 0x58, 0x73, 0x43, 0x4f,
-	0xc2, 0x38, 0xf1, 0xad, 0x0d, 0x8f, 0xac, 0x04, 0x9d, 0x8e, 0xeb, 0x77, 0xa6, 0x47, 0xe5, 0x59,
-	0x97, 0xc4, 0xc3, 0xa5, 0x2c, 0x12, 0xe7, 0x69, 0x77, 0xcb, 0x97, 0xc7, 0x86, 0xc8, 0x97, 0x63,
-	0x37, 0xaf, 0x0c, 0x74, 0xf3, 0xab, 0x50, 0xf5, 0x92, 0xb2, 0x92, 0x4d, 0x83, 0xb4, 0xc6, 0x13,
-	0xc3, 0x5a, 0x23, 0xad, 0x4c, 0xd3, 0x6c, 0x24, 0x85, 0x31, 0x9c, 0x95, 0x21, 0xcc, 0xe2, 0x05,
-	0x1d, 0x79, 0x4a, 0x4c, 0x57, 0xf3, 0x31, 0x66, 0x45, 0xc3, 0x71, 0x42, 0x61, 0xbe, 0x53, 0x04,
-	0x94, 0xf3, 0x28, 0x11, 0xa9, 0xd8, 0x7f, 0x48, 0xba, 0x12, 0x42, 0x8d, 0x53, 0xab, 0xdd, 0x76,
-	0x6d, 0xa9, 0xd5, 0x2d, 0x24, 0x72, 0xf2, 0x8d, 0x61, 0x3d, 0x7e, 0x63, 0x58, 0x5f, 0xcf, 0x8c,
-	0xce, 0x34, 0xf1, 0x32, 0x50, 0x9c, 0x93, 0x80, 0xde, 0x32, 0x60, 0x52, 0x64, 0x27, 0x59, 0x12,
-	0xdd, 0x7e, 0x7c, 0xe2, 0xd6, 0xc5, 0xe2, 0x1e, 0x0e, 0x69, 0xcb, 0xa3, 0x17, 0x83, 0xfb, 0xa4,
-	0x99, 0x7f, 0x36, 0x60, 0xaa, 0xcf, 0x22, 0xd1, 0x51, 0xf4, 0x7f, 0x3d, 0x28, 0x8b, 0xdc, 0x23,
-	0x0e, 0xb9, 0xcb, 0x07, 0xb2, 0x75, 0x9a, 0xf5, 0xa4, 0x79, 0x92, 0x80, 0x31, 0xac, 0x84, 0x98,
-	0x67, 0x61, 0x3c, 0xd7, 0x6a, 0xdf, 0xfb, 0xfe, 0xc9, 0x7c, 0xaf, 0x0c, 0x93, 0x31, 0x5f, 0xb6,
-	0x16, 0x75, 0xbb, 0x16, 0x3d, 0x8a, 0xea, 0xfd, 0x7b, 0x06, 0x1c, 0xcf, 0x3a, 0xa6, 0x9b, 0x2c,
-	0x51, 0xe3, 0x40, 0x4b, 0xa4, 0x7c, 0xe3, 0xa4, 0x96, 0x7d, 0x7c, 0x35, 0x2f, 0x02, 0xf7, 0xca,
-	0x44, 0xbf, 0x34, 0xe0, 0x7e, 0x25, 0x45, 0xbf, 0xc9, 0xe8, 0x19, 0xa1, 0x1d, 0xf5, 0x30, 0x94,
-	0xfa, 0x5f, 0xad, 0xd4, 0xfd, 0x0b, 0x37, 0x91, 0x87, 0x6f, 0xaa, 0x0d, 0xfa, 0x99, 0x01, 0x77,
-	0x2b, 0x82, 0x5e, 0x3d, 0x4b, 0x87, 0xa6, 0xe7, 0x29, 0xad, 0xe7, 0xdd, 0x0b, 0xbb, 0x09, 0xc2,
-	0xbb, 0xcb, 0x47, 0x0c, 0x2a, 0xdd, 0xb8, 0x53, 0x26, 0x53, 0xab, 0x7d, 0x28, 0xd3, 0xdf, 0x6a,
-	0x4b, 0x73, 0xa2, 0x04, 0x87, 0x53, 0x39, 0xe6, 0x2b, 0x70, 0x57, 0xcb, 0xea, 0xe8, 0x9a, 0x71,
-	0x99, 0xf0, 0xcb, 0xa1, 0xf8, 0xc1, 0x54, 0x23, 0xbb, 0xa3, 0xdc, 0xbe, 0x98, 0x6d, 0x64, 0x77,
-	0x08, 0x96, 0x18, 0xf4, 0x20, 0x94, 0x3d, 0xb7, 0xeb, 0x72, 0x5d, 0x02, 0x24, 0xdb, 0x69, 0x45,
-	0x00, 0xb1, 0xc2, 0x99, 0x16, 0xd4, 0xb2, 0x6d, 0xb8, 0xdb, 0x71, 0x9b, 0xfb, 0xbe, 0x01, 0xa3,
-	0xba, 0xa2, 0x3b, 0x60, 0x96, 0xb5, 0x77, 0x7f, 0x2f, 0x4d, 0x17, 0x8a, 0x87, 0x99, 0x2e, 0x98,
-	0xbf, 0x29, 0x42, 0x7c, 0xd7, 0x86, 0x1e, 0xcd, 0xf4, 0x10, 0xd5, 0x14, 0xa6, 0xf7, 0xee, 0x1f,
-	0xa2, 0x55, 0xdd, 0xbd, 0x2c, 0xec, 0x71, 0xd6, 0x44, 0xdc, 0xf5, 0xea, 0xea, 0xa1, 0x77, 0xbd,
-	0xe9, 0xf3, 0xcb, 0x74, 0x8d, 0x53, 0xd7, 0xef, 0xa8, 0x7e, 0x70, 0xa6, 0xd7, 0xf9, 0x7f, 0x30,
-	0x4a, 0x7c, 0xd9, 0x18, 0x95, 0x53, 0x2d, 0xab, 0x8e, 0xce, 0x92, 0x02, 0xe1, 0x18, 0x87, 0xce,
-	0xc0, 0x98, 0x6b, 0x77, 0x43, 0x91, 0x95, 0xcb, 0xac, 0xb9, 0xac, 0x1a, 0x30, 0xcd, 0xc5, 0x4b,
-	0x2d, 0x99, 0xa9, 0x27, 0xd8, 0x98, 0x72, 0x31, 0xbe, 0x03, 0xcd, 0x50, 0x0a, 0x18, 0x4e, 0xb0,
-	0x92, 0xb2, 0xa3, 0x79, 0x8e, 0x64, 0x28, 0x97, 0x13, 0x9e, 0x1a, 0x8b, 0xce, 0xeb, 0x37, 0x37,
-	0xba, 0x6a, 0x93, 0x49, 0x56, 0xa5, 0xe7, 0xd9, 0x4c, 0xdc, 0x89, 0xcf, 0x51, 0x8a, 0xe9, 0x31,
-	0x6a, 0xcb, 0xe9, 0x8d, 0xa5, 0xd3, 0x5b, 0x53, 0x20, 0x1c, 0xe3, 0x50, 0x1d, 0x80, 0x51, 0x5b,
-	0xcf, 0x5a, 0x26, 0x54, 0xe5, 0xc6, 0x84, 0x38, 0x91, 0xd7, 0x12, 0x28, 0xce, 0x50, 0x98, 0x04,
-	0x26, 0x7b, 0xeb, 0xaa, 0xdb, 0xe1, 0xf2, 0xef, 0x94, 0xe0, 0xe4, 0x5a, 0x14, 0x0a, 0x43, 0xa9,
-	0x97, 0x81, 0x8b, 0x81, 0xe7, 0x69, 0x27, 0xbe, 0xfd, 0x81, 0xe7, 0x65, 0xa8, 0x90, 0x6b, 0xa1,
-	0x4b, 0x89, 0xb3, 0x10, 0xfb, 0xdb, 0x17, 0x6e, 0x4d, 0xc4, 0xba, 0xdb, 0x25, 0xe9, 0xd4, 0x96,
-	0x62, 0x26, 0x38, 0xe5, 0x27, 0xd6, 0x82, 0xb9, 0xbe, 0x4d, 0x04, 0xa9, 0xde, 0x64, 0xc9, 0x80,
-	0xb5, 0x18, 0x81, 0x53, 0x1a, 0x51, 0x0c, 0xb7, 0x93, 0x47, 0x98, 0xd2, 0x07, 0xf7, 0x51, 0x0c,
-	0xf7, 0x3e, 0xe6, 0x4c, 0x57, 0x20, 0x85, 0xe1, 0x8c, 0x1c, 0xf4, 0x23, 0x03, 0x26, 0xac, 0xfc,
-	0x73, 0x48, 0x75, 0xb1, 0x7f, 0x69, 0x7f, 0xa2, 0x07, 0x3c, 0xed, 0x6c, 0xdc, 0xa3, 0xf5, 0x98,
-	0xe8, 0x79, 0x17, 0xd9, 0x23, 0xdc, 0xfc, 0xd4, 0x80, 0xfb, 0x06, 0x78, 0xc4, 0x11, 0x34, 0xb0,
-	0xbc, 0x7c, 0x03, 0x6b, 0xe8, 0x14, 0x6d, 0x80, 0xe6, 0x03, 0x5a, 0x59, 0x3f, 0x2d, 0xc0, 0x03,
-	0x03, 0x46, 0xec, 0xbb, 0xa9, 0xf5, 0x24, 0x8c, 0xc7, 0xbf, 0xb3, 0xdb, 0x30, 0x2d, 0x08, 0xb2,
-	0x48, 0x9c, 0xa7, 0x8d, 0x45, 0xc9, 0x03, 0xab, 0xd8, 0x2f, 0x4a, 0x1d, 0x5a, 0x31, 0x85, 0xf0,
-	0x70, 0x3b, 0xe8, 0x86, 0x1e, 0xe1, 0x44, 0x75, 0x1a, 0xc6, 0x52, 0x0f, 0x5f, 0x8c, 0x11, 0x38,
-	0xa5, 0x11, 0x81, 0x96, 0x50, 0x1a, 0x50, 0xe9, 0x61, 0x99, 0xbb, 0xb2, 0x25, 0x01, 0xc4, 0x0a,
-	0x67, 0xfe, 0xdd, 0x80, 0x53, 0x03, 0x16, 0xe5, 0xc8, 0x32, 0xf5, 0xad, 0x7c, 0xa6, 0xfe, 0xdc,
-	0x21, 0xb9, 0xc1, 0x9e, 0x39, 0xfb, 0xc3, 0x50, 0xcd, 0x5c, 0x40, 0xa2, 0x53, 0x50, 0x64, 0xbe,
-	0xdb, 0xfb, 0x10, 0x7b, 0x6d, 0xb5, 0x89, 0x05, 0xbc, 0xb1, 0xfe, 0xc1, 0xc7, 0x33, 0xc7, 0x3e,
-	0xfc, 0x78, 0xe6, 0xd8, 0x47, 0x1f, 0xcf, 0x1c, 0x7b, 0x6b, 0x67, 0xc6, 0xf8, 0x60, 0x67, 0xc6,
-	0xf8, 0x70, 0x67, 0xc6, 0xf8, 0x68, 0x67, 0xc6, 0xf8, 0xe3, 0xce, 0x8c, 0xf1, 0x93, 0x3f, 0xcd,
-	0x1c, 0x7b, 0xa9, 0x3e, 0xdc, 0x7f, 0xa8, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x53, 0x7e, 0xc9,
-	0xb1, 0xd2, 0x36, 0x00, 0x00,
+	0xb1, 0x9a, 0x9d, 0x5d, 0x92, 0x5b, 0xbb, 0xfc, 0x35, 0x25, 0x6b, 0x9f, 0x2d, 0x91, 0xf2, 0xf8,
+	0x3d, 0x43, 0xef, 0xc1, 0x6f, 0x29, 0x31, 0xb2, 0xa5, 0xc4, 0x1f, 0x84, 0x4b, 0x51, 0xd4, 0x3a,
+	0x24, 0xb5, 0x6e, 0xd2, 0x76, 0x62, 0x47, 0x8a, 0x87, 0x33, 0xbd, 0xcb, 0xb1, 0x66, 0x67, 0x46,
+	0x3d, 0xbd, 0xb4, 0x98, 0x43, 0xe0, 0x20, 0x1f, 0xc0, 0xf9, 0x39, 0xc8, 0x25, 0xf0, 0x2d, 0xb7,
+	0x5c, 0x72, 0xcb, 0xcd, 0x39, 0xe5, 0x10, 0xc0, 0x27, 0xc3, 0x41, 0x90, 0xc4, 0xb9, 0x10, 0x11,
+	0x83, 0x24, 0xc8, 0x21, 0x08, 0x90, 0x5b, 0x14, 0x04, 0x08, 0xfa, 0x33, 0xbf, 0x5d, 0x52, 0xf4,
+	0x92, 0x14, 0x13, 0xc4, 0x3a, 0x91, 0x53, 0x55, 0x5d, 0x55, 0xdd, 0x5d, 0xd5, 0xf5, 0xe9, 0x5e,
+	0x78, 0xce, 0xf4, 0x18, 0x25, 0x66, 0xd5, 0xf1, 0xa7, 0xe5, 0x7f, 0xd3, 0xc1, 0xcd, 0xd6, 0xb4,
+	0x19, 0x38, 0xe1, 0xb4, 0xe5, 0x7b, 0x8c, 0xfa, 0x6e, 0xe0, 0x9a, 0x1e, 0x99, 0xde, 0x38, 0xbf,
+	0x46, 0x98, 0x39, 0x33, 0xdd, 0x22, 0x1e, 0xa1, 0x26, 0x23, 0x76, 0x35, 0xa0, 0x3e, 0xf3, 0x51,
+	0x55, 0x8e, 0xfa, 0x82, 0xe3, 0xab, 0xff, 0xaa, 0xc1, 0xcd, 0x56, 0x95, 0x8f, 0xaf, 0xa6, 0xc7,
+	0x57, 0xd5, 0xf8, 0x87, 0x2f, 0xed, 0x2e, 0x2f, 0x64, 0x26, 0x0b, 0xa7, 0x37, 0xce, 0x9b, 0x6e,
+	0xb0, 0x6e, 0x9e, 0xef, 0x96, 0xf4, 0xf0, 0xff, 0xb7, 0x1c, 0xb6, 0xde, 0x59, 0xab, 0x5a, 0x7e,
+	0x7b, 0xba, 0xe5, 0xb7, 0xfc, 0x69, 0x01, 0x5e, 0xeb, 0x34, 0xc5, 0x97, 0xf8, 0x10, 0xff, 0x29,
+	0xf2, 0x0b, 0x37, 0x2f, 0x85, 0x42, 0x4a, 0xe0, 0xb4, 0x4d, 0x6b, 0xdd, 0xf1, 0x08, 0xdd, 0x4c,
+	0x64, 0xb5, 0x09, 0x33, 0xa7, 0x37, 0x7a, 0x85, 0x4c, 0xef, 0x36, 0x8a, 0x76, 0x3c, 0xe6, 0xb4,
+	0x49, 0xcf, 0x80, 0xa7, 0xf6, 0x1a, 0x10, 0x5a, 0xeb, 0xa4, 0x6d, 0xf6, 0x8c, 0xfb, 0xc4, 0x6e,
+	0xe3, 0x3a, 0xcc, 0x71, 0xa7, 0x1d, 0x8f, 0x85, 0x8c, 0x76, 0x0f, 0x32, 0xfe, 0xa8, 0x41, 0x79,
+	0xd6, 0xb6, 0x29, 0x09, 0xc3, 0x05, 0xea, 0x77, 0x02, 0xf4, 0x1a, 0x0c, 0xf1, 0x99, 0xd8, 0x26,
+	0x33, 0x2b, 0xda, 0x19, 0xed, 0x6c, 0x69, 0xe6, 0x5c, 0x55, 0x32, 0xae, 0xa6, 0x19, 0x27, 0x7b,
+	0xc2, 0xa9, 0xab, 0x1b, 0xe7, 0xab, 0xd7, 0xd6, 0x5e, 0x27, 0x16, 0x5b, 0x22, 0xcc, 0xac, 0xa1,
+	0xf7, 0xb6, 0xa6, 0x8e, 0x6d, 0x6f, 0x4d, 0x41, 0x02, 0xc3, 0x31, 0x57, 0xd4, 0x81, 0x72, 0x8b,
+	0x8b, 0x5a, 0x22, 0xed, 0x35, 0x42, 0xc3, 0x4a, 0xee, 0x8c, 0x7e, 0xb6, 0x34, 0xf3, 0x74, 0x9f,
+	0xdb, 0x5e, 0x5d, 0x48, 0x78, 0xd4, 0x8e, 0x2b, 0x81, 0xe5, 0x14, 0x30, 0xc4, 0x19, 0x31, 0xc6,
+	0x2f, 0x34, 0x18, 0x4b, 0xcf, 0x74, 0xd1, 0x09, 0x19, 0xfa, 0x7c, 0xcf, 0x6c, 0xab, 0x1f, 0x6d,
+	0xb6, 0x7c, 0xb4, 0x98, 0xeb, 0x98, 0x12, 0x3d, 0x14, 0x41, 0x52, 0x33, 0x35, 0xa1, 0xe0, 0x30,
+	0xd2, 0x8e, 0xa6, 0xf8, 0x4c, 0xbf, 0x53, 0x4c, 0xab, 0x5b, 0x1b, 0x56, 0x82, 0x0a, 0x75, 0xce,
+	0x12, 0x4b, 0xce, 0xc6, 0x5b, 0x3a, 0x8c, 0xa7, 0xc9, 0x1a, 0x26, 0xb3, 0xd6, 0x8f, 0x60, 0x13,
+	0xbf, 0xaa, 0xc1, 0xb8, 0x69, 0xdb, 0xc4, 0x5e, 0x38, 0xe4, 0xad, 0xfc, 0x2f, 0x25, 0x76, 0x7c,
+	0xb6, 0x9b, 0x3b, 0xee, 0x15, 0x88, 0xbe, 0xa1, 0xc1, 0x04, 0x25, 0x6d, 0x7f, 0xa3, 0x4b, 0x11,
+	0xfd, 0xe0, 0x8a, 0x3c, 0xa2, 0x14, 0x99, 0xc0, 0xbd, 0xfc, 0xf1, 0x4e, 0x42, 0x8d, 0x3f, 0x69,
+	0x30, 0x32, 0x1b, 0x04, 0xae, 0x43, 0xec, 0x55, 0xff, 0x3f, 0xdc, 0x9b, 0x7e, 0xad, 0x01, 0xca,
+	0xce, 0xf5, 0x08, 0xfc, 0xc9, 0xca, 0xfa, 0xd3, 0x73, 0x7d, 0xfb, 0x53, 0x46, 0xe1, 0x5d, 0x3c,
+	0xea, 0x9b, 0x3a, 0x4c, 0x64, 0x09, 0x1f, 0xf8, 0xd4, 0xbf, 0xce, 0xa7, 0x6e, 0xc1, 0x44, 0xcd,
+	0x0c, 0x1d, 0x6b, 0xb6, 0xc3, 0xd6, 0x89, 0xc7, 0x1c, 0xcb, 0x64, 0x8e, 0xef, 0xa1, 0x27, 0x60,
+	0xa8, 0x13, 0x12, 0xea, 0x99, 0x6d, 0x22, 0x36, 0xa3, 0x98, 0xd8, 0xcd, 0x8b, 0x0a, 0x8e, 0x63,
+	0x0a, 0x4e, 0x1d, 0x98, 0x61, 0xf8, 0x86, 0x4f, 0xed, 0x4a, 0x2e, 0x4b, 0xdd, 0x50, 0x70, 0x1c,
+	0x53, 0x18, 0xaf, 0xc3, 0x58, 0xad, 0xe3, 0xd9, 0x2e, 0xb9, 0xe2, 0xb8, 0x64, 0x85, 0xd0, 0x0d,
+	0x42, 0xd1, 0x69, 0xd0, 0x3b, 0xd4, 0x55, 0xa2, 0x4a, 0x6a, 0xb0, 0xfe, 0x22, 0x5e, 0xc4, 0x1c,
+	0x8e, 0x2e, 0xc2, 0xf0, 0xba, 0x1f, 0xb2, 0x46, 0x67, 0xcd, 0x75, 0xac, 0xcf, 0x90, 0x4d, 0x21,
+	0xa5, 0x5c, 0x1b, 0xdf, 0xde, 0x9a, 0x1a, 0xbe, 0x9a, 0x46, 0xe0, 0x2c, 0x9d, 0xf1, 0x76, 0x0e,
+	0x4e, 0x4b, 0x61, 0x52, 0x10, 0x9f, 0xe6, 0x9c, 0xef, 0x35, 0x9d, 0x56, 0x87, 0xca, 0x99, 0x3e,
+	0x09, 0xa5, 0x35, 0x62, 0x52, 0x42, 0x57, 0xfd, 0x9b, 0xc4, 0x53, 0x1a, 0x4c, 0x28, 0x0d, 0x4a,
+	0xb5, 0x04, 0x85, 0xd3, 0x74, 0xe8, 0x71, 0x18, 0x30, 0x03, 0x27, 0x52, 0xa5, 0x58, 0x1b, 0x51,
+	0x23, 0x06, 0x66, 0x1b, 0x75, 0xae, 0x87, 0xc2, 0xa2, 0xef, 0x68, 0x30, 0xb1, 0xd6, 0xbb, 0xc0,
+	0x15, 0x5d, 0x58, 0xf8, 0x5c, 0xbf, 0x9b, 0xbd, 0xc3, 0x5e, 0xd5, 0x4e, 0xf2, 0x0d, 0xdf, 0x01,
+	0x81, 0x77, 0x12, 0x6c, 0xfc, 0x20, 0x0f, 0x13, 0x73, 0x6e, 0x27, 0x64, 0x84, 0x66, 0xac, 0xf2,
+	0xfe, 0xbb, 0xdf, 0x97, 0x35, 0x18, 0x23, 0xcd, 0x26, 0xb1, 0x98, 0xb3, 0x41, 0x0e, 0xd1, 0xfb,
+	0x2a, 0x4a, 0xea, 0xd8, 0x7c, 0x17, 0x73, 0xdc, 0x23, 0x0e, 0x7d, 0x09, 0xc6, 0x63, 0x58, 0xbd,
+	0x51, 0x73, 0x7d, 0xeb, 0x66, 0xe4, 0x78, 0x4f, 0xf6, 0xab, 0x43, 0xbd, 0xb1, 0x4c, 0x58, 0xe2,
+	0xfb, 0xf3, 0xdd, 0x7c, 0x71, 0xaf, 0x28, 0x74, 0x09, 0xca, 0xcc, 0x67, 0xa6, 0x1b, 0x4d, 0x3f,
+	0x7f, 0x46, 0x3b, 0xab, 0x27, 0x01, 0x61, 0x35, 0x85, 0xc3, 0x19, 0x4a, 0x34, 0x03, 0x20, 0xbe,
+	0x1b, 0x66, 0x8b, 0x84, 0x95, 0x82, 0x18, 0x17, 0xaf, 0xf7, 0x6a, 0x8c, 0xc1, 0x29, 0x2a, 0x6e,
+	0xdb, 0x56, 0x87, 0x52, 0xe2, 0x31, 0xfe, 0x5d, 0x19, 0x10, 0x83, 0x62, 0xdb, 0x9e, 0x4b, 0x50,
+	0x38, 0x4d, 0x67, 0x5c, 0x80, 0xd2, 0xe5, 0xe5, 0x95, 0x06, 0xf5, 0x99, 0x6f, 0xf9, 0x2e, 0x1a,
+	0x87, 0xe2, 0xad, 0x0e, 0xa1, 0x9b, 0xcb, 0xf1, 0x61, 0x80, 0x10, 0x80, 0x00, 0xad, 0x6e, 0x06,
+	0x44, 0xee, 0x61, 0xd1, 0xf8, 0x83, 0x06, 0xa5, 0xf9, 0xd6, 0xc7, 0x20, 0xd1, 0xfd, 0xb9, 0x06,
+	0xa3, 0xa9, 0x89, 0x1e, 0x41, 0x5c, 0x7e, 0x2d, 0x1b, 0x97, 0xfb, 0x9e, 0x61, 0x4a, 0xdb, 0x5d,
+	0x82, 0xf2, 0xb7, 0x74, 0x18, 0x4b, 0x51, 0xc9, 0x88, 0x6c, 0x03, 0xf8, 0xf1, 0xba, 0x1f, 0xea,
+	0x1e, 0xa6, 0xf8, 0x3e, 0x88, 0xca, 0x3b, 0x44, 0x65, 0x13, 0x06, 0xe6, 0x3d, 0xe6, 0xb0, 0x4d,
+	0xf4, 0x32, 0xe8, 0x81, 0x6f, 0xab, 0xc5, 0xef, 0xbb, 0xc0, 0x69, 0xf8, 0x36, 0x26, 0x4d, 0x42,
+	0x89, 0x67, 0x91, 0xda, 0x20, 0x0f, 0xa9, 0x1c, 0xc2, 0x39, 0x1a, 0x2e, 0x9c, 0x9c, 0xbf, 0xcd,
+	0x78, 0x00, 0x77, 0xa5, 0xa8, 0x98, 0x10, 0x9d, 0x81, 0x7c, 0x2a, 0xf0, 0x97, 0x95, 0xf6, 0x79,
+	0xee, 0xff, 0x58, 0x60, 0xd0, 0x34, 0x14, 0xf9, 0xdf, 0x30, 0x30, 0x2d, 0xa2, 0x02, 0xe0, 0xb8,
+	0x22, 0x2b, 0x2e, 0x47, 0x08, 0x9c, 0xd0, 0x18, 0xd3, 0x50, 0x5e, 0xc0, 0x8d, 0xb9, 0xf8, 0x4c,
+	0x19, 0x85, 0xc1, 0x90, 0xd0, 0x0d, 0xc7, 0x8a, 0x4e, 0x94, 0x11, 0x18, 0x68, 0x13, 0xb6, 0xee,
+	0xab, 0x04, 0xc2, 0xf8, 0xbb, 0x06, 0x63, 0x62, 0x49, 0x66, 0xc3, 0xd0, 0xb7, 0x1c, 0x19, 0xab,
+	0x8f, 0x24, 0x45, 0x1c, 0x33, 0x95, 0x44, 0xb5, 0x27, 0xfb, 0xce, 0x86, 0xc5, 0xe8, 0x64, 0xf9,
+	0xe3, 0x30, 0x35, 0xdb, 0xc5, 0x1f, 0xf7, 0x48, 0x34, 0xde, 0xcd, 0x43, 0x29, 0x65, 0x10, 0xf7,
+	0xcd, 0x0a, 0xd0, 0x57, 0x34, 0x18, 0x21, 0x19, 0x33, 0x10, 0xeb, 0x5f, 0x9a, 0x59, 0xe8, 0xfb,
+	0x8c, 0xd9, 0xd9, 0x98, 0x6a, 0x68, 0x7b, 0x6b, 0x6a, 0xa4, 0x0b, 0xd9, 0x25, 0x12, 0x3d, 0x0e,
+	0xba, 0x13, 0x48, 0x57, 0x2b, 0xd7, 0x8e, 0x73, 0x05, 0xeb, 0x8d, 0xf0, 0xee, 0xd6, 0x54, 0xb1,
+	0xde, 0x50, 0xb5, 0x37, 0xe6, 0x04, 0xe8, 0x06, 0x14, 0x02, 0x9f, 0x32, 0x1e, 0x36, 0xf9, 0x8e,
+	0x7c, 0xb2, 0x5f, 0x1d, 0xb9, 0x69, 0xda, 0x0d, 0x9f, 0xb2, 0xe4, 0x14, 0xe4, 0x5f, 0x21, 0x96,
+	0x6c, 0xd1, 0xab, 0x90, 0xf7, 0x7c, 0x9b, 0x88, 0xe8, 0x5a, 0x9a, 0x79, 0xb6, 0x6f, 0xf6, 0xbe,
+	0x4d, 0x92, 0x89, 0x0f, 0x09, 0x9f, 0xe1, 0x20, 0xc1, 0x14, 0xb5, 0x12, 0x93, 0x1f, 0x10, 0xfc,
+	0x3f, 0xdd, 0x2f, 0xff, 0x15, 0x39, 0x3c, 0x11, 0x51, 0xda, 0xde, 0x9a, 0x1a, 0x8c, 0xa0, 0x11,
+	0x77, 0xe3, 0x9d, 0x3c, 0x94, 0x1f, 0xa4, 0x76, 0x0f, 0x52, 0xbb, 0x9d, 0x52, 0xbb, 0x1f, 0x6a,
+	0x30, 0x92, 0x3d, 0x97, 0xb2, 0x67, 0xb9, 0xb6, 0xf7, 0x59, 0x1e, 0x87, 0x87, 0xdc, 0xae, 0xe1,
+	0xa1, 0x06, 0x7a, 0xc7, 0xb1, 0x45, 0x8d, 0x53, 0xac, 0x9d, 0x8b, 0xab, 0xb9, 0xfa, 0xe5, 0xbb,
+	0x5b, 0x53, 0x8f, 0xee, 0xd6, 0x45, 0x65, 0x3c, 0x97, 0xac, 0xbe, 0x58, 0xbf, 0x8c, 0xf9, 0x60,
+	0xe3, 0x1c, 0x8c, 0x5f, 0x5d, 0x5d, 0x6d, 0x5c, 0x25, 0xa6, 0x4d, 0xe8, 0x12, 0xcf, 0x47, 0x08,
+	0x45, 0xe5, 0x74, 0x64, 0x42, 0xc3, 0x50, 0xd8, 0x30, 0xdd, 0x8e, 0xd2, 0xc4, 0x78, 0x5f, 0x83,
+	0x32, 0x1f, 0x12, 0x07, 0x99, 0x33, 0x90, 0xe7, 0xd5, 0x60, 0x77, 0x1c, 0xe3, 0x05, 0x23, 0x16,
+	0x18, 0xf4, 0x78, 0x36, 0xea, 0x24, 0x55, 0xdc, 0x92, 0x80, 0x62, 0x85, 0xe5, 0x9c, 0x02, 0x93,
+	0xad, 0x57, 0xf4, 0x2c, 0xa7, 0x86, 0xc9, 0xd6, 0xb1, 0xc0, 0x20, 0x0c, 0x83, 0xeb, 0x42, 0xd5,
+	0xe8, 0x70, 0x9a, 0xed, 0xd7, 0xe6, 0x7a, 0x66, 0x6b, 0xfc, 0x54, 0x83, 0x41, 0x65, 0x5e, 0xe8,
+	0x65, 0xc8, 0x5b, 0x8e, 0x4d, 0x95, 0xff, 0xee, 0xd3, 0xa0, 0x63, 0xc5, 0xe7, 0xea, 0x97, 0x31,
+	0x16, 0x0c, 0xd1, 0x75, 0x18, 0x20, 0xb7, 0x2d, 0x12, 0x30, 0xe5, 0xaf, 0xfb, 0x64, 0x1d, 0xaf,
+	0xdc, 0xbc, 0x60, 0x86, 0x15, 0x53, 0xe3, 0x1f, 0x1a, 0xa0, 0x7a, 0xe3, 0xe3, 0x1b, 0xc9, 0x9b,
+	0x50, 0x10, 0x0b, 0x84, 0x1e, 0x83, 0x9c, 0x13, 0x88, 0xb9, 0x96, 0x6b, 0x13, 0xdb, 0x5b, 0x53,
+	0xb9, 0x7a, 0x23, 0x1b, 0xe1, 0x72, 0x4e, 0xc0, 0xcf, 0x90, 0x80, 0x92, 0xa6, 0x73, 0x7b, 0x91,
+	0x78, 0x2d, 0xb6, 0x2e, 0xac, 0xb2, 0x90, 0x9c, 0x21, 0x8d, 0x14, 0x0e, 0x67, 0x28, 0x8d, 0x5f,
+	0xe5, 0x00, 0x16, 0x2f, 0xc6, 0xa6, 0xff, 0x0a, 0xe4, 0xd7, 0x19, 0x0b, 0xf6, 0x9b, 0x31, 0xa4,
+	0xdd, 0x48, 0x06, 0x32, 0x0e, 0xc1, 0x82, 0x27, 0x7a, 0x09, 0x74, 0xe6, 0x86, 0x2a, 0x4f, 0xe8,
+	0xfb, 0x78, 0x5f, 0x5d, 0x8c, 0x2b, 0x4b, 0x99, 0x8b, 0xac, 0x2e, 0xae, 0x60, 0xce, 0x10, 0x3d,
+	0x0f, 0xf9, 0x16, 0x0d, 0xac, 0x8a, 0xbe, 0x3f, 0x9d, 0x33, 0xf9, 0xe5, 0x55, 0xd0, 0x6d, 0x4f,
+	0x9e, 0xc1, 0xfb, 0xd0, 0x31, 0x55, 0xfd, 0x1a, 0x33, 0x50, 0x5a, 0xf4, 0x5b, 0x2b, 0x66, 0x3b,
+	0x70, 0x1d, 0xaf, 0xc5, 0xcf, 0x1c, 0xd1, 0x38, 0x12, 0x2b, 0x5b, 0x40, 0xc7, 0xa1, 0xdc, 0x36,
+	0x6f, 0x37, 0x08, 0x5d, 0x21, 0x96, 0xef, 0xc9, 0x63, 0xa4, 0x60, 0xbc, 0xa3, 0x01, 0x5a, 0xea,
+	0xb8, 0xcc, 0xb1, 0xcc, 0x90, 0x09, 0x43, 0xa8, 0x7b, 0x4d, 0x1f, 0x3d, 0x06, 0x05, 0x51, 0x48,
+	0xaa, 0x03, 0x29, 0xce, 0x41, 0xa4, 0x79, 0x49, 0x1c, 0xba, 0x01, 0xf9, 0xc0, 0xb7, 0xf7, 0x7d,
+	0xa5, 0x91, 0xc9, 0xf5, 0x92, 0x83, 0xca, 0xb7, 0x43, 0x2c, 0xf8, 0x1a, 0x6f, 0x69, 0x50, 0x8c,
+	0xf3, 0x20, 0x71, 0xb0, 0xf9, 0x54, 0x1e, 0x91, 0x85, 0x34, 0x3d, 0x65, 0x38, 0x1f, 0x28, 0x8a,
+	0x3d, 0x4e, 0xfb, 0x4b, 0x30, 0x14, 0xa8, 0xd5, 0x52, 0x07, 0xe4, 0xa9, 0xb8, 0xfb, 0xa7, 0xe0,
+	0x77, 0x53, 0xff, 0xe3, 0x98, 0xda, 0xf8, 0x7a, 0x1e, 0x86, 0x97, 0x09, 0x7b, 0xc3, 0xa7, 0x37,
+	0x1b, 0xbe, 0xeb, 0x58, 0x9b, 0x47, 0x70, 0x2e, 0x34, 0xa1, 0x40, 0x3b, 0x2e, 0x89, 0x16, 0xb8,
+	0xef, 0x63, 0x3a, 0xa3, 0x2f, 0xee, 0xb8, 0x24, 0xd9, 0x47, 0xfe, 0x15, 0x62, 0xc9, 0x1e, 0x3d,
+	0x0b, 0xa3, 0x66, 0xa6, 0xcb, 0x2d, 0x93, 0x91, 0xa2, 0x70, 0xfe, 0xd1, 0x6c, 0x03, 0x3c, 0xc4,
+	0xdd, 0xb4, 0xe8, 0x2c, 0x5f, 0x54, 0xc7, 0xa7, 0x3c, 0x23, 0xe7, 0x56, 0xac, 0xd5, 0xca, 0x72,
+	0x41, 0x25, 0x0c, 0xc7, 0x58, 0x74, 0x01, 0xca, 0xcc, 0x21, 0x34, 0xc2, 0x88, 0xfc, 0xa1, 0x50,
+	0x1b, 0x13, 0x39, 0x47, 0x0a, 0x8e, 0x33, 0x54, 0x28, 0x84, 0x62, 0xe8, 0x77, 0xa8, 0xc8, 0x26,
+	0x55, 0x3e, 0x7a, 0xe5, 0x60, 0x4b, 0x11, 0x5b, 0xdd, 0x30, 0xcf, 0x1c, 0x56, 0x22, 0xe6, 0x38,
+	0x91, 0x83, 0x4e, 0xc2, 0x28, 0xf1, 0x9a, 0x3e, 0xb5, 0x48, 0x9b, 0x78, 0x6c, 0x89, 0xa7, 0xda,
+	0x83, 0x22, 0x74, 0xff, 0x44, 0x83, 0x13, 0x19, 0x6e, 0xb3, 0x9e, 0xe9, 0x6e, 0x86, 0x4e, 0x88,
+	0x6a, 0x07, 0x37, 0x08, 0x74, 0x03, 0x86, 0x9a, 0x8e, 0x67, 0x3b, 0x5e, 0x2b, 0xda, 0xf5, 0xc5,
+	0x03, 0x4d, 0x35, 0x52, 0xee, 0x8a, 0x64, 0x6a, 0xfc, 0x46, 0x83, 0x53, 0xf7, 0x22, 0xe0, 0x69,
+	0x0b, 0xcf, 0x6e, 0x54, 0xda, 0xf2, 0x02, 0xe4, 0xb9, 0x89, 0x54, 0x72, 0xfb, 0x6b, 0x01, 0x27,
+	0x96, 0x97, 0xe4, 0x70, 0x9f, 0x85, 0x12, 0x25, 0x2e, 0x0f, 0x3b, 0x1c, 0x5e, 0xd1, 0x0f, 0x8d,
+	0xb3, 0xf1, 0xe7, 0x1c, 0x9c, 0xcc, 0xcc, 0x6d, 0x9e, 0xa7, 0x5c, 0xbd, 0x41, 0x5c, 0xbf, 0x4f,
+	0x1d, 0xbe, 0x41, 0x4a, 0x6e, 0x75, 0x88, 0x4a, 0xe2, 0x4a, 0x33, 0xcb, 0x07, 0xda, 0xb8, 0x44,
+	0x77, 0x2c, 0xb9, 0xca, 0x0a, 0x4a, 0x7d, 0xe0, 0x48, 0x16, 0xda, 0x84, 0x21, 0x4a, 0xc2, 0xc0,
+	0xf7, 0xc2, 0x68, 0x97, 0xae, 0x1d, 0x9a, 0x5c, 0xc9, 0x56, 0x7a, 0x73, 0xf4, 0x85, 0x63, 0x71,
+	0xc6, 0x5f, 0x34, 0x98, 0xbc, 0xb7, 0xce, 0xe8, 0x06, 0x0c, 0x48, 0x97, 0x52, 0x6b, 0xf2, 0x54,
+	0xdf, 0xa5, 0xba, 0xa8, 0xba, 0x93, 0x94, 0x4d, 0xf9, 0xaa, 0xe2, 0x8a, 0xda, 0x50, 0xb2, 0x49,
+	0xc8, 0x1c, 0x4f, 0x48, 0xad, 0xe4, 0x0e, 0x24, 0x24, 0x2e, 0x49, 0x2e, 0x27, 0x2c, 0x71, 0x9a,
+	0xbf, 0xf1, 0xe3, 0x1c, 0x4c, 0xed, 0xb1, 0x5a, 0xbc, 0x4d, 0x31, 0xec, 0xa5, 0x69, 0x2a, 0xda,
+	0xa1, 0x1e, 0x59, 0x27, 0x94, 0x96, 0xd9, 0x68, 0x84, 0xb3, 0x32, 0x79, 0xa5, 0xc4, 0x1d, 0xb7,
+	0xee, 0xd9, 0xe4, 0xb6, 0x4a, 0xcd, 0xe2, 0x4a, 0x09, 0x47, 0x08, 0x9c, 0xd0, 0xa0, 0xcf, 0x29,
+	0x4f, 0x97, 0xce, 0x71, 0xb1, 0x5f, 0x65, 0x95, 0x27, 0x26, 0x41, 0x57, 0x00, 0x04, 0x4b, 0xe3,
+	0x97, 0x1a, 0x8c, 0x67, 0x94, 0x3d, 0x82, 0x36, 0xf4, 0x5a, 0xb6, 0x0d, 0xfd, 0xec, 0x81, 0x16,
+	0x7f, 0x97, 0x46, 0xf4, 0x5f, 0xb5, 0xae, 0xf3, 0x86, 0x77, 0x50, 0x56, 0x98, 0xc9, 0x3a, 0x21,
+	0xbf, 0x66, 0xe4, 0x9d, 0x94, 0xe5, 0x1d, 0x2e, 0x25, 0x97, 0x15, 0x1c, 0xc7, 0x14, 0xbc, 0xaa,
+	0x56, 0x8f, 0x71, 0x22, 0x2b, 0x4e, 0x55, 0xd5, 0x0b, 0x31, 0x06, 0xa7, 0xa8, 0xd0, 0xf3, 0x80,
+	0x28, 0x31, 0x5d, 0xe7, 0x8b, 0xe2, 0xf3, 0x8a, 0xe9, 0xb8, 0x1d, 0x2a, 0xb7, 0x6f, 0xa8, 0xf6,
+	0xb0, 0x1a, 0x8b, 0x70, 0x0f, 0x05, 0xde, 0x61, 0x14, 0xfa, 0x5f, 0x18, 0x6c, 0x93, 0x30, 0xe4,
+	0xd5, 0x79, 0x5e, 0x28, 0x3b, 0xaa, 0x18, 0x0c, 0x2e, 0x49, 0x30, 0x8e, 0xf0, 0xe2, 0x91, 0x49,
+	0x66, 0xd2, 0x0d, 0x42, 0x28, 0xbf, 0xf4, 0x34, 0x53, 0x2f, 0x4f, 0xc2, 0x8a, 0x26, 0xf2, 0x07,
+	0x71, 0xe9, 0x99, 0x7e, 0x92, 0x12, 0xe2, 0x2c, 0x1d, 0x22, 0x30, 0xe4, 0x04, 0xaa, 0x01, 0x22,
+	0xb7, 0xea, 0x62, 0xff, 0x45, 0x9d, 0x18, 0x9f, 0x2c, 0x70, 0xdc, 0xf9, 0x88, 0x59, 0xa3, 0x29,
+	0x28, 0x34, 0x6f, 0xd9, 0x5e, 0x94, 0xd7, 0x14, 0xf9, 0x5e, 0x5e, 0x79, 0xe1, 0xf2, 0x72, 0x88,
+	0x25, 0x1c, 0x31, 0xde, 0xd7, 0x50, 0xed, 0xa9, 0xa8, 0x2c, 0x3e, 0x78, 0xd3, 0x2b, 0xd5, 0x19,
+	0x89, 0x78, 0xe3, 0x94, 0x1c, 0x9e, 0x78, 0xb9, 0xe6, 0x1a, 0x71, 0xeb, 0x36, 0xe1, 0x47, 0x90,
+	0x23, 0x5a, 0x2a, 0xfa, 0xd9, 0x61, 0x99, 0x78, 0x2d, 0x66, 0x51, 0xb8, 0x9b, 0x96, 0x5f, 0x63,
+	0x3d, 0xb4, 0xf3, 0x29, 0x81, 0x9e, 0x4c, 0x87, 0xf1, 0xda, 0xa3, 0x91, 0x57, 0xf2, 0x4b, 0xb0,
+	0xbb, 0x5b, 0x53, 0xd9, 0x1d, 0xe4, 0x40, 0x2c, 0xc8, 0xfb, 0x6e, 0x96, 0xc7, 0x29, 0xb7, 0xbe,
+	0x57, 0x83, 0x25, 0x7f, 0x90, 0x06, 0xcb, 0x9d, 0xc1, 0x2e, 0xa3, 0xe3, 0xa7, 0x0b, 0x7a, 0x06,
+	0x8a, 0xb6, 0x43, 0x89, 0x25, 0x9c, 0x46, 0x4e, 0x74, 0x32, 0x52, 0xf6, 0x72, 0x84, 0xb8, 0x9b,
+	0xfe, 0xc0, 0xc9, 0x00, 0x64, 0x41, 0xbe, 0x49, 0xfd, 0xb6, 0x8a, 0x19, 0x07, 0xcb, 0xad, 0xb9,
+	0x0f, 0x24, 0x93, 0xbf, 0x42, 0xfd, 0x36, 0x16, 0xcc, 0xd1, 0x75, 0xc8, 0x31, 0xbf, 0xa2, 0x1f,
+	0x96, 0x08, 0x50, 0x22, 0x72, 0xab, 0x3e, 0xce, 0x31, 0x9f, 0x7b, 0x4f, 0x98, 0xb5, 0xd9, 0x8b,
+	0xfb, 0xb4, 0xd9, 0xc4, 0x7b, 0x62, 0x43, 0x8d, 0x59, 0x8b, 0x37, 0x13, 0x5d, 0x29, 0x7b, 0x52,
+	0x35, 0xf5, 0x24, 0xf9, 0x2f, 0xc1, 0x80, 0x29, 0xf7, 0x64, 0x40, 0xec, 0xc9, 0x73, 0xe2, 0xa9,
+	0x41, 0xb4, 0x19, 0xe7, 0xee, 0xf1, 0x22, 0x94, 0xda, 0xea, 0x21, 0xe8, 0x79, 0x11, 0x4f, 0xe4,
+	0x18, 0xac, 0xb8, 0xa1, 0xa7, 0x61, 0x98, 0x78, 0xe6, 0x9a, 0x4b, 0x16, 0xfd, 0x56, 0xcb, 0xf1,
+	0x5a, 0x22, 0x1f, 0x1f, 0x4a, 0xe2, 0xe1, 0x7c, 0x1a, 0x89, 0xb3, 0xb4, 0x3b, 0x95, 0x38, 0x43,
+	0x7d, 0x94, 0x38, 0x91, 0x99, 0x17, 0x77, 0x35, 0xf3, 0x5b, 0x50, 0x72, 0xe3, 0x9e, 0x46, 0x58,
+	0x01, 0xb1, 0x1b, 0x9f, 0xea, 0x77, 0x37, 0x92, 0xb6, 0x48, 0x92, 0x8d, 0x24, 0xb0, 0x10, 0xa7,
+	0x65, 0xf0, 0x6d, 0x71, 0xfd, 0x96, 0x38, 0x25, 0x2a, 0xa5, 0x6c, 0x8c, 0x59, 0x54, 0x70, 0x1c,
+	0x53, 0xa0, 0x45, 0x28, 0x52, 0x93, 0x91, 0x45, 0xa7, 0xed, 0xb0, 0x4a, 0xf9, 0x8c, 0xb6, 0x9f,
+	0x4b, 0x09, 0x1c, 0x31, 0x40, 0x0d, 0x28, 0xb9, 0x49, 0xab, 0xa1, 0x32, 0xbc, 0xbf, 0xe6, 0x45,
+	0xaa, 0x5b, 0x61, 0xbc, 0xad, 0x03, 0xca, 0x58, 0x3c, 0x8f, 0xa4, 0xe1, 0xbf, 0x49, 0x3a, 0x15,
+	0x40, 0x99, 0x51, 0xb3, 0xd9, 0x74, 0x2c, 0xa1, 0xd5, 0x47, 0x48, 0x34, 0xc5, 0x73, 0xe3, 0x6a,
+	0xf4, 0xdc, 0xb8, 0xba, 0x9a, 0x1a, 0x9d, 0x6a, 0xb4, 0xa7, 0xa0, 0x38, 0x23, 0x01, 0xbd, 0xa9,
+	0xc1, 0x18, 0xcf, 0x9e, 0xd2, 0x24, 0x15, 0x7d, 0x4f, 0xab, 0xea, 0x12, 0x8b, 0xbb, 0x38, 0x24,
+	0xfd, 0xc0, 0x6e, 0x0c, 0xee, 0x91, 0x66, 0xfc, 0x5e, 0x83, 0x89, 0x9e, 0x1d, 0xe9, 0x1c, 0xc5,
+	0x1d, 0x8d, 0x0b, 0x05, 0x9e, 0x1b, 0x45, 0x29, 0xc1, 0xc2, 0x81, 0xf6, 0x3a, 0xc9, 0xca, 0x92,
+	0x3c, 0x8e, 0xc3, 0x42, 0x2c, 0x85, 0x18, 0xe7, 0x61, 0x38, 0x73, 0x1d, 0xb6, 0xf7, 0xa5, 0xb2,
+	0xf1, 0x6e, 0x01, 0xc6, 0x22, 0xbe, 0xe1, 0x4a, 0xa7, 0xdd, 0x36, 0xe9, 0x51, 0x34, 0x84, 0xbe,
+	0xa6, 0xc1, 0x68, 0xda, 0x30, 0x9d, 0x78, 0x89, 0x6a, 0x07, 0x5a, 0x22, 0x69, 0x1b, 0x27, 0x95,
+	0xec, 0xd1, 0xe5, 0xac, 0x08, 0xdc, 0x2d, 0x13, 0xfd, 0x48, 0x83, 0x53, 0x52, 0x8a, 0x7a, 0x9e,
+	0xd5, 0x35, 0xa2, 0xa2, 0x1f, 0x9a, 0x52, 0xff, 0xad, 0x94, 0x3a, 0x35, 0x7b, 0x0f, 0x79, 0xf8,
+	0x9e, 0xda, 0xa0, 0xef, 0x6b, 0x70, 0x42, 0x12, 0x74, 0xeb, 0x99, 0x3f, 0x34, 0x3d, 0x4f, 0x2b,
+	0x3d, 0x4f, 0xcc, 0xee, 0x24, 0x08, 0xef, 0x2c, 0x9f, 0xb7, 0xb6, 0xda, 0x51, 0xf3, 0xb5, 0x52,
+	0xd8, 0x9f, 0x32, 0xbd, 0xdd, 0xdb, 0x24, 0x67, 0x8b, 0x71, 0x38, 0x91, 0x63, 0x5c, 0x87, 0xe3,
+	0x0d, 0xb3, 0xa5, 0x6a, 0xda, 0x05, 0xc2, 0xae, 0x05, 0xfc, 0x9f, 0x50, 0xde, 0x1c, 0xb5, 0xa4,
+	0xd9, 0xeb, 0xe9, 0x9b, 0xa3, 0x16, 0xc1, 0x02, 0xc3, 0xbb, 0xc2, 0xae, 0x88, 0x1f, 0xb2, 0x44,
+	0x89, 0xdd, 0x49, 0xc4, 0x04, 0x2c, 0x71, 0x86, 0x09, 0xe5, 0x74, 0x67, 0xf7, 0x7e, 0x3c, 0xd1,
+	0x78, 0x5f, 0x83, 0x89, 0x9d, 0x7a, 0x4b, 0xd7, 0xef, 0x6b, 0xac, 0xe0, 0xaf, 0xcb, 0xba, 0x8a,
+	0x6a, 0x34, 0x7f, 0x28, 0x65, 0xb3, 0xf1, 0x14, 0x14, 0x93, 0xd8, 0x5a, 0x86, 0x7c, 0xc7, 0x73,
+	0xd4, 0x5d, 0x20, 0xff, 0xa2, 0x26, 0x93, 0xeb, 0xa2, 0xf3, 0x9e, 0xfe, 0x5a, 0x87, 0x86, 0x4c,
+	0x08, 0xd4, 0xc5, 0xb5, 0x9b, 0xe2, 0x71, 0xc0, 0x74, 0x78, 0xef, 0xde, 0x79, 0x92, 0xd7, 0xe9,
+	0x87, 0x99, 0xd7, 0x19, 0x3f, 0xd3, 0x21, 0x7a, 0x18, 0x80, 0x2e, 0xa4, 0xfa, 0xf3, 0x72, 0x0a,
+	0x95, 0xbd, 0x7b, 0xf3, 0x68, 0x59, 0xdd, 0x0c, 0xe4, 0xf6, 0x38, 0x74, 0xf9, 0x8f, 0x5f, 0xaa,
+	0xf2, 0xc7, 0x2f, 0xd5, 0xba, 0xc7, 0xae, 0xd1, 0x15, 0x46, 0x1d, 0xaf, 0x55, 0x1b, 0xea, 0xba,
+	0x47, 0xf8, 0x1f, 0x18, 0x24, 0x9e, 0xb8, 0x74, 0x10, 0x53, 0x2d, 0xc8, 0xd6, 0xdb, 0xbc, 0x04,
+	0xe1, 0x08, 0xc7, 0xfb, 0xde, 0x8e, 0xd5, 0x0e, 0x78, 0xf9, 0x24, 0xca, 0x9b, 0x82, 0xec, 0x94,
+	0xd5, 0xe7, 0x96, 0x1a, 0x1c, 0x86, 0x63, 0x6c, 0x44, 0x39, 0x17, 0x3d, 0xd8, 0x48, 0x51, 0x72,
+	0x18, 0x8e, 0xb1, 0x82, 0xb2, 0xa5, 0x78, 0x0e, 0xa4, 0x28, 0x17, 0x62, 0x9e, 0x0a, 0xcb, 0xef,
+	0xdf, 0xc4, 0x2d, 0x8c, 0x2a, 0xaf, 0x65, 0x77, 0xba, 0xeb, 0x51, 0xa0, 0xc2, 0xe1, 0x0c, 0x25,
+	0x9f, 0x5e, 0x48, 0x2d, 0x31, 0xbd, 0xa1, 0x64, 0x7a, 0x2b, 0x12, 0x84, 0x23, 0x1c, 0xaa, 0x02,
+	0x84, 0xd4, 0x52, 0xb3, 0x16, 0x99, 0x6f, 0xa1, 0x36, 0xc2, 0x43, 0xd3, 0x4a, 0x0c, 0xc5, 0x29,
+	0x0a, 0x83, 0xc0, 0x58, 0x77, 0x01, 0x7c, 0x3f, 0x7c, 0xff, 0xed, 0x3c, 0x9c, 0x5c, 0xe9, 0x04,
+	0x7c, 0xa3, 0xe4, 0x6b, 0xe9, 0x39, 0xdf, 0x75, 0x95, 0x11, 0xdf, 0xff, 0x08, 0xfc, 0x2a, 0x14,
+	0xc9, 0xed, 0xc0, 0xa1, 0xc4, 0x9e, 0x8d, 0xec, 0xed, 0xff, 0x3e, 0x9a, 0x88, 0x55, 0xa7, 0x4d,
+	0x92, 0xa9, 0xcd, 0x47, 0x4c, 0x70, 0xc2, 0x8f, 0xaf, 0x45, 0xe8, 0x78, 0x16, 0xe1, 0xa4, 0xca,
+	0xc9, 0xe2, 0x01, 0x2b, 0x11, 0x02, 0x27, 0x34, 0xbc, 0x6b, 0xd1, 0x8c, 0x1f, 0xa6, 0xab, 0x1b,
+	0xc4, 0xbe, 0xbb, 0x16, 0xdd, 0x0f, 0xdc, 0x93, 0x15, 0x48, 0x60, 0x38, 0x25, 0x07, 0x7d, 0x5b,
+	0x83, 0x11, 0x33, 0xfb, 0x44, 0x5c, 0xbe, 0x42, 0x5a, 0xda, 0x9f, 0xe8, 0x5d, 0x9e, 0xbb, 0xd7,
+	0x1e, 0x52, 0x7a, 0x8c, 0x74, 0xbd, 0x15, 0xef, 0x12, 0xce, 0x7f, 0x6b, 0xf3, 0xc8, 0x2e, 0x16,
+	0x71, 0x04, 0x9d, 0x46, 0x37, 0xdb, 0x69, 0xec, 0x3b, 0x57, 0xdd, 0x45, 0xf3, 0x5d, 0x7a, 0x8e,
+	0xdf, 0xcb, 0xc1, 0xa3, 0xbb, 0x8c, 0xd8, 0x77, 0xf7, 0xf1, 0x69, 0x18, 0x8e, 0xfe, 0x4f, 0xbb,
+	0x61, 0x52, 0x19, 0xa5, 0x91, 0x38, 0x4b, 0x1b, 0x89, 0x12, 0x07, 0x96, 0xde, 0x2b, 0x4a, 0x1e,
+	0x5a, 0x11, 0x05, 0xb7, 0x70, 0xcb, 0x6f, 0x07, 0x2e, 0x61, 0x44, 0xb6, 0x84, 0x86, 0x12, 0x0b,
+	0x9f, 0x8b, 0x10, 0x38, 0xa1, 0xe1, 0x19, 0x07, 0xa1, 0xd4, 0xa7, 0x95, 0x42, 0xf6, 0x1e, 0x7a,
+	0x9e, 0x03, 0xb1, 0xc4, 0x19, 0x7f, 0xd3, 0xe0, 0xf4, 0x2e, 0x8b, 0x72, 0x64, 0x25, 0xcb, 0x46,
+	0xb6, 0x64, 0x79, 0xe1, 0x90, 0xcc, 0x60, 0xcf, 0xe2, 0xe5, 0x09, 0x28, 0xa5, 0x9e, 0x29, 0xf0,
+	0x1f, 0xa7, 0x84, 0x9e, 0xd3, 0xfd, 0xe3, 0x94, 0x95, 0xe5, 0x3a, 0xe6, 0xf0, 0xda, 0xea, 0x7b,
+	0x77, 0x26, 0x8f, 0x7d, 0x70, 0x67, 0xf2, 0xd8, 0x87, 0x77, 0x26, 0x8f, 0xbd, 0xb9, 0x3d, 0xa9,
+	0xbd, 0xb7, 0x3d, 0xa9, 0x7d, 0xb0, 0x3d, 0xa9, 0x7d, 0xb8, 0x3d, 0xa9, 0xfd, 0x76, 0x7b, 0x52,
+	0xfb, 0xee, 0xef, 0x26, 0x8f, 0xbd, 0x52, 0xed, 0xef, 0x57, 0xbb, 0xff, 0x1c, 0x00, 0xc7, 0xc4,
+	0x27, 0x0e, 0xe6, 0x3b, 0x00, 0x00,
 }
 
 func (m *AddressGroup) Marshal() (dAtA []byte, err error) {
@@ -2166,6 +2418,43 @@ func (m *ClusterGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DNSProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DNSProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DNSProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryTypes) > 0 {
+		for iNdEx := len(m.QueryTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryTypes[iNdEx])
+			copy(dAtA[i:], m.QueryTypes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueryTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.QueryName)
+	copy(dAtA[i:], m.QueryName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueryName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EgressGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2389,7 +2678,7 @@ func (m *ExternalEntityReference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
+func (m *GRPCProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2399,37 +2688,70 @@ func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupAssociation) MarshalTo(dAtA []byte) (int, error) {
+func (m *GRPCProtocol) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupAssociation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GRPCProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AssociatedGroups) > 0 {
-		for iNdEx := len(m.AssociatedGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssociatedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupAssociation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupAssociation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssociatedGroups) > 0 {
+		for iNdEx := len(m.AssociatedGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssociatedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0xa
@@ -2638,6 +2960,39 @@ func (m *GroupReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPHeaderMatcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeaderMatcher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeaderMatcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2658,6 +3013,20 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -2823,6 +3192,30 @@ func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DNS != nil {
+		{
+			size, err := m.DNS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -2850,6 +3243,35 @@ func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LogSampling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogSampling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogSampling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxPerSecond))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Ratio))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *MulticastGroupInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2948,6 +3370,11 @@ func (m *NetworkPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.EnforcementMode)
+	copy(dAtA[i:], m.EnforcementMode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EnforcementMode)))
+	i--
+	dAtA[i] = 0x3a
 	if m.SourceRef != nil {
 		{
 			size, err := m.SourceRef.MarshalToSizedBuffer(dAtA[:i])
@@ -3007,6 +3434,101 @@ func (m *NetworkPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NetworkPolicyAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkPolicyAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkPolicyAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Findings) > 0 {
+		for iNdEx := len(m.Findings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Findings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkPolicyAnalysisFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkPolicyAnalysisFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkPolicyAnalysisFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RelatedRule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NetworkPolicyEvaluation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3383,6 +3905,30 @@ func (m *NetworkPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LogSampling != nil {
+		{
+			size, err := m.LogSampling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i -= len(m.LogLabel)
 	copy(dAtA[i:], m.LogLabel)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogLabel)))
@@ -3759,7 +4305,7 @@ func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RuleRef) Marshal() (dAtA []byte, err error) {
+func (m *PolicyRuleReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3769,26 +4315,106 @@ func (m *RuleRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RuleRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *PolicyRuleReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RuleRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PolicyRuleReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Action != nil {
-		i -= len(*m.Action)
-		copy(dAtA[i:], *m.Action)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Action)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RuleIndex))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.NetworkPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Rate))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Unit)
+	copy(dAtA[i:], m.Unit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Unit)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RuleRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuleRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuleRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != nil {
+		i -= len(*m.Action)
+		copy(dAtA[i:], *m.Action)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0x12
 	i -= len(m.Direction)
@@ -4346,6 +4972,23 @@ func (m *ClusterGroupMembers) Size() (n int) {
 	return n
 }
 
+func (m *DNSProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.QueryTypes) > 0 {
+		for _, s := range m.QueryTypes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EgressGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4429,6 +5072,19 @@ func (m *ExternalEntityReference) Size() (n int) {
 	return n
 }
 
+func (m *GRPCProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GroupAssociation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4524,6 +5180,19 @@ func (m *GroupReference) Size() (n int) {
 	return n
 }
 
+func (m *HTTPHeaderMatcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HTTPProtocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4536,6 +5205,12 @@ func (m *HTTPProtocol) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4601,6 +5276,25 @@ func (m *L7Protocol) Size() (n int) {
 		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNS != nil {
+		l = m.DNS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *LogSampling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Ratio))
+	n += 1 + sovGenerated(uint64(m.MaxPerSecond))
 	return n
 }
 
@@ -4665,6 +5359,40 @@ func (m *NetworkPolicy) Size() (n int) {
 		l = m.SourceRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.EnforcementMode)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NetworkPolicyAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Findings) > 0 {
+		for _, e := range m.Findings {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkPolicyAnalysisFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Rule.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.RelatedRule.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4841,6 +5569,14 @@ func (m *NetworkPolicyRule) Size() (n int) {
 	}
 	l = len(m.LogLabel)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LogSampling != nil {
+		l = m.LogSampling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4950,6 +5686,33 @@ func (m *PodReference) Size() (n int) {
 	return n
 }
 
+func (m *PolicyRuleReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetworkPolicy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RuleIndex))
+	l = m.Rule.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Unit)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Rate))
+	n += 1 + sovGenerated(uint64(m.Burst))
+	return n
+}
+
 func (m *RuleRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5272,6 +6035,17 @@ func (this *ClusterGroupMembers) String() string {
 	}, "")
 	return s
 }
+func (this *DNSProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DNSProtocol{`,
+		`QueryName:` + fmt.Sprintf("%v", this.QueryName) + `,`,
+		`QueryTypes:` + fmt.Sprintf("%v", this.QueryTypes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EgressGroup) String() string {
 	if this == nil {
 		return "nil"
@@ -5347,6 +6121,17 @@ func (this *ExternalEntityReference) String() string {
 	}, "")
 	return s
 }
+func (this *GRPCProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCProtocol{`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GroupAssociation) String() string {
 	if this == nil {
 		return "nil"
@@ -5420,14 +6205,31 @@ func (this *GroupReference) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPHeaderMatcher) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderMatcher{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HTTPProtocol) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHeaders := "[]HTTPHeaderMatcher{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeaderMatcher", "HTTPHeaderMatcher", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
 	s := strings.Join([]string{`&HTTPProtocol{`,
 		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
 		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5482,6 +6284,19 @@ func (this *L7Protocol) String() string {
 	s := strings.Join([]string{`&L7Protocol{`,
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProtocol", "HTTPProtocol", 1) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLSProtocol", "TLSProtocol", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPCProtocol", "GRPCProtocol", 1) + `,`,
+		`DNS:` + strings.Replace(this.DNS.String(), "DNSProtocol", "DNSProtocol", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogSampling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogSampling{`,
+		`Ratio:` + fmt.Sprintf("%v", this.Ratio) + `,`,
+		`MaxPerSecond:` + fmt.Sprintf("%v", this.MaxPerSecond) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5530,6 +6345,35 @@ func (this *NetworkPolicy) String() string {
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
 		`TierPriority:` + valueToStringGenerated(this.TierPriority) + `,`,
 		`SourceRef:` + strings.Replace(this.SourceRef.String(), "NetworkPolicyReference", "NetworkPolicyReference", 1) + `,`,
+		`EnforcementMode:` + fmt.Sprintf("%v", this.EnforcementMode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkPolicyAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFindings := "[]NetworkPolicyAnalysisFinding{"
+	for _, f := range this.Findings {
+		repeatedStringForFindings += strings.Replace(strings.Replace(f.String(), "NetworkPolicyAnalysisFinding", "NetworkPolicyAnalysisFinding", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFindings += "}"
+	s := strings.Join([]string{`&NetworkPolicyAnalysis{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Findings:` + repeatedStringForFindings + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkPolicyAnalysisFinding) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkPolicyAnalysisFinding{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Rule:` + strings.Replace(strings.Replace(this.Rule.String(), "PolicyRuleReference", "PolicyRuleReference", 1), `&`, ``, 1) + `,`,
+		`RelatedRule:` + strings.Replace(strings.Replace(this.RelatedRule.String(), "PolicyRuleReference", "PolicyRuleReference", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5661,6 +6505,8 @@ func (this *NetworkPolicyRule) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`L7Protocols:` + repeatedStringForL7Protocols + `,`,
 		`LogLabel:` + fmt.Sprintf("%v", this.LogLabel) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
+		`LogSampling:` + strings.Replace(this.LogSampling.String(), "LogSampling", "LogSampling", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5764,6 +6610,30 @@ func (this *PodReference) String() string {
 	}, "")
 	return s
 }
+func (this *PolicyRuleReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PolicyRuleReference{`,
+		`NetworkPolicy:` + strings.Replace(strings.Replace(this.NetworkPolicy.String(), "NetworkPolicyReference", "NetworkPolicyReference", 1), `&`, ``, 1) + `,`,
+		`RuleIndex:` + fmt.Sprintf("%v", this.RuleIndex) + `,`,
+		`Rule:` + strings.Replace(strings.Replace(this.Rule.String(), "RuleRef", "RuleRef", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimit{`,
+		`Unit:` + fmt.Sprintf("%v", this.Unit) + `,`,
+		`Rate:` + fmt.Sprintf("%v", this.Rate) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RuleRef) String() string {
 	if this == nil {
 		return "nil"
@@ -7241,7 +8111,7 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EgressGroup) Unmarshal(dAtA []byte) error {
+func (m *DNSProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7264,17 +8134,17 @@ func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EgressGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNSProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EgressGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNSProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7284,15 +8154,129 @@ func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryTypes = append(m.QueryTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
@@ -7826,6 +8810,120 @@ func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GRPCProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8557,7 +9655,7 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderMatcher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,15 +9678,15 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderMatcher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8616,11 +9714,11 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8648,29 +9746,143 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			postIndex := iNdEx + intStringLen
@@ -8682,6 +9894,40 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, HTTPHeaderMatcher{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9141,6 +10387,78 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPC == nil {
+				m.GRPC = &GRPCProtocol{}
+			}
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DNS == nil {
+				m.DNS = &DNSProtocol{}
+			}
+			if err := m.DNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9162,7 +10480,7 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
+func (m *LogSampling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9181,21 +10499,489 @@ func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MulticastGroupInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MulticastGroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogSampling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogSampling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			m.Ratio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ratio |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerSecond", wireType)
+			}
+			m.MaxPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MulticastGroupInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MulticastGroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pods = append(m.Pods, PodReference{})
+			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamedPort) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedPort: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedPort: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = Protocol(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, NetworkPolicyRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedToGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedToGroups = append(m.AppliedToGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Priority = &v2
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierPriority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TierPriority = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9205,29 +10991,33 @@ func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.SourceRef == nil {
+				m.SourceRef = &NetworkPolicyReference{}
+			}
+			if err := m.SourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforcementMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9237,25 +11027,23 @@ func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pods = append(m.Pods, PodReference{})
-			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EnforcementMode = antrea_io_antrea_pkg_apis_crd_v1beta1.PolicyEnforcementMode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9278,7 +11066,7 @@ func (m *MulticastGroupInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamedPort) Unmarshal(dAtA []byte) error {
+func (m *NetworkPolicyAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9301,36 +11089,17 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamedPort: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkPolicyAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamedPort: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkPolicyAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
-			}
-			m.Port = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Port |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9340,29 +11109,30 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Findings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9372,23 +11142,25 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = Protocol(dAtA[iNdEx:postIndex])
+			m.Findings = append(m.Findings, NetworkPolicyAnalysisFinding{})
+			if err := m.Findings[len(m.Findings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9411,7 +11183,7 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
+func (m *NetworkPolicyAnalysisFinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9434,17 +11206,17 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkPolicyAnalysisFinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkPolicyAnalysisFinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9454,28 +11226,27 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = NetworkPolicyAnalysisFindingType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9502,78 +11273,13 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rules = append(m.Rules, NetworkPolicyRule{})
-			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppliedToGroups", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AppliedToGroups = append(m.AppliedToGroups, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			v2 := float64(math.Float64frombits(v))
-			m.Priority = &v2
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TierPriority", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TierPriority = &v
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelatedRule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9600,10 +11306,7 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceRef == nil {
-				m.SourceRef = &NetworkPolicyReference{}
-			}
-			if err := m.SourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RelatedRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11071,11 +12774,79 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogSampling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11085,23 +12856,27 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogLabel = string(dAtA[iNdEx:postIndex])
+			if m.LogSampling == nil {
+				m.LogSampling = &LogSampling{}
+			}
+			if err := m.LogSampling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11894,6 +13669,261 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PolicyRuleReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyRuleReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyRuleReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleIndex", wireType)
+			}
+			m.RuleIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RuleIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = antrea_io_antrea_pkg_apis_crd_v1beta1.RateLimitUnit(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
+			}
+			m.Burst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burst |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RuleRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -49,6 +49,11 @@ var (
 		Version:  SchemeGroupVersion.Version,
 		Resource: "networkpolicyevaluation",
 	}
+	NetworkPolicyAnalysisVersionResource = schema.GroupVersionResource{
+		Group:    SchemeGroupVersion.Group,
+		Version:  SchemeGroupVersion.Version,
+		Resource: "networkpolicyanalyses",
+	}
 )
 
 // Resource takes an unqualified resource and returns a Group qualified GroupResource.
@@ -75,6 +80,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkPolicyList{},
 		&NetworkPolicyStatus{},
 		&NetworkPolicyEvaluation{},
+		&NetworkPolicyAnalysis{},
 		&NodeStatsSummary{},
 		&ClusterGroupMembers{},
 		&GroupMembers{},
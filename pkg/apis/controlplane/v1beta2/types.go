@@ -235,6 +235,9 @@ type NetworkPolicy struct {
 	TierPriority *int32 `json:"tierPriority,omitempty" protobuf:"varint,5,opt,name=tierPriority"`
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference `json:"sourceRef,omitempty" protobuf:"bytes,6,opt,name=sourceRef"`
+	// EnforcementMode specifies whether the Drop and Reject actions of the rules are enforced.
+	// It is empty for K8s NetworkPolicy, which means the rules are enforced.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode `json:"enforcementMode,omitempty" protobuf:"bytes,7,opt,name=enforcementMode,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.PolicyEnforcementMode"`
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -277,6 +280,31 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol `json:"l7Protocols,omitempty" protobuf:"bytes,10,rep,name=l7Protocols"`
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string `json:"logLabel,omitempty" protobuf:"bytes,11,opt,name=logLabel"`
+	// RateLimit specifies the rate to which the traffic matching the rule is policed.
+	// It is only set for rules whose action is RateLimit.
+	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,12,opt,name=rateLimit"`
+	// LogSampling specifies how the connections logged for the rule are sampled.
+	// It is only set for Allow rules with EnableLogging set to true.
+	LogSampling *LogSampling `json:"logSampling,omitempty" protobuf:"bytes,13,opt,name=logSampling"`
+}
+
+// LogSampling describes how the connections logged for a rule are sampled.
+// Exactly one of Ratio and MaxPerSecond is set.
+type LogSampling struct {
+	// Ratio logs 1 of every Ratio connections.
+	Ratio int32 `json:"ratio,omitempty" protobuf:"varint,1,opt,name=ratio"`
+	// MaxPerSecond logs at most MaxPerSecond connections per second.
+	MaxPerSecond int32 `json:"maxPerSecond,omitempty" protobuf:"varint,2,opt,name=maxPerSecond"`
+}
+
+// RateLimit describes the rate to which the traffic matching a rule is policed.
+type RateLimit struct {
+	// Unit of Rate and Burst.
+	Unit crdv1beta1.RateLimitUnit `json:"unit,omitempty" protobuf:"bytes,1,opt,name=unit,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.RateLimitUnit"`
+	// Rate is the maximum rate of the traffic, in packets per second or bits per second.
+	Rate int64 `json:"rate,omitempty" protobuf:"varint,2,opt,name=rate"`
+	// Burst is the maximum burst size, in packets or bits.
+	Burst int64 `json:"burst,omitempty" protobuf:"varint,3,opt,name=burst"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -330,6 +358,8 @@ type Service struct {
 type L7Protocol struct {
 	HTTP *HTTPProtocol `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
 	TLS  *TLSProtocol  `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	GRPC *GRPCProtocol `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+	DNS  *DNSProtocol  `json:"dns,omitempty" protobuf:"bytes,4,opt,name=dns"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -343,6 +373,34 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// Headers is a list of HTTP request headers to match. A request is matched only if all the headers are matched.
+	Headers []HTTPHeaderMatcher `json:"headers,omitempty" protobuf:"bytes,4,rep,name=headers"`
+}
+
+// HTTPHeaderMatcher matches an HTTP request header with a specific name and, optionally, a specific value.
+type HTTPHeaderMatcher struct {
+	// Name is the name of the header to match. It is case-insensitive.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Value is the exact value of the header to match. If it is not provided, any request with the header is matched.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+}
+
+// GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match.
+	Service string `json:"service,omitempty" protobuf:"bytes,1,opt,name=service"`
+	// Method represents the name of the gRPC method to match.
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+}
+
+// DNSProtocol matches DNS queries with specific query name and query types. All fields could be used alone or together.
+// If all fields are not provided, it matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match.
+	QueryName string `json:"queryName,omitempty" protobuf:"bytes,1,opt,name=queryName"`
+	// QueryTypes represents the types of DNS query to match.
+	QueryTypes []string `json:"queryTypes,omitempty" protobuf:"bytes,2,rep,name=queryTypes"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -497,6 +555,52 @@ type NetworkPolicyEvaluationResponse struct {
 	Rule RuleRef `json:"rule,omitempty" protobuf:"bytes,3,opt,name=rule"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkPolicyAnalysis contains the result of the analysis of the rules of all NetworkPolicies.
+type NetworkPolicyAnalysis struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Findings is the list of shadowed, redundant and conflicting rules.
+	Findings []NetworkPolicyAnalysisFinding `json:"findings,omitempty" protobuf:"bytes,2,rep,name=findings"`
+}
+
+// NetworkPolicyAnalysisFindingType is the type of a NetworkPolicyAnalysisFinding.
+type NetworkPolicyAnalysisFindingType string
+
+const (
+	// NetworkPolicyAnalysisFindingShadowed means that the rule is fully covered by a rule with a higher
+	// precedence and a different action, so it never takes effect.
+	NetworkPolicyAnalysisFindingShadowed NetworkPolicyAnalysisFindingType = "Shadowed"
+	// NetworkPolicyAnalysisFindingRedundant means that the rule is fully covered by a rule with a higher or
+	// equal precedence and the same action, so it can be removed without changing the behavior.
+	NetworkPolicyAnalysisFindingRedundant NetworkPolicyAnalysisFindingType = "Redundant"
+	// NetworkPolicyAnalysisFindingConflicting means that the rule overlaps with a rule with the same precedence
+	// and a different action, so the action applied to the overlapping traffic is undefined.
+	NetworkPolicyAnalysisFindingConflicting NetworkPolicyAnalysisFindingType = "Conflicting"
+)
+
+// NetworkPolicyAnalysisFinding describes an issue detected between two rules.
+type NetworkPolicyAnalysisFinding struct {
+	Type NetworkPolicyAnalysisFindingType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=NetworkPolicyAnalysisFindingType"`
+	// The rule the finding is about.
+	Rule PolicyRuleReference `json:"rule,omitempty" protobuf:"bytes,2,opt,name=rule"`
+	// The rule which shadows Rule, makes it redundant, or conflicts with it.
+	RelatedRule PolicyRuleReference `json:"relatedRule,omitempty" protobuf:"bytes,3,opt,name=relatedRule"`
+}
+
+// PolicyRuleReference references a rule of a NetworkPolicy.
+type PolicyRuleReference struct {
+	// The reference of the original NetworkPolicy.
+	NetworkPolicy NetworkPolicyReference `json:"networkPolicy,omitempty" protobuf:"bytes,1,opt,name=networkPolicy"`
+	// The index of the rule among the rules of the same direction in the original NetworkPolicy.
+	RuleIndex int32   `json:"ruleIndex,omitempty" protobuf:"varint,2,opt,name=ruleIndex"`
+	Rule      RuleRef `json:"rule,omitempty" protobuf:"bytes,3,opt,name=rule"`
+}
+
 type GroupReference struct {
 	// Namespace of the Group. Empty for ClusterGroup.
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
@@ -139,6 +139,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSProtocol)(nil), (*controlplane.DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(a.(*DNSProtocol), b.(*controlplane.DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.DNSProtocol)(nil), (*DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(a.(*controlplane.DNSProtocol), b.(*DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EgressGroup)(nil), (*controlplane.EgressGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_EgressGroup_To_controlplane_EgressGroup(a.(*EgressGroup), b.(*controlplane.EgressGroup), scope)
 	}); err != nil {
@@ -189,6 +199,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GRPCProtocol)(nil), (*controlplane.GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(a.(*GRPCProtocol), b.(*controlplane.GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.GRPCProtocol)(nil), (*GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(a.(*controlplane.GRPCProtocol), b.(*GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GroupAssociation)(nil), (*controlplane.GroupAssociation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(a.(*GroupAssociation), b.(*controlplane.GroupAssociation), scope)
 	}); err != nil {
@@ -229,6 +249,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPHeaderMatcher)(nil), (*controlplane.HTTPHeaderMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPHeaderMatcher_To_controlplane_HTTPHeaderMatcher(a.(*HTTPHeaderMatcher), b.(*controlplane.HTTPHeaderMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPHeaderMatcher)(nil), (*HTTPHeaderMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPHeaderMatcher_To_v1beta2_HTTPHeaderMatcher(a.(*controlplane.HTTPHeaderMatcher), b.(*HTTPHeaderMatcher), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPProtocol)(nil), (*controlplane.HTTPProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(a.(*HTTPProtocol), b.(*controlplane.HTTPProtocol), scope)
 	}); err != nil {
@@ -279,6 +309,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LogSampling)(nil), (*controlplane.LogSampling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LogSampling_To_controlplane_LogSampling(a.(*LogSampling), b.(*controlplane.LogSampling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.LogSampling)(nil), (*LogSampling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_LogSampling_To_v1beta2_LogSampling(a.(*controlplane.LogSampling), b.(*LogSampling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MulticastGroupInfo)(nil), (*controlplane.MulticastGroupInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_MulticastGroupInfo_To_controlplane_MulticastGroupInfo(a.(*MulticastGroupInfo), b.(*controlplane.MulticastGroupInfo), scope)
 	}); err != nil {
@@ -309,6 +349,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyAnalysis)(nil), (*controlplane.NetworkPolicyAnalysis)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkPolicyAnalysis_To_controlplane_NetworkPolicyAnalysis(a.(*NetworkPolicyAnalysis), b.(*controlplane.NetworkPolicyAnalysis), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.NetworkPolicyAnalysis)(nil), (*NetworkPolicyAnalysis)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_NetworkPolicyAnalysis_To_v1beta2_NetworkPolicyAnalysis(a.(*controlplane.NetworkPolicyAnalysis), b.(*NetworkPolicyAnalysis), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyAnalysisFinding)(nil), (*controlplane.NetworkPolicyAnalysisFinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkPolicyAnalysisFinding_To_controlplane_NetworkPolicyAnalysisFinding(a.(*NetworkPolicyAnalysisFinding), b.(*controlplane.NetworkPolicyAnalysisFinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.NetworkPolicyAnalysisFinding)(nil), (*NetworkPolicyAnalysisFinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_NetworkPolicyAnalysisFinding_To_v1beta2_NetworkPolicyAnalysisFinding(a.(*controlplane.NetworkPolicyAnalysisFinding), b.(*NetworkPolicyAnalysisFinding), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicyEvaluation)(nil), (*controlplane.NetworkPolicyEvaluation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_NetworkPolicyEvaluation_To_controlplane_NetworkPolicyEvaluation(a.(*NetworkPolicyEvaluation), b.(*controlplane.NetworkPolicyEvaluation), scope)
 	}); err != nil {
@@ -449,6 +509,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PolicyRuleReference)(nil), (*controlplane.PolicyRuleReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PolicyRuleReference_To_controlplane_PolicyRuleReference(a.(*PolicyRuleReference), b.(*controlplane.PolicyRuleReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.PolicyRuleReference)(nil), (*PolicyRuleReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_PolicyRuleReference_To_v1beta2_PolicyRuleReference(a.(*controlplane.PolicyRuleReference), b.(*PolicyRuleReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RateLimit)(nil), (*controlplane.RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RateLimit_To_controlplane_RateLimit(a.(*RateLimit), b.(*controlplane.RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.RateLimit)(nil), (*RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_RateLimit_To_v1beta2_RateLimit(a.(*controlplane.RateLimit), b.(*RateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RuleRef)(nil), (*controlplane.RuleRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_RuleRef_To_controlplane_RuleRef(a.(*RuleRef), b.(*controlplane.RuleRef), scope)
 	}); err != nil {
@@ -771,6 +851,28 @@ func Convert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in
 	return autoConvert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in, out, s)
 }
 
+func autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	out.QueryTypes = *(*[]string)(unsafe.Pointer(&in.QueryTypes))
+	return nil
+}
+
+// Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol is an autogenerated conversion function.
+func Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	out.QueryTypes = *(*[]string)(unsafe.Pointer(&in.QueryTypes))
+	return nil
+}
+
+// Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol is an autogenerated conversion function.
+func Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_EgressGroup_To_controlplane_EgressGroup(in *EgressGroup, out *controlplane.EgressGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.GroupMembers = *(*[]controlplane.GroupMember)(unsafe.Pointer(&in.GroupMembers))
@@ -881,6 +983,28 @@ func Convert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityRefer
 	return autoConvert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityReference(in, out, s)
 }
 
+func autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	return nil
+}
+
+// Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol is an autogenerated conversion function.
+func Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	return nil
+}
+
+// Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol is an autogenerated conversion function.
+func Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(in *GroupAssociation, out *controlplane.GroupAssociation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.AssociatedGroups = *(*[]controlplane.GroupReference)(unsafe.Pointer(&in.AssociatedGroups))
@@ -987,10 +1111,33 @@ func Convert_controlplane_GroupReference_To_v1beta2_GroupReference(in *controlpl
 	return autoConvert_controlplane_GroupReference_To_v1beta2_GroupReference(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPHeaderMatcher_To_controlplane_HTTPHeaderMatcher(in *HTTPHeaderMatcher, out *controlplane.HTTPHeaderMatcher, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta2_HTTPHeaderMatcher_To_controlplane_HTTPHeaderMatcher is an autogenerated conversion function.
+func Convert_v1beta2_HTTPHeaderMatcher_To_controlplane_HTTPHeaderMatcher(in *HTTPHeaderMatcher, out *controlplane.HTTPHeaderMatcher, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPHeaderMatcher_To_controlplane_HTTPHeaderMatcher(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPHeaderMatcher_To_v1beta2_HTTPHeaderMatcher(in *controlplane.HTTPHeaderMatcher, out *HTTPHeaderMatcher, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_controlplane_HTTPHeaderMatcher_To_v1beta2_HTTPHeaderMatcher is an autogenerated conversion function.
+func Convert_controlplane_HTTPHeaderMatcher_To_v1beta2_HTTPHeaderMatcher(in *controlplane.HTTPHeaderMatcher, out *HTTPHeaderMatcher, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPHeaderMatcher_To_v1beta2_HTTPHeaderMatcher(in, out, s)
+}
+
 func autoConvert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in *HTTPProtocol, out *controlplane.HTTPProtocol, s conversion.Scope) error {
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.Headers = *(*[]controlplane.HTTPHeaderMatcher)(unsafe.Pointer(&in.Headers))
 	return nil
 }
 
@@ -1003,6 +1150,7 @@ func autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlpl
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.Headers = *(*[]HTTPHeaderMatcher)(unsafe.Pointer(&in.Headers))
 	return nil
 }
 
@@ -1084,6 +1232,8 @@ func Convert_controlplane_IPNet_To_v1beta2_IPNet(in *controlplane.IPNet, out *IP
 func autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*controlplane.HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*controlplane.TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*controlplane.GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*controlplane.DNSProtocol)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -1095,6 +1245,8 @@ func Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *
 func autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*DNSProtocol)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -1103,6 +1255,28 @@ func Convert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Pr
 	return autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in, out, s)
 }
 
+func autoConvert_v1beta2_LogSampling_To_controlplane_LogSampling(in *LogSampling, out *controlplane.LogSampling, s conversion.Scope) error {
+	out.Ratio = in.Ratio
+	out.MaxPerSecond = in.MaxPerSecond
+	return nil
+}
+
+// Convert_v1beta2_LogSampling_To_controlplane_LogSampling is an autogenerated conversion function.
+func Convert_v1beta2_LogSampling_To_controlplane_LogSampling(in *LogSampling, out *controlplane.LogSampling, s conversion.Scope) error {
+	return autoConvert_v1beta2_LogSampling_To_controlplane_LogSampling(in, out, s)
+}
+
+func autoConvert_controlplane_LogSampling_To_v1beta2_LogSampling(in *controlplane.LogSampling, out *LogSampling, s conversion.Scope) error {
+	out.Ratio = in.Ratio
+	out.MaxPerSecond = in.MaxPerSecond
+	return nil
+}
+
+// Convert_controlplane_LogSampling_To_v1beta2_LogSampling is an autogenerated conversion function.
+func Convert_controlplane_LogSampling_To_v1beta2_LogSampling(in *controlplane.LogSampling, out *LogSampling, s conversion.Scope) error {
+	return autoConvert_controlplane_LogSampling_To_v1beta2_LogSampling(in, out, s)
+}
+
 func autoConvert_v1beta2_MulticastGroupInfo_To_controlplane_MulticastGroupInfo(in *MulticastGroupInfo, out *controlplane.MulticastGroupInfo, s conversion.Scope) error {
 	out.Group = in.Group
 	out.Pods = *(*[]controlplane.PodReference)(unsafe.Pointer(&in.Pods))
@@ -1166,6 +1340,7 @@ func autoConvert_v1beta2_NetworkPolicy_To_controlplane_NetworkPolicy(in *Network
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*controlplane.NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1beta1.PolicyEnforcementMode(in.EnforcementMode)
 	return nil
 }
 
@@ -1191,6 +1366,7 @@ func autoConvert_controlplane_NetworkPolicy_To_v1beta2_NetworkPolicy(in *control
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1beta1.PolicyEnforcementMode(in.EnforcementMode)
 	return nil
 }
 
@@ -1199,6 +1375,80 @@ func Convert_controlplane_NetworkPolicy_To_v1beta2_NetworkPolicy(in *controlplan
 	return autoConvert_controlplane_NetworkPolicy_To_v1beta2_NetworkPolicy(in, out, s)
 }
 
+func autoConvert_v1beta2_NetworkPolicyAnalysis_To_controlplane_NetworkPolicyAnalysis(in *NetworkPolicyAnalysis, out *controlplane.NetworkPolicyAnalysis, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if in.Findings != nil {
+		in, out := &in.Findings, &out.Findings
+		*out = make([]controlplane.NetworkPolicyAnalysisFinding, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_NetworkPolicyAnalysisFinding_To_controlplane_NetworkPolicyAnalysisFinding(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Findings = nil
+	}
+	return nil
+}
+
+// Convert_v1beta2_NetworkPolicyAnalysis_To_controlplane_NetworkPolicyAnalysis is an autogenerated conversion function.
+func Convert_v1beta2_NetworkPolicyAnalysis_To_controlplane_NetworkPolicyAnalysis(in *NetworkPolicyAnalysis, out *controlplane.NetworkPolicyAnalysis, s conversion.Scope) error {
+	return autoConvert_v1beta2_NetworkPolicyAnalysis_To_controlplane_NetworkPolicyAnalysis(in, out, s)
+}
+
+func autoConvert_controlplane_NetworkPolicyAnalysis_To_v1beta2_NetworkPolicyAnalysis(in *controlplane.NetworkPolicyAnalysis, out *NetworkPolicyAnalysis, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if in.Findings != nil {
+		in, out := &in.Findings, &out.Findings
+		*out = make([]NetworkPolicyAnalysisFinding, len(*in))
+		for i := range *in {
+			if err := Convert_controlplane_NetworkPolicyAnalysisFinding_To_v1beta2_NetworkPolicyAnalysisFinding(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Findings = nil
+	}
+	return nil
+}
+
+// Convert_controlplane_NetworkPolicyAnalysis_To_v1beta2_NetworkPolicyAnalysis is an autogenerated conversion function.
+func Convert_controlplane_NetworkPolicyAnalysis_To_v1beta2_NetworkPolicyAnalysis(in *controlplane.NetworkPolicyAnalysis, out *NetworkPolicyAnalysis, s conversion.Scope) error {
+	return autoConvert_controlplane_NetworkPolicyAnalysis_To_v1beta2_NetworkPolicyAnalysis(in, out, s)
+}
+
+func autoConvert_v1beta2_NetworkPolicyAnalysisFinding_To_controlplane_NetworkPolicyAnalysisFinding(in *NetworkPolicyAnalysisFinding, out *controlplane.NetworkPolicyAnalysisFinding, s conversion.Scope) error {
+	out.Type = controlplane.NetworkPolicyAnalysisFindingType(in.Type)
+	if err := Convert_v1beta2_PolicyRuleReference_To_controlplane_PolicyRuleReference(&in.Rule, &out.Rule, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta2_PolicyRuleReference_To_controlplane_PolicyRuleReference(&in.RelatedRule, &out.RelatedRule, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_NetworkPolicyAnalysisFinding_To_controlplane_NetworkPolicyAnalysisFinding is an autogenerated conversion function.
+func Convert_v1beta2_NetworkPolicyAnalysisFinding_To_controlplane_NetworkPolicyAnalysisFinding(in *NetworkPolicyAnalysisFinding, out *controlplane.NetworkPolicyAnalysisFinding, s conversion.Scope) error {
+	return autoConvert_v1beta2_NetworkPolicyAnalysisFinding_To_controlplane_NetworkPolicyAnalysisFinding(in, out, s)
+}
+
+func autoConvert_controlplane_NetworkPolicyAnalysisFinding_To_v1beta2_NetworkPolicyAnalysisFinding(in *controlplane.NetworkPolicyAnalysisFinding, out *NetworkPolicyAnalysisFinding, s conversion.Scope) error {
+	out.Type = NetworkPolicyAnalysisFindingType(in.Type)
+	if err := Convert_controlplane_PolicyRuleReference_To_v1beta2_PolicyRuleReference(&in.Rule, &out.Rule, s); err != nil {
+		return err
+	}
+	if err := Convert_controlplane_PolicyRuleReference_To_v1beta2_PolicyRuleReference(&in.RelatedRule, &out.RelatedRule, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_controlplane_NetworkPolicyAnalysisFinding_To_v1beta2_NetworkPolicyAnalysisFinding is an autogenerated conversion function.
+func Convert_controlplane_NetworkPolicyAnalysisFinding_To_v1beta2_NetworkPolicyAnalysisFinding(in *controlplane.NetworkPolicyAnalysisFinding, out *NetworkPolicyAnalysisFinding, s conversion.Scope) error {
+	return autoConvert_controlplane_NetworkPolicyAnalysisFinding_To_v1beta2_NetworkPolicyAnalysisFinding(in, out, s)
+}
+
 func autoConvert_v1beta2_NetworkPolicyEvaluation_To_controlplane_NetworkPolicyEvaluation(in *NetworkPolicyEvaluation, out *controlplane.NetworkPolicyEvaluation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Request = (*controlplane.NetworkPolicyEvaluationRequest)(unsafe.Pointer(in.Request))
@@ -1433,6 +1683,8 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.Name = in.Name
 	out.L7Protocols = *(*[]controlplane.L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.RateLimit = (*controlplane.RateLimit)(unsafe.Pointer(in.RateLimit))
+	out.LogSampling = (*controlplane.LogSampling)(unsafe.Pointer(in.LogSampling))
 	return nil
 }
 
@@ -1467,6 +1719,8 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.L7Protocols = *(*[]L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.RateLimit = (*RateLimit)(unsafe.Pointer(in.RateLimit))
+	out.LogSampling = (*LogSampling)(unsafe.Pointer(in.LogSampling))
 	return nil
 }
 
@@ -1642,6 +1896,62 @@ func Convert_controlplane_PodReference_To_v1beta2_PodReference(in *controlplane.
 	return autoConvert_controlplane_PodReference_To_v1beta2_PodReference(in, out, s)
 }
 
+func autoConvert_v1beta2_PolicyRuleReference_To_controlplane_PolicyRuleReference(in *PolicyRuleReference, out *controlplane.PolicyRuleReference, s conversion.Scope) error {
+	if err := Convert_v1beta2_NetworkPolicyReference_To_controlplane_NetworkPolicyReference(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
+		return err
+	}
+	out.RuleIndex = in.RuleIndex
+	if err := Convert_v1beta2_RuleRef_To_controlplane_RuleRef(&in.Rule, &out.Rule, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta2_PolicyRuleReference_To_controlplane_PolicyRuleReference is an autogenerated conversion function.
+func Convert_v1beta2_PolicyRuleReference_To_controlplane_PolicyRuleReference(in *PolicyRuleReference, out *controlplane.PolicyRuleReference, s conversion.Scope) error {
+	return autoConvert_v1beta2_PolicyRuleReference_To_controlplane_PolicyRuleReference(in, out, s)
+}
+
+func autoConvert_controlplane_PolicyRuleReference_To_v1beta2_PolicyRuleReference(in *controlplane.PolicyRuleReference, out *PolicyRuleReference, s conversion.Scope) error {
+	if err := Convert_controlplane_NetworkPolicyReference_To_v1beta2_NetworkPolicyReference(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
+		return err
+	}
+	out.RuleIndex = in.RuleIndex
+	if err := Convert_controlplane_RuleRef_To_v1beta2_RuleRef(&in.Rule, &out.Rule, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_controlplane_PolicyRuleReference_To_v1beta2_PolicyRuleReference is an autogenerated conversion function.
+func Convert_controlplane_PolicyRuleReference_To_v1beta2_PolicyRuleReference(in *controlplane.PolicyRuleReference, out *PolicyRuleReference, s conversion.Scope) error {
+	return autoConvert_controlplane_PolicyRuleReference_To_v1beta2_PolicyRuleReference(in, out, s)
+}
+
+func autoConvert_v1beta2_RateLimit_To_controlplane_RateLimit(in *RateLimit, out *controlplane.RateLimit, s conversion.Scope) error {
+	out.Unit = v1beta1.RateLimitUnit(in.Unit)
+	out.Rate = in.Rate
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_v1beta2_RateLimit_To_controlplane_RateLimit is an autogenerated conversion function.
+func Convert_v1beta2_RateLimit_To_controlplane_RateLimit(in *RateLimit, out *controlplane.RateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta2_RateLimit_To_controlplane_RateLimit(in, out, s)
+}
+
+func autoConvert_controlplane_RateLimit_To_v1beta2_RateLimit(in *controlplane.RateLimit, out *RateLimit, s conversion.Scope) error {
+	out.Unit = v1beta1.RateLimitUnit(in.Unit)
+	out.Rate = in.Rate
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_controlplane_RateLimit_To_v1beta2_RateLimit is an autogenerated conversion function.
+func Convert_controlplane_RateLimit_To_v1beta2_RateLimit(in *controlplane.RateLimit, out *RateLimit, s conversion.Scope) error {
+	return autoConvert_controlplane_RateLimit_To_v1beta2_RateLimit(in, out, s)
+}
+
 func autoConvert_v1beta2_RuleRef_To_controlplane_RuleRef(in *RuleRef, out *controlplane.RuleRef, s conversion.Scope) error {
 	out.Direction = controlplane.Direction(in.Direction)
 	out.Name = in.Name
@@ -277,21 +277,79 @@ type BGPPolicySpec struct {
 
 	// BGPPeers is the list of BGP peers.
 	BGPPeers []BGPPeer `json:"bgpPeers,omitempty"`
+
+	// RouteImport configures the routes received from BGP peers to be installed on the Node. If not set, the received
+	// routes are not installed.
+	RouteImport *RouteImport `json:"routeImport,omitempty"`
+}
+
+type ImportRouteTable string
+
+const (
+	// ImportRouteTableMain installs the imported routes into the main route table of the Node.
+	ImportRouteTableMain ImportRouteTable = "Main"
+	// ImportRouteTableEgress installs the imported routes into the route tables created for the Egress subnets, which
+	// contain the next hops of the routes.
+	ImportRouteTableEgress ImportRouteTable = "Egress"
+)
+
+type RouteImport struct {
+	// Prefixes lists the filters of the prefixes of the received routes. A received route is only imported if its
+	// prefix matches any of the filters.
+	Prefixes []PrefixFilter `json:"prefixes"`
+
+	// RouteTable specifies the route tables into which the imported routes are installed. The default value is Main.
+	RouteTable ImportRouteTable `json:"routeTable,omitempty"`
+
+	// MaxRoutes is the maximum number of routes imported for each IP family, with a range of 1 to 10000. The default
+	// value is 100.
+	MaxRoutes *int32 `json:"maxRoutes,omitempty"`
+}
+
+// PrefixFilter matches the prefixes within a CIDR whose lengths are within a range.
+type PrefixFilter struct {
+	// CIDR is the CIDR containing the matched prefixes.
+	CIDR string `json:"cidr"`
+
+	// MinLength is the minimum length of the matched prefixes. The default value is the prefix length of CIDR.
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// MaxLength is the maximum length of the matched prefixes. The default value is the prefix length of CIDR if
+	// MinLength is not set, otherwise it's the length of the IP family (32 for IPv4 and 128 for IPv6).
+	MaxLength *int32 `json:"maxLength,omitempty"`
 }
 
 type Advertisements struct {
 	// Service specifies how to advertise Service IPs.
 	Service *ServiceAdvertisement `json:"service,omitempty"`
 
-	// Pod specifies how to advertise Pod IPs. Currently, if this is set, NodeIPAM Pod CIDR instead of specific Pods IPs
-	// will be advertised since pod selector is not added yet.
+	// Pod specifies how to advertise Pod IPs.
 	Pod *PodAdvertisement `json:"pod,omitempty"`
 
-	// Egress specifies how to advertise Egress IPs. Currently, if this is set, all Egress IPs will be advertised since
-	// Egress selector is not added yet.
+	// Egress specifies how to advertise Egress IPs.
 	Egress *EgressAdvertisement `json:"egress,omitempty"`
 }
 
+type AdvertisementType string
+
+const (
+	AdvertisementTypeService AdvertisementType = "Service"
+	AdvertisementTypePod     AdvertisementType = "Pod"
+	AdvertisementTypeEgress  AdvertisementType = "Egress"
+)
+
+// RouteAttributes specifies the BGP path attributes of the advertised routes.
+type RouteAttributes struct {
+	// Communities lists the BGP communities attached to the advertised routes. A community is either in the format of
+	// "<ASN>:<value>" (e.g., "65000:100"), where both ASN and value are in the range of 0-65535, or one of the
+	// well-known communities "no-export", "no-advertise", "no-export-subconfed" and "no-peer".
+	Communities []string `json:"communities,omitempty"`
+
+	// LocalPreference is the LOCAL_PREF attribute of the advertised routes. It is only sent to iBGP peers, and the
+	// default value used by the BGP peers is 100 if not set.
+	LocalPreference *int32 `json:"localPreference,omitempty"`
+}
+
 type Confederation struct {
 	// Identifier specifies the confederation's ASN.
 	Identifier int32 `json:"identifier,omitempty"`
@@ -309,22 +367,53 @@ const (
 )
 
 type ServiceAdvertisement struct {
-	// IPTypes specifies the types of Service IPs from the selected Services to be advertised. Currently, all Services
-	// will be selected since Service selector is not added yet.
+	// IPTypes specifies the types of Service IPs from the selected Services to be advertised.
 	IPTypes []ServiceIPType `json:"ipTypes,omitempty"`
 
-	// Empty now, selectors to be added later, which are used to select specific Services.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// ServiceSelector selects Services by their labels in the Namespaces selected by NamespaceSelector. If not set,
+	// all Services in the selected Namespaces are selected.
+	ServiceSelector *metav1.LabelSelector `json:"serviceSelector,omitempty"`
+
+	// NamespaceSelector selects Namespaces by their labels. If not set, all Namespaces are selected.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// RouteAttributes specifies the BGP path attributes of the routes of the Service IPs.
+	RouteAttributes `json:",inline"`
 }
 
 type PodAdvertisement struct {
-	// Empty now, selectors to be added later, which are used to select specific Pods.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// PodSelector selects Pods by their labels in the Namespaces selected by NamespaceSelector. If neither PodSelector
+	// nor NamespaceSelector is set, the NodeIPAM Pod CIDRs of the Node are advertised. Otherwise, the IPs of the
+	// selected Pods running on the Node are advertised, and all Pods in the selected Namespaces are selected if
+	// PodSelector is not set.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// NamespaceSelector selects Namespaces by their labels. If not set while PodSelector is set, all Namespaces are
+	// selected.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// RouteAttributes specifies the BGP path attributes of the routes of the Pod IPs or CIDRs.
+	RouteAttributes `json:",inline"`
 }
 
 type EgressAdvertisement struct {
-	// Empty now, selectors to be added later, which are used to select specific Egresses.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// EgressSelector selects Egresses by their labels. If not set, all Egresses are selected. A Node only advertises
+	// the IPs of the selected Egresses which are assigned to it.
+	EgressSelector *metav1.LabelSelector `json:"egressSelector,omitempty"`
+
+	// RouteAttributes specifies the BGP path attributes of the routes of the Egress IPs.
+	RouteAttributes `json:",inline"`
+}
+
+// BGPPeerExportFilter restricts the advertised routes exported to a BGP peer.
+type BGPPeerExportFilter struct {
+	// AdvertisementTypes lists the types of advertisements whose routes are exported to the BGP peer. If not set, the
+	// routes of all advertisements are exported.
+	AdvertisementTypes []AdvertisementType `json:"advertisementTypes,omitempty"`
+
+	// CIDRs restricts the exported routes to those within any of the CIDRs. If not set, the routes are not restricted
+	// by CIDR.
+	CIDRs []string `json:"cidrs,omitempty"`
 }
 
 type BGPPeer struct {
@@ -344,6 +433,44 @@ type BGPPeer struct {
 	// GracefulRestartTimeSeconds specifies how long the BGP peer would wait for the BGP session to re-establish after
 	// a restart before deleting stale routes. The range of the value is from 1 to 3600, and the default value is 120.
 	GracefulRestartTimeSeconds *int32 `json:"gracefulRestartTimeSeconds,omitempty"`
+
+	// HoldTimeSeconds specifies the hold time proposed to the BGP peer. The BGP session is torn down if no message is
+	// received from the BGP peer within the negotiated hold time. The range of the value is from 3 to 65535, and the
+	// default value is 90.
+	HoldTimeSeconds *int32 `json:"holdTimeSeconds,omitempty"`
+
+	// KeepaliveTimeSeconds specifies the interval of the keepalive messages sent to the BGP peer. The range of the
+	// value is from 1 to 21845, and it must be less than HoldTimeSeconds, or less than 90 if HoldTimeSeconds is not
+	// set. The default value is one third of HoldTimeSeconds.
+	KeepaliveTimeSeconds *int32 `json:"keepaliveTimeSeconds,omitempty"`
+
+	// PasswordSecretKey is the key of the entry in the Secret antrea-bgp-passwords whose value is the password used to
+	// authenticate the BGP session with the TCP MD5 signature option. The default key is the concatenated string of
+	// the BGP peer IP address and ASN (e.g., "192.168.77.100-65000"). Authentication is disabled if the key doesn't
+	// exist in the Secret.
+	PasswordSecretKey string `json:"passwordSecretKey,omitempty"`
+
+	// BFD enables Bidirectional Forwarding Detection (BFD) for the BGP peer when set. The BGP session is torn down
+	// as soon as the BFD session goes down, instead of waiting for the hold time to expire.
+	BFD *BFDConfig `json:"bfd,omitempty"`
+
+	// ExportFilter restricts the advertised routes exported to the BGP peer. If not set, all advertised routes are
+	// exported to the BGP peer.
+	ExportFilter *BGPPeerExportFilter `json:"exportFilter,omitempty"`
+}
+
+type BFDConfig struct {
+	// DetectMultiplier is the number of BFD control packets that can be missed before the BFD session is declared
+	// down. The range of the value is from 1 to 255, and the default value is 3.
+	DetectMultiplier *int32 `json:"detectMultiplier,omitempty"`
+
+	// MinTxIntervalMilliseconds is the minimum interval at which BFD control packets are sent to the BGP peer. The
+	// range of the value is from 10 to 60000, and the default value is 300.
+	MinTxIntervalMilliseconds *int32 `json:"minTxIntervalMilliseconds,omitempty"`
+
+	// MinRxIntervalMilliseconds is the minimum interval at which BFD control packets can be received from the BGP
+	// peer. The range of the value is from 10 to 60000, and the default value is 300.
+	MinRxIntervalMilliseconds *int32 `json:"minRxIntervalMilliseconds,omitempty"`
 }
 
 type PodReference struct {
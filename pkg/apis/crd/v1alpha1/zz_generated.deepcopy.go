@@ -37,12 +37,12 @@ func (in *Advertisements) DeepCopyInto(out *Advertisements) {
 	if in.Pod != nil {
 		in, out := &in.Pod, &out.Pod
 		*out = new(PodAdvertisement)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Egress != nil {
 		in, out := &in.Egress, &out.Egress
 		*out = new(EgressAdvertisement)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -57,6 +57,37 @@ func (in *Advertisements) DeepCopy() *Advertisements {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDConfig) DeepCopyInto(out *BFDConfig) {
+	*out = *in
+	if in.DetectMultiplier != nil {
+		in, out := &in.DetectMultiplier, &out.DetectMultiplier
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinTxIntervalMilliseconds != nil {
+		in, out := &in.MinTxIntervalMilliseconds, &out.MinTxIntervalMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRxIntervalMilliseconds != nil {
+		in, out := &in.MinRxIntervalMilliseconds, &out.MinRxIntervalMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDConfig.
+func (in *BFDConfig) DeepCopy() *BFDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
 	*out = *in
@@ -75,6 +106,26 @@ func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.HoldTimeSeconds != nil {
+		in, out := &in.HoldTimeSeconds, &out.HoldTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KeepaliveTimeSeconds != nil {
+		in, out := &in.KeepaliveTimeSeconds, &out.KeepaliveTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BFDConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportFilter != nil {
+		in, out := &in.ExportFilter, &out.ExportFilter
+		*out = new(BGPPeerExportFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -88,6 +139,32 @@ func (in *BGPPeer) DeepCopy() *BGPPeer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerExportFilter) DeepCopyInto(out *BGPPeerExportFilter) {
+	*out = *in
+	if in.AdvertisementTypes != nil {
+		in, out := &in.AdvertisementTypes, &out.AdvertisementTypes
+		*out = make([]AdvertisementType, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerExportFilter.
+func (in *BGPPeerExportFilter) DeepCopy() *BGPPeerExportFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerExportFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPolicy) DeepCopyInto(out *BGPPolicy) {
 	*out = *in
@@ -170,6 +247,11 @@ func (in *BGPPolicySpec) DeepCopyInto(out *BGPPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RouteImport != nil {
+		in, out := &in.RouteImport, &out.RouteImport
+		*out = new(RouteImport)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -348,6 +430,12 @@ func (in *Destination) DeepCopy() *Destination {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressAdvertisement) DeepCopyInto(out *EgressAdvertisement) {
 	*out = *in
+	if in.EgressSelector != nil {
+		in, out := &in.EgressSelector, &out.EgressSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RouteAttributes.DeepCopyInto(&out.RouteAttributes)
 	return
 }
 
@@ -815,6 +903,17 @@ func (in *PacketCaptureStatus) DeepCopy() *PacketCaptureStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodAdvertisement) DeepCopyInto(out *PodAdvertisement) {
 	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RouteAttributes.DeepCopyInto(&out.RouteAttributes)
 	return
 }
 
@@ -844,6 +943,86 @@ func (in *PodReference) DeepCopy() *PodReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixFilter) DeepCopyInto(out *PrefixFilter) {
+	*out = *in
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixFilter.
+func (in *PrefixFilter) DeepCopy() *PrefixFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteAttributes) DeepCopyInto(out *RouteAttributes) {
+	*out = *in
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LocalPreference != nil {
+		in, out := &in.LocalPreference, &out.LocalPreference
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteAttributes.
+func (in *RouteAttributes) DeepCopy() *RouteAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImport) DeepCopyInto(out *RouteImport) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]PrefixFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxRoutes != nil {
+		in, out := &in.MaxRoutes, &out.MaxRoutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImport.
+func (in *RouteImport) DeepCopy() *RouteImport {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAdvertisement) DeepCopyInto(out *ServiceAdvertisement) {
 	*out = *in
@@ -852,6 +1031,17 @@ func (in *ServiceAdvertisement) DeepCopyInto(out *ServiceAdvertisement) {
 		*out = make([]ServiceIPType, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RouteAttributes.DeepCopyInto(&out.RouteAttributes)
 	return
 }
 
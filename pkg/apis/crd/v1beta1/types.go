@@ -498,8 +498,26 @@ type NetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// EnforcementMode specifies whether the Drop and Reject actions of the
+	// rules are enforced. Defaults to "Enforce". When set to "Audit", traffic
+	// matching a Drop or Reject rule is counted in the rule's stats and logged
+	// (if logging is enabled for the rule), but it is not dropped or rejected.
+	// +optional
+	EnforcementMode PolicyEnforcementMode `json:"enforcementMode,omitempty"`
 }
 
+// PolicyEnforcementMode describes how the rules of an Antrea-native policy are enforced.
+type PolicyEnforcementMode string
+
+const (
+	// EnforcementModeEnforce means that the rule actions are enforced in the datapath.
+	EnforcementModeEnforce PolicyEnforcementMode = "Enforce"
+	// EnforcementModeAudit means that the traffic matching a Drop or Reject rule is
+	// allowed, and only reported through NetworkPolicyStats and the audit log.
+	// Allow and Pass rules are enforced as usual.
+	EnforcementModeAudit PolicyEnforcementMode = "Audit"
+)
+
 // NetworkPolicyPhase defines the phase in which a NetworkPolicy is.
 type NetworkPolicyPhase string
 
@@ -524,6 +542,9 @@ const (
 	NetworkPolicyConditionRealizable NetworkPolicyConditionType = "Realizable"
 	// NetworkPolicyConditionRealizationFailure reports information about a failure when realizing the NetworkPolicy on a Node.
 	NetworkPolicyConditionRealizationFailure NetworkPolicyConditionType = "RealizationFailure"
+	// NetworkPolicyConditionScheduledRulesActive reports whether all the rules of the NetworkPolicy which have a
+	// schedule are currently inside their time window. It is only set if at least one rule has a schedule.
+	NetworkPolicyConditionScheduledRulesActive NetworkPolicyConditionType = "ScheduledRulesActive"
 )
 
 // NetworkPolicyCondition describes the state of a NetworkPolicy at a certain point.
@@ -607,10 +628,76 @@ type Rule struct {
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	// +optional
 	LogLabel string `json:"logLabel,omitempty"`
+	// LogSampling restricts the logging of the connections matching this rule
+	// to a sample of them. It can only be set when EnableLogging is true and
+	// Action is Allow. If this field is not set, all connections are logged.
+	// +optional
+	LogSampling *LogSampling `json:"logSampling,omitempty"`
 	// Select workloads on which this rule will be applied to. Cannot be set in
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []AppliedTo `json:"appliedTo,omitempty"`
+	// Schedule restricts the enforcement of this rule to a daily time window.
+	// If this field is not set, the rule is always enforced.
+	// +optional
+	Schedule *RuleSchedule `json:"schedule,omitempty"`
+	// RateLimit specifies the rate to which the traffic matching this rule is
+	// policed. It must be set when Action is RateLimit, and cannot be set otherwise.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+}
+
+// LogSampling defines how the connections logged for a rule are sampled. The
+// sampling is done in the datapath on each Node, before the connections are
+// sent to the antrea-agent for logging. Exactly one of Ratio and MaxPerSecond
+// must be set.
+type LogSampling struct {
+	// Ratio logs 1 of every Ratio connections matching the rule. The logged
+	// connections are selected with a hash of their 5-tuple.
+	// +optional
+	Ratio int32 `json:"ratio,omitempty"`
+	// MaxPerSecond logs at most MaxPerSecond connections matching the rule
+	// per second on each Node.
+	// +optional
+	MaxPerSecond int32 `json:"maxPerSecond,omitempty"`
+}
+
+// RateLimitUnit describes the unit of a rate limit.
+type RateLimitUnit string
+
+const (
+	// RateLimitUnitPacketsPerSecond means that the rate is expressed in packets per
+	// second and the burst size in packets.
+	RateLimitUnitPacketsPerSecond RateLimitUnit = "PacketsPerSecond"
+	// RateLimitUnitBitsPerSecond means that the rate is expressed in bits per second
+	// and the burst size in bits.
+	RateLimitUnitBitsPerSecond RateLimitUnit = "BitsPerSecond"
+)
+
+// RateLimit defines the rate to which the traffic matching a rule is policed.
+// The traffic exceeding the rate is dropped. The rate is enforced on each Node
+// independently, for all the workloads on the Node to which the rule applies.
+type RateLimit struct {
+	// Unit of Rate and Burst, PacketsPerSecond or BitsPerSecond.
+	Unit RateLimitUnit `json:"unit"`
+	// Rate specifies the maximum traffic rate. e.g. 1000, 300k, 10M
+	Rate string `json:"rate"`
+	// Burst specifies the maximum burst size when traffic exceeds the rate. e.g. 100, 300k, 10M
+	// Defaults to Rate if not set.
+	// +optional
+	Burst string `json:"burst,omitempty"`
+}
+
+// RuleSchedule defines a daily time window, in UTC, during which a rule is
+// enforced. Outside of the window, the rule is not realized at all, i.e. the
+// traffic is evaluated as if the rule did not exist.
+type RuleSchedule struct {
+	// Start is the beginning of the time window, in the "HH:MM" format.
+	Start string `json:"start"`
+	// End is the end of the time window, in the "HH:MM" format. It is not
+	// included in the window. If End is earlier than Start, the window spans
+	// midnight.
+	End string `json:"end"`
 }
 
 // NetworkPolicyPeer describes the grouping selector of workloads.
@@ -798,6 +885,9 @@ const (
 	// RuleActionReject indicates that the traffic matching the rule must be rejected and the
 	// client will receive a response.
 	RuleActionReject RuleAction = "Reject"
+	// RuleActionRateLimit indicates that the traffic matching the rule must be allowed,
+	// but policed to the rate specified by the RateLimit field of the rule.
+	RuleActionRateLimit RuleAction = "RateLimit"
 
 	IGMPQuery    int32 = 0x11
 	IGMPReportV1 int32 = 0x12
@@ -855,6 +945,12 @@ type ClusterNetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// EnforcementMode specifies whether the Drop and Reject actions of the
+	// rules are enforced. Defaults to "Enforce". When set to "Audit", traffic
+	// matching a Drop or Reject rule is counted in the rule's stats and logged
+	// (if logging is enabled for the rule), but it is not dropped or rejected.
+	// +optional
+	EnforcementMode PolicyEnforcementMode `json:"enforcementMode,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -912,6 +1008,8 @@ type IGMPProtocol struct {
 type L7Protocol struct {
 	HTTP *HTTPProtocol `json:"http,omitempty"`
 	TLS  *TLSProtocol  `json:"tls,omitempty"`
+	GRPC *GRPCProtocol `json:"grpc,omitempty"`
+	DNS  *DNSProtocol  `json:"dns,omitempty"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -925,6 +1023,42 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty"`
+	// Headers is a list of HTTP request headers to match. A request is matched
+	// only if all the headers are matched.
+	Headers []HTTPHeaderMatcher `json:"headers,omitempty"`
+}
+
+// HTTPHeaderMatcher matches an HTTP request header with a specific name and,
+// optionally, a specific value.
+type HTTPHeaderMatcher struct {
+	// Name is the name of the header to match. It is case-insensitive.
+	Name string `json:"name"`
+	// Value is the exact value of the header to match. If it is not provided,
+	// any request with the header is matched.
+	Value string `json:"value,omitempty"`
+}
+
+// GRPCProtocol matches gRPC requests with specific service and method. All
+// fields could be used alone or together. If all fields are not provided, it
+// matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match
+	// (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty"`
+}
+
+// DNSProtocol matches DNS queries with specific query name and query types. All
+// fields could be used alone or together. If all fields are not provided, it
+// matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match. It is
+	// case-insensitive and supports wildcards (Ex. "*.foo.com", "*.foo.*").
+	QueryName string `json:"queryName,omitempty"`
+	// QueryTypes represents the types of DNS query to match (Ex. "A", "AAAA").
+	// If not provided, queries of all types are matched.
+	QueryTypes []string `json:"queryTypes,omitempty"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -959,10 +1093,21 @@ type EgressStatus struct {
 	// EgressIP indicates the effective Egress IP for the selected workloads. It could be empty if the Egress IP in spec
 	// is not assigned to any Node. It's also useful when there are more than one Egress IP specified in spec.
 	EgressIP string `json:"egressIP"`
+	// EgressIPs indicates the Egress IPs that are assigned to Nodes and the Nodes holding them. It's only set for
+	// Egresses in ActiveActive mode, in which case EgressNode and EgressIP are empty.
+	EgressIPs []EgressIPStatus `json:"egressIPs,omitempty"`
 
 	Conditions []EgressCondition `json:"conditions,omitempty"`
 }
 
+// EgressIPStatus represents an Egress IP and the Node that holds it.
+type EgressIPStatus struct {
+	// The Egress IP.
+	EgressIP string `json:"egressIP"`
+	// The name of the Node that holds the Egress IP.
+	EgressNode string `json:"egressNode"`
+}
+
 type EgressConditionType string
 
 const (
@@ -982,6 +1127,18 @@ type EgressCondition struct {
 	Message            string                 `json:"message,omitempty"`
 }
 
+// EgressMode defines how the Egress IPs of an Egress are used by the selected workloads.
+type EgressMode string
+
+const (
+	// EgressModeActiveStandby means the traffic of the selected workloads is SNAT'd with a single Egress IP, which is
+	// held by a single Node at any given time. This is the default mode.
+	EgressModeActiveStandby EgressMode = "ActiveStandby"
+	// EgressModeActiveActive means the Egress IPs allocated from ExternalIPPools are held by different Nodes at the same
+	// time, and the connections of the selected workloads are distributed across all of them based on flow hash.
+	EgressModeActiveActive EgressMode = "ActiveActive"
+)
+
 // EgressSpec defines the desired state for Egress.
 type EgressSpec struct {
 	// AppliedTo selects Pods to which the Egress will be applied.
@@ -1003,8 +1160,23 @@ type EgressSpec struct {
 	// same index in EgressIPs and ExternalIPPools are correlated.
 	// Cannot be set with ExternalIPPool.
 	ExternalIPPools []string `json:"externalIPPools,omitempty"`
+	// Mode specifies how the Egress IPs are used. Defaults to ActiveStandby.
+	// ActiveActive mode requires ExternalIPPools to be set, and each Egress IP in EgressIPs will be assigned to a Node
+	// selected by its ExternalIPPool independently, preferring Nodes that don't hold other Egress IPs of the Egress.
+	Mode EgressMode `json:"mode,omitempty"`
 	// Bandwidth specifies the rate limit of north-south egress traffic of this Egress.
 	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+	// Destinations selects the destinations of the traffic that should be SNAT'd by this Egress.
+	// If it is empty, all egress traffic of the selected Pods is SNAT'd by this Egress. Otherwise, only the traffic
+	// destined for the selected destinations is SNAT'd by this Egress, and the Egress takes precedence over the
+	// Egresses without Destinations which select the same Pods.
+	Destinations *EgressDestinations `json:"destinations,omitempty"`
+}
+
+type EgressDestinations struct {
+	// CIDRs is a list of IP blocks. When the CIDRs of multiple Egresses applied to a Pod overlap, the longest prefix
+	// match takes precedence; for identical CIDRs, the Egress created earlier takes precedence.
+	CIDRs []string `json:"cidrs,omitempty"`
 }
 
 type Bandwidth struct {
@@ -127,10 +127,16 @@ type TrafficStats struct {
 	Bytes int64
 	// Sessions is the sessions count hit by the NetworkPolicy.
 	Sessions int64
+	// DroppedPackets is the count of packets dropped by the rate limit of the
+	// rule. It is only set for rules with action RateLimit.
+	DroppedPackets int64
 }
 
 // RuleTrafficStats contains TrafficStats of single rule inside a NetworkPolicy.
 type RuleTrafficStats struct {
 	Name         string
 	TrafficStats TrafficStats
+	// LastHitTime is the last time the rule was observed matching traffic. It is
+	// unset if the rule has not matched any traffic since its stats started.
+	LastHitTime *metav1.Time
 }
@@ -28,6 +28,8 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -461,6 +463,62 @@ func (m *TrafficStats) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TrafficStats proto.InternalMessageInfo
 
+func (m *UnusedRule) Reset()      { *m = UnusedRule{} }
+func (*UnusedRule) ProtoMessage() {}
+func (*UnusedRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{15}
+}
+func (m *UnusedRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnusedRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UnusedRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnusedRule.Merge(m, src)
+}
+func (m *UnusedRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnusedRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnusedRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnusedRule proto.InternalMessageInfo
+
+func (m *UnusedRuleList) Reset()      { *m = UnusedRuleList{} }
+func (*UnusedRuleList) ProtoMessage() {}
+func (*UnusedRuleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{16}
+}
+func (m *UnusedRuleList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnusedRuleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UnusedRuleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnusedRuleList.Merge(m, src)
+}
+func (m *UnusedRuleList) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnusedRuleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnusedRuleList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnusedRuleList proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AntreaClusterNetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaClusterNetworkPolicyStats")
 	proto.RegisterType((*AntreaClusterNetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaClusterNetworkPolicyStatsList")
@@ -477,6 +535,8 @@ func init() {
 	proto.RegisterType((*RuleTrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.RuleTrafficStats")
 	proto.RegisterType((*TargetIPLatencyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.TargetIPLatencyStats")
 	proto.RegisterType((*TrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.TrafficStats")
+	proto.RegisterType((*UnusedRule)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.UnusedRule")
+	proto.RegisterType((*UnusedRuleList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.UnusedRuleList")
 }
 
 func init() {
@@ -484,64 +544,71 @@ func init() {
 }
 
 var fileDescriptor_91b517c6fa558473 = []byte{
-	// 906 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x24, 0xad, 0xb6, 0x9d, 0x0d, 0x50, 0xac, 0x0a, 0x45, 0xd1, 0xca, 0xad, 0xbc, 0x97,
-	0x80, 0xc0, 0xa6, 0x2b, 0xb4, 0xaa, 0x10, 0x42, 0xc2, 0x1c, 0x50, 0xa5, 0x36, 0x44, 0xd3, 0x1c,
-	0x10, 0x02, 0x2d, 0x13, 0xfb, 0xd5, 0x35, 0x89, 0x3d, 0x96, 0x67, 0x5c, 0xd4, 0xdb, 0xde, 0xb8,
-	0x70, 0xd8, 0xbf, 0x82, 0xbf, 0xa5, 0xc7, 0xe5, 0x80, 0x58, 0x2e, 0x2b, 0x1a, 0x84, 0xc4, 0x15,
-	0xc1, 0x81, 0x23, 0x9a, 0xb1, 0x1d, 0xc7, 0x89, 0x4b, 0x1d, 0xad, 0x14, 0x0e, 0xec, 0xa9, 0xf6,
-	0xfb, 0xf1, 0x7d, 0xef, 0xbd, 0xef, 0xcd, 0xb8, 0xc1, 0x87, 0x34, 0x14, 0x31, 0x50, 0xd3, 0x67,
-	0x56, 0xfa, 0x64, 0x45, 0x63, 0xcf, 0xa2, 0x91, 0xcf, 0x2d, 0x2e, 0xa8, 0xe0, 0xd6, 0xc5, 0x01,
-	0x9d, 0x44, 0xe7, 0xf4, 0xc0, 0xf2, 0x20, 0x84, 0x98, 0x0a, 0x70, 0xcd, 0x28, 0x66, 0x82, 0x69,
-	0xbd, 0x34, 0xfe, 0x91, 0xcf, 0xcc, 0x0c, 0x23, 0x1a, 0x7b, 0xa6, 0xcc, 0x34, 0x55, 0xa6, 0x99,
-	0x67, 0x76, 0xdf, 0xf1, 0x7c, 0x71, 0x9e, 0x8c, 0x4c, 0x87, 0x05, 0x96, 0xc7, 0x3c, 0x66, 0x29,
-	0x80, 0x51, 0x72, 0xa6, 0xde, 0xd4, 0x8b, 0x7a, 0x4a, 0x81, 0xbb, 0xef, 0x8d, 0x0f, 0xb9, 0xaa,
-	0x27, 0xf2, 0x03, 0xea, 0x9c, 0xfb, 0x21, 0xc4, 0x97, 0x45, 0x55, 0x01, 0x08, 0x6a, 0x5d, 0x2c,
-	0x95, 0xd3, 0xb5, 0x6e, 0xca, 0x8a, 0x93, 0x50, 0xf8, 0x01, 0x2c, 0x25, 0x3c, 0xbc, 0x2d, 0x81,
-	0x3b, 0xe7, 0x10, 0xd0, 0xc5, 0x3c, 0xe3, 0xef, 0x26, 0xde, 0xfb, 0x48, 0x35, 0xfc, 0xf1, 0x24,
-	0xe1, 0x02, 0xe2, 0x3e, 0x88, 0x6f, 0x58, 0x3c, 0x1e, 0xb0, 0x89, 0xef, 0x5c, 0x9e, 0xca, 0xd6,
-	0xb5, 0xaf, 0xf0, 0x96, 0xac, 0xd3, 0xa5, 0x82, 0x76, 0xd0, 0x3e, 0xea, 0xdd, 0x7d, 0xf0, 0xae,
-	0x99, 0xd2, 0x99, 0xf3, 0x74, 0xc5, 0xc4, 0x64, 0xb4, 0x79, 0x71, 0x60, 0x7e, 0x3a, 0xfa, 0x1a,
-	0x1c, 0x71, 0x02, 0x82, 0xda, 0xda, 0xd5, 0xf3, 0xbd, 0xc6, 0xf4, 0xf9, 0x1e, 0x2e, 0x6c, 0x64,
-	0x86, 0xaa, 0x45, 0xb8, 0x2d, 0x62, 0x7a, 0x76, 0xe6, 0x3b, 0x8a, 0xb1, 0xd3, 0x54, 0x2c, 0x0f,
-	0xcd, 0xba, 0xa2, 0x98, 0xc3, 0xb9, 0x6c, 0x7b, 0x37, 0xe3, 0x6a, 0xcf, 0x5b, 0x49, 0x89, 0x41,
-	0x7b, 0x8c, 0xf0, 0x4e, 0x9c, 0x4c, 0x60, 0x3e, 0xa4, 0xd3, 0xda, 0x6f, 0xf5, 0xee, 0x3e, 0x78,
-	0xbf, 0x3e, 0x2d, 0x59, 0x40, 0xb0, 0x3b, 0x19, 0xf5, 0xce, 0xa2, 0x87, 0x2c, 0xb1, 0x19, 0x7f,
-	0x22, 0x7c, 0xff, 0x96, 0xd1, 0x1f, 0xfb, 0x5c, 0x68, 0x5f, 0x2c, 0x8d, 0xdf, 0xac, 0x37, 0x7e,
-	0x99, 0xad, 0x86, 0xbf, 0x93, 0x55, 0xb5, 0x95, 0x5b, 0xe6, 0x46, 0x1f, 0xe2, 0x4d, 0x5f, 0x40,
-	0x20, 0x67, 0x2e, 0x9b, 0x3f, 0xaa, 0xdf, 0xfc, 0x2d, 0xb5, 0xdb, 0xaf, 0x64, 0xac, 0x9b, 0x47,
-	0x12, 0x9f, 0xa4, 0x34, 0xc6, 0x1f, 0x4d, 0xdc, 0x49, 0x33, 0x5f, 0x6e, 0xda, 0xba, 0x36, 0xed,
-	0x37, 0x84, 0xef, 0xdd, 0x34, 0xf3, 0x35, 0xac, 0x98, 0x57, 0x5e, 0x31, 0x7b, 0xd5, 0x15, 0xab,
-	0xbf, 0x5b, 0x08, 0xbf, 0x7a, 0x92, 0x4c, 0x84, 0xef, 0x50, 0x2e, 0x3e, 0x89, 0x59, 0x12, 0xad,
-	0x61, 0xa3, 0xee, 0xe3, 0x4d, 0x4f, 0x52, 0xa9, 0x55, 0xda, 0x2e, 0x2a, 0x53, 0xfc, 0x24, 0xf5,
-	0x69, 0x9f, 0xe1, 0x8d, 0x88, 0xb9, 0xb9, 0xee, 0x2b, 0xac, 0xdb, 0x80, 0xb9, 0x04, 0xce, 0x20,
-	0x86, 0xd0, 0x01, 0xbb, 0x9d, 0x61, 0x6f, 0x0c, 0x98, 0xcb, 0x89, 0x42, 0x34, 0x7e, 0x40, 0x58,
-	0x2b, 0xf7, 0xbc, 0x06, 0x45, 0xbf, 0x2c, 0x2b, 0x7a, 0x58, 0xbf, 0x9f, 0x72, 0xa9, 0x37, 0xe8,
-	0xf8, 0x3b, 0xc2, 0xda, 0xff, 0xe3, 0x76, 0x30, 0x7e, 0x46, 0xf8, 0x8d, 0xff, 0xe4, 0x50, 0xd2,
-	0xb2, 0x84, 0x1f, 0xd4, 0xef, 0xb1, 0xf6, 0x71, 0xfc, 0xb6, 0x89, 0x77, 0xfa, 0xcc, 0x85, 0x63,
-	0x2a, 0x20, 0x5c, 0x9f, 0x88, 0x4f, 0x10, 0xde, 0x8d, 0x00, 0xe2, 0x45, 0xea, 0xac, 0xd3, 0x0f,
-	0x57, 0x38, 0x7c, 0x15, 0x28, 0xf6, 0xbd, 0x8c, 0x7c, 0xb7, 0xca, 0x4b, 0x2a, 0x99, 0x8d, 0x1f,
-	0x11, 0xde, 0x5d, 0x34, 0xae, 0x41, 0xe3, 0x47, 0x65, 0x8d, 0x57, 0xf8, 0xdc, 0x2c, 0x75, 0x5d,
-	0xad, 0xf0, 0x4f, 0x08, 0x57, 0x8e, 0x41, 0x7b, 0x1b, 0x6f, 0x85, 0xcc, 0x85, 0x3e, 0x0d, 0x40,
-	0xf5, 0xb5, 0x5d, 0xd4, 0xd9, 0xcf, 0xec, 0x64, 0x16, 0xa1, 0x14, 0x13, 0x34, 0xf6, 0x40, 0x1c,
-	0x0d, 0x5e, 0x4c, 0xb1, 0x61, 0x05, 0x4a, 0xa1, 0x58, 0x95, 0x97, 0x54, 0x32, 0x1b, 0x14, 0xb7,
-	0xe7, 0xaf, 0x5e, 0x6d, 0x1f, 0x6f, 0x84, 0x45, 0x33, 0xb3, 0x8b, 0x58, 0x35, 0xa2, 0x3c, 0x9a,
-	0x85, 0xb7, 0xe5, 0x5f, 0x1e, 0x51, 0x07, 0xb2, 0x6f, 0xc1, 0xeb, 0x59, 0xd8, 0x76, 0x3f, 0x77,
-	0x90, 0x22, 0xc6, 0xf8, 0x1e, 0xe1, 0xa5, 0x8f, 0x77, 0x0d, 0x9e, 0xf5, 0xdf, 0x51, 0x7f, 0x35,
-	0x71, 0xe5, 0xe8, 0xa4, 0xca, 0xf9, 0xf0, 0x16, 0x55, 0xce, 0xe3, 0xc9, 0x2c, 0x42, 0x73, 0x71,
-	0x7b, 0x42, 0xb9, 0x38, 0x85, 0xd0, 0x1d, 0xfa, 0x01, 0x64, 0x85, 0xbf, 0x55, 0x6f, 0xdf, 0x65,
-	0x46, 0x51, 0xec, 0xf1, 0x1c, 0x0e, 0x29, 0xa1, 0xe6, 0x2c, 0x04, 0x9c, 0x0b, 0xc5, 0xd2, 0x7a,
-	0x31, 0x96, 0x1c, 0x87, 0x94, 0x50, 0xb5, 0x11, 0xee, 0xca, 0xf7, 0x13, 0xa0, 0x3c, 0x89, 0xc1,
-	0x25, 0xc3, 0x61, 0x9f, 0x86, 0x8c, 0x83, 0xc3, 0x42, 0x97, 0x77, 0x36, 0xf6, 0x51, 0xaf, 0x65,
-	0x1b, 0x19, 0x4e, 0xf7, 0xf8, 0xc6, 0x48, 0xf2, 0x2f, 0x28, 0xc6, 0x77, 0x08, 0x97, 0x54, 0xd1,
-	0xde, 0xc4, 0x77, 0x22, 0xea, 0x8c, 0x41, 0x70, 0x35, 0xed, 0x96, 0xfd, 0x5a, 0xc6, 0x70, 0x67,
-	0x90, 0x9a, 0x49, 0xee, 0x97, 0xff, 0x94, 0x8c, 0x2e, 0x05, 0xa4, 0xdb, 0xd1, 0x2a, 0x4e, 0xaf,
-	0x2d, 0x8d, 0x24, 0xf5, 0x49, 0xf9, 0x38, 0x70, 0xee, 0xb3, 0x90, 0xab, 0x31, 0xb5, 0x0a, 0xf9,
-	0x4e, 0x33, 0x3b, 0x99, 0x45, 0xd8, 0xfd, 0xab, 0x6b, 0xbd, 0xf1, 0xf4, 0x5a, 0x6f, 0x3c, 0xbb,
-	0xd6, 0x1b, 0x8f, 0xa7, 0x3a, 0xba, 0x9a, 0xea, 0xe8, 0xe9, 0x54, 0x47, 0xcf, 0xa6, 0x3a, 0xfa,
-	0x65, 0xaa, 0xa3, 0x27, 0xbf, 0xea, 0x8d, 0xcf, 0x7b, 0x75, 0x7f, 0x81, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x1b, 0x44, 0xff, 0x68, 0xac, 0x0f, 0x00, 0x00,
+	// 1016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xcf, 0x24, 0x29, 0xdb, 0xbc, 0x86, 0x6e, 0x18, 0x55, 0x4b, 0x14, 0xad, 0xd2, 0xca, 0x7b,
+	0x09, 0x08, 0x6c, 0xba, 0x5a, 0xad, 0x2a, 0x84, 0xf8, 0xe3, 0x3d, 0x40, 0xa5, 0x36, 0x44, 0xd3,
+	0x20, 0x21, 0x04, 0x5a, 0x26, 0xf6, 0x34, 0x35, 0x49, 0x3c, 0x96, 0x67, 0x52, 0xd4, 0xdb, 0xde,
+	0xb8, 0xee, 0x8d, 0x2f, 0xc0, 0x87, 0xe9, 0x71, 0x39, 0x20, 0x96, 0xcb, 0x8a, 0x06, 0x10, 0x5c,
+	0x11, 0x1c, 0x38, 0xa2, 0x19, 0x3b, 0x71, 0x9c, 0xa4, 0xd4, 0xa1, 0x52, 0x38, 0xb0, 0xa7, 0xd8,
+	0x6f, 0xde, 0x7b, 0xbf, 0xf7, 0xde, 0xef, 0xbd, 0x37, 0x56, 0x60, 0x8f, 0xfa, 0x32, 0x64, 0xd4,
+	0xf4, 0xb8, 0x15, 0x3d, 0x59, 0x41, 0xaf, 0x6b, 0xd1, 0xc0, 0x13, 0x96, 0x90, 0x54, 0x0a, 0xeb,
+	0x74, 0x97, 0xf6, 0x83, 0x13, 0xba, 0x6b, 0x75, 0x99, 0xcf, 0x42, 0x2a, 0x99, 0x6b, 0x06, 0x21,
+	0x97, 0x1c, 0x37, 0x22, 0xfd, 0x87, 0x1e, 0x37, 0x63, 0x1f, 0x41, 0xaf, 0x6b, 0x2a, 0x4b, 0x53,
+	0x5b, 0x9a, 0x63, 0xcb, 0xda, 0xeb, 0x5d, 0x4f, 0x9e, 0x0c, 0x3b, 0xa6, 0xc3, 0x07, 0x56, 0x97,
+	0x77, 0xb9, 0xa5, 0x1d, 0x74, 0x86, 0xc7, 0xfa, 0x4d, 0xbf, 0xe8, 0xa7, 0xc8, 0x71, 0xed, 0x5e,
+	0x6f, 0x4f, 0xe8, 0x78, 0x02, 0x6f, 0x40, 0x9d, 0x13, 0xcf, 0x67, 0xe1, 0x59, 0x12, 0xd5, 0x80,
+	0x49, 0x6a, 0x9d, 0xce, 0x85, 0x53, 0xb3, 0x2e, 0xb3, 0x0a, 0x87, 0xbe, 0xf4, 0x06, 0x6c, 0xce,
+	0xe0, 0xfe, 0x55, 0x06, 0xc2, 0x39, 0x61, 0x03, 0x3a, 0x6b, 0x67, 0xfc, 0x95, 0x87, 0xed, 0xf7,
+	0x74, 0xc2, 0x0f, 0xfa, 0x43, 0x21, 0x59, 0xd8, 0x64, 0xf2, 0x4b, 0x1e, 0xf6, 0x5a, 0xbc, 0xef,
+	0x39, 0x67, 0x47, 0x2a, 0x75, 0xfc, 0x39, 0xac, 0xab, 0x38, 0x5d, 0x2a, 0x69, 0x15, 0xed, 0xa0,
+	0xc6, 0xc6, 0xdd, 0x37, 0xcc, 0x08, 0xce, 0x9c, 0x86, 0x4b, 0x2a, 0xa6, 0xb4, 0xcd, 0xd3, 0x5d,
+	0xf3, 0xc3, 0xce, 0x17, 0xcc, 0x91, 0x87, 0x4c, 0x52, 0x1b, 0x9f, 0x3f, 0xdb, 0xce, 0x8d, 0x9e,
+	0x6d, 0x43, 0x22, 0x23, 0x13, 0xaf, 0x38, 0x80, 0xb2, 0x0c, 0xe9, 0xf1, 0xb1, 0xe7, 0x68, 0xc4,
+	0x6a, 0x5e, 0xa3, 0xdc, 0x37, 0xb3, 0x92, 0x62, 0xb6, 0xa7, 0xac, 0xed, 0xad, 0x18, 0xab, 0x3c,
+	0x2d, 0x25, 0x29, 0x04, 0xfc, 0x08, 0x41, 0x25, 0x1c, 0xf6, 0xd9, 0xb4, 0x4a, 0xb5, 0xb0, 0x53,
+	0x68, 0x6c, 0xdc, 0x7d, 0x33, 0x3b, 0x2c, 0x99, 0xf1, 0x60, 0x57, 0x63, 0xe8, 0xca, 0xec, 0x09,
+	0x99, 0x43, 0x33, 0xfe, 0x40, 0x70, 0xe7, 0x8a, 0xd2, 0x1f, 0x78, 0x42, 0xe2, 0x4f, 0xe7, 0xca,
+	0x6f, 0x66, 0x2b, 0xbf, 0xb2, 0xd6, 0xc5, 0xaf, 0xc4, 0x51, 0xad, 0x8f, 0x25, 0x53, 0xa5, 0xf7,
+	0x61, 0xcd, 0x93, 0x6c, 0xa0, 0x6a, 0xae, 0x92, 0xdf, 0xcf, 0x9e, 0xfc, 0x15, 0xb1, 0xdb, 0x2f,
+	0xc6, 0xa8, 0x6b, 0xfb, 0xca, 0x3f, 0x89, 0x60, 0x8c, 0xdf, 0xf3, 0x50, 0x8d, 0x2c, 0x9f, 0x77,
+	0xda, 0xaa, 0x3a, 0xed, 0x17, 0x04, 0xb7, 0x2f, 0xab, 0xf9, 0x0a, 0x5a, 0xac, 0x9b, 0x6e, 0x31,
+	0x7b, 0xd9, 0x16, 0xcb, 0xde, 0x5b, 0x08, 0x36, 0x0f, 0x87, 0x7d, 0xe9, 0x39, 0x54, 0xc8, 0xf7,
+	0x43, 0x3e, 0x0c, 0x56, 0xd0, 0x51, 0x77, 0x60, 0xad, 0xab, 0xa0, 0x74, 0x2b, 0x95, 0x92, 0xc8,
+	0x34, 0x3e, 0x89, 0xce, 0xf0, 0xc7, 0x50, 0x0c, 0xb8, 0x3b, 0xe6, 0x7d, 0x89, 0x76, 0x6b, 0x71,
+	0x97, 0xb0, 0x63, 0x16, 0x32, 0xdf, 0x61, 0x76, 0x39, 0xf6, 0x5d, 0x6c, 0x71, 0x57, 0x10, 0xed,
+	0xd1, 0xf8, 0x16, 0x01, 0x4e, 0xe7, 0xbc, 0x02, 0x46, 0x3f, 0x4b, 0x33, 0xba, 0x97, 0x3d, 0x9f,
+	0x74, 0xa8, 0x97, 0xf0, 0xf8, 0x1b, 0x02, 0xfc, 0xff, 0xd8, 0x0e, 0xc6, 0x0f, 0x08, 0x6e, 0xfd,
+	0x27, 0x43, 0x49, 0xd3, 0x14, 0xbe, 0x95, 0x3d, 0xc7, 0xcc, 0xe3, 0xf8, 0x55, 0x1e, 0x2a, 0x4d,
+	0xee, 0xb2, 0x03, 0x2a, 0x99, 0xbf, 0x3a, 0x12, 0x1f, 0x23, 0xd8, 0x0a, 0x18, 0x0b, 0x67, 0xa1,
+	0xe3, 0x4c, 0xdf, 0x5e, 0x62, 0xf8, 0x16, 0x78, 0xb1, 0x6f, 0xc7, 0xe0, 0x5b, 0x8b, 0x4e, 0xc9,
+	0x42, 0x64, 0xe3, 0x3b, 0x04, 0x5b, 0xb3, 0xc2, 0x15, 0x70, 0xfc, 0x30, 0xcd, 0xf1, 0x12, 0xd7,
+	0xcd, 0x5c, 0xd6, 0x8b, 0x19, 0xfe, 0x1e, 0xc1, 0xc2, 0x32, 0xe0, 0xd7, 0x60, 0xdd, 0xe7, 0x2e,
+	0x6b, 0xd2, 0x01, 0xd3, 0x79, 0x95, 0x92, 0x38, 0x9b, 0xb1, 0x9c, 0x4c, 0x34, 0x34, 0x63, 0x92,
+	0x86, 0x5d, 0x26, 0xf7, 0x5b, 0xd7, 0x63, 0xac, 0xbd, 0xc0, 0x4b, 0xc2, 0xd8, 0xa2, 0x53, 0xb2,
+	0x10, 0xd9, 0xa0, 0x50, 0x9e, 0x5e, 0xbd, 0x78, 0x07, 0x8a, 0x7e, 0x92, 0xcc, 0x64, 0x11, 0xeb,
+	0x44, 0xf4, 0x09, 0xb6, 0xa0, 0xa4, 0x7e, 0x45, 0x40, 0x1d, 0x16, 0xdf, 0x05, 0x2f, 0xc5, 0x6a,
+	0xa5, 0xe6, 0xf8, 0x80, 0x24, 0x3a, 0xc6, 0xaf, 0x08, 0xe6, 0x2e, 0xef, 0x0c, 0x38, 0xab, 0xff,
+	0x82, 0x79, 0x07, 0x36, 0xfa, 0x54, 0xc8, 0x0f, 0x3c, 0xd9, 0xf6, 0x06, 0xac, 0x5a, 0xd0, 0x80,
+	0xaf, 0x66, 0xeb, 0x53, 0x65, 0x61, 0xfc, 0x99, 0x87, 0x85, 0xb5, 0x57, 0x6d, 0x32, 0xae, 0xfe,
+	0x6c, 0x9b, 0x8c, 0xf5, 0xc9, 0x44, 0x03, 0xbb, 0x50, 0x56, 0x71, 0x1c, 0x31, 0xdf, 0xd5, 0x81,
+	0xe4, 0x97, 0x0d, 0x24, 0xc9, 0xf6, 0x60, 0xca, 0x0f, 0x49, 0x79, 0x1d, 0xa3, 0x10, 0xe6, 0x9c,
+	0xfe, 0xbb, 0x74, 0xd3, 0x28, 0x63, 0x3f, 0x24, 0xe5, 0x15, 0x77, 0xa0, 0xa6, 0xde, 0x0f, 0x19,
+	0x15, 0xc3, 0x90, 0xb9, 0xa4, 0xdd, 0x6e, 0x52, 0x9f, 0x0b, 0xe6, 0x70, 0xdf, 0x15, 0xd5, 0xe2,
+	0x0e, 0x6a, 0x14, 0x6c, 0x23, 0xf6, 0x53, 0x3b, 0xb8, 0x54, 0x93, 0xfc, 0x83, 0x17, 0xe3, 0x1b,
+	0x04, 0x29, 0x5a, 0xf1, 0x2b, 0x70, 0x23, 0xa0, 0x4e, 0x8f, 0x49, 0xa1, 0xab, 0x5d, 0xb0, 0x6f,
+	0xc6, 0x08, 0x37, 0x5a, 0x91, 0x98, 0x8c, 0xcf, 0xd5, 0x57, 0x4d, 0xe7, 0x4c, 0xb2, 0xa8, 0xbd,
+	0x0a, 0xc9, 0xf8, 0xdb, 0x4a, 0x48, 0xa2, 0x33, 0x45, 0x9f, 0x60, 0x42, 0x78, 0xdc, 0x17, 0xba,
+	0x4c, 0x85, 0x84, 0xbe, 0xa3, 0x58, 0x4e, 0x26, 0x1a, 0xf8, 0x16, 0x6c, 0xba, 0x21, 0x0f, 0x02,
+	0xe6, 0xc6, 0x68, 0x51, 0x9a, 0xc6, 0xcf, 0x08, 0xe0, 0x23, 0x7f, 0x28, 0x98, 0xab, 0xa6, 0x01,
+	0xdb, 0xd7, 0xbf, 0x20, 0x30, 0x06, 0x08, 0xf4, 0xf5, 0xd4, 0x3e, 0x0b, 0xe2, 0x61, 0xc4, 0x2f,
+	0xc3, 0xcd, 0x48, 0x36, 0x19, 0x46, 0x1d, 0x73, 0x29, 0x51, 0xd6, 0xdb, 0xaa, 0xa8, 0x65, 0x15,
+	0x58, 0x57, 0x5f, 0xd1, 0x5a, 0xb2, 0xa6, 0x25, 0x33, 0x43, 0xf0, 0xc2, 0xd2, 0x43, 0xf0, 0x35,
+	0x82, 0xcd, 0x24, 0x4d, 0xbd, 0xfd, 0xdf, 0xbd, 0xee, 0xf6, 0xc7, 0x0f, 0xd2, 0x1b, 0xfe, 0x5e,
+	0xf6, 0x2d, 0x90, 0x84, 0x62, 0x37, 0xcf, 0x2f, 0xea, 0xb9, 0x27, 0x17, 0xf5, 0xdc, 0xd3, 0x8b,
+	0x7a, 0xee, 0xd1, 0xa8, 0x8e, 0xce, 0x47, 0x75, 0xf4, 0x64, 0x54, 0x47, 0x4f, 0x47, 0x75, 0xf4,
+	0xe3, 0xa8, 0x8e, 0x1e, 0xff, 0x54, 0xcf, 0x7d, 0xd2, 0xc8, 0xfa, 0xdf, 0xca, 0xdf, 0x03, 0x00,
+	0xe3, 0x37, 0x60, 0x33, 0x86, 0x11, 0x00, 0x00,
 }
 
 func (m *AntreaClusterNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
@@ -1130,6 +1197,18 @@ func (m *RuleTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastHitTime != nil {
+		{
+			size, err := m.LastHitTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1219,6 +1298,9 @@ func (m *TrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DroppedPackets))
+	i--
+	dAtA[i] = 0x20
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Sessions))
 	i--
 	dAtA[i] = 0x18
@@ -1231,6 +1313,118 @@ func (m *TrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnusedRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnusedRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnusedRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHitTime != nil {
+		{
+			size, err := m.LastHitTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i -= len(m.RuleName)
+	copy(dAtA[i:], m.RuleName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RuleName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.PolicyName)
+	copy(dAtA[i:], m.PolicyName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PolicyName)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.PolicyNamespace)
+	copy(dAtA[i:], m.PolicyNamespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PolicyNamespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.PolicyType)
+	copy(dAtA[i:], m.PolicyType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PolicyType)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UnusedRuleList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnusedRuleList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnusedRuleList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -1454,6 +1648,10 @@ func (m *RuleTrafficStats) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.TrafficStats.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastHitTime != nil {
+		l = m.LastHitTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1482,6 +1680,47 @@ func (m *TrafficStats) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.Packets))
 	n += 1 + sovGenerated(uint64(m.Bytes))
 	n += 1 + sovGenerated(uint64(m.Sessions))
+	n += 1 + sovGenerated(uint64(m.DroppedPackets))
+	return n
+}
+
+func (m *UnusedRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PolicyType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PolicyNamespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PolicyName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RuleName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastHitTime != nil {
+		l = m.LastHitTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *UnusedRuleList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1683,6 +1922,7 @@ func (this *RuleTrafficStats) String() string {
 	s := strings.Join([]string{`&RuleTrafficStats{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`LastHitTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHitTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1708,6 +1948,38 @@ func (this *TrafficStats) String() string {
 		`Packets:` + fmt.Sprintf("%v", this.Packets) + `,`,
 		`Bytes:` + fmt.Sprintf("%v", this.Bytes) + `,`,
 		`Sessions:` + fmt.Sprintf("%v", this.Sessions) + `,`,
+		`DroppedPackets:` + fmt.Sprintf("%v", this.DroppedPackets) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnusedRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnusedRule{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`PolicyType:` + fmt.Sprintf("%v", this.PolicyType) + `,`,
+		`PolicyNamespace:` + fmt.Sprintf("%v", this.PolicyNamespace) + `,`,
+		`PolicyName:` + fmt.Sprintf("%v", this.PolicyName) + `,`,
+		`RuleName:` + fmt.Sprintf("%v", this.RuleName) + `,`,
+		`LastHitTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHitTime), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnusedRuleList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]UnusedRule{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "UnusedRule", "UnusedRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&UnusedRuleList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3311,6 +3583,42 @@ func (m *RuleTrafficStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHitTime == nil {
+				m.LastHitTime = &v1.Time{}
+			}
+			if err := m.LastHitTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3585,6 +3893,389 @@ func (m *TrafficStats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedPackets", wireType)
+			}
+			m.DroppedPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedPackets |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnusedRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnusedRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnusedRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHitTime == nil {
+				m.LastHitTime = &v1.Time{}
+			}
+			if err := m.LastHitTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnusedRuleList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnusedRuleList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnusedRuleList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, UnusedRule{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
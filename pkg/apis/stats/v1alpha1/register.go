@@ -26,6 +26,11 @@ const GroupName = "stats.antrea.io"
 // SchemeGroupVersion is group version used to register these objects
 var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
 
+var UnusedRuleVersionResource = schema.GroupVersionResource{
+	Group:    SchemeGroupVersion.Group,
+	Version:  SchemeGroupVersion.Version,
+	Resource: "unusedrules"}
+
 // Resource takes an unqualified resource and returns a Group qualified GroupResource
 func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
@@ -51,6 +56,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&MulticastGroupList{},
 		&NodeLatencyStats{},
 		&NodeLatencyStatsList{},
+		&UnusedRule{},
+		&UnusedRuleList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
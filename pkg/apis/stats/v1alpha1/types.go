@@ -139,12 +139,57 @@ type TrafficStats struct {
 	Bytes int64 `json:"bytes,omitempty" protobuf:"varint,2,opt,name=bytes"`
 	// Sessions is the sessions count hit by the NetworkPolicy.
 	Sessions int64 `json:"sessions,omitempty" protobuf:"varint,3,opt,name=sessions"`
+	// DroppedPackets is the count of packets dropped by the rate limit of the
+	// rule. It is only set for rules with action RateLimit.
+	DroppedPackets int64 `json:"droppedPackets,omitempty" protobuf:"varint,4,opt,name=droppedPackets"`
 }
 
 // RuleTrafficStats contains TrafficStats of single rule inside a NetworkPolicy.
 type RuleTrafficStats struct {
 	Name         string       `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
+	// LastHitTime is the last time the rule was observed matching traffic. It is
+	// unset if the rule has not matched any traffic since its stats started.
+	LastHitTime *metav1.Time `json:"lastHitTime,omitempty" protobuf:"bytes,3,opt,name=lastHitTime"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=list
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UnusedRule is an Antrea-native policy rule which has not matched any traffic
+// in a given time window. The window can be specified with the "since" field
+// selector, e.g. "since=30d", and defaults to 24h. The CreationTimestamp of an
+// UnusedRule is the time that the stats of the rule start.
+type UnusedRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The type of the policy the rule belongs to, AntreaClusterNetworkPolicy or
+	// AntreaNetworkPolicy.
+	PolicyType string `json:"policyType,omitempty" protobuf:"bytes,2,opt,name=policyType"`
+	// The namespace of the policy the rule belongs to. Empty for
+	// AntreaClusterNetworkPolicy.
+	PolicyNamespace string `json:"policyNamespace,omitempty" protobuf:"bytes,3,opt,name=policyNamespace"`
+	// The name of the policy the rule belongs to.
+	PolicyName string `json:"policyName,omitempty" protobuf:"bytes,4,opt,name=policyName"`
+	// The name of the rule.
+	RuleName string `json:"ruleName,omitempty" protobuf:"bytes,5,opt,name=ruleName"`
+	// LastHitTime is the last time the rule was observed matching traffic. It is
+	// unset if the rule has not matched any traffic since its stats started.
+	LastHitTime *metav1.Time `json:"lastHitTime,omitempty" protobuf:"bytes,6,opt,name=lastHitTime"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UnusedRuleList is a list of UnusedRule.
+type UnusedRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of UnusedRule.
+	Items []UnusedRule `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
 // +genclient
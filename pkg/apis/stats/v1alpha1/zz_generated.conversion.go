@@ -23,6 +23,7 @@ import (
 	unsafe "unsafe"
 
 	stats "antrea.io/antrea/pkg/apis/stats"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -368,6 +369,7 @@ func autoConvert_v1alpha1_RuleTrafficStats_To_stats_RuleTrafficStats(in *RuleTra
 	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
 		return err
 	}
+	out.LastHitTime = (*v1.Time)(unsafe.Pointer(in.LastHitTime))
 	return nil
 }
 
@@ -381,6 +383,7 @@ func autoConvert_stats_RuleTrafficStats_To_v1alpha1_RuleTrafficStats(in *stats.R
 	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
 		return err
 	}
+	out.LastHitTime = (*v1.Time)(unsafe.Pointer(in.LastHitTime))
 	return nil
 }
 
@@ -393,6 +396,7 @@ func autoConvert_v1alpha1_TrafficStats_To_stats_TrafficStats(in *TrafficStats, o
 	out.Packets = in.Packets
 	out.Bytes = in.Bytes
 	out.Sessions = in.Sessions
+	out.DroppedPackets = in.DroppedPackets
 	return nil
 }
 
@@ -405,6 +409,7 @@ func autoConvert_stats_TrafficStats_To_v1alpha1_TrafficStats(in *stats.TrafficSt
 	out.Packets = in.Packets
 	out.Bytes = in.Bytes
 	out.Sessions = in.Sessions
+	out.DroppedPackets = in.DroppedPackets
 	return nil
 }
 
@@ -32,7 +32,9 @@ func (in *AntreaClusterNetworkPolicyStats) DeepCopyInto(out *AntreaClusterNetwor
 	if in.RuleTrafficStats != nil {
 		in, out := &in.RuleTrafficStats, &out.RuleTrafficStats
 		*out = make([]RuleTrafficStats, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -97,7 +99,9 @@ func (in *AntreaNetworkPolicyStats) DeepCopyInto(out *AntreaNetworkPolicyStats)
 	if in.RuleTrafficStats != nil {
 		in, out := &in.RuleTrafficStats, &out.RuleTrafficStats
 		*out = make([]RuleTrafficStats, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -386,6 +390,10 @@ func (in *PodReference) DeepCopy() *PodReference {
 func (in *RuleTrafficStats) DeepCopyInto(out *RuleTrafficStats) {
 	*out = *in
 	out.TrafficStats = in.TrafficStats
+	if in.LastHitTime != nil {
+		in, out := &in.LastHitTime, &out.LastHitTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -432,3 +440,66 @@ func (in *TrafficStats) DeepCopy() *TrafficStats {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnusedRule) DeepCopyInto(out *UnusedRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.LastHitTime != nil {
+		in, out := &in.LastHitTime, &out.LastHitTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnusedRule.
+func (in *UnusedRule) DeepCopy() *UnusedRule {
+	if in == nil {
+		return nil
+	}
+	out := new(UnusedRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UnusedRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnusedRuleList) DeepCopyInto(out *UnusedRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UnusedRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnusedRuleList.
+func (in *UnusedRuleList) DeepCopy() *UnusedRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(UnusedRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UnusedRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
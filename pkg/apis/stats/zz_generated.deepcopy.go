@@ -32,7 +32,9 @@ func (in *AntreaClusterNetworkPolicyStats) DeepCopyInto(out *AntreaClusterNetwor
 	if in.RuleTrafficStats != nil {
 		in, out := &in.RuleTrafficStats, &out.RuleTrafficStats
 		*out = make([]RuleTrafficStats, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -97,7 +99,9 @@ func (in *AntreaNetworkPolicyStats) DeepCopyInto(out *AntreaNetworkPolicyStats)
 	if in.RuleTrafficStats != nil {
 		in, out := &in.RuleTrafficStats, &out.RuleTrafficStats
 		*out = make([]RuleTrafficStats, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -297,6 +301,10 @@ func (in *PodReference) DeepCopy() *PodReference {
 func (in *RuleTrafficStats) DeepCopyInto(out *RuleTrafficStats) {
 	*out = *in
 	out.TrafficStats = in.TrafficStats
+	if in.LastHitTime != nil {
+		in, out := &in.LastHitTime, &out.LastHitTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -39,23 +39,29 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleFileServer":                  schema_pkg_apis_controlplane_v1beta2_BundleFileServer(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleServerAuthConfiguration":     schema_pkg_apis_controlplane_v1beta2_BundleServerAuthConfiguration(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ClusterGroupMembers":               schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol":                       schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup":                       schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupList":                   schema_pkg_apis_controlplane_v1beta2_EgressGroupList(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupPatch":                  schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity":                            schema_pkg_apis_controlplane_v1beta2_Entity(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference":           schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol":                      schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupAssociation":                  schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember":                       schema_pkg_apis_controlplane_v1beta2_GroupMember(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMembers":                      schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference":                    schema_pkg_apis_controlplane_v1beta2_GroupReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatcher":                 schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatcher(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol":                      schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock":                           schema_pkg_apis_controlplane_v1beta2_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPGroupAssociation":                schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet":                             schema_pkg_apis_controlplane_v1beta2_IPNet(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol":                        schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.LogSampling":                       schema_pkg_apis_controlplane_v1beta2_LogSampling(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo":                schema_pkg_apis_controlplane_v1beta2_MulticastGroupInfo(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort":                         schema_pkg_apis_controlplane_v1beta2_NamedPort(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicy":                     schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyAnalysis":             schema_pkg_apis_controlplane_v1beta2_NetworkPolicyAnalysis(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyAnalysisFinding":      schema_pkg_apis_controlplane_v1beta2_NetworkPolicyAnalysisFinding(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluation":           schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationRequest":    schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluationRequest(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationResponse":   schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluationResponse(ref),
@@ -70,6 +76,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeStatsSummary":                  schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PaginationGetOptions":              schema_pkg_apis_controlplane_v1beta2_PaginationGetOptions(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference":                      schema_pkg_apis_controlplane_v1beta2_PodReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyRuleReference":               schema_pkg_apis_controlplane_v1beta2_PolicyRuleReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RateLimit":                         schema_pkg_apis_controlplane_v1beta2_RateLimit(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef":                           schema_pkg_apis_controlplane_v1beta2_RuleRef(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service":                           schema_pkg_apis_controlplane_v1beta2_Service(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference":                  schema_pkg_apis_controlplane_v1beta2_ServiceReference(ref),
@@ -91,9 +99,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicyList":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicyList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicySpec":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ControllerCondition":                        schema_pkg_apis_crd_v1beta1_ControllerCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol":                                schema_pkg_apis_crd_v1beta1_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination":                                schema_pkg_apis_crd_v1beta1_Destination(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Egress":                                     schema_pkg_apis_crd_v1beta1_Egress(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition":                            schema_pkg_apis_crd_v1beta1_EgressCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressDestinations":                         schema_pkg_apis_crd_v1beta1_EgressDestinations(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressIPStatus":                             schema_pkg_apis_crd_v1beta1_EgressIPStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressList":                                 schema_pkg_apis_crd_v1beta1_EgressList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressSpec":                                 schema_pkg_apis_crd_v1beta1_EgressSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressStatus":                               schema_pkg_apis_crd_v1beta1_EgressStatus(ref),
@@ -101,11 +112,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolList":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolSpec":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolStatus":                       schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol":                               schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Group":                                      schema_pkg_apis_crd_v1beta1_Group(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition":                             schema_pkg_apis_crd_v1beta1_GroupCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupList":                                  schema_pkg_apis_crd_v1beta1_GroupList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupSpec":                                  schema_pkg_apis_crd_v1beta1_GroupSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupStatus":                                schema_pkg_apis_crd_v1beta1_GroupStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatcher":                          schema_pkg_apis_crd_v1beta1_HTTPHeaderMatcher(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol":                               schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader":                      schema_pkg_apis_crd_v1beta1_ICMPEchoRequestHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPProtocol":                               schema_pkg_apis_crd_v1beta1_ICMPProtocol(ref),
@@ -122,6 +135,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange":                                    schema_pkg_apis_crd_v1beta1_IPRange(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header":                                 schema_pkg_apis_crd_v1beta1_IPv6Header(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol":                                 schema_pkg_apis_crd_v1beta1_L7Protocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling":                                schema_pkg_apis_crd_v1beta1_LogSampling(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName":                             schema_pkg_apis_crd_v1beta1_NamespacedName(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicy":                              schema_pkg_apis_crd_v1beta1_NetworkPolicy(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition":                     schema_pkg_apis_crd_v1beta1_NetworkPolicyCondition(ref),
@@ -139,6 +153,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerNamespaces":                             schema_pkg_apis_crd_v1beta1_PeerNamespaces(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService":                                schema_pkg_apis_crd_v1beta1_PeerService(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PodOwner":                                   schema_pkg_apis_crd_v1beta1_PodOwner(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RateLimit":                                  schema_pkg_apis_crd_v1beta1_RateLimit(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Rule":                                       schema_pkg_apis_crd_v1beta1_Rule(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Source":                                     schema_pkg_apis_crd_v1beta1_Source(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.StatefulSetOwner":                           schema_pkg_apis_crd_v1beta1_StatefulSetOwner(ref),
@@ -169,6 +184,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats":                        schema_pkg_apis_stats_v1alpha1_RuleTrafficStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.TargetIPLatencyStats":                    schema_pkg_apis_stats_v1alpha1_TargetIPLatencyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats":                            schema_pkg_apis_stats_v1alpha1_TrafficStats(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.UnusedRule":                              schema_pkg_apis_stats_v1alpha1_UnusedRule(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.UnusedRuleList":                          schema_pkg_apis_stats_v1alpha1_UnusedRuleList(ref),
 		"antrea.io/antrea/pkg/apis/system/v1beta1.SupportBundle":                           schema_pkg_apis_system_v1beta1_SupportBundle(ref),
 		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                              schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                                      schema_k8sio_api_core_v1_Affinity(ref),
@@ -941,6 +958,41 @@ func schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name and query types. All fields could be used alone or together. If all fields are not provided, it matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the domain name in the DNS query to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queryTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryTypes represents the types of DNS query to match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1139,6 +1191,33 @@ func schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1372,6 +1451,33 @@ func schema_pkg_apis_controlplane_v1beta2_GroupReference(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatcher matches an HTTP request header with a specific name and, optionally, a specific value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the header to match. It is case-insensitive.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the exact value of the header to match. If it is not provided, any request with the header is matched.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1400,9 +1506,25 @@ func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers is a list of HTTP request headers to match. A request is matched only if all the headers are matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatcher"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatcher"},
 	}
 }
 
@@ -1534,11 +1656,48 @@ func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallbac
 							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_LogSampling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogSampling describes how the connections logged for a rule are sampled. Exactly one of Ratio and MaxPerSecond is set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ratio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ratio logs 1 of every Ratio connections.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPerSecond logs at most MaxPerSecond connections per second.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1688,6 +1847,13 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref common.ReferenceCall
 							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
 						},
 					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies whether the Drop and Reject actions of the rules are enforced. It is empty for K8s NetworkPolicy, which means the rules are enforced.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1696,6 +1862,90 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyAnalysis contains the result of the analysis of the rules of all NetworkPolicies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"findings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Findings is the list of shadowed, redundant and conflicting rules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyAnalysisFinding"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyAnalysisFinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyAnalysisFinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyAnalysisFinding describes an issue detected between two rules.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The rule the finding is about.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyRuleReference"),
+						},
+					},
+					"relatedRule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The rule which shadows Rule, makes it redundant, or conflicts with it.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyRuleReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PolicyRuleReference"},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2131,12 +2381,24 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyRule(ref common.Reference
 							Format:      "",
 						},
 					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit specifies the rate to which the traffic matching the rule is policed. It is only set for rules whose action is RateLimit.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RateLimit"),
+						},
+					},
+					"logSampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSampling specifies how the connections logged for the rule are sampled. It is only set for Allow rules with EnableLogging set to true.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.LogSampling"),
+						},
+					},
 				},
 				Required: []string{"enableLogging"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.LogSampling", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RateLimit", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
 	}
 }
 
@@ -2411,6 +2673,75 @@ func schema_pkg_apis_controlplane_v1beta2_PodReference(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_PolicyRuleReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyRuleReference references a rule of a NetworkPolicy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"networkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reference of the original NetworkPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
+						},
+					},
+					"ruleIndex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The index of the rule among the rules of the same direction in the original NetworkPolicy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRef"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RateLimit describes the rate to which the traffic matching a rule is policed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit of Rate and Burst.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate is the maximum rate of the traffic, in packets per second or bits per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the maximum burst size, in packets or bits.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_RuleRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3542,6 +3873,41 @@ func schema_pkg_apis_crd_v1beta1_ControllerCondition(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name and query types. All fields could be used alone or together. If all fields are not provided, it matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the domain name in the DNS query to match. It is case-insensitive and supports wildcards (Ex. \"*.foo.com\", \"*.foo.*\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queryTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryTypes represents the types of DNS query to match (Ex. \"A\", \"AAAA\"). If not provided, queries of all types are matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Destination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3677,6 +4043,63 @@ func schema_pkg_apis_crd_v1beta1_EgressCondition(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_EgressDestinations(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidrs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDRs is a list of IP blocks. When the CIDRs of multiple Egresses applied to a Pod overlap, the longest prefix match takes precedence; for identical CIDRs, the Egress created earlier takes precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_EgressIPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressIPStatus represents an Egress IP and the Node that holds it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"egressIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Egress IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"egressNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the Node that holds the Egress IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"egressIP", "egressNode"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_EgressList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3783,18 +4206,31 @@ func schema_pkg_apis_crd_v1beta1_EgressSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode specifies how the Egress IPs are used. Defaults to ActiveStandby. ActiveActive mode requires ExternalIPPools to be set, and each Egress IP in EgressIPs will be assigned to a Node selected by its ExternalIPPool independently, preferring Nodes that don't hold other Egress IPs of the Egress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"bandwidth": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Bandwidth specifies the rate limit of north-south egress traffic of this Egress.",
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"),
 						},
 					},
+					"destinations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destinations selects the destinations of the traffic that should be SNAT'd by this Egress. If it is empty, all egress traffic of the selected Pods is SNAT'd by this Egress. Otherwise, only the traffic destined for the selected destinations is SNAT'd by this Egress, and the Egress takes precedence over the Egresses without Destinations which select the same Pods.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EgressDestinations"),
+						},
+					},
 				},
 				Required: []string{"appliedTo"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth", "antrea.io/antrea/pkg/apis/crd/v1beta1.EgressDestinations"},
 	}
 }
 
@@ -3821,6 +4257,20 @@ func schema_pkg_apis_crd_v1beta1_EgressStatus(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"egressIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressIPs indicates the Egress IPs that are assigned to Nodes and the Nodes holding them. It's only set for Egresses in ActiveActive mode, in which case EgressNode and EgressIP are empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EgressIPStatus"),
+									},
+								},
+							},
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -3839,7 +4289,7 @@ func schema_pkg_apis_crd_v1beta1_EgressStatus(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition", "antrea.io/antrea/pkg/apis/crd/v1beta1.EgressIPStatus"},
 	}
 }
 
@@ -4004,6 +4454,33 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Group(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4231,6 +4708,35 @@ func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_HTTPHeaderMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatcher matches an HTTP request header with a specific name and, optionally, a specific value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the header to match. It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the exact value of the header to match. If it is not provided, any request with the header is matched.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4259,9 +4765,25 @@ func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers is a list of HTTP request headers to match. A request is matched only if all the headers are matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatcher"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatcher"},
 	}
 }
 
@@ -4749,11 +5271,48 @@ func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_LogSampling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogSampling defines how the connections logged for a rule are sampled. The sampling is done in the datapath on each Node, before the connections are sent to the antrea-agent for logging. Exactly one of Ratio and MaxPerSecond must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ratio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ratio logs 1 of every Ratio connections matching the rule. The logged connections are selected with a hash of their 5-tuple.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPerSecond logs at most MaxPerSecond connections matching the rule per second on each Node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -5614,6 +6173,43 @@ func schema_pkg_apis_crd_v1beta1_PodOwner(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RateLimit defines the rate to which the traffic matching a rule is policed. The traffic exceeding the rate is dropped. The rate is enforced on each Node independently, for all the workloads on the Node to which the rule applies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit of Rate and Burst, PacketsPerSecond or BitsPerSecond.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate specifies the maximum traffic rate. e.g. 1000, 300k, 10M",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst specifies the maximum burst size when traffic exceeds the rate. e.g. 100, 300k, 10M Defaults to Rate if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"unit", "rate"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5734,6 +6330,12 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							Format:      "",
 						},
 					},
+					"logSampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSampling restricts the logging of the connections matching this rule to a sample of them. It can only be set when EnableLogging is true and Action is Allow. If this field is not set, all connections are logged.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling"),
+						},
+					},
 					"appliedTo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Select workloads on which this rule will be applied to. Cannot be set in conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.",
@@ -5748,12 +6350,18 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit specifies the rate to which the traffic matching this rule is policed. It must be set when Action is RateLimit, and cannot be set otherwise.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RateLimit"),
+						},
+					},
 				},
 				Required: []string{"action"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService", "antrea.io/antrea/pkg/apis/crd/v1beta1.RateLimit"},
 	}
 }
 
@@ -6896,11 +7504,17 @@ func schema_pkg_apis_stats_v1alpha1_RuleTrafficStats(ref common.ReferenceCallbac
 							Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
 						},
 					},
+					"lastHitTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHitTime is the last time the rule was observed matching traffic. It is unset if the rule has not matched any traffic since its stats started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6973,9 +7587,135 @@ func schema_pkg_apis_stats_v1alpha1_TrafficStats(ref common.ReferenceCallback) c
 							Format:      "int64",
 						},
 					},
+					"droppedPackets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DroppedPackets is the count of packets dropped by the rate limit of the rule. It is only set for rules with action RateLimit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_stats_v1alpha1_UnusedRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UnusedRule is an Antrea-native policy rule which has not matched any traffic in a given time window. The window can be specified with the \"since\" field selector, e.g. \"since=30d\", and defaults to 24h. The CreationTimestamp of an UnusedRule is the time that the stats of the rule start.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"policyType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The type of the policy the rule belongs to, AntreaClusterNetworkPolicy or AntreaNetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policyNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The namespace of the policy the rule belongs to. Empty for AntreaClusterNetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the policy the rule belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ruleName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastHitTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHitTime is the last time the rule was observed matching traffic. It is unset if the rule has not matched any traffic since its stats started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_stats_v1alpha1_UnusedRuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UnusedRuleList is a list of UnusedRule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of UnusedRule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.UnusedRule"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.UnusedRule", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
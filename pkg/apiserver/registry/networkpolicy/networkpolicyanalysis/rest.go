@@ -0,0 +1,68 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicyanalysis
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+type REST struct {
+	analyzer networkpolicy.PolicyAnalyzer
+}
+
+var (
+	_ rest.Storage              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.Creater              = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(analyzer networkpolicy.PolicyAnalyzer) *REST {
+	return &REST{analyzer}
+}
+
+func (r *REST) New() runtime.Object {
+	return &controlplane.NetworkPolicyAnalysis{}
+}
+
+func (r *REST) Destroy() {
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	analysis, ok := obj.(*controlplane.NetworkPolicyAnalysis)
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("not a NetworkPolicyAnalysis object: %T", obj))
+	}
+	analysis.Findings = r.analyzer.AnalyzeNetworkPolicies()
+	return analysis, nil
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+func (r *REST) GetSingularName() string {
+	return "networkpolicyanalysis"
+}
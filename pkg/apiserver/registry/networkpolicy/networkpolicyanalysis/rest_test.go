@@ -0,0 +1,93 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicyanalysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	queriermock "antrea.io/antrea/pkg/controller/networkpolicy/testing"
+)
+
+func TestREST(t *testing.T) {
+	r := NewREST(nil)
+	assert.Equal(t, &controlplane.NetworkPolicyAnalysis{}, r.New())
+	assert.False(t, r.NamespaceScoped())
+}
+
+func TestRESTCreate(t *testing.T) {
+	findings := []controlplane.NetworkPolicyAnalysisFinding{
+		{
+			Type: controlplane.NetworkPolicyAnalysisFindingShadowed,
+			Rule: controlplane.PolicyRuleReference{
+				NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp2"},
+				Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "rule1"},
+			},
+			RelatedRule: controlplane.PolicyRuleReference{
+				NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1"},
+				RuleIndex:     1,
+				Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "rule2"},
+			},
+		},
+	}
+	tests := []struct {
+		name                string
+		obj                 runtime.Object
+		expectedReturnedObj runtime.Object
+		expectedErr         error
+		mockFindings        []controlplane.NetworkPolicyAnalysisFinding
+	}{
+		{
+			name:                "Succeed",
+			obj:                 &controlplane.NetworkPolicyAnalysis{},
+			expectedReturnedObj: &controlplane.NetworkPolicyAnalysis{Findings: findings},
+			mockFindings:        findings,
+		},
+		{
+			name:                "No finding",
+			obj:                 &controlplane.NetworkPolicyAnalysis{},
+			expectedReturnedObj: &controlplane.NetworkPolicyAnalysis{},
+		},
+		{
+			name: "Unexpected type",
+			obj: &controlplane.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "foo",
+				},
+			},
+			expectedErr: errors.NewBadRequest("not a NetworkPolicyAnalysis object: *controlplane.NetworkPolicy"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockAnalyzer := queriermock.NewMockPolicyAnalyzer(mockCtrl)
+			if tt.expectedErr == nil {
+				mockAnalyzer.EXPECT().AnalyzeNetworkPolicies().Return(tt.mockFindings)
+			}
+			r := NewREST(mockAnalyzer)
+			actualObj, err := r.Create(context.TODO(), tt.obj, nil, &v1.CreateOptions{})
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedReturnedObj, actualObj)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unusedrule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+const (
+	// sinceField is the field selector used to specify the time window in which the rules have not matched any
+	// traffic.
+	sinceField = "since"
+	// defaultWindow is the time window used when no window is specified.
+	defaultWindow = 24 * time.Hour
+)
+
+var (
+	tableColumnDefinitions = []metav1.TableColumnDefinition{
+		{Name: "Policy Type", Type: "string", Description: "The type of the policy the rule belongs to."},
+		{Name: "Namespace", Type: "string", Description: "The namespace of the policy the rule belongs to."},
+		{Name: "Policy", Type: "string", Description: "The name of the policy the rule belongs to."},
+		{Name: "Rule", Type: "string", Description: "The name of the rule."},
+		{Name: "Last Hit", Type: "string", Description: "The last time the rule matched traffic."},
+	}
+)
+
+type REST struct {
+	statsProvider statsProvider
+	clock         clock.PassiveClock
+}
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(p statsProvider) *REST {
+	return &REST{statsProvider: p, clock: clock.RealClock{}}
+}
+
+var (
+	_ rest.Storage              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.Lister               = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+type statsProvider interface {
+	ListUnusedRules(since time.Time) []statsv1alpha1.UnusedRule
+}
+
+func (r *REST) New() runtime.Object {
+	return &statsv1alpha1.UnusedRule{}
+}
+
+func (r *REST) Destroy() {
+}
+
+func (r *REST) NewList() runtime.Object {
+	return &statsv1alpha1.UnusedRuleList{}
+}
+
+func (r *REST) List(ctx context.Context, options *internalversion.ListOptions) (runtime.Object, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		return &statsv1alpha1.UnusedRuleList{}, nil
+	}
+	if !features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		return &statsv1alpha1.UnusedRuleList{}, nil
+	}
+	window := defaultWindow
+	if options != nil && options.FieldSelector != nil {
+		for _, req := range options.FieldSelector.Requirements() {
+			if req.Field != sinceField || (req.Operator != selection.Equals && req.Operator != selection.DoubleEquals) {
+				return nil, errors.NewBadRequest(fmt.Sprintf("unsupported field selector %s%s%s, only %s=<duration> is supported", req.Field, req.Operator, req.Value, sinceField))
+			}
+			var err error
+			if window, err = parseWindow(req.Value); err != nil {
+				return nil, errors.NewBadRequest(fmt.Sprintf("invalid %s %q: %v", sinceField, req.Value, err))
+			}
+		}
+	}
+	items := r.statsProvider.ListUnusedRules(r.clock.Now().Add(-window))
+	return &statsv1alpha1.UnusedRuleList{Items: items}, nil
+}
+
+// parseWindow parses a time window which, in addition to the units supported by time.ParseDuration, can be
+// expressed in days, e.g. "30d".
+func parseWindow(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseUint(days, 10, 16)
+		if err != nil || n == 0 {
+			return 0, fmt.Errorf("number of days must be a positive integer")
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	window, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if window <= 0 {
+		return 0, fmt.Errorf("duration must be positive")
+	}
+	return window, nil
+}
+
+func formatLastHitTime(t *metav1.Time) string {
+	if t == nil {
+		return "<never>"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func (r *REST) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{
+		ColumnDefinitions: tableColumnDefinitions,
+	}
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+		table.RemainingItemCount = m.GetRemainingItemCount()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
+		rule := obj.(*statsv1alpha1.UnusedRule)
+		return []interface{}{rule.PolicyType, rule.PolicyNamespace, rule.PolicyName, rule.RuleName, formatLastHitTime(rule.LastHitTime)}, nil
+	})
+	return table, err
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+func (r *REST) GetSingularName() string {
+	return "unusedrule"
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unusedrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+var (
+	lastHitTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	rule1       = statsv1alpha1.UnusedRule{
+		ObjectMeta:  metav1.ObjectMeta{Name: "uid1.rule1"},
+		PolicyType:  "AntreaClusterNetworkPolicy",
+		PolicyName:  "acnp1",
+		RuleName:    "rule1",
+		LastHitTime: &lastHitTime,
+	}
+	rule2 = statsv1alpha1.UnusedRule{
+		ObjectMeta:      metav1.ObjectMeta{Name: "uid2.rule2"},
+		PolicyType:      "AntreaNetworkPolicy",
+		PolicyNamespace: "ns1",
+		PolicyName:      "annp1",
+		RuleName:        "rule2",
+	}
+)
+
+type fakeStatsProvider struct {
+	rules []statsv1alpha1.UnusedRule
+	since time.Time
+}
+
+func (p *fakeStatsProvider) ListUnusedRules(since time.Time) []statsv1alpha1.UnusedRule {
+	p.since = since
+	return p.rules
+}
+
+func TestREST(t *testing.T) {
+	r := NewREST(nil)
+	assert.Equal(t, &statsv1alpha1.UnusedRule{}, r.New())
+	assert.Equal(t, &statsv1alpha1.UnusedRuleList{}, r.NewList())
+	assert.False(t, r.NamespaceScoped())
+}
+
+func TestRESTList(t *testing.T) {
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                      string
+		networkPolicyStatsEnabled bool
+		antreaPolicyEnabled       bool
+		fieldSelector             fields.Selector
+		expectedSince             time.Time
+		expectedObj               runtime.Object
+		expectedErr               bool
+	}{
+		{
+			name:                      "NetworkPolicyStats feature disabled",
+			networkPolicyStatsEnabled: false,
+			antreaPolicyEnabled:       true,
+			expectedObj:               &statsv1alpha1.UnusedRuleList{},
+		},
+		{
+			name:                      "AntreaPolicy feature disabled",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       false,
+			expectedObj:               &statsv1alpha1.UnusedRuleList{},
+		},
+		{
+			name:                      "default window",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			expectedSince:             now.Add(-24 * time.Hour),
+			expectedObj:               &statsv1alpha1.UnusedRuleList{Items: []statsv1alpha1.UnusedRule{rule1, rule2}},
+		},
+		{
+			name:                      "window in days",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			fieldSelector:             fields.OneTermEqualSelector("since", "30d"),
+			expectedSince:             now.Add(-30 * 24 * time.Hour),
+			expectedObj:               &statsv1alpha1.UnusedRuleList{Items: []statsv1alpha1.UnusedRule{rule1, rule2}},
+		},
+		{
+			name:                      "window in hours",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			fieldSelector:             fields.OneTermEqualSelector("since", "2h30m"),
+			expectedSince:             now.Add(-150 * time.Minute),
+			expectedObj:               &statsv1alpha1.UnusedRuleList{Items: []statsv1alpha1.UnusedRule{rule1, rule2}},
+		},
+		{
+			name:                      "invalid window",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			fieldSelector:             fields.OneTermEqualSelector("since", "-1d"),
+			expectedErr:               true,
+		},
+		{
+			name:                      "unsupported field selector",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			fieldSelector:             fields.OneTermNotEqualSelector("since", "1d"),
+			expectedErr:               true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, tt.networkPolicyStatsEnabled)
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicy, tt.antreaPolicyEnabled)
+
+			provider := &fakeStatsProvider{rules: []statsv1alpha1.UnusedRule{rule1, rule2}}
+			r := &REST{
+				statsProvider: provider,
+				clock:         clocktesting.NewFakePassiveClock(now),
+			}
+			actualObj, err := r.List(context.TODO(), &internalversion.ListOptions{FieldSelector: tt.fieldSelector})
+			if tt.expectedErr {
+				require.Error(t, err)
+				assert.True(t, errors.IsBadRequest(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedObj, actualObj)
+			assert.Equal(t, tt.expectedSince, provider.since)
+		})
+	}
+}
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		window         string
+		expectedWindow time.Duration
+		expectedErr    bool
+	}{
+		{window: "30d", expectedWindow: 30 * 24 * time.Hour},
+		{window: "12h", expectedWindow: 12 * time.Hour},
+		{window: "90m", expectedWindow: 90 * time.Minute},
+		{window: "0d", expectedErr: true},
+		{window: "1.5d", expectedErr: true},
+		{window: "0s", expectedErr: true},
+		{window: "-1h", expectedErr: true},
+		{window: "foo", expectedErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window, func(t *testing.T) {
+			window, err := parseWindow(tt.window)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedWindow, window)
+			}
+		})
+	}
+}
+
+func TestRESTConvertToTable(t *testing.T) {
+	r := NewREST(nil)
+	list := &statsv1alpha1.UnusedRuleList{Items: []statsv1alpha1.UnusedRule{rule1, rule2}}
+	table, err := r.ConvertToTable(context.TODO(), list, &metav1.TableOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, tableColumnDefinitions, table.ColumnDefinitions)
+	require.Len(t, table.Rows, 2)
+	assert.Equal(t, []interface{}{"AntreaClusterNetworkPolicy", "", "acnp1", "rule1", "2026-01-01T00:00:00Z"}, table.Rows[0].Cells)
+	assert.Equal(t, []interface{}{"AntreaNetworkPolicy", "ns1", "annp1", "rule2", "<never>"}, table.Rows[1].Cells)
+}
@@ -34,6 +34,7 @@ type ControlplaneV1beta2Interface interface {
 	GroupMembersGetter
 	IPGroupAssociationsGetter
 	NetworkPoliciesGetter
+	NetworkPolicyAnalysesGetter
 	NetworkPolicyEvaluationsGetter
 	NodeStatsSummariesGetter
 	SupportBundleCollectionsGetter
@@ -76,6 +77,10 @@ func (c *ControlplaneV1beta2Client) NetworkPolicies() NetworkPolicyInterface {
 	return newNetworkPolicies(c)
 }
 
+func (c *ControlplaneV1beta2Client) NetworkPolicyAnalyses() NetworkPolicyAnalysisInterface {
+	return newNetworkPolicyAnalyses(c)
+}
+
 func (c *ControlplaneV1beta2Client) NetworkPolicyEvaluations() NetworkPolicyEvaluationInterface {
 	return newNetworkPolicyEvaluations(c)
 }
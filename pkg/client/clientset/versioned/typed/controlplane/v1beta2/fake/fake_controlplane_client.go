@@ -58,6 +58,10 @@ func (c *FakeControlplaneV1beta2) NetworkPolicies() v1beta2.NetworkPolicyInterfa
 	return newFakeNetworkPolicies(c)
 }
 
+func (c *FakeControlplaneV1beta2) NetworkPolicyAnalyses() v1beta2.NetworkPolicyAnalysisInterface {
+	return newFakeNetworkPolicyAnalyses(c)
+}
+
 func (c *FakeControlplaneV1beta2) NetworkPolicyEvaluations() v1beta2.NetworkPolicyEvaluationInterface {
 	return newFakeNetworkPolicyEvaluations(c)
 }
@@ -26,6 +26,8 @@ type GroupAssociationExpansion interface{}
 
 type IPGroupAssociationExpansion interface{}
 
+type NetworkPolicyAnalysisExpansion interface{}
+
 type NetworkPolicyEvaluationExpansion interface{}
 
 type NodeStatsSummaryExpansion interface{}
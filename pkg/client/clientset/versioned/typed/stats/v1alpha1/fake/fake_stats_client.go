@@ -46,6 +46,10 @@ func (c *FakeStatsV1alpha1) NodeLatencyStats() v1alpha1.NodeLatencyStatsInterfac
 	return newFakeNodeLatencyStats(c)
 }
 
+func (c *FakeStatsV1alpha1) UnusedRules() v1alpha1.UnusedRuleInterface {
+	return newFakeUnusedRules(c)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeStatsV1alpha1) RESTClient() rest.Interface {
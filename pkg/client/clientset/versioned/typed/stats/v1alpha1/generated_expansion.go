@@ -25,3 +25,5 @@ type MulticastGroupExpansion interface{}
 type NetworkPolicyStatsExpansion interface{}
 
 type NodeLatencyStatsExpansion interface{}
+
+type UnusedRuleExpansion interface{}
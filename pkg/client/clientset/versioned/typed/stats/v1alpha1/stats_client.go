@@ -31,6 +31,7 @@ type StatsV1alpha1Interface interface {
 	MulticastGroupsGetter
 	NetworkPolicyStatsGetter
 	NodeLatencyStatsGetter
+	UnusedRulesGetter
 }
 
 // StatsV1alpha1Client is used to interact with features provided by the stats.antrea.io group.
@@ -58,6 +59,10 @@ func (c *StatsV1alpha1Client) NodeLatencyStats() NodeLatencyStatsInterface {
 	return newNodeLatencyStats(c)
 }
 
+func (c *StatsV1alpha1Client) UnusedRules() UnusedRuleInterface {
+	return newUnusedRules(c)
+}
+
 // NewForConfig creates a new StatsV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -312,6 +312,60 @@ type FlowExporterConfig struct {
 	// protocols are exported which are:
 	// "tcp", "udp", "sctp"
 	ProtocolFilter []string `yaml:"protocolFilter,omitempty"`
+	// Provide the rules used to select which connections are exported. When
+	// no include rule is provided, all connections are included. Connections
+	// matching any of the exclude rules are never exported.
+	ConnectionFilter FlowExporterConnectionFilterConfig `yaml:"connectionFilter,omitempty"`
+	// Provide the sampling rate N to export only 1 in N connections, after
+	// applying the connection filter. The sampling decision is a deterministic
+	// function of the connection 5-tuple. Defaults to 0, which means that all
+	// the connections are exported.
+	SamplingRate uint32 `yaml:"samplingRate,omitempty"`
+	// Enable sharding of flow records across multiple Flow Aggregator replicas.
+	// Replicas are discovered using the EndpointSlices of the collector Service,
+	// and each connection is assigned to one replica using a consistent hash of
+	// its flow key, so that records from the source and destination Nodes can
+	// be correlated. Requires FlowCollectorAddr to refer to a Service
+	// (<Service namespace>/<Service name>). Defaults to false.
+	EnableSharding bool `yaml:"enableSharding,omitempty"`
+	// Enable the collection of TCP performance metrics (smoothed RTT, RTT
+	// variance, retransmissions and time limited by the peer's receive window)
+	// for exported connections. Metrics are sampled from the kernel sockets
+	// (tcp_info) of local Pods and of the Node, using sock_diag, at every poll
+	// interval. Only supported on Linux Nodes. Defaults to false.
+	EnableTCPMetrics bool `yaml:"enableTCPMetrics,omitempty"`
+}
+
+type FlowExporterConnectionFilterConfig struct {
+	// Connections matching any of these rules are exported. If empty, all
+	// connections are exported (unless they match an exclude rule).
+	Include []FlowExporterConnectionFilterRule `yaml:"include,omitempty"`
+	// Connections matching any of these rules are not exported.
+	Exclude []FlowExporterConnectionFilterRule `yaml:"exclude,omitempty"`
+}
+
+// FlowExporterConnectionFilterRule matches a connection when all the provided
+// fields match. A rule with no fields matches all connections.
+type FlowExporterConnectionFilterRule struct {
+	// Match connections for which the source or destination Pod is in one of
+	// these Namespaces. Only Pods running on the local Node can be matched.
+	Namespaces []string `yaml:"namespaces,omitempty"`
+	// Match connections for which the source or destination Pod has labels
+	// matching this label selector, e.g. "app=web,tier!=cache". When
+	// Namespaces is also provided, the same Pod must match both. Only Pods
+	// running on the local Node can be matched.
+	PodSelector string `yaml:"podSelector,omitempty"`
+	// Match connections for which the source or destination IP address (or the
+	// Service IP address, if any) is in one of these CIDRs.
+	CIDRs []string `yaml:"cidrs,omitempty"`
+	// Match connections for which the destination port (or the Service port,
+	// if any) is one of these ports.
+	Ports []int32 `yaml:"ports,omitempty"`
+	// Match connections for which the action of the ingress or egress
+	// NetworkPolicy rule is one of these actions. Supported actions are
+	// "Allow", "Drop", "Reject" and "None" (no NetworkPolicy rule applied to
+	// the connection).
+	PolicyActions []string `yaml:"policyActions,omitempty"`
 }
 
 type MulticastConfig struct {
@@ -406,6 +460,26 @@ type AuditLoggingConfig struct {
 	MaxAge *int32 `yaml:"maxAge,omitempty"`
 	// Compress enables gzip compression on rotated files. Defaults to true.
 	Compress *bool `yaml:"compress,omitempty"`
+	// Format of the audit log records: "Text" (space-separated fields) or "JSON" (one JSON
+	// object per line). Defaults to "Text".
+	Format string `yaml:"format,omitempty"`
+	// Syslog configures the forwarding of audit logs to a remote syslog server.
+	Syslog AuditLoggingSyslogConfig `yaml:"syslog,omitempty"`
+}
+
+type AuditLoggingSyslogConfig struct {
+	// Enable forwarding audit logs to a remote syslog server, in addition to the local log
+	// file. The messages are formatted according to RFC 5424. Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// Address of the syslog server, in the "host:port" format.
+	Address string `yaml:"address,omitempty"`
+	// Transport protocol used to send the messages: "UDP", "TCP" or "TLS". Defaults to "TCP".
+	Transport string `yaml:"transport,omitempty"`
+	// Facility of the messages, from "local0" to "local7". Defaults to "local0".
+	Facility string `yaml:"facility,omitempty"`
+	// CAFile is the path to a PEM-encoded CA bundle used to verify the certificate of the
+	// server when the transport is "TLS". If empty, the system root CAs are used.
+	CAFile string `yaml:"caFile,omitempty"`
 }
 
 type SecondaryNetworkConfig struct {
@@ -70,6 +70,23 @@ type FlowAggregatorConfig struct {
 	S3Uploader S3UploaderConfig `yaml:"s3Uploader,omitempty"`
 	// FlowLogger contains configuration options for writing flow records to a local log file.
 	FlowLogger FlowLoggerConfig `yaml:"flowLogger,omitempty"`
+	// Kafka contains configuration options for publishing flow records to a Kafka topic.
+	Kafka KafkaConfig `yaml:"kafka,omitempty"`
+	// OTLP contains configuration options for exporting flow records to an OpenTelemetry
+	// Protocol (OTLP) receiver.
+	OTLP OTLPConfig `yaml:"otlp,omitempty"`
+	// FlowMetrics contains configuration options for exposing Prometheus metrics derived from
+	// flow records.
+	FlowMetrics FlowMetricsConfig `yaml:"flowMetrics,omitempty"`
+	// FlowGraph contains configuration options for maintaining an in-memory graph of the
+	// traffic between workloads and Services, which can be queried through the API server.
+	FlowGraph FlowGraphConfig `yaml:"flowGraph,omitempty"`
+	// AnomalyDetection contains configuration options for detecting unusual traffic patterns
+	// in flow records, and reporting them as alerts.
+	AnomalyDetection AnomalyDetectionConfig `yaml:"anomalyDetection,omitempty"`
+	// DiskQueue contains configuration options for buffering flow records on disk between the
+	// aggregation process and the exporters.
+	DiskQueue DiskQueueConfig `yaml:"diskQueue,omitempty"`
 	// Provide a ClusterID to be added to records. By default this ID is an autogenerated UUID
 	// which can be found in the antrea-cluster-identity ConfigMap
 	ClusterID string `yaml:"clusterID,omitempty"`
@@ -195,18 +212,28 @@ type S3UploaderConfig struct {
 	// belongs to. If region is omitted, the value of the AWS_REGION environment variable will
 	// be used, and if it is missing, we will default to "us-west-2".
 	Region string `yaml:"region,omitempty"`
-	// RecordFormat defines the format of the flow records uploaded to S3. Only "CSV" is
-	// supported at the moment.
+	// RecordFormat defines the format of the flow records uploaded to S3. Supported formats
+	// are "CSV" and "Parquet". Defaults to "CSV".
 	RecordFormat string `yaml:"recordFormat,omitempty"`
-	// Compress enables gzip compression when uploading files to S3. Defaults to true.
+	// Compress enables gzip compression when uploading files to S3. Defaults to true. With the
+	// "Parquet" format, the data pages are compressed, rather than the whole file.
 	Compress *bool `yaml:"compress,omitempty"`
 	// MaxRecordsPerFile is the maximum number of records per file uploaded. It is not recommended
-	// to change this value. Defaults to 1,000,000.
+	// to change this value. Defaults to 1,000,000. With the "Parquet" format, each file has a
+	// single row group, so this is also the maximum number of records per row group.
 	MaxRecordsPerFile int32 `yaml:"maxRecordsPerFile,omitempty"`
 	// UploadInterval is the duration between each file upload to S3.
 	UploadInterval string `yaml:"uploadInterval,omitempty"`
+	// PartitionByTime enables time-based partitioning of the uploaded files: files are uploaded
+	// under "year=YYYY/month=MM/day=DD/hour=HH" (UTC) prefixes, under BucketPrefix.
+	PartitionByTime bool `yaml:"partitionByTime,omitempty"`
 }
 
+const (
+	S3RecordFormatCSV     = "CSV"
+	S3RecordFormatParquet = "Parquet"
+)
+
 type FlowLoggerConfig struct {
 	// Enable is the switch to enable writing flow records to a local log file.
 	Enable bool `yaml:"enable,omitempty"`
@@ -235,6 +262,306 @@ type FlowLoggerConfig struct {
 	PrettyPrint *bool `yaml:"prettyPrint,omitempty"`
 }
 
+type KafkaRecordFormat string
+
+const (
+	KafkaRecordFormatProtobuf KafkaRecordFormat = "Protobuf"
+	KafkaRecordFormatJSON     KafkaRecordFormat = "JSON"
+)
+
+type KafkaPartitionKey string
+
+const (
+	// With the ClusterID partition key, all the flow records exported by a given cluster are
+	// assigned to the same partition.
+	KafkaPartitionKeyClusterID KafkaPartitionKey = "ClusterID"
+	// With the Namespace partition key, flow records are assigned to partitions based on the
+	// cluster ID and on the Namespace of the source Pod (or of the destination Pod if the
+	// source is not a Pod).
+	KafkaPartitionKeyNamespace KafkaPartitionKey = "Namespace"
+)
+
+type KafkaSASLMechanism string
+
+const (
+	KafkaSASLMechanismPlain       KafkaSASLMechanism = "PLAIN"
+	KafkaSASLMechanismSCRAMSHA256 KafkaSASLMechanism = "SCRAM-SHA-256"
+	KafkaSASLMechanismSCRAMSHA512 KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+type KafkaConfig struct {
+	// Enable is the switch to enable publishing flow records to Kafka.
+	Enable bool `yaml:"enable,omitempty"`
+	// Brokers is the list of Kafka brokers used to bootstrap the connection to the cluster,
+	// with format <host>:<port>. At least one broker must be provided.
+	Brokers []string `yaml:"brokers,omitempty"`
+	// Topic is the name of the Kafka topic to which flow records are published. It must be
+	// provided.
+	Topic string `yaml:"topic,omitempty"`
+	// RecordFormat defines the encoding of the published flow records. Supported formats are
+	// "Protobuf" (records are serialized as antrea.io/antrea/pkg/apis/flow/v1alpha1.Flow
+	// messages) and "JSON". Defaults to "Protobuf".
+	RecordFormat KafkaRecordFormat `yaml:"recordFormat,omitempty"`
+	// PartitionKey determines the key of the published messages, which is used to select the
+	// partition. Supported values are "ClusterID" and "Namespace". Defaults to "ClusterID".
+	PartitionKey KafkaPartitionKey `yaml:"partitionKey,omitempty"`
+	// Compression is the compression codec used for message batches. Supported values are
+	// "none", "gzip", "snappy", "lz4" and "zstd". Defaults to "none".
+	Compression string `yaml:"compression,omitempty"`
+	// MaxBatchSize is the maximum number of flow records buffered by the Flow Aggregator before
+	// they are published. Buffered records are also published every time the Flow Aggregator
+	// flushes its exporters. Defaults to 1000.
+	MaxBatchSize int32 `yaml:"maxBatchSize,omitempty"`
+	// WriteTimeout is the maximum duration for publishing a batch of flow records. Defaults to
+	// "10s". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	WriteTimeout string `yaml:"writeTimeout,omitempty"`
+	// TLS configuration options, when using TLS to connect to the Kafka brokers.
+	TLS KafkaTLSConfig `yaml:"tls,omitempty"`
+	// SASL configuration options, when using SASL to authenticate to the Kafka brokers.
+	SASL KafkaSASLConfig `yaml:"sasl,omitempty"`
+}
+
+type KafkaTLSConfig struct {
+	// Enable TLS.
+	Enable bool `yaml:"enable,omitempty"`
+	// Name of the Secret containing the CA certificate used to authenticate the Kafka brokers.
+	// Default root CAs will be used if this field is empty. The Secret must be created in the
+	// Namespace in which the Flow Aggregator is deployed, and it must contain the ca.crt key.
+	CASecretName string `yaml:"caSecretName,omitempty"`
+	// ServerName is used to verify the hostname on the returned certificates. If this field is
+	// omitted, the hostname of each broker address will be used.
+	ServerName string `yaml:"serverName,omitempty"`
+	// Name of the Secret containing the client's certificate and private key for mTLS. If
+	// omitted, client authentication will be disabled. The Secret must be created in Namespace
+	// in which the Flow Aggregator is deployed, and it must be of type kubernetes.io/tls and
+	// contain the tls.crt and tls.key keys.
+	ClientSecretName string `yaml:"clientSecretName,omitempty"`
+	// TLS min version.
+	MinVersion string `yaml:"minVersion,omitempty"`
+	// InsecureSkipVerify determines whether to skip the verification of the brokers'
+	// certificate chain and host name. Default is false.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify,omitempty"`
+}
+
+type KafkaSASLConfig struct {
+	// Mechanism is the SASL mechanism used to authenticate to the Kafka brokers. Supported
+	// values are "PLAIN", "SCRAM-SHA-256" and "SCRAM-SHA-512". SASL is disabled if this field
+	// is empty. The username and password are read from the KAFKA_USERNAME and KAFKA_PASSWORD
+	// environment variables.
+	Mechanism KafkaSASLMechanism `yaml:"mechanism,omitempty"`
+}
+
+type OTLPProtocol string
+
+const (
+	OTLPProtocolGRPC OTLPProtocol = "gRPC"
+	OTLPProtocolHTTP OTLPProtocol = "HTTP"
+)
+
+type OTLPConfig struct {
+	// Enable is the switch to enable exporting flow records to an OTLP receiver (e.g., an
+	// OpenTelemetry Collector). Each flow record is exported as an OTLP log record.
+	Enable bool `yaml:"enable,omitempty"`
+	// Endpoint is the address of the OTLP receiver, with format <host>:<port>. It must be
+	// provided.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// Protocol is the transport protocol used to export flow records. Supported values are
+	// "gRPC" and "HTTP" (OTLP/HTTP with binary Protobuf encoding, records are sent to the
+	// /v1/logs path). Defaults to "gRPC".
+	Protocol OTLPProtocol `yaml:"protocol,omitempty"`
+	// Headers are additional headers (gRPC metadata for the gRPC protocol) sent with each
+	// export request, e.g. for authentication.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// Compression is the compression codec used for export requests. Supported values are
+	// "none" and "gzip". Defaults to "none".
+	Compression string `yaml:"compression,omitempty"`
+	// MaxBatchSize is the maximum number of flow records buffered by the Flow Aggregator before
+	// they are exported. Buffered records are also exported every time the Flow Aggregator
+	// flushes its exporters. Defaults to 1000.
+	MaxBatchSize int32 `yaml:"maxBatchSize,omitempty"`
+	// Timeout is the maximum duration for an export request. Defaults to "10s". Valid time
+	// units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	Timeout string `yaml:"timeout,omitempty"`
+	// TLS configuration options, when using TLS to connect to the OTLP receiver.
+	TLS OTLPTLSConfig `yaml:"tls,omitempty"`
+}
+
+type OTLPTLSConfig struct {
+	// Enable TLS.
+	Enable bool `yaml:"enable,omitempty"`
+	// Name of the Secret containing the CA certificate used to authenticate the OTLP receiver.
+	// Default root CAs will be used if this field is empty. The Secret must be created in the
+	// Namespace in which the Flow Aggregator is deployed, and it must contain the ca.crt key.
+	CASecretName string `yaml:"caSecretName,omitempty"`
+	// ServerName is used to verify the hostname on the returned certificates. If this field is
+	// omitted, the hostname of the endpoint will be used.
+	ServerName string `yaml:"serverName,omitempty"`
+	// Name of the Secret containing the client's certificate and private key for mTLS. If
+	// omitted, client authentication will be disabled. The Secret must be created in Namespace
+	// in which the Flow Aggregator is deployed, and it must be of type kubernetes.io/tls and
+	// contain the tls.crt and tls.key keys.
+	ClientSecretName string `yaml:"clientSecretName,omitempty"`
+	// TLS min version.
+	MinVersion string `yaml:"minVersion,omitempty"`
+	// InsecureSkipVerify determines whether to skip the verification of the receiver's
+	// certificate chain and host name. Default is false.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify,omitempty"`
+}
+
+type FlowMetricsLabel string
+
+const (
+	FlowMetricsLabelSourceNamespace        FlowMetricsLabel = "source_namespace"
+	FlowMetricsLabelSourceNode             FlowMetricsLabel = "source_node"
+	FlowMetricsLabelDestinationNamespace   FlowMetricsLabel = "destination_namespace"
+	FlowMetricsLabelDestinationNode        FlowMetricsLabel = "destination_node"
+	FlowMetricsLabelDestinationService     FlowMetricsLabel = "destination_service"
+	FlowMetricsLabelIngressPolicyType      FlowMetricsLabel = "ingress_policy_type"
+	FlowMetricsLabelIngressPolicyNamespace FlowMetricsLabel = "ingress_policy_namespace"
+	FlowMetricsLabelIngressPolicyName      FlowMetricsLabel = "ingress_policy_name"
+	FlowMetricsLabelIngressRuleAction      FlowMetricsLabel = "ingress_rule_action"
+	FlowMetricsLabelEgressPolicyType       FlowMetricsLabel = "egress_policy_type"
+	FlowMetricsLabelEgressPolicyNamespace  FlowMetricsLabel = "egress_policy_namespace"
+	FlowMetricsLabelEgressPolicyName       FlowMetricsLabel = "egress_policy_name"
+	FlowMetricsLabelEgressRuleAction       FlowMetricsLabel = "egress_rule_action"
+	FlowMetricsLabelEgressName             FlowMetricsLabel = "egress_name"
+	FlowMetricsLabelFlowType               FlowMetricsLabel = "flow_type"
+	FlowMetricsLabelProtocol               FlowMetricsLabel = "protocol"
+)
+
+// FlowMetricsLabels is the list of all the supported labels for flow metrics.
+var FlowMetricsLabels = []FlowMetricsLabel{
+	FlowMetricsLabelSourceNamespace,
+	FlowMetricsLabelSourceNode,
+	FlowMetricsLabelDestinationNamespace,
+	FlowMetricsLabelDestinationNode,
+	FlowMetricsLabelDestinationService,
+	FlowMetricsLabelIngressPolicyType,
+	FlowMetricsLabelIngressPolicyNamespace,
+	FlowMetricsLabelIngressPolicyName,
+	FlowMetricsLabelIngressRuleAction,
+	FlowMetricsLabelEgressPolicyType,
+	FlowMetricsLabelEgressPolicyNamespace,
+	FlowMetricsLabelEgressPolicyName,
+	FlowMetricsLabelEgressRuleAction,
+	FlowMetricsLabelEgressName,
+	FlowMetricsLabelFlowType,
+	FlowMetricsLabelProtocol,
+}
+
+type FlowMetricsConfig struct {
+	// Enable is the switch to enable exposing Prometheus metrics (traffic counters) derived
+	// from flow records on the Flow Aggregator metrics endpoint.
+	Enable bool `yaml:"enable,omitempty"`
+	// Labels is the set of labels used to aggregate flow records into metric series. Supported
+	// labels are "source_namespace", "source_node", "destination_namespace",
+	// "destination_node", "destination_service", "ingress_policy_type",
+	// "ingress_policy_namespace", "ingress_policy_name", "ingress_rule_action",
+	// "egress_policy_type", "egress_policy_namespace", "egress_policy_name",
+	// "egress_rule_action", "egress_name", "flow_type" and "protocol". Defaults to
+	// ["source_namespace", "destination_namespace", "destination_service"].
+	Labels []FlowMetricsLabel `yaml:"labels,omitempty"`
+	// MaxSeries is the maximum number of label value combinations tracked for flow metrics.
+	// Once the limit is reached, flow records with new label value combinations are accounted
+	// for in a single overflow series, in which all the labels are set to "__overflow__".
+	// Defaults to 10000.
+	MaxSeries int32 `yaml:"maxSeries,omitempty"`
+	// SeriesTTL is the duration after which a series which has not been updated is removed.
+	// Defaults to "1h". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h". A
+	// value of "0s" means that series are never removed.
+	SeriesTTL string `yaml:"seriesTTL,omitempty"`
+}
+
+type FlowGraphConfig struct {
+	// Enable is the switch to enable the flow graph, which can be retrieved with "antctl get
+	// flowgraph" or through the /flowgraph API endpoint of the Flow Aggregator.
+	Enable bool `yaml:"enable,omitempty"`
+	// Window is the maximum duration over which traffic is accounted for in the graph. Queries
+	// can request any shorter window. Defaults to "15m". Valid time units are "ns", "us" (or
+	// "µs"), "ms", "s", "m", "h".
+	Window string `yaml:"window,omitempty"`
+	// MaxEdges is the maximum number of edges in the graph. Once the limit is reached, flow
+	// records which would create a new edge are ignored until existing edges expire.
+	// Defaults to 10000.
+	MaxEdges int32 `yaml:"maxEdges,omitempty"`
+}
+
+type AnomalyDetectionConfig struct {
+	// Enable is the switch to enable anomaly detection. Flow records are accumulated per
+	// source Pod over fixed intervals, and the value observed by each detector for an
+	// interval is compared to a baseline learned from the previous intervals.
+	Enable bool `yaml:"enable,omitempty"`
+	// Interval is the duration of the intervals over which flow records are accumulated
+	// before being evaluated by the detectors. Defaults to "1m". Valid time units are "ns",
+	// "us" (or "µs"), "ms", "s", "m", "h".
+	Interval string `yaml:"interval,omitempty"`
+	// WarmupIntervals is the number of intervals during which the baseline of a Pod is
+	// learned before any alert can be raised for that Pod. Defaults to 10.
+	WarmupIntervals int32 `yaml:"warmupIntervals,omitempty"`
+	// Sensitivity is the number of standard deviations above the baseline mean at which a
+	// value is considered anomalous. Defaults to 3.
+	Sensitivity int32 `yaml:"sensitivity,omitempty"`
+	// MaxPods is the maximum number of Pods for which a baseline is maintained by each
+	// detector. Once the limit is reached, new Pods are ignored until existing Pods become
+	// inactive. Defaults to 10000.
+	MaxPods int32 `yaml:"maxPods,omitempty"`
+	// NewDestinations detects Pods which suddenly contact many destinations (IP, protocol and
+	// port) they have not contacted before, which is typical of scanning. MinValue defaults
+	// to 20 new destinations per interval.
+	NewDestinations AnomalyDetectorConfig `yaml:"newDestinations,omitempty"`
+	// ConnectionSpike detects Pods which suddenly open many more connections than usual.
+	// MinValue defaults to 100 new connections per interval.
+	ConnectionSpike AnomalyDetectorConfig `yaml:"connectionSpike,omitempty"`
+	// ExternalEgress detects Pods which suddenly send a large volume of traffic to
+	// destinations outside of the cluster. MinValue defaults to 104857600 bytes (100MiB) per
+	// interval.
+	ExternalEgress AnomalyDetectorConfig `yaml:"externalEgress,omitempty"`
+	// Events is the switch to emit a Kubernetes Event for the source Pod of each alert.
+	// Defaults to true.
+	Events *bool `yaml:"events,omitempty"`
+	// AlertLog contains configuration options for writing alerts to a dedicated local log
+	// file, with one JSON object per line.
+	AlertLog AnomalyAlertLogConfig `yaml:"alertLog,omitempty"`
+}
+
+type AnomalyDetectorConfig struct {
+	// Enable is the switch to enable the detector. Defaults to true.
+	Enable *bool `yaml:"enable,omitempty"`
+	// MinValue is the minimum value which must be observed for an interval before an alert
+	// can be raised, regardless of the baseline. It prevents alerts for Pods which are
+	// usually idle.
+	MinValue int64 `yaml:"minValue,omitempty"`
+}
+
+type AnomalyAlertLogConfig struct {
+	// Enable is the switch to enable writing alerts to a local log file. Defaults to true.
+	Enable *bool `yaml:"enable,omitempty"`
+	// Path is the path to the local log file. Defaults to "/tmp/antrea-flow-alerts.log".
+	Path string `yaml:"path,omitempty"`
+	// MaxSize is the maximum size in MB of a log file before it gets rotated. Defaults to
+	// 100.
+	MaxSize int32 `yaml:"maxSize,omitempty"`
+	// MaxBackups is the maximum number of old log files to retain. Defaults to 3.
+	MaxBackups int32 `yaml:"maxBackups,omitempty"`
+}
+
+type DiskQueueConfig struct {
+	// Enable is the switch to enable buffering flow records in a write-ahead queue on disk.
+	// When enabled, each exporter (flowCollector, clickHouse, s3Uploader, kafka and otlp) has
+	// its own queue: flow records are first appended to the queue, and are only removed from
+	// it once they have been exported successfully. When the destination is unavailable, flow
+	// records accumulate in the queue, and they are replayed once the destination is
+	// available again, including after a restart of the Flow Aggregator.
+	Enable bool `yaml:"enable,omitempty"`
+	// Path is the directory in which the queues are stored, with one sub-directory for each
+	// exporter. Defaults to "/var/lib/flow-aggregator/queue".
+	Path string `yaml:"path,omitempty"`
+	// MaxSize is the maximum size of the queue for each exporter, as a Kubernetes quantity
+	// (e.g., "512Mi"). When the limit is reached, the oldest flow records are dropped.
+	// Defaults to "1Gi".
+	MaxSize string `yaml:"maxSize,omitempty"`
+}
+
 type NetworkPolicyRuleAction string
 
 const (
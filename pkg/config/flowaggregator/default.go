@@ -41,7 +41,7 @@ const (
 	DefaultClickHouseDatabaseUrl    = "tcp://clickhouse-clickhouse.flow-visibility.svc:9000"
 
 	DefaultS3Region            = "us-west-2"
-	DefaultS3RecordFormat      = "CSV"
+	DefaultS3RecordFormat      = S3RecordFormatCSV
 	DefaultS3MaxRecordsPerFile = 1000000
 	DefaultS3UploadInterval    = "60s"
 	MinS3CommitInterval        = 1 * time.Second
@@ -49,8 +49,47 @@ const (
 	DefaultLoggerMaxSize      = 100
 	DefaultLoggerMaxBackups   = 3
 	DefaultLoggerRecordFormat = "CSV"
+
+	DefaultKafkaRecordFormat = KafkaRecordFormatProtobuf
+	DefaultKafkaPartitionKey = KafkaPartitionKeyClusterID
+	DefaultKafkaCompression  = "none"
+	DefaultKafkaMaxBatchSize = 1000
+	DefaultKafkaWriteTimeout = "10s"
+
+	DefaultOTLPProtocol     = OTLPProtocolGRPC
+	DefaultOTLPCompression  = "none"
+	DefaultOTLPMaxBatchSize = 1000
+	DefaultOTLPTimeout      = "10s"
+
+	DefaultFlowMetricsMaxSeries = 10000
+	DefaultFlowMetricsSeriesTTL = "1h"
+
+	DefaultFlowGraphWindow   = "15m"
+	DefaultFlowGraphMaxEdges = 10000
+
+	DefaultAnomalyDetectionInterval           = "1m"
+	DefaultAnomalyDetectionWarmupIntervals    = 10
+	DefaultAnomalyDetectionSensitivity        = 3
+	DefaultAnomalyDetectionMaxPods            = 10000
+	DefaultAnomalyDetectionNewDestinationsMin = 20
+	DefaultAnomalyDetectionConnectionSpikeMin = 100
+	DefaultAnomalyDetectionExternalEgressMin  = 100 << 20
+	DefaultAnomalyDetectionAlertLogPath       = "/tmp/antrea-flow-alerts.log"
+	DefaultAnomalyDetectionAlertLogMaxSize    = 100
+	DefaultAnomalyDetectionAlertLogMaxBackups = 3
+
+	DefaultDiskQueuePath    = "/var/lib/flow-aggregator/queue"
+	DefaultDiskQueueMaxSize = "1Gi"
+	MinDiskQueueMaxSize     = 1 << 20
 )
 
+// DefaultFlowMetricsLabels is the default set of labels for flow metrics.
+var DefaultFlowMetricsLabels = []FlowMetricsLabel{
+	FlowMetricsLabelSourceNamespace,
+	FlowMetricsLabelDestinationNamespace,
+	FlowMetricsLabelDestinationService,
+}
+
 func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.Mode == "" {
 		flowAggregatorConf.Mode = AggregatorModeAggregate
@@ -121,4 +160,95 @@ func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.FlowLogger.PrettyPrint == nil {
 		flowAggregatorConf.FlowLogger.PrettyPrint = ptr.To(true)
 	}
+	if flowAggregatorConf.Kafka.RecordFormat == "" {
+		flowAggregatorConf.Kafka.RecordFormat = DefaultKafkaRecordFormat
+	}
+	if flowAggregatorConf.Kafka.PartitionKey == "" {
+		flowAggregatorConf.Kafka.PartitionKey = DefaultKafkaPartitionKey
+	}
+	if flowAggregatorConf.Kafka.Compression == "" {
+		flowAggregatorConf.Kafka.Compression = DefaultKafkaCompression
+	}
+	if flowAggregatorConf.Kafka.MaxBatchSize == 0 {
+		flowAggregatorConf.Kafka.MaxBatchSize = DefaultKafkaMaxBatchSize
+	}
+	if flowAggregatorConf.Kafka.WriteTimeout == "" {
+		flowAggregatorConf.Kafka.WriteTimeout = DefaultKafkaWriteTimeout
+	}
+	if flowAggregatorConf.OTLP.Protocol == "" {
+		flowAggregatorConf.OTLP.Protocol = DefaultOTLPProtocol
+	}
+	if flowAggregatorConf.OTLP.Compression == "" {
+		flowAggregatorConf.OTLP.Compression = DefaultOTLPCompression
+	}
+	if flowAggregatorConf.OTLP.MaxBatchSize == 0 {
+		flowAggregatorConf.OTLP.MaxBatchSize = DefaultOTLPMaxBatchSize
+	}
+	if flowAggregatorConf.OTLP.Timeout == "" {
+		flowAggregatorConf.OTLP.Timeout = DefaultOTLPTimeout
+	}
+	if len(flowAggregatorConf.FlowMetrics.Labels) == 0 {
+		flowAggregatorConf.FlowMetrics.Labels = append([]FlowMetricsLabel{}, DefaultFlowMetricsLabels...)
+	}
+	if flowAggregatorConf.FlowMetrics.MaxSeries == 0 {
+		flowAggregatorConf.FlowMetrics.MaxSeries = DefaultFlowMetricsMaxSeries
+	}
+	if flowAggregatorConf.FlowMetrics.SeriesTTL == "" {
+		flowAggregatorConf.FlowMetrics.SeriesTTL = DefaultFlowMetricsSeriesTTL
+	}
+	if flowAggregatorConf.FlowGraph.Window == "" {
+		flowAggregatorConf.FlowGraph.Window = DefaultFlowGraphWindow
+	}
+	if flowAggregatorConf.FlowGraph.MaxEdges == 0 {
+		flowAggregatorConf.FlowGraph.MaxEdges = DefaultFlowGraphMaxEdges
+	}
+	setAnomalyDetectionDefaults(&flowAggregatorConf.AnomalyDetection)
+	if flowAggregatorConf.DiskQueue.Path == "" {
+		flowAggregatorConf.DiskQueue.Path = DefaultDiskQueuePath
+	}
+	if flowAggregatorConf.DiskQueue.MaxSize == "" {
+		flowAggregatorConf.DiskQueue.MaxSize = DefaultDiskQueueMaxSize
+	}
+}
+
+func setAnomalyDetectorDefaults(detectorConf *AnomalyDetectorConfig, minValue int64) {
+	if detectorConf.Enable == nil {
+		detectorConf.Enable = ptr.To(true)
+	}
+	if detectorConf.MinValue == 0 {
+		detectorConf.MinValue = minValue
+	}
+}
+
+func setAnomalyDetectionDefaults(conf *AnomalyDetectionConfig) {
+	if conf.Interval == "" {
+		conf.Interval = DefaultAnomalyDetectionInterval
+	}
+	if conf.WarmupIntervals == 0 {
+		conf.WarmupIntervals = DefaultAnomalyDetectionWarmupIntervals
+	}
+	if conf.Sensitivity == 0 {
+		conf.Sensitivity = DefaultAnomalyDetectionSensitivity
+	}
+	if conf.MaxPods == 0 {
+		conf.MaxPods = DefaultAnomalyDetectionMaxPods
+	}
+	setAnomalyDetectorDefaults(&conf.NewDestinations, DefaultAnomalyDetectionNewDestinationsMin)
+	setAnomalyDetectorDefaults(&conf.ConnectionSpike, DefaultAnomalyDetectionConnectionSpikeMin)
+	setAnomalyDetectorDefaults(&conf.ExternalEgress, DefaultAnomalyDetectionExternalEgressMin)
+	if conf.Events == nil {
+		conf.Events = ptr.To(true)
+	}
+	if conf.AlertLog.Enable == nil {
+		conf.AlertLog.Enable = ptr.To(true)
+	}
+	if conf.AlertLog.Path == "" {
+		conf.AlertLog.Path = DefaultAnomalyDetectionAlertLogPath
+	}
+	if conf.AlertLog.MaxSize == 0 {
+		conf.AlertLog.MaxSize = DefaultAnomalyDetectionAlertLogMaxSize
+	}
+	if conf.AlertLog.MaxBackups == 0 {
+		conf.AlertLog.MaxBackups = DefaultAnomalyDetectionAlertLogMaxBackups
+	}
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -76,8 +78,11 @@ type EgressController struct {
 
 	// ipAllocationMap is a map from Egress name to ipAllocation, which is used to check whether the Egress's IP has
 	// changed and to release the IP after the Egress is removed.
-	ipAllocationMap   map[string]*ipAllocation
-	ipAllocationMutex sync.RWMutex
+	ipAllocationMap map[string]*ipAllocation
+	// multiIPAllocationMap is a map from Egress name to ipAllocations for Egresses in ActiveActive mode, which may
+	// have an IP allocated from each of their ExternalIPPools.
+	multiIPAllocationMap map[string][]*ipAllocation
+	ipAllocationMutex    sync.RWMutex
 
 	egressInformer egressinformers.EgressInformer
 	egressLister   egresslisters.EgressLister
@@ -116,6 +121,7 @@ func NewEgressController(crdClient clientset.Interface,
 		groupingInterface:       groupingInterface,
 		groupingInterfaceSynced: groupingInterface.HasSynced,
 		ipAllocationMap:         map[string]*ipAllocation{},
+		multiIPAllocationMap:    map[string][]*ipAllocation{},
 		externalIPAllocator:     externalIPAllocator,
 	}
 	// Add handlers for Group events and Egress events.
@@ -173,7 +179,25 @@ func (c *EgressController) Run(stopCh <-chan struct{}) {
 // restoreIPAllocations restores the existing EgressIPs of Egresses and records the successful ones in ipAllocationMap.
 func (c *EgressController) restoreIPAllocations(egresses []*egressv1beta1.Egress) {
 	var previousIPAllocations []externalippool.IPAllocation
+	activeActiveEgresses := sets.New[string]()
 	for _, egress := range egresses {
+		if isActiveActiveEgress(egress) {
+			activeActiveEgresses.Insert(egress.Name)
+			for i, egressIP := range egress.Spec.EgressIPs {
+				if egressIP == "" || i >= len(egress.Spec.ExternalIPPools) {
+					continue
+				}
+				previousIPAllocations = append(previousIPAllocations, externalippool.IPAllocation{
+					ObjectReference: v1.ObjectReference{
+						Name: egress.Name,
+						Kind: egress.Kind,
+					},
+					IPPoolName: egress.Spec.ExternalIPPools[i],
+					IP:         net.ParseIP(egressIP),
+				})
+			}
+			continue
+		}
 		// Ignore Egress that is not associated to ExternalIPPool or doesn't have EgressIP assigned.
 		if egress.Spec.ExternalIPPool == "" || egress.Spec.EgressIP == "" {
 			continue
@@ -191,7 +215,11 @@ func (c *EgressController) restoreIPAllocations(egresses []*egressv1beta1.Egress
 	}
 	succeededAllocations := c.externalIPAllocator.RestoreIPAllocations(previousIPAllocations)
 	for _, alloc := range succeededAllocations {
-		c.setIPAllocation(alloc.ObjectReference.Name, alloc.IP, alloc.IPPoolName)
+		if activeActiveEgresses.Has(alloc.ObjectReference.Name) {
+			c.addIPAllocation(alloc.ObjectReference.Name, alloc.IP, alloc.IPPoolName)
+		} else {
+			c.setIPAllocation(alloc.ObjectReference.Name, alloc.IP, alloc.IPPoolName)
+		}
 		klog.InfoS("Restored EgressIP", "egress", alloc.ObjectReference.Name, "ip", alloc.IP, "pool", alloc.IPPoolName)
 	}
 }
@@ -245,8 +273,35 @@ func (c *EgressController) setIPAllocation(egressName string, ip net.IP, poolNam
 	}
 }
 
+func (c *EgressController) getIPAllocations(egressName string) []*ipAllocation {
+	c.ipAllocationMutex.RLock()
+	defer c.ipAllocationMutex.RUnlock()
+	return c.multiIPAllocationMap[egressName]
+}
+
+func (c *EgressController) setIPAllocations(egressName string, allocations []*ipAllocation) {
+	c.ipAllocationMutex.Lock()
+	defer c.ipAllocationMutex.Unlock()
+	if len(allocations) == 0 {
+		delete(c.multiIPAllocationMap, egressName)
+		return
+	}
+	c.multiIPAllocationMap[egressName] = allocations
+}
+
+func (c *EgressController) addIPAllocation(egressName string, ip net.IP, poolName string) {
+	c.ipAllocationMutex.Lock()
+	defer c.ipAllocationMutex.Unlock()
+	c.multiIPAllocationMap[egressName] = append(c.multiIPAllocationMap[egressName], &ipAllocation{
+		ip:     ip,
+		ipPool: poolName,
+	})
+}
+
 // syncEgressIP is responsible for releasing stale EgressIP and allocating new EgressIP for an Egress if applicable.
 func (c *EgressController) syncEgressIP(egress *egressv1beta1.Egress) (net.IP, *egressv1beta1.Egress, error) {
+	// Release the IPs allocated when the Egress was in ActiveActive mode.
+	c.releaseEgressIPs(egress.Name)
 	prevIP, prevIPPool, exists := c.getIPAllocation(egress.Name)
 	if exists {
 		// The EgressIP and the ExternalIPPool haven't changed.
@@ -315,6 +370,110 @@ func (c *EgressController) syncEgressIP(egress *egressv1beta1.Egress) (net.IP, *
 	return ip, egress, nil
 }
 
+// syncEgressIPs is responsible for releasing stale EgressIPs and allocating new EgressIPs for an Egress in ActiveActive
+// mode. Each ExternalIPPool in the spec provides one IP, which is stored in the EgressIPs entry with the same index.
+func (c *EgressController) syncEgressIPs(egress *egressv1beta1.Egress) (*egressv1beta1.Egress, error) {
+	// Release the IP allocated when the Egress was in ActiveStandby mode.
+	if prevIP, prevIPPool, exists := c.getIPAllocation(egress.Name); exists {
+		c.releaseEgressIP(egress.Name, prevIP, prevIPPool)
+	}
+	prevAllocations := c.getIPAllocations(egress.Name)
+	isPrevAllocation := func(ip net.IP, pool string) bool {
+		return slices.ContainsFunc(prevAllocations, func(allocation *ipAllocation) bool {
+			return allocation.ip.Equal(ip) && allocation.ipPool == pool
+		})
+	}
+
+	pools := egress.Spec.ExternalIPPools
+	egressIPs := make([]string, len(pools))
+	copy(egressIPs, egress.Spec.EgressIPs)
+	var allocations []*ipAllocation
+	var errs []error
+	// Keep the EgressIPs that are still valid for their ExternalIPPools.
+	for i, pool := range pools {
+		if egressIPs[i] == "" {
+			continue
+		}
+		ip := net.ParseIP(egressIPs[i])
+		if !c.externalIPAllocator.IPPoolHasIP(pool, ip) {
+			// The ExternalIPPool may no longer exist, or the IP is not in range. Reclaim the IP from the Egress API.
+			klog.InfoS("EgressIP is not part of ExternalIPPool, releasing it", "egress", klog.KObj(egress), "ip", ip, "pool", pool)
+			egressIPs[i] = ""
+			continue
+		}
+		// User specifies the Egress IP, try to allocate it. If it fails, the datapath may still work, we just don't
+		// track the IP allocation so deleting this Egress won't release the IP to the Pool.
+		if !isPrevAllocation(ip, pool) {
+			if err := c.externalIPAllocator.UpdateIPAllocation(pool, ip); err != nil {
+				errs = append(errs, fmt.Errorf("error when allocating IP %v for Egress %s from ExternalIPPool %s: %v", ip, egress.Name, pool, err))
+				continue
+			}
+		}
+		allocations = append(allocations, &ipAllocation{ip: ip, ipPool: pool})
+	}
+	// Release the previous allocations that are no longer used.
+	for _, prev := range prevAllocations {
+		if !slices.ContainsFunc(allocations, func(allocation *ipAllocation) bool {
+			return allocation.ip.Equal(prev.ip) && allocation.ipPool == prev.ipPool
+		}) {
+			c.releaseIP(egress.Name, prev.ip, prev.ipPool)
+		}
+	}
+	// Allocate IPs for the ExternalIPPools that don't have one.
+	var newAllocations []*ipAllocation
+	for i, pool := range pools {
+		if egressIPs[i] != "" {
+			continue
+		}
+		if !c.externalIPAllocator.IPPoolExists(pool) {
+			errs = append(errs, fmt.Errorf("ExternalIPPool %s does not exist", pool))
+			continue
+		}
+		ip, err := c.externalIPAllocator.AllocateIPFromPool(pool)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		egressIPs[i] = ip.String()
+		newAllocations = append(newAllocations, &ipAllocation{ip: ip, ipPool: pool})
+	}
+
+	if !slices.Equal(egressIPs, egress.Spec.EgressIPs) {
+		updatedEgress, err := c.updateEgressIPs(egress, egressIPs)
+		if err != nil {
+			for _, allocation := range newAllocations {
+				if rerr := c.externalIPAllocator.ReleaseIP(allocation.ipPool, allocation.ip); rerr != nil &&
+					rerr != externalippool.ErrExternalIPPoolNotFound {
+					klog.ErrorS(rerr, "Failed to release IP", "ip", allocation.ip, "pool", allocation.ipPool)
+				}
+			}
+			c.setIPAllocations(egress.Name, allocations)
+			return egress, err
+		}
+		egress = updatedEgress
+	}
+	for _, allocation := range newAllocations {
+		klog.InfoS("Allocated EgressIP", "egress", egress.Name, "ip", allocation.ip, "pool", allocation.ipPool)
+	}
+	c.setIPAllocations(egress.Name, append(allocations, newAllocations...))
+	return egress, utilerrors.NewAggregate(errs)
+}
+
+// updateEgressIPs updates the Egress's EgressIPs in Kubernetes API.
+func (c *EgressController) updateEgressIPs(egress *egressv1beta1.Egress, ips []string) (*egressv1beta1.Egress, error) {
+	patch := map[string]interface{}{
+		"spec": map[string][]string{
+			"egressIPs": ips,
+		},
+	}
+	patchBytes, _ := json.Marshal(patch)
+	if updatedEgress, err := c.crdClient.CrdV1beta1().Egresses().Patch(context.TODO(), egress.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return nil, fmt.Errorf("error when updating EgressIPs for Egress %s: %v", egress.Name, err)
+	} else {
+		return updatedEgress, nil
+	}
+}
+
 // updateEgressIP updates the Egress's EgressIP in Kubernetes API.
 func (c *EgressController) updateEgressIP(egress *egressv1beta1.Egress, ip string) (*egressv1beta1.Egress, error) {
 	var egressIPPtr *string
@@ -336,6 +495,21 @@ func (c *EgressController) updateEgressIP(egress *egressv1beta1.Egress, ip strin
 
 // releaseEgressIP removes the Egress's ipAllocation in the cache and releases the IP to the pool.
 func (c *EgressController) releaseEgressIP(egressName string, egressIP net.IP, poolName string) {
+	c.releaseIP(egressName, egressIP, poolName)
+	c.deleteIPAllocation(egressName)
+}
+
+// releaseEgressIPs removes the ipAllocations of an Egress in ActiveActive mode in the cache and releases the IPs to
+// the pools.
+func (c *EgressController) releaseEgressIPs(egressName string) {
+	for _, allocation := range c.getIPAllocations(egressName) {
+		c.releaseIP(egressName, allocation.ip, allocation.ipPool)
+	}
+	c.setIPAllocations(egressName, nil)
+}
+
+// releaseIP releases an IP allocated for the Egress to the pool.
+func (c *EgressController) releaseIP(egressName string, egressIP net.IP, poolName string) {
 	if err := c.externalIPAllocator.ReleaseIP(poolName, egressIP); err != nil {
 		if err == externalippool.ErrExternalIPPoolNotFound {
 			// Ignore the error since the external IP Pool could be deleted.
@@ -344,14 +518,12 @@ func (c *EgressController) releaseEgressIP(egressName string, egressIP net.IP, p
 			// It is possible for the external IP Pool to have been deleted and
 			// recreated immediately with a different range, which would trigger this
 			// case. Transient errors in ReleaseIP are not possible, so there is no
-			// point in retrying. We should still delete our own state by calling
-			// deleteIPAllocation.
+			// point in retrying. The callers should still delete their own state.
 			klog.ErrorS(err, "Failed to release IP", "ip", egressIP, "pool", poolName)
 		}
 	} else {
 		klog.InfoS("Released EgressIP", "egress", egressName, "ip", egressIP, "pool", poolName)
 	}
-	c.deleteIPAllocation(egressName)
 }
 
 func (c *EgressController) syncEgress(key string) error {
@@ -367,10 +539,15 @@ func (c *EgressController) syncEgress(key string) error {
 		if prevIP, prevIPPool, exists := c.getIPAllocation(key); exists {
 			c.releaseEgressIP(key, prevIP, prevIPPool)
 		}
+		c.releaseEgressIPs(key)
 		return nil
 	}
 
-	_, egress, err = c.syncEgressIP(egress)
+	if isActiveActiveEgress(egress) {
+		egress, err = c.syncEgressIPs(egress)
+	} else {
+		_, egress, err = c.syncEgressIP(egress)
+	}
 	c.updateEgressAllocatedCondition(egress, err)
 	if err != nil {
 		return err
@@ -478,7 +655,7 @@ func (c *EgressController) enqueueEgresses(poolName string) {
 
 func (c *EgressController) updateEgressAllocatedCondition(egress *egressv1beta1.Egress, err error) {
 	var desiredCondition *egressv1beta1.EgressCondition
-	if egress.Spec.ExternalIPPool != "" {
+	if egress.Spec.ExternalIPPool != "" || len(egress.Spec.ExternalIPPools) > 0 {
 		if err == nil {
 			desiredCondition = &egressv1beta1.EgressCondition{
 				Type:               egressv1beta1.IPAllocated,
@@ -527,6 +704,12 @@ func (c *EgressController) updateEgressAllocatedCondition(egress *egressv1beta1.
 	}
 }
 
+// isActiveActiveEgress returns whether the Egress IPs of the Egress are allocated from multiple ExternalIPPools and
+// held by multiple Nodes at the same time.
+func isActiveActiveEgress(egress *egressv1beta1.Egress) bool {
+	return egress.Spec.Mode == egressv1beta1.EgressModeActiveActive
+}
+
 // compareConditionIgnoringTimestamp compares two conditions ignoring the timestamp
 func compareConditionIgnoringTimestamp(condition1, condition2 *egressv1beta1.EgressCondition) bool {
 	if condition1 == nil && condition2 == nil {
@@ -84,6 +84,17 @@ func newEgress(name, egressIP, externalIPPool string, podSelector, namespaceSele
 	return egress
 }
 
+func newActiveActiveEgress(name string, egressIPs, externalIPPools []string, mode v1beta1.EgressMode) *v1beta1.Egress {
+	return &v1beta1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.EgressSpec{
+			EgressIPs:       egressIPs,
+			ExternalIPPools: externalIPPools,
+			Mode:            mode,
+		},
+	}
+}
+
 func newExternalIPPool(name, cidr, start, end string) *v1beta1.ExternalIPPool {
 	pool := &v1beta1.ExternalIPPool{
 		ObjectMeta: metav1.ObjectMeta{
@@ -815,6 +826,136 @@ func TestSyncEgressIP(t *testing.T) {
 	}
 }
 
+func TestSyncEgressIPs(t *testing.T) {
+	tests := []struct {
+		name                        string
+		existingEgresses            []*v1beta1.Egress
+		inputEgress                 *v1beta1.Egress
+		expectedEgressIPs           []string
+		expectedExternalIPPoolsUsed map[string]int
+		expectErr                   bool
+	}{
+		{
+			name: "Egress with empty EgressIPs",
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1beta1.EgressSpec{
+					ExternalIPPools: []string{"ipPoolA", "ipPoolB"},
+					Mode:            v1beta1.EgressModeActiveActive,
+				},
+			},
+			expectedEgressIPs:           []string{"1.1.1.1", "1.1.2.10"},
+			expectedExternalIPPoolsUsed: map[string]int{"ipPoolA": 1, "ipPoolB": 1},
+		},
+		{
+			name: "Egress with partial EgressIPs",
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1beta1.EgressSpec{
+					EgressIPs:       []string{"", "1.1.2.15"},
+					ExternalIPPools: []string{"ipPoolA", "ipPoolB"},
+					Mode:            v1beta1.EgressModeActiveActive,
+				},
+			},
+			expectedEgressIPs:           []string{"1.1.1.1", "1.1.2.15"},
+			expectedExternalIPPoolsUsed: map[string]int{"ipPoolA": 1, "ipPoolB": 1},
+		},
+		{
+			name: "Egress with restored EgressIPs",
+			existingEgresses: []*v1beta1.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec: v1beta1.EgressSpec{
+						EgressIPs:       []string{"1.1.1.2", "1.1.2.11"},
+						ExternalIPPools: []string{"ipPoolA", "ipPoolB"},
+						Mode:            v1beta1.EgressModeActiveActive,
+					},
+				},
+			},
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1beta1.EgressSpec{
+					EgressIPs:       []string{"1.1.1.2", "1.1.2.11"},
+					ExternalIPPools: []string{"ipPoolA", "ipPoolB"},
+					Mode:            v1beta1.EgressModeActiveActive,
+				},
+			},
+			expectedEgressIPs:           []string{"1.1.1.2", "1.1.2.11"},
+			expectedExternalIPPoolsUsed: map[string]int{"ipPoolA": 1, "ipPoolB": 1},
+		},
+		{
+			name: "Egress with ExternalIPPool removed",
+			existingEgresses: []*v1beta1.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec: v1beta1.EgressSpec{
+						EgressIPs:       []string{"1.1.1.2", "1.1.2.11"},
+						ExternalIPPools: []string{"ipPoolA", "ipPoolB"},
+						Mode:            v1beta1.EgressModeActiveActive,
+					},
+				},
+			},
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1beta1.EgressSpec{
+					EgressIPs:       []string{"1.1.2.11"},
+					ExternalIPPools: []string{"ipPoolB"},
+					Mode:            v1beta1.EgressModeActiveActive,
+				},
+			},
+			expectedEgressIPs:           []string{"1.1.2.11"},
+			expectedExternalIPPoolsUsed: map[string]int{"ipPoolA": 0, "ipPoolB": 1},
+		},
+		{
+			name: "Egress with non-existing ExternalIPPool",
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1beta1.EgressSpec{
+					ExternalIPPools: []string{"ipPoolA", "ipPoolC"},
+					Mode:            v1beta1.EgressModeActiveActive,
+				},
+			},
+			expectedEgressIPs:           []string{"1.1.1.1", ""},
+			expectedExternalIPPoolsUsed: map[string]int{"ipPoolA": 1, "ipPoolB": 0},
+			expectErr:                   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			fakeObjects := []runtime.Object{
+				tt.inputEgress,
+				newExternalIPPool("ipPoolA", "1.1.1.0/30", "", ""),
+				newExternalIPPool("ipPoolB", "", "1.1.2.10", "1.1.2.20"),
+			}
+			controller := newController(nil, fakeObjects)
+			controller.informerFactory.Start(stopCh)
+			controller.crdInformerFactory.Start(stopCh)
+			controller.informerFactory.WaitForCacheSync(stopCh)
+			controller.crdInformerFactory.WaitForCacheSync(stopCh)
+			go controller.externalIPAllocator.Run(stopCh)
+			require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+			controller.restoreIPAllocations(tt.existingEgresses)
+			gotEgress, err := controller.syncEgressIPs(tt.inputEgress)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedEgressIPs, gotEgress.Spec.EgressIPs)
+			for pool, used := range tt.expectedExternalIPPoolsUsed {
+				checkExternalIPPoolUsed(t, controller, pool, used)
+			}
+			// Deleting the Egress should release all of its IPs.
+			controller.releaseEgressIPs(tt.inputEgress.Name)
+			for pool := range tt.expectedExternalIPPoolsUsed {
+				checkExternalIPPoolUsed(t, controller, pool, 0)
+			}
+		})
+	}
+}
+
 func checkExternalIPPoolUsed(t *testing.T, controller *egressController, poolName string, used int) {
 	exists := controller.externalIPAllocator.IPPoolExists(poolName)
 	require.True(t, exists)
@@ -22,6 +22,7 @@ import (
 	admv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -48,11 +49,17 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 	}
 
 	shouldAllow := func(oldEgress, newEgress *crdv1beta1.Egress) (bool, string) {
+		if allowed, msg := validateEgressDestinations(newEgress.Spec.Destinations); !allowed {
+			return allowed, msg
+		}
+		if newEgress.Spec.Mode == crdv1beta1.EgressModeActiveActive {
+			return c.validateActiveActiveEgress(oldEgress, newEgress)
+		}
 		if len(newEgress.Spec.EgressIPs) > 0 {
-			return false, "spec.egressIPs is not supported yet"
+			return false, "spec.egressIPs is only supported in ActiveActive mode"
 		}
 		if len(newEgress.Spec.ExternalIPPools) > 0 {
-			return false, "spec.externalIPPools is not supported yet"
+			return false, "spec.externalIPPools is only supported in ActiveActive mode"
 		}
 		// Validate Egress trafficShaping
 		if newEgress.Spec.Bandwidth != nil {
@@ -110,6 +117,81 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 	}
 }
 
+// validateActiveActiveEgress validates an Egress in ActiveActive mode. Each Egress IP must be allocated from the
+// ExternalIPPool with the same index, and all Egress IPs must be of the same IP family as they are load balanced by a
+// single OpenFlow group.
+func (c *EgressController) validateActiveActiveEgress(oldEgress, newEgress *crdv1beta1.Egress) (bool, string) {
+	spec := &newEgress.Spec
+	if spec.EgressIP != "" || spec.ExternalIPPool != "" {
+		return false, "spec.egressIP and spec.externalIPPool are not supported in ActiveActive mode, use spec.egressIPs and spec.externalIPPools instead"
+	}
+	if len(spec.ExternalIPPools) == 0 {
+		return false, "spec.externalIPPools must be set in ActiveActive mode"
+	}
+	if len(spec.EgressIPs) > len(spec.ExternalIPPools) {
+		return false, "spec.egressIPs must not have more entries than spec.externalIPPools"
+	}
+	if spec.Bandwidth != nil {
+		return false, "spec.bandwidth is not supported in ActiveActive mode"
+	}
+	pools := sets.New[string]()
+	for _, pool := range spec.ExternalIPPools {
+		if pool == "" {
+			return false, "spec.externalIPPools must not contain empty entries"
+		}
+		if pools.Has(pool) {
+			return false, fmt.Sprintf("ExternalIPPool %s is specified more than once", pool)
+		}
+		pools.Insert(pool)
+	}
+	var isIPv4 *bool
+	for i, egressIP := range spec.EgressIPs {
+		if egressIP == "" {
+			continue
+		}
+		ip := net.ParseIP(egressIP)
+		if ip == nil {
+			return false, fmt.Sprintf("IP %s is not valid", egressIP)
+		}
+		ipv4 := ip.To4() != nil
+		if isIPv4 == nil {
+			isIPv4 = &ipv4
+		} else if *isIPv4 != ipv4 {
+			return false, "spec.egressIPs must be of the same IP family"
+		}
+		pool := spec.ExternalIPPools[i]
+		// Skip the pool check if neither the IP nor its pool changes.
+		if i < len(oldEgress.Spec.EgressIPs) && i < len(oldEgress.Spec.ExternalIPPools) &&
+			oldEgress.Spec.EgressIPs[i] == egressIP && oldEgress.Spec.ExternalIPPools[i] == pool {
+			continue
+		}
+		if !c.externalIPAllocator.IPPoolExists(pool) {
+			return false, fmt.Sprintf("ExternalIPPool %s does not exist", pool)
+		}
+		if !c.externalIPAllocator.IPPoolHasIP(pool, ip) {
+			return false, fmt.Sprintf("IP %s is not within the IP range of ExternalIPPool %s", egressIP, pool)
+		}
+	}
+	return true, ""
+}
+
+// validateEgressDestinations validates the destinations of an Egress. The CIDRs must be valid and not empty if
+// destinations is set, as an empty destinations would make the Egress select no traffic.
+func validateEgressDestinations(destinations *crdv1beta1.EgressDestinations) (bool, string) {
+	if destinations == nil {
+		return true, ""
+	}
+	if len(destinations.CIDRs) == 0 {
+		return false, "spec.destinations.cidrs must not be empty if spec.destinations is set"
+	}
+	for _, cidr := range destinations.CIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return false, fmt.Sprintf("CIDR %s in spec.destinations.cidrs is not valid", cidr)
+		}
+	}
+	return true, ""
+}
+
 func newAdmissionResponseForErr(err error) *admv1.AdmissionResponse {
 	return &admv1.AdmissionResponse{
 		Result: &metav1.Status{
@@ -190,6 +190,149 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Creating an Egress with EgressIPs in ActiveStandby mode should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", []string{"10.10.10.1"}, []string{"bar"}, ""))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.egressIPs is only supported in ActiveActive mode",
+				},
+			},
+		},
+		{
+			name:                   "Creating an ActiveActive Egress with EgressIPs in the pools should be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", []string{"10.10.10.1"}, []string{"bar"}, crdv1beta1.EgressModeActiveActive))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Creating an ActiveActive Egress without EgressIPs should be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", nil, []string{"bar", "baz"}, crdv1beta1.EgressModeActiveActive))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name:                   "Creating an ActiveActive Egress with EgressIP out of range should not be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", []string{"10.10.11.1"}, []string{"bar"}, crdv1beta1.EgressModeActiveActive))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.11.1 is not within the IP range of ExternalIPPool bar",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveActive Egress with duplicate ExternalIPPools should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", nil, []string{"bar", "bar"}, crdv1beta1.EgressModeActiveActive))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "ExternalIPPool bar is specified more than once",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveActive Egress with EgressIPs of different IP families should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newActiveActiveEgress("foo", []string{"10.10.10.1", "2021::1"}, []string{"bar", "baz"}, crdv1beta1.EgressModeActiveActive))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.egressIPs must be of the same IP family",
+				},
+			},
+		},
+		{
+			name: "Creating an ActiveActive Egress with ExternalIPPool should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newEgress("foo", "", "bar", nil, nil, nil)
+					egress.Spec.Mode = crdv1beta1.EgressModeActiveActive
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.egressIP and spec.externalIPPool are not supported in ActiveActive mode, use spec.egressIPs and spec.externalIPPools instead",
+				},
+			},
+		},
+		{
+			name: "Creating an Egress with destination CIDRs should be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil, nil)
+					egress.Spec.Destinations = &crdv1beta1.EgressDestinations{CIDRs: []string{"10.20.0.0/16", "2021:20::/64"}}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Creating an Egress with empty destination CIDRs should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil, nil)
+					egress.Spec.Destinations = &crdv1beta1.EgressDestinations{CIDRs: nil}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "spec.destinations.cidrs must not be empty if spec.destinations is set",
+				},
+			},
+		},
+		{
+			name: "Creating an Egress with invalid destination CIDR should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1beta1.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil, nil)
+					egress.Spec.Destinations = &crdv1beta1.EgressDestinations{CIDRs: []string{"10.20.0.0"}}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "CIDR 10.20.0.0 in spec.destinations.cidrs is not valid",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
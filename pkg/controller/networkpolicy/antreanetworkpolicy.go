@@ -94,8 +94,13 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 	// Create AppliedToGroup for each AppliedTo present in AntreaNetworkPolicy spec.
 	atgs := n.processAppliedTo(np.Namespace, np.Spec.AppliedTo)
 	appliedToGroups = mergeAppliedToGroups(appliedToGroups, atgs...)
+	now := n.clock.Now()
 	// Compute NetworkPolicyRule for Ingress Rule.
 	for idx, ingressRule := range np.Spec.Ingress {
+		// Rules outside of their scheduled time window are not realized.
+		if !isRuleScheduleActive(ingressRule.Schedule, now) {
+			continue
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(ingressRule.Ports, ingressRule.Protocols)
 		// Create AppliedToGroup for each AppliedTo present in the ingress rule.
@@ -117,10 +122,15 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(ingressRule.L7Protocols),
 			LogLabel:        ingressRule.LogLabel,
+			RateLimit:       toAntreaRateLimitForCRD(ingressRule.RateLimit),
+			LogSampling:     toAntreaLogSamplingForCRD(ingressRule.LogSampling),
 		})
 	}
 	// Compute NetworkPolicyRule for Egress Rule.
 	for idx, egressRule := range np.Spec.Egress {
+		if !isRuleScheduleActive(egressRule.Schedule, now) {
+			continue
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(egressRule.Ports, egressRule.Protocols)
 		// Create AppliedToGroup for each AppliedTo present in the egress rule.
@@ -149,9 +159,12 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(egressRule.L7Protocols),
 			LogLabel:        egressRule.LogLabel,
+			RateLimit:       toAntreaRateLimitForCRD(egressRule.RateLimit),
+			LogSampling:     toAntreaLogSamplingForCRD(egressRule.LogSampling),
 		})
 	}
 	tierPriority := n.getTierPriority(np.Spec.Tier)
+	activeScheduledRules, inactiveScheduledRules := getScheduledRuleNames(now, np.Spec.Ingress, np.Spec.Egress)
 	internalNetworkPolicy := &antreatypes.NetworkPolicy{
 		SourceRef: &controlplane.NetworkPolicyReference{
 			Type:      controlplane.AntreaNetworkPolicy,
@@ -159,14 +172,17 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			Name:      np.Name,
 			UID:       np.UID,
 		},
-		Name:             internalNetworkPolicyKeyFunc(np),
-		UID:              np.UID,
-		Generation:       np.Generation,
-		AppliedToGroups:  sets.List(sets.KeySet(appliedToGroups)),
-		Rules:            rules,
-		Priority:         &np.Spec.Priority,
-		TierPriority:     &tierPriority,
-		AppliedToPerRule: appliedToPerRule,
+		Name:                   internalNetworkPolicyKeyFunc(np),
+		UID:                    np.UID,
+		Generation:             np.Generation,
+		AppliedToGroups:        sets.List(sets.KeySet(appliedToGroups)),
+		Rules:                  rules,
+		Priority:               &np.Spec.Priority,
+		TierPriority:           &tierPriority,
+		AppliedToPerRule:       appliedToPerRule,
+		EnforcementMode:        np.Spec.EnforcementMode,
+		ActiveScheduledRules:   activeScheduledRules,
+		InactiveScheduledRules: inactiveScheduledRules,
 	}
 	if n.stretchNPEnabled {
 		n.labelIdentityInterface.RemoveStalePolicySelectors(clusterSetScopeSelectorKeys, internalNetworkPolicyKeyFunc(np))
@@ -55,6 +55,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 		},
 	}
 	allowAction := crdv1beta1.RuleActionAllow
+	dropAction := crdv1beta1.RuleActionDrop
 	protocolTCP := controlplane.ProtocolTCP
 	tests := []struct {
 		name                    string
@@ -152,6 +153,55 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 			expectedAppliedToGroups: 1,
 			expectedAddressGroups:   1,
 		},
+		{
+			name: "audit-enforcement-mode",
+			inputPolicy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "npAudit", UID: "uidAudit"},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{PodSelector: &selectorA},
+					},
+					Priority:        p10,
+					EnforcementMode: crdv1beta1.EnforcementModeAudit,
+					Ingress: []crdv1beta1.Rule{
+						{
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									PodSelector: &selectorB,
+								},
+							},
+							Action: &dropAction,
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidAudit",
+				Name: "uidAudit",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type:      controlplane.AntreaNetworkPolicy,
+					Namespace: "ns1",
+					Name:      "npAudit",
+					UID:       "uidAudit",
+				},
+				Priority:        &p10,
+				TierPriority:    ptr.To(crdv1beta1.DefaultTierPriority),
+				EnforcementMode: crdv1beta1.EnforcementModeAudit,
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns1", &selectorB, nil, nil, nil).NormalizedName)},
+						},
+						Priority: 0,
+						Action:   &dropAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns1", &selectorA, nil, nil, nil).NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
 		{
 			name: "rules-with-different-selectors",
 			inputPolicy: &crdv1beta1.NetworkPolicy{
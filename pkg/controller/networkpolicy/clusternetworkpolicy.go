@@ -400,9 +400,14 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 		}
 	}
 	var rules []controlplane.NetworkPolicyRule
+	now := n.clock.Now()
 	processRules := func(cnpRules []crdv1beta1.Rule, direction controlplane.Direction) {
 		for idx := range cnpRules {
 			cnpRule := &cnpRules[idx]
+			// Rules outside of their scheduled time window are not realized.
+			if !isRuleScheduleActive(cnpRule.Schedule, now) {
+				continue
+			}
 			services, namedPortExists := toAntreaServicesForCRD(cnpRule.Ports, cnpRule.Protocols)
 			clusterPeers, perNSPeers, nsLabelPeers := splitPeersByScope(cnpRule, direction)
 			priority := int32(idx)
@@ -417,6 +422,8 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 					AppliedToGroups: getAppliedToGroupNames(ruleAppliedTos),
 					L7Protocols:     toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols),
 					LogLabel:        cnpRule.LogLabel,
+					RateLimit:       toAntreaRateLimitForCRD(cnpRule.RateLimit),
+					LogSampling:     toAntreaLogSamplingForCRD(cnpRule.LogSampling),
 				}
 				switch dir {
 				case controlplane.DirectionIn:
@@ -528,6 +535,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 		appliedToGroups = mergeAppliedToGroups(appliedToGroups, n.processClusterAppliedTo(cnp.Spec.AppliedTo)...)
 	}
 	tierPriority := n.getTierPriority(cnp.Spec.Tier)
+	activeScheduledRules, inactiveScheduledRules := getScheduledRuleNames(now, cnp.Spec.Ingress, cnp.Spec.Egress)
 	internalNetworkPolicy := &antreatypes.NetworkPolicy{
 		Name:       internalNetworkPolicyKeyFunc(cnp),
 		Generation: cnp.Generation,
@@ -536,12 +544,15 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 			Name: cnp.Name,
 			UID:  cnp.UID,
 		},
-		UID:              cnp.UID,
-		AppliedToGroups:  sets.List(sets.KeySet(appliedToGroups)),
-		Rules:            rules,
-		Priority:         &cnp.Spec.Priority,
-		TierPriority:     &tierPriority,
-		AppliedToPerRule: appliedToPerRule,
+		UID:                    cnp.UID,
+		AppliedToGroups:        sets.List(sets.KeySet(appliedToGroups)),
+		Rules:                  rules,
+		Priority:               &cnp.Spec.Priority,
+		TierPriority:           &tierPriority,
+		AppliedToPerRule:       appliedToPerRule,
+		EnforcementMode:        cnp.Spec.EnforcementMode,
+		ActiveScheduledRules:   activeScheduledRules,
+		InactiveScheduledRules: inactiveScheduledRules,
 	}
 	if n.stretchNPEnabled {
 		n.labelIdentityInterface.RemoveStalePolicySelectors(clusterSetScopeSelectorKeys, internalNetworkPolicyKeyFunc(cnp))
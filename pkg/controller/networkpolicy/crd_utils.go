@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/types"
@@ -119,13 +120,70 @@ func toAntreaL7ProtocolsForCRD(l7Protocols []crdv1beta1.L7Protocol) []controlpla
 	var antreaL7Protocols []controlplane.L7Protocol
 	for _, l7p := range l7Protocols {
 		antreaL7Protocols = append(antreaL7Protocols, controlplane.L7Protocol{
-			HTTP: (*controlplane.HTTPProtocol)(l7p.HTTP),
+			HTTP: toAntreaHTTPProtocolForCRD(l7p.HTTP),
 			TLS:  (*controlplane.TLSProtocol)(l7p.TLS),
+			GRPC: (*controlplane.GRPCProtocol)(l7p.GRPC),
+			DNS:  (*controlplane.DNSProtocol)(l7p.DNS),
 		})
 	}
 	return antreaL7Protocols
 }
 
+// toAntreaHTTPProtocolForCRD converts a v1beta1.HTTPProtocol object to an
+// Antrea HTTPProtocol object.
+func toAntreaHTTPProtocolForCRD(http *crdv1beta1.HTTPProtocol) *controlplane.HTTPProtocol {
+	if http == nil {
+		return nil
+	}
+	antreaHTTP := &controlplane.HTTPProtocol{
+		Host:   http.Host,
+		Method: http.Method,
+		Path:   http.Path,
+	}
+	for _, header := range http.Headers {
+		antreaHTTP.Headers = append(antreaHTTP.Headers, controlplane.HTTPHeaderMatcher(header))
+	}
+	return antreaHTTP
+}
+
+// toAntreaRateLimitForCRD converts a v1beta1.RateLimit object to an Antrea
+// RateLimit object, in which the rate and burst are integers. The burst
+// defaults to the rate. The quantities must have been validated already.
+func toAntreaRateLimitForCRD(rateLimit *crdv1beta1.RateLimit) *controlplane.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	rate, err := resource.ParseQuantity(rateLimit.Rate)
+	if err != nil {
+		klog.ErrorS(err, "Invalid rate in RateLimit", "rate", rateLimit.Rate)
+		return nil
+	}
+	burst := rate
+	if rateLimit.Burst != "" {
+		if burst, err = resource.ParseQuantity(rateLimit.Burst); err != nil {
+			klog.ErrorS(err, "Invalid burst in RateLimit", "burst", rateLimit.Burst)
+			return nil
+		}
+	}
+	return &controlplane.RateLimit{
+		Unit:  rateLimit.Unit,
+		Rate:  rate.Value(),
+		Burst: burst.Value(),
+	}
+}
+
+// toAntreaLogSamplingForCRD converts a v1beta1.LogSampling object to an Antrea
+// LogSampling object.
+func toAntreaLogSamplingForCRD(logSampling *crdv1beta1.LogSampling) *controlplane.LogSampling {
+	if logSampling == nil {
+		return nil
+	}
+	return &controlplane.LogSampling{
+		Ratio:        logSampling.Ratio,
+		MaxPerSecond: logSampling.MaxPerSecond,
+	}
+}
+
 // toAntreaIPBlockForCRD converts a crdv1beta1.IPBlock to an Antrea IPBlock.
 func toAntreaIPBlockForCRD(ipBlock *crdv1beta1.IPBlock) (*controlplane.IPBlock, error) {
 	// Convert the allowed IPBlock to networkpolicy.IPNet.
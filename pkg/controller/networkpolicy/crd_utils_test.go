@@ -226,6 +226,30 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 				{TLS: &controlplane.TLSProtocol{SNI: "test.com"}},
 			},
 		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{HTTP: &crdv1beta1.HTTPProtocol{Path: "/admin", Headers: []crdv1beta1.HTTPHeaderMatcher{{Name: "X-Env", Value: "prod"}, {Name: "Authorization"}}}},
+			},
+			[]controlplane.L7Protocol{
+				{HTTP: &controlplane.HTTPProtocol{Path: "/admin", Headers: []controlplane.HTTPHeaderMatcher{{Name: "X-Env", Value: "prod"}, {Name: "Authorization"}}}},
+			},
+		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{GRPC: &crdv1beta1.GRPCProtocol{Service: "helloworld.Greeter", Method: "SayHello"}},
+			},
+			[]controlplane.L7Protocol{
+				{GRPC: &controlplane.GRPCProtocol{Service: "helloworld.Greeter", Method: "SayHello"}},
+			},
+		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{DNS: &crdv1beta1.DNSProtocol{QueryName: "*.foo.com", QueryTypes: []string{"A", "AAAA"}}},
+			},
+			[]controlplane.L7Protocol{
+				{DNS: &controlplane.DNSProtocol{QueryName: "*.foo.com", QueryTypes: []string{"A", "AAAA"}}},
+			},
+		},
 	}
 	for _, table := range tables {
 		gotValue := toAntreaL7ProtocolsForCRD(table.l7Protocol)
@@ -233,6 +257,40 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 	}
 }
 
+func TestToAntreaRateLimitForCRD(t *testing.T) {
+	tables := []struct {
+		rateLimit *crdv1beta1.RateLimit
+		expValue  *controlplane.RateLimit
+	}{
+		{
+			nil,
+			nil,
+		},
+		{
+			&crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitBitsPerSecond, Rate: "10M", Burst: "1M"},
+			&controlplane.RateLimit{Unit: crdv1beta1.RateLimitUnitBitsPerSecond, Rate: 10000000, Burst: 1000000},
+		},
+		{
+			&crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitPacketsPerSecond, Rate: "1k"},
+			&controlplane.RateLimit{Unit: crdv1beta1.RateLimitUnitPacketsPerSecond, Rate: 1000, Burst: 1000},
+		},
+		{
+			&crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitPacketsPerSecond, Rate: "invalid"},
+			nil,
+		},
+	}
+	for _, table := range tables {
+		gotValue := toAntreaRateLimitForCRD(table.rateLimit)
+		assert.Equal(t, table.expValue, gotValue)
+	}
+}
+
+func TestToAntreaLogSamplingForCRD(t *testing.T) {
+	assert.Nil(t, toAntreaLogSamplingForCRD(nil))
+	assert.Equal(t, &controlplane.LogSampling{Ratio: 100}, toAntreaLogSamplingForCRD(&crdv1beta1.LogSampling{Ratio: 100}))
+	assert.Equal(t, &controlplane.LogSampling{MaxPerSecond: 10}, toAntreaLogSamplingForCRD(&crdv1beta1.LogSampling{MaxPerSecond: 10}))
+}
+
 func TestToAntreaIPBlockForCRD(t *testing.T) {
 	expIPNet := controlplane.IPNet{
 		IP:           ipStrToIPAddress("10.0.0.0"),
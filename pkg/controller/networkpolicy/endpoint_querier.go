@@ -20,6 +20,7 @@ package networkpolicy
 import (
 	"errors"
 	"math"
+	"slices"
 	"sort"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -206,7 +207,8 @@ func processEndpointAppliedRules(appliedPolicies []*antreatypes.NetworkPolicy, i
 // predictEndpointsRules returns the predicted rules effective from srcEndpoints to dstEndpoints.
 // Rules returned satisfy a. in source applied policies and destination egress rules,
 // or b. in source ingress rules and destination applied policies or c. applied to KNP default isolation.
-func predictEndpointsRules(srcEndpointRules, dstEndpointRules *antreatypes.EndpointNetworkPolicyRules) (commonRule *antreatypes.RuleInfo) {
+// If ruleFilter is not nil, only the rules for which it returns true are considered.
+func predictEndpointsRules(srcEndpointRules, dstEndpointRules *antreatypes.EndpointNetworkPolicyRules, ruleFilter func(*antreatypes.RuleInfo) bool) (commonRule *antreatypes.RuleInfo) {
 	commonRules := make([]*antreatypes.RuleInfo, 0)
 	if srcEndpointRules != nil && dstEndpointRules != nil {
 		srcPolicies, srcIsolated := processEndpointAppliedRules(srcEndpointRules.AppliedPolicies, true)
@@ -224,6 +226,9 @@ func predictEndpointsRules(srcEndpointRules, dstEndpointRules *antreatypes.Endpo
 		commonRules = append(commonRules, srcIsolated...)
 		commonRules = append(commonRules, dstIsolated...)
 	}
+	if ruleFilter != nil {
+		commonRules = slices.DeleteFunc(commonRules, func(rule *antreatypes.RuleInfo) bool { return !ruleFilter(rule) })
+	}
 
 	// sort the common rules based on multiple closures, the top rule has the highest precedence
 	tierPriority := func(r1, r2 *antreatypes.RuleInfo) int {
@@ -291,6 +296,10 @@ func predictEndpointsRules(srcEndpointRules, dstEndpointRules *antreatypes.Endpo
 // QueryNetworkPolicyEvaluation returns the effective NetworkPolicy rule on given
 // source and destination entities.
 func (eq *policyRuleQuerier) QueryNetworkPolicyEvaluation(entities *controlplane.NetworkPolicyEvaluationRequest) (*controlplane.NetworkPolicyEvaluationResponse, error) {
+	return eq.queryNetworkPolicyEvaluation(entities, nil)
+}
+
+func (eq *policyRuleQuerier) queryNetworkPolicyEvaluation(entities *controlplane.NetworkPolicyEvaluationRequest, ruleFilter func(*antreatypes.RuleInfo) bool) (*controlplane.NetworkPolicyEvaluationResponse, error) {
 	if entities.Source.Pod == nil || entities.Destination.Pod == nil || entities.Source.Pod.Name == "" || entities.Destination.Pod.Name == "" {
 		return nil, errors.New("invalid NetworkPolicyEvaluation request entities")
 	}
@@ -303,7 +312,7 @@ func (eq *policyRuleQuerier) QueryNetworkPolicyEvaluation(entities *controlplane
 	if err != nil {
 		return nil, err
 	}
-	endpointAnalysisRule := predictEndpointsRules(endpointAnalysisSource, endpointAnalysisDestination)
+	endpointAnalysisRule := predictEndpointsRules(endpointAnalysisSource, endpointAnalysisDestination, ruleFilter)
 	if endpointAnalysisRule == nil {
 		return nil, nil
 	}
@@ -145,14 +145,18 @@ func generateRuleNames(prefix string, rules []crdv1beta1.Rule) ([]string, []stri
 	var values []string
 	for idx, rule := range rules {
 		if rule.Name == "" {
-			genName := fmt.Sprintf("%s-%s-%s", prefix, strings.ToLower(string(*rule.Action)), hashRule(rule))
 			paths = append(paths, fmt.Sprintf("/spec/%s/%d/name", prefix, idx))
-			values = append(values, genName)
+			values = append(values, generateRuleName(prefix, rule))
 		}
 	}
 	return paths, values
 }
 
+// generateRuleName generates a unique name for a rule, based on its direction, action and hash.
+func generateRuleName(prefix string, rule crdv1beta1.Rule) string {
+	return fmt.Sprintf("%s-%s-%s", prefix, strings.ToLower(string(*rule.Action)), hashRule(rule))
+}
+
 type jsonPatchOperation string
 
 const (
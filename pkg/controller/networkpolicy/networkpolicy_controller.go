@@ -43,6 +43,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	policyinformers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha1"
 	policylisters "sigs.k8s.io/network-policy-api/pkg/client/listers/apis/v1alpha1"
 
@@ -258,6 +259,8 @@ type NetworkPolicyController struct {
 	// Enable Stretched Networkpolicy feature which allows Antrea-native policies to select peer
 	// from other clusters in a ClusterSet.
 	stretchNPEnabled bool
+	// clock is used to evaluate the schedules of Antrea-native policy rules.
+	clock clock.Clock
 	// heartbeatCh is an internal channel for testing. It's used to know whether all tasks have been
 	// processed, and to count executions of each function.
 	heartbeatCh chan heartbeat
@@ -460,6 +463,7 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		labelIdentityInterface:  labelIdentityInterface,
 		stretchNPEnabled:        stretchedNPEnabled,
 		appliedToGroupNotifier:  newNotifier(),
+		clock:                   clock.RealClock{},
 	}
 	n.groupingInterface.AddEventHandler(appliedToGroupType, n.enqueueAppliedToGroup)
 	n.groupingInterface.AddEventHandler(addressGroupType, n.enqueueAddressGroup)
@@ -979,6 +983,9 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 		go wait.Until(n.internalNetworkPolicyWorker, time.Second, stopCh)
 		go wait.Until(n.internalGroupWorker, time.Second, stopCh)
 	}
+	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		go wait.Until(n.syncRuleSchedules, ruleScheduleSyncPeriod, stopCh)
+	}
 	<-stopCh
 }
 
@@ -41,6 +41,7 @@ import (
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	fakepolicyversioned "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
 	policyv1a1informers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions"
@@ -255,6 +256,7 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		),
 		groupingInterface:      groupEntityIndex,
 		appliedToGroupNotifier: newNotifier(),
+		clock:                  clock.RealClock{},
 	}
 	npController.tierInformer.Informer().AddIndexers(tierIndexers)
 	npController.acnpInformer.Informer().AddIndexers(acnpIndexers)
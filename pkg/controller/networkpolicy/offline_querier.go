@@ -0,0 +1,294 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+	fakepolicyversioned "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
+	policyinformerfactory "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	secv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	"antrea.io/antrea/pkg/controller/grouping"
+	"antrea.io/antrea/pkg/controller/labelidentity"
+	"antrea.io/antrea/pkg/controller/networkpolicy/store"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// offlineIdleTimeout is the period without any event handled by the NetworkPolicyController after which all
+// the provided objects are considered processed.
+const offlineIdleTimeout = 500 * time.Millisecond
+
+// OfflinePolicyRuleQuerier evaluates NetworkPolicies against a static set of objects, e.g. loaded from YAML
+// manifests, instead of the objects of a running cluster. It runs a NetworkPolicyController against in-memory
+// clientsets, so that the evaluation logic is the same as the one of the NetworkPolicyEvaluation API.
+type OfflinePolicyRuleQuerier struct {
+	*policyRuleQuerier
+	podLister corelisters.PodLister
+}
+
+// NewOfflinePolicyRuleQuerier returns a new *OfflinePolicyRuleQuerier once all the provided objects have been
+// processed. The supported objects are Namespaces, Pods, Services, Nodes, K8s NetworkPolicies, Antrea-native
+// policies, Tiers, ClusterGroups, Groups, ExternalEntities, AdminNetworkPolicies and BaselineAdminNetworkPolicies.
+// Missing Namespaces and the system generated Tiers are created automatically.
+func NewOfflinePolicyRuleQuerier(ctx context.Context, objects []runtime.Object) (*OfflinePolicyRuleQuerier, error) {
+	var k8sObjects, crdObjects, policyObjects []runtime.Object
+	namespaces := sets.New[string]()
+	referencedNamespaces := sets.New[string]()
+	tiers := sets.New[string]()
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *corev1.Namespace:
+			namespaces.Insert(o.Name)
+			k8sObjects = append(k8sObjects, newOfflineNamespace(o))
+		case *corev1.Pod, *corev1.Service, *corev1.Node, *networkingv1.NetworkPolicy:
+			k8sObjects = append(k8sObjects, obj)
+		case *secv1beta1.ClusterNetworkPolicy:
+			acnp := o.DeepCopy()
+			if err := mutateOfflineAntreaPolicy(&acnp.Spec.Tier, acnp.Spec.Ingress, acnp.Spec.Egress); err != nil {
+				return nil, fmt.Errorf("invalid ClusterNetworkPolicy %s: %w", o.Name, err)
+			}
+			crdObjects = append(crdObjects, acnp)
+		case *secv1beta1.NetworkPolicy:
+			annp := o.DeepCopy()
+			if err := mutateOfflineAntreaPolicy(&annp.Spec.Tier, annp.Spec.Ingress, annp.Spec.Egress); err != nil {
+				return nil, fmt.Errorf("invalid NetworkPolicy %s/%s: %w", o.Namespace, o.Name, err)
+			}
+			crdObjects = append(crdObjects, annp)
+		case *secv1beta1.Tier:
+			tiers.Insert(o.Name)
+			crdObjects = append(crdObjects, obj)
+		case *secv1beta1.ClusterGroup, *secv1beta1.Group, *v1alpha2.ExternalEntity:
+			crdObjects = append(crdObjects, obj)
+		case *policyv1alpha1.AdminNetworkPolicy, *policyv1alpha1.BaselineAdminNetworkPolicy:
+			policyObjects = append(policyObjects, obj)
+		default:
+			return nil, fmt.Errorf("unsupported object type %T", obj)
+		}
+		if accessor, err := meta.Accessor(obj); err == nil && accessor.GetNamespace() != "" {
+			referencedNamespaces.Insert(accessor.GetNamespace())
+		}
+	}
+	for _, name := range sets.List(referencedNamespaces.Difference(namespaces)) {
+		k8sObjects = append(k8sObjects, newOfflineNamespace(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}))
+	}
+	for _, t := range systemGeneratedTiers {
+		if !tiers.Has(t.Name) {
+			crdObjects = append(crdObjects, t.DeepCopy())
+		}
+	}
+
+	client := fake.NewSimpleClientset(k8sObjects...)
+	crdClient := fakeversioned.NewSimpleClientset(crdObjects...)
+	policyClient := fakepolicyversioned.NewSimpleClientset(policyObjects...)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+	policyInformerFactory := policyinformerfactory.NewSharedInformerFactory(policyClient, 0)
+	groupEntityIndex := grouping.NewGroupEntityIndex()
+	groupingController := grouping.NewGroupEntityController(groupEntityIndex,
+		informerFactory.Core().V1().Pods(),
+		informerFactory.Core().V1().Namespaces(),
+		crdInformerFactory.Crd().V1alpha2().ExternalEntities())
+	n := NewNetworkPolicyController(client,
+		crdClient,
+		groupEntityIndex,
+		labelidentity.NewLabelIdentityIndex(),
+		informerFactory.Core().V1().Namespaces(),
+		informerFactory.Core().V1().Services(),
+		informerFactory.Networking().V1().NetworkPolicies(),
+		informerFactory.Core().V1().Nodes(),
+		crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(),
+		crdInformerFactory.Crd().V1beta1().NetworkPolicies(),
+		policyInformerFactory.Policy().V1alpha1().AdminNetworkPolicies(),
+		policyInformerFactory.Policy().V1alpha1().BaselineAdminNetworkPolicies(),
+		crdInformerFactory.Crd().V1beta1().Tiers(),
+		crdInformerFactory.Crd().V1beta1().ClusterGroups(),
+		crdInformerFactory.Crd().V1beta1().Groups(),
+		store.NewAddressGroupStore(),
+		store.NewAppliedToGroupStore(),
+		store.NewNetworkPolicyStore(),
+		store.NewGroupStore(),
+		false)
+	n.heartbeatCh = make(chan heartbeat, 1000)
+	podLister := informerFactory.Core().V1().Pods().Lister()
+
+	stopCh := make(chan struct{})
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+	policyInformerFactory.Start(stopCh)
+	go groupingController.Run(stopCh)
+	go groupEntityIndex.Run(stopCh)
+	go n.Run(stopCh)
+	err := n.waitForOfflineProcessing(ctx)
+	close(stopCh)
+	// Event handlers may still be called while the informers are stopping.
+	go func() {
+		for range n.heartbeatCh {
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+	return &OfflinePolicyRuleQuerier{
+		policyRuleQuerier: NewPolicyRuleQuerier(NewEndpointQuerier(n)),
+		podLister:         podLister,
+	}, nil
+}
+
+// newOfflineNamespace returns a copy of the Namespace with the label set by the K8s apiserver to the Namespace
+// name, which can be used by Namespace selectors.
+func newOfflineNamespace(namespace *corev1.Namespace) *corev1.Namespace {
+	namespace = namespace.DeepCopy()
+	if namespace.Labels == nil {
+		namespace.Labels = map[string]string{}
+	}
+	namespace.Labels[corev1.LabelMetadataName] = namespace.Name
+	return namespace
+}
+
+// mutateOfflineAntreaPolicy applies the same defaults as the mutating webhook, which is not called for offline
+// objects.
+func mutateOfflineAntreaPolicy(tier *string, ingress, egress []secv1beta1.Rule) error {
+	if *tier == "" {
+		*tier = defaultTierName
+	}
+	for prefix, rules := range map[string][]secv1beta1.Rule{"ingress": ingress, "egress": egress} {
+		for i := range rules {
+			if rules[i].Action == nil {
+				return fmt.Errorf("action of %s rule %d must be set", prefix, i)
+			}
+			if rules[i].Name == "" {
+				rules[i].Name = generateRuleName(prefix, rules[i])
+			}
+		}
+	}
+	return nil
+}
+
+// waitForOfflineProcessing blocks until the NetworkPolicyController has processed all the objects, i.e. until
+// the GroupEntityIndex is synced, all the work queues are empty, and no event has been handled for
+// offlineIdleTimeout.
+func (n *NetworkPolicyController) waitForOfflineProcessing(ctx context.Context) error {
+	timer := time.NewTimer(offlineIdleTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("objects were not processed: %w", ctx.Err())
+		case <-n.heartbeatCh:
+			timer.Reset(offlineIdleTimeout)
+		case <-timer.C:
+			if n.groupingInterfaceSynced() && n.appliedToGroupQueue.Len() == 0 && n.addressGroupQueue.Len() == 0 &&
+				n.internalNetworkPolicyQueue.Len() == 0 && n.internalGroupQueue.Len() == 0 {
+				return nil
+			}
+			timer.Reset(offlineIdleTimeout)
+		}
+	}
+}
+
+// QueryNetworkPolicyEvaluationForPort is like QueryNetworkPolicyEvaluation, but only considers the rules which
+// apply to traffic sent to the provided port of the destination.
+func (q *OfflinePolicyRuleQuerier) QueryNetworkPolicyEvaluationForPort(entities *controlplane.NetworkPolicyEvaluationRequest, protocol controlplane.Protocol, port int32) (*controlplane.NetworkPolicyEvaluationResponse, error) {
+	var dstPod *corev1.Pod
+	if entities.Destination.Pod != nil {
+		namespace := entities.Destination.Pod.Namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		// A missing Pod is reported by the evaluation itself, and only prevents named ports from being resolved.
+		dstPod, _ = q.podLister.Pods(namespace).Get(entities.Destination.Pod.Name)
+	}
+	return q.queryNetworkPolicyEvaluation(entities, func(rule *antreatypes.RuleInfo) bool {
+		return ruleMatchesPort(rule, protocol, port, dstPod)
+	})
+}
+
+// ruleMatchesPort returns true if the rule applies to traffic sent to the provided port of the destination Pod.
+func ruleMatchesPort(ruleInfo *antreatypes.RuleInfo, protocol controlplane.Protocol, port int32, dstPod *corev1.Pod) bool {
+	// K8s NetworkPolicy default isolation applies to all traffic.
+	if ruleInfo.Index == math.MaxInt32 {
+		return true
+	}
+	// The index of a RuleInfo is the index of the rule among the internal rules with the same direction.
+	index := ruleInfo.Index
+	for i := range ruleInfo.Policy.Rules {
+		rule := &ruleInfo.Policy.Rules[i]
+		if rule.Direction != ruleInfo.Rule.Direction {
+			continue
+		}
+		if index > 0 {
+			index--
+			continue
+		}
+		if len(rule.Services) == 0 {
+			return true
+		}
+		for j := range rule.Services {
+			if serviceMatchesPort(&rule.Services[j], protocol, port, dstPod) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func serviceMatchesPort(service *controlplane.Service, protocol controlplane.Protocol, port int32, dstPod *corev1.Pod) bool {
+	if serviceProtocol(service) != protocol {
+		return false
+	}
+	if service.Port == nil {
+		return true
+	}
+	if service.Port.Type == intstr.String {
+		return dstPod != nil && podHasNamedPort(dstPod, service.Port.StrVal, protocol, port)
+	}
+	start, end, _ := portRange(service.Port, service.EndPort)
+	return start <= port && port <= end
+}
+
+func podHasNamedPort(pod *corev1.Pod, name string, protocol controlplane.Protocol, port int32) bool {
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			containerProtocol := containerPort.Protocol
+			if containerProtocol == "" {
+				containerProtocol = corev1.ProtocolTCP
+			}
+			if containerPort.Name == name && containerPort.ContainerPort == port && string(containerProtocol) == string(protocol) {
+				return true
+			}
+		}
+	}
+	return false
+}
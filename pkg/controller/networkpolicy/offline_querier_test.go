@@ -0,0 +1,231 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newOfflineTestPod(namespace, name string, labels map[string]string, ip string, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "container-1", Ports: ports}},
+			NodeName:   "nodeA",
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			PodIP:      ip,
+		},
+	}
+}
+
+func TestOfflinePolicyRuleQuerier(t *testing.T) {
+	clientPod := newOfflineTestPod("ns1", "client", map[string]string{"app": "client"}, "10.0.0.1")
+	serverPod := newOfflineTestPod("ns2", "server", map[string]string{"app": "server"}, "10.0.0.2",
+		corev1.ContainerPort{Name: "http", ContainerPort: 8080})
+	ingressRule := crdv1beta1.Rule{
+		Action: ptr.To(crdv1beta1.RuleActionDrop),
+		From: []crdv1beta1.NetworkPolicyPeer{{
+			PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}},
+		}},
+		Ports: []crdv1beta1.NetworkPolicyPort{{
+			Protocol: ptr.To(corev1.ProtocolTCP),
+			Port:     ptr.To(intstr.FromString("http")),
+		}},
+	}
+	acnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "acnp1", UID: "uid-acnp1"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Priority: 1,
+			AppliedTo: []crdv1beta1.AppliedTo{{
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "server"}},
+			}},
+			Ingress: []crdv1beta1.Rule{ingressRule},
+		},
+	}
+	// Only ns1 is provided, ns2 must be created automatically.
+	objects := []runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}},
+		clientPod,
+		serverPod,
+		acnp,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	querier, err := NewOfflinePolicyRuleQuerier(ctx, objects)
+	require.NoError(t, err)
+
+	request := &controlplane.NetworkPolicyEvaluationRequest{
+		Source:      controlplane.Entity{Pod: &controlplane.PodReference{Namespace: "ns1", Name: "client"}},
+		Destination: controlplane.Entity{Pod: &controlplane.PodReference{Namespace: "ns2", Name: "server"}},
+	}
+	expectedResponse := &controlplane.NetworkPolicyEvaluationResponse{
+		NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1", UID: "uid-acnp1"},
+		RuleIndex:     0,
+		Rule: controlplane.RuleRef{
+			Direction: controlplane.DirectionIn,
+			Name:      generateRuleName("ingress", ingressRule),
+			Action:    ptr.To(crdv1beta1.RuleActionDrop),
+		},
+	}
+
+	response, err := querier.QueryNetworkPolicyEvaluation(request)
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, response)
+
+	tests := []struct {
+		name             string
+		protocol         controlplane.Protocol
+		port             int32
+		expectedResponse *controlplane.NetworkPolicyEvaluationResponse
+	}{
+		{
+			name:             "named port",
+			protocol:         controlplane.ProtocolTCP,
+			port:             8080,
+			expectedResponse: expectedResponse,
+		},
+		{
+			name:     "other port",
+			protocol: controlplane.ProtocolTCP,
+			port:     80,
+		},
+		{
+			name:     "other protocol",
+			protocol: controlplane.ProtocolUDP,
+			port:     8080,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := querier.QueryNetworkPolicyEvaluationForPort(request, tt.protocol, tt.port)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResponse, response)
+		})
+	}
+}
+
+func TestNewOfflinePolicyRuleQuerierErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		objects     []runtime.Object
+		expectedErr string
+	}{
+		{
+			name:        "unsupported object",
+			objects:     []runtime.Object{&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cm1"}}},
+			expectedErr: "unsupported object type *v1.ConfigMap",
+		},
+		{
+			name: "missing rule action",
+			objects: []runtime.Object{&crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "annp1"},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					Egress: []crdv1beta1.Rule{{}},
+				},
+			}},
+			expectedErr: "invalid NetworkPolicy ns1/annp1: action of egress rule 0 must be set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOfflinePolicyRuleQuerier(context.Background(), tt.objects)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestMutateOfflineAntreaPolicy(t *testing.T) {
+	tier := ""
+	ingress := []crdv1beta1.Rule{{Action: &allowAction}, {Action: &dropAction, Name: "rule2"}}
+	egress := []crdv1beta1.Rule{{Action: &passAction}}
+	require.NoError(t, mutateOfflineAntreaPolicy(&tier, ingress, egress))
+	assert.Equal(t, defaultTierName, tier)
+	assert.Equal(t, generateRuleName("ingress", crdv1beta1.Rule{Action: &allowAction}), ingress[0].Name)
+	assert.Equal(t, "rule2", ingress[1].Name)
+	assert.Equal(t, generateRuleName("egress", crdv1beta1.Rule{Action: &passAction}), egress[0].Name)
+
+	tier = "securityops"
+	require.NoError(t, mutateOfflineAntreaPolicy(&tier, nil, nil))
+	assert.Equal(t, "securityops", tier)
+}
+
+func TestRuleMatchesPort(t *testing.T) {
+	pod := newOfflineTestPod("ns1", "pod1", nil, "10.0.0.1",
+		corev1.ContainerPort{Name: "http", ContainerPort: 8080},
+		corev1.ContainerPort{Name: "dns", ContainerPort: 53, Protocol: corev1.ProtocolUDP})
+	policy := &antreatypes.NetworkPolicy{
+		Rules: []controlplane.NetworkPolicyRule{
+			{
+				Direction: controlplane.DirectionOut,
+				Services:  []controlplane.Service{{Protocol: ptr.To(controlplane.ProtocolUDP)}},
+			},
+			{
+				Direction: controlplane.DirectionIn,
+				Services:  []controlplane.Service{{Port: ptr.To(intstr.FromInt32(80)), EndPort: ptr.To[int32](90)}},
+			},
+			{
+				Direction: controlplane.DirectionIn,
+				Services:  []controlplane.Service{{Port: ptr.To(intstr.FromString("http"))}, {Port: ptr.To(intstr.FromString("dns")), Protocol: ptr.To(controlplane.ProtocolUDP)}},
+			},
+			{
+				Direction: controlplane.DirectionIn,
+			},
+		},
+	}
+	ruleInfo := func(direction controlplane.Direction, index int32) *antreatypes.RuleInfo {
+		return &antreatypes.RuleInfo{Policy: policy, Index: index, Rule: &controlplane.NetworkPolicyRule{Direction: direction}}
+	}
+	tests := []struct {
+		name     string
+		ruleInfo *antreatypes.RuleInfo
+		protocol controlplane.Protocol
+		port     int32
+		pod      *corev1.Pod
+		expected bool
+	}{
+		{name: "isolation rule", ruleInfo: ruleInfo(controlplane.DirectionIn, math.MaxInt32), protocol: controlplane.ProtocolTCP, port: 22, expected: true},
+		{name: "protocol only matched", ruleInfo: ruleInfo(controlplane.DirectionOut, 0), protocol: controlplane.ProtocolUDP, port: 22, expected: true},
+		{name: "protocol only not matched", ruleInfo: ruleInfo(controlplane.DirectionOut, 0), protocol: controlplane.ProtocolTCP, port: 22, expected: false},
+		{name: "port range matched", ruleInfo: ruleInfo(controlplane.DirectionIn, 0), protocol: controlplane.ProtocolTCP, port: 85, expected: true},
+		{name: "port range not matched", ruleInfo: ruleInfo(controlplane.DirectionIn, 0), protocol: controlplane.ProtocolTCP, port: 91, expected: false},
+		{name: "named port matched", ruleInfo: ruleInfo(controlplane.DirectionIn, 1), protocol: controlplane.ProtocolTCP, port: 8080, pod: pod, expected: true},
+		{name: "named port with protocol matched", ruleInfo: ruleInfo(controlplane.DirectionIn, 1), protocol: controlplane.ProtocolUDP, port: 53, pod: pod, expected: true},
+		{name: "named port not matched", ruleInfo: ruleInfo(controlplane.DirectionIn, 1), protocol: controlplane.ProtocolTCP, port: 53, pod: pod, expected: false},
+		{name: "named port without Pod", ruleInfo: ruleInfo(controlplane.DirectionIn, 1), protocol: controlplane.ProtocolTCP, port: 8080, expected: false},
+		{name: "any port", ruleInfo: ruleInfo(controlplane.DirectionIn, 2), protocol: controlplane.ProtocolSCTP, port: 22, expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ruleMatchesPort(tt.ruleInfo, tt.protocol, tt.port, tt.pod))
+		})
+	}
+}
@@ -0,0 +1,646 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"cmp"
+	"net"
+	"reflect"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/apiserver/storage"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	utilip "antrea.io/antrea/pkg/util/ip"
+)
+
+// k8sNPTierPriority is the effective Tier priority of K8s NetworkPolicies: they are enforced after all
+// Antrea-native policies, except for the ones in the Baseline Tier and BaselineAdminNetworkPolicies.
+const k8sNPTierPriority = float64(crdv1beta1.BaselineTierPriority) - 0.5
+
+// PolicyAnalyzer handles requests for analyzing the rules of all NetworkPolicies.
+type PolicyAnalyzer interface {
+	// AnalyzeNetworkPolicies returns the rules which are shadowed by, redundant with, or conflicting with
+	// other rules.
+	AnalyzeNetworkPolicies() []controlplane.NetworkPolicyAnalysisFinding
+}
+
+// policyAnalyzer implements the PolicyAnalyzer interface. The analysis is based on the GroupMembers currently
+// selected by the rules, so its result only reflects the state of the cluster at the time of the request.
+type policyAnalyzer struct {
+	networkPolicyStore  storage.Interface
+	appliedToGroupStore storage.Interface
+	addressGroupStore   storage.Interface
+}
+
+// NewPolicyAnalyzer returns a new *policyAnalyzer.
+func NewPolicyAnalyzer(networkPolicyController *NetworkPolicyController) *policyAnalyzer {
+	return &policyAnalyzer{
+		networkPolicyStore:  networkPolicyController.internalNetworkPolicyStore,
+		appliedToGroupStore: networkPolicyController.appliedToGroupStore,
+		addressGroupStore:   networkPolicyController.addressGroupStore,
+	}
+}
+
+// analyzedRule is a rule of an original policy. A rule of an Antrea-native policy can be realized as multiple
+// internal rules, e.g. when it has both cluster-scoped and Namespace-scoped peers, each of them being a part.
+type analyzedRule struct {
+	policy       *antreatypes.NetworkPolicy
+	rule         *controlplane.NetworkPolicyRule
+	index        int32
+	action       crdv1beta1.RuleAction
+	tierPriority float64
+	// scheduled is true if the rule is only enforced during a time window, in which case it cannot be
+	// considered to cover other rules.
+	scheduled bool
+	// audited is true if the rule is a Drop or Reject rule of a policy in Audit mode. Traffic matching
+	// it is only logged and is still evaluated against the following rules, so it cannot be considered
+	// to cover or to conflict with other rules.
+	audited bool
+	parts   []*rulePart
+}
+
+type rulePart struct {
+	appliedTo controlplane.GroupMemberSet
+	peer      *rulePeer
+	services  []controlplane.Service
+}
+
+type rulePeer struct {
+	members         controlplane.GroupMemberSet
+	ipBlocks        []*ipBlock
+	fqdns           sets.Set[string]
+	toServices      sets.Set[controlplane.ServiceReference]
+	labelIdentities sets.Set[uint32]
+}
+
+type ipBlock struct {
+	cidr   *net.IPNet
+	except []*net.IPNet
+}
+
+type ruleKey struct {
+	direction controlplane.Direction
+	index     int32
+}
+
+// AnalyzeNetworkPolicies compares all rules of the same direction pairwise. Like QueryNetworkPolicyRules, it
+// iterates over all NetworkPolicies, which is acceptable as it only supports user queries.
+func (a *policyAnalyzer) AnalyzeNetworkPolicies() []controlplane.NetworkPolicyAnalysisFinding {
+	rules := a.getAnalyzedRules()
+	var findings []controlplane.NetworkPolicyAnalysisFinding
+	for i := range rules {
+		for j := i + 1; j < len(rules); j++ {
+			if rules[i].rule.Direction != rules[j].rule.Direction {
+				continue
+			}
+			if finding := analyzeRulePair(rules[i], rules[j]); finding != nil {
+				findings = append(findings, *finding)
+			}
+		}
+	}
+	return findings
+}
+
+// analyzeRulePair returns the finding for a pair of rules of the same direction, or nil if there is none.
+func analyzeRulePair(r1, r2 *analyzedRule) *controlplane.NetworkPolicyAnalysisFinding {
+	switch comparePrecedence(r1, r2) {
+	case -1:
+		return coverFinding(r1, r2)
+	case 1:
+		return coverFinding(r2, r1)
+	}
+	// The order in which rules with the same precedence are evaluated is undefined.
+	if r1.action != r2.action {
+		if r1.audited || r2.audited {
+			return nil
+		}
+		if r1.overlaps(r2) {
+			return newFinding(controlplane.NetworkPolicyAnalysisFindingConflicting, r2, r1)
+		}
+		return nil
+	}
+	if !r1.scheduled && !r1.audited && r1.covers(r2) {
+		return newFinding(controlplane.NetworkPolicyAnalysisFindingRedundant, r2, r1)
+	}
+	if !r2.scheduled && !r2.audited && r2.covers(r1) {
+		return newFinding(controlplane.NetworkPolicyAnalysisFindingRedundant, r1, r2)
+	}
+	return nil
+}
+
+// coverFinding returns the finding for a rule which may be covered by a rule with a higher precedence.
+func coverFinding(higher, lower *analyzedRule) *controlplane.NetworkPolicyAnalysisFinding {
+	if higher.scheduled || higher.audited || !higher.covers(lower) {
+		return nil
+	}
+	if higher.action == crdv1beta1.RuleActionPass && lower.tierPriority >= k8sNPTierPriority {
+		// Traffic matching a Pass rule is still evaluated against K8s NetworkPolicies and Baseline rules.
+		return nil
+	}
+	// RateLimit rules are only redundant if they police the traffic to the same rate.
+	if higher.action == lower.action && ptr.Equal(higher.rule.RateLimit, lower.rule.RateLimit) {
+		return newFinding(controlplane.NetworkPolicyAnalysisFindingRedundant, lower, higher)
+	}
+	return newFinding(controlplane.NetworkPolicyAnalysisFindingShadowed, lower, higher)
+}
+
+func newFinding(findingType controlplane.NetworkPolicyAnalysisFindingType, rule, relatedRule *analyzedRule) *controlplane.NetworkPolicyAnalysisFinding {
+	return &controlplane.NetworkPolicyAnalysisFinding{
+		Type:        findingType,
+		Rule:        rule.reference(),
+		RelatedRule: relatedRule.reference(),
+	}
+}
+
+func (r *analyzedRule) reference() controlplane.PolicyRuleReference {
+	return controlplane.PolicyRuleReference{
+		NetworkPolicy: *r.policy.SourceRef,
+		RuleIndex:     r.index,
+		Rule: controlplane.RuleRef{
+			Direction: r.rule.Direction,
+			Name:      r.rule.Name,
+			Action:    r.rule.Action,
+		},
+	}
+}
+
+// comparePrecedence returns -1 if r1 is evaluated before r2, 1 if r2 is evaluated before r1, and 0 if the
+// order is undefined.
+func comparePrecedence(r1, r2 *analyzedRule) int {
+	if c := cmp.Compare(r1.tierPriority, r2.tierPriority); c != 0 {
+		return c
+	}
+	if r1.policy.UID == r2.policy.UID {
+		return cmp.Compare(r1.rule.Priority, r2.rule.Priority)
+	}
+	// K8s NetworkPolicies have no priority, all their rules are evaluated together.
+	if r1.policy.Priority == nil || r2.policy.Priority == nil {
+		return 0
+	}
+	return cmp.Compare(*r1.policy.Priority, *r2.policy.Priority)
+}
+
+func (r *analyzedRule) covers(o *analyzedRule) bool {
+	if len(r.rule.L7Protocols) > 0 && !reflect.DeepEqual(r.rule.L7Protocols, o.rule.L7Protocols) {
+		return false
+	}
+	// Each part of the other rule must be covered by a part of this rule.
+	for _, op := range o.parts {
+		if !slices.ContainsFunc(r.parts, func(p *rulePart) bool { return p.covers(op) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *analyzedRule) overlaps(o *analyzedRule) bool {
+	for _, p := range r.parts {
+		if slices.ContainsFunc(o.parts, p.overlaps) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *rulePart) covers(o *rulePart) bool {
+	return p.appliedTo.IsSuperset(o.appliedTo) && p.peer.covers(o.peer) && servicesCover(p.services, o.services)
+}
+
+func (p *rulePart) overlaps(o *rulePart) bool {
+	return groupMembersIntersect(p.appliedTo, o.appliedTo) && p.peer.overlaps(o.peer) && servicesOverlap(p.services, o.services)
+}
+
+func groupMembersIntersect(s, o controlplane.GroupMemberSet) bool {
+	for key := range s {
+		if _, contained := o[key]; contained {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *rulePeer) isEmpty() bool {
+	return len(p.members) == 0 && len(p.ipBlocks) == 0 && p.fqdns.Len() == 0 && p.toServices.Len() == 0 && p.labelIdentities.Len() == 0
+}
+
+// matchesAll returns true if the peer matches all IPv4 and IPv6 addresses.
+func (p *rulePeer) matchesAll() bool {
+	var ipv4, ipv6 bool
+	for _, b := range p.ipBlocks {
+		if ones, _ := b.cidr.Mask.Size(); ones != 0 || len(b.except) > 0 {
+			continue
+		}
+		if b.cidr.IP.To4() != nil {
+			ipv4 = true
+		} else {
+			ipv6 = true
+		}
+	}
+	return ipv4 && ipv6
+}
+
+func (p *rulePeer) covers(o *rulePeer) bool {
+	if p.matchesAll() {
+		return true
+	}
+	for key, member := range o.members {
+		if _, contained := p.members[key]; !contained && !p.containsAllIPs(member.IPs) {
+			return false
+		}
+	}
+	for _, ob := range o.ipBlocks {
+		if !slices.ContainsFunc(p.ipBlocks, func(b *ipBlock) bool { return b.containsBlock(ob) }) {
+			return false
+		}
+	}
+	return p.fqdns.IsSuperset(o.fqdns) && p.toServices.IsSuperset(o.toServices) && p.labelIdentities.IsSuperset(o.labelIdentities)
+}
+
+func (p *rulePeer) overlaps(o *rulePeer) bool {
+	if p.matchesAll() || o.matchesAll() {
+		return true
+	}
+	for key, member := range o.members {
+		if _, contained := p.members[key]; contained || p.containsAnyIP(member.IPs) {
+			return true
+		}
+	}
+	for _, member := range p.members {
+		if o.containsAnyIP(member.IPs) {
+			return true
+		}
+	}
+	for _, b := range p.ipBlocks {
+		if slices.ContainsFunc(o.ipBlocks, b.overlaps) {
+			return true
+		}
+	}
+	return p.fqdns.HasAny(o.fqdns.UnsortedList()...) ||
+		p.toServices.HasAny(o.toServices.UnsortedList()...) ||
+		p.labelIdentities.HasAny(o.labelIdentities.UnsortedList()...)
+}
+
+func (p *rulePeer) containsIP(ip net.IP) bool {
+	return slices.ContainsFunc(p.ipBlocks, func(b *ipBlock) bool { return b.containsIP(ip) })
+}
+
+func (p *rulePeer) containsAllIPs(ips []controlplane.IPAddress) bool {
+	if len(ips) == 0 {
+		return false
+	}
+	for _, ip := range ips {
+		if !p.containsIP(net.IP(ip)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *rulePeer) containsAnyIP(ips []controlplane.IPAddress) bool {
+	for _, ip := range ips {
+		if p.containsIP(net.IP(ip)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *ipBlock) containsIP(ip net.IP) bool {
+	if !b.cidr.Contains(ip) {
+		return false
+	}
+	return !slices.ContainsFunc(b.except, func(except *net.IPNet) bool { return except.Contains(ip) })
+}
+
+// containsBlock returns true if all the addresses of the other block are in the block.
+func (b *ipBlock) containsBlock(o *ipBlock) bool {
+	if !utilip.IPNetContains(b.cidr, o.cidr) {
+		return false
+	}
+	// The addresses of the other block excluded from the block must also be excluded from the other block.
+	for _, except := range b.except {
+		if !ipNetsOverlap(except, o.cidr) {
+			continue
+		}
+		excluded := smallerIPNet(except, o.cidr)
+		if !slices.ContainsFunc(o.except, func(oe *net.IPNet) bool { return utilip.IPNetContains(oe, excluded) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *ipBlock) overlaps(o *ipBlock) bool {
+	if !ipNetsOverlap(b.cidr, o.cidr) {
+		return false
+	}
+	intersection := smallerIPNet(b.cidr, o.cidr)
+	isExcluded := func(except *net.IPNet) bool { return utilip.IPNetContains(except, intersection) }
+	return !slices.ContainsFunc(b.except, isExcluded) && !slices.ContainsFunc(o.except, isExcluded)
+}
+
+// ipNetsOverlap returns true if one of the provided IPNets contains the other one, which is the only way
+// for 2 CIDRs to overlap.
+func ipNetsOverlap(ipNet1, ipNet2 *net.IPNet) bool {
+	return utilip.IPNetContains(ipNet1, ipNet2) || utilip.IPNetContains(ipNet2, ipNet1)
+}
+
+// smallerIPNet returns the smaller of 2 overlapping IPNets, which is their intersection.
+func smallerIPNet(ipNet1, ipNet2 *net.IPNet) *net.IPNet {
+	if utilip.IPNetContains(ipNet1, ipNet2) {
+		return ipNet2
+	}
+	return ipNet1
+}
+
+func servicesCover(services, others []controlplane.Service) bool {
+	// An empty list of services matches all traffic.
+	if len(services) == 0 {
+		return true
+	}
+	if len(others) == 0 {
+		return false
+	}
+	for i := range others {
+		if !slices.ContainsFunc(services, func(s controlplane.Service) bool { return serviceCovers(&s, &others[i]) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func servicesOverlap(services, others []controlplane.Service) bool {
+	if len(services) == 0 || len(others) == 0 {
+		return true
+	}
+	for i := range services {
+		if slices.ContainsFunc(others, func(o controlplane.Service) bool { return serviceOverlaps(&services[i], &o) }) {
+			return true
+		}
+	}
+	return false
+}
+
+func serviceProtocol(s *controlplane.Service) controlplane.Protocol {
+	if s.Protocol == nil {
+		return controlplane.ProtocolTCP
+	}
+	return *s.Protocol
+}
+
+func serviceCovers(s, o *controlplane.Service) bool {
+	if serviceProtocol(s) != serviceProtocol(o) {
+		return false
+	}
+	switch serviceProtocol(s) {
+	case controlplane.ProtocolTCP, controlplane.ProtocolUDP, controlplane.ProtocolSCTP:
+		if !portsCover(s.Port, s.EndPort, o.Port, o.EndPort) {
+			return false
+		}
+		start, end := srcPortRange(s.SrcPort, s.SrcEndPort)
+		oStart, oEnd := srcPortRange(o.SrcPort, o.SrcEndPort)
+		return start <= oStart && oEnd <= end
+	case controlplane.ProtocolICMP:
+		if s.ICMPType == nil {
+			return true
+		}
+		if o.ICMPType == nil || *s.ICMPType != *o.ICMPType {
+			return false
+		}
+		return s.ICMPCode == nil || (o.ICMPCode != nil && *s.ICMPCode == *o.ICMPCode)
+	default:
+		return reflect.DeepEqual(s, o)
+	}
+}
+
+func serviceOverlaps(s, o *controlplane.Service) bool {
+	if serviceProtocol(s) != serviceProtocol(o) {
+		return false
+	}
+	switch serviceProtocol(s) {
+	case controlplane.ProtocolTCP, controlplane.ProtocolUDP, controlplane.ProtocolSCTP:
+		if !portsOverlap(s.Port, s.EndPort, o.Port, o.EndPort) {
+			return false
+		}
+		start, end := srcPortRange(s.SrcPort, s.SrcEndPort)
+		oStart, oEnd := srcPortRange(o.SrcPort, o.SrcEndPort)
+		return start <= oEnd && oStart <= end
+	case controlplane.ProtocolICMP:
+		if s.ICMPType == nil || o.ICMPType == nil {
+			return true
+		}
+		return *s.ICMPType == *o.ICMPType && (s.ICMPCode == nil || o.ICMPCode == nil || *s.ICMPCode == *o.ICMPCode)
+	default:
+		return reflect.DeepEqual(s, o)
+	}
+}
+
+// portRange returns the range of destination ports matched by port and endPort. named is true if port is a
+// named port, which is resolved by the Agents and cannot be compared with a range.
+func portRange(port *intstr.IntOrString, endPort *int32) (start, end int32, named bool) {
+	switch {
+	case port == nil:
+		return 0, 65535, false
+	case port.Type == intstr.String:
+		return 0, 0, true
+	case endPort != nil:
+		return port.IntVal, *endPort, false
+	default:
+		return port.IntVal, port.IntVal, false
+	}
+}
+
+func portsCover(port *intstr.IntOrString, endPort *int32, oPort *intstr.IntOrString, oEndPort *int32) bool {
+	start, end, named := portRange(port, endPort)
+	oStart, oEnd, oNamed := portRange(oPort, oEndPort)
+	if named || oNamed {
+		return port == nil || (named && oNamed && port.StrVal == oPort.StrVal)
+	}
+	return start <= oStart && oEnd <= end
+}
+
+func portsOverlap(port *intstr.IntOrString, endPort *int32, oPort *intstr.IntOrString, oEndPort *int32) bool {
+	start, end, named := portRange(port, endPort)
+	oStart, oEnd, oNamed := portRange(oPort, oEndPort)
+	if named || oNamed {
+		return port == nil || oPort == nil || (named && oNamed && port.StrVal == oPort.StrVal)
+	}
+	return start <= oEnd && oStart <= end
+}
+
+func srcPortRange(port, endPort *int32) (int32, int32) {
+	switch {
+	case port == nil:
+		return 0, 65535
+	case endPort != nil:
+		return *port, *endPort
+	default:
+		return *port, *port
+	}
+}
+
+// getAnalyzedRules returns the rules of all NetworkPolicies which currently select at least one GroupMember or
+// IPBlock, sorted by direction and precedence.
+func (a *policyAnalyzer) getAnalyzedRules() []*analyzedRule {
+	var rules []*analyzedRule
+	for _, obj := range a.networkPolicyStore.List() {
+		policy := obj.(*antreatypes.NetworkPolicy)
+		scheduledRules := sets.New[string](policy.ActiveScheduledRules...)
+		tierPriority := k8sNPTierPriority
+		if policy.TierPriority != nil {
+			tierPriority = float64(*policy.TierPriority)
+		}
+		ruleByKey := map[ruleKey]*analyzedRule{}
+		var policyRules []*analyzedRule
+		var ingressIndex, egressIndex int32
+		for i := range policy.Rules {
+			rule := &policy.Rules[i]
+			index := rule.Priority
+			// All the rules of a K8s NetworkPolicy have the same priority, their index is computed instead.
+			if policy.SourceRef.Type == controlplane.K8sNetworkPolicy {
+				if rule.Direction == controlplane.DirectionIn {
+					index = ingressIndex
+					ingressIndex++
+				} else {
+					index = egressIndex
+					egressIndex++
+				}
+			}
+			key := ruleKey{direction: rule.Direction, index: index}
+			r, exists := ruleByKey[key]
+			if !exists {
+				r = &analyzedRule{
+					policy:       policy,
+					rule:         rule,
+					index:        index,
+					action:       ruleAction(rule),
+					tierPriority: tierPriority,
+					scheduled:    scheduledRules.Has(rule.Name),
+					audited:      isAuditedRule(policy, rule),
+				}
+				ruleByKey[key] = r
+				policyRules = append(policyRules, r)
+			}
+			if part := a.newRulePart(policy, rule); part != nil {
+				r.parts = append(r.parts, part)
+			}
+		}
+		for _, r := range policyRules {
+			if len(r.parts) > 0 {
+				rules = append(rules, r)
+			}
+		}
+	}
+	slices.SortFunc(rules, func(r1, r2 *analyzedRule) int {
+		if c := cmp.Compare(r1.rule.Direction, r2.rule.Direction); c != 0 {
+			return c
+		}
+		if c := comparePrecedence(r1, r2); c != 0 {
+			return c
+		}
+		ref1, ref2 := r1.policy.SourceRef, r2.policy.SourceRef
+		return cmp.Or(
+			cmp.Compare(ref1.Type, ref2.Type),
+			cmp.Compare(ref1.Namespace, ref2.Namespace),
+			cmp.Compare(ref1.Name, ref2.Name),
+			cmp.Compare(r1.index, r2.index),
+		)
+	})
+	return rules
+}
+
+// ruleAction returns the action of the rule. The rules of K8s NetworkPolicies have no action and allow
+// traffic.
+func ruleAction(rule *controlplane.NetworkPolicyRule) crdv1beta1.RuleAction {
+	if rule.Action == nil {
+		return crdv1beta1.RuleActionAllow
+	}
+	return *rule.Action
+}
+
+// isAuditedRule returns true if the rule is a Drop or Reject rule of a policy in Audit mode, which only logs
+// the matching traffic instead of dropping it.
+func isAuditedRule(policy *antreatypes.NetworkPolicy, rule *controlplane.NetworkPolicyRule) bool {
+	action := ruleAction(rule)
+	return policy.EnforcementMode == crdv1beta1.EnforcementModeAudit && (action == crdv1beta1.RuleActionDrop || action == crdv1beta1.RuleActionReject)
+}
+
+// newRulePart returns the part of a rule corresponding to an internal rule, or nil if the internal rule
+// does not select any GroupMember or IPBlock.
+func (a *policyAnalyzer) newRulePart(policy *antreatypes.NetworkPolicy, rule *controlplane.NetworkPolicyRule) *rulePart {
+	appliedToGroups := rule.AppliedToGroups
+	if len(appliedToGroups) == 0 {
+		appliedToGroups = policy.AppliedToGroups
+	}
+	appliedTo := controlplane.GroupMemberSet{}
+	for _, name := range appliedToGroups {
+		obj, found, _ := a.appliedToGroupStore.Get(name)
+		if !found {
+			continue
+		}
+		for _, members := range obj.(*antreatypes.AppliedToGroup).GroupMemberByNode {
+			appliedTo.Merge(members)
+		}
+	}
+	peer := &rule.From
+	if rule.Direction == controlplane.DirectionOut {
+		peer = &rule.To
+	}
+	rp := a.newRulePeer(peer)
+	if len(appliedTo) == 0 || rp.isEmpty() {
+		return nil
+	}
+	return &rulePart{appliedTo: appliedTo, peer: rp, services: rule.Services}
+}
+
+func (a *policyAnalyzer) newRulePeer(peer *controlplane.NetworkPolicyPeer) *rulePeer {
+	rp := &rulePeer{
+		members:         controlplane.GroupMemberSet{},
+		fqdns:           sets.New[string](peer.FQDNs...),
+		toServices:      sets.New[controlplane.ServiceReference](peer.ToServices...),
+		labelIdentities: sets.New[uint32](peer.LabelIdentities...),
+	}
+	for _, name := range peer.AddressGroups {
+		obj, found, _ := a.addressGroupStore.Get(name)
+		if !found {
+			continue
+		}
+		rp.members.Merge(obj.(*antreatypes.AddressGroup).GroupMembers)
+	}
+	for _, b := range peer.IPBlocks {
+		block := &ipBlock{cidr: ipNetToNetIPNet(b.CIDR)}
+		for _, except := range b.Except {
+			block.except = append(block.except, ipNetToNetIPNet(except))
+		}
+		rp.ipBlocks = append(rp.ipBlocks, block)
+	}
+	return rp
+}
+
+func ipNetToNetIPNet(ipNet controlplane.IPNet) *net.IPNet {
+	ip := net.IP(ipNet.IP)
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		bits = 8 * net.IPv4len
+	}
+	mask := net.CIDRMask(int(ipNet.PrefixLength), bits)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
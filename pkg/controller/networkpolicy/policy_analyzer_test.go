@@ -0,0 +1,408 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/networkpolicy/store"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+var (
+	analyzerPodA = &controlplane.GroupMember{Pod: &controlplane.PodReference{Name: "podA", Namespace: "ns1"}, IPs: []controlplane.IPAddress{ipStrToIPAddress("10.0.0.1")}}
+	analyzerPodB = &controlplane.GroupMember{Pod: &controlplane.PodReference{Name: "podB", Namespace: "ns1"}, IPs: []controlplane.IPAddress{ipStrToIPAddress("10.0.0.2")}}
+	analyzerPodC = &controlplane.GroupMember{Pod: &controlplane.PodReference{Name: "podC", Namespace: "ns2"}, IPs: []controlplane.IPAddress{ipStrToIPAddress("10.0.1.1")}}
+
+	appliedToGroupAB = &antreatypes.AppliedToGroup{
+		Name: "atgAB",
+		GroupMemberByNode: map[string]controlplane.GroupMemberSet{
+			"node1": controlplane.NewGroupMemberSet(&controlplane.GroupMember{Pod: analyzerPodA.Pod}),
+			"node2": controlplane.NewGroupMemberSet(&controlplane.GroupMember{Pod: analyzerPodB.Pod}),
+		},
+	}
+	appliedToGroupA = &antreatypes.AppliedToGroup{
+		Name:              "atgA",
+		GroupMemberByNode: map[string]controlplane.GroupMemberSet{"node1": controlplane.NewGroupMemberSet(&controlplane.GroupMember{Pod: analyzerPodA.Pod})},
+	}
+	appliedToGroupEmpty = &antreatypes.AppliedToGroup{Name: "atgEmpty"}
+	addressGroupC       = &antreatypes.AddressGroup{Name: "agC", GroupMembers: controlplane.NewGroupMemberSet(analyzerPodC)}
+	addressGroupAC      = &antreatypes.AddressGroup{Name: "agAC", GroupMembers: controlplane.NewGroupMemberSet(analyzerPodA, analyzerPodC)}
+
+	cidr10 = controlplane.IPNet{IP: ipStrToIPAddress("10.0.0.0"), PrefixLength: 8}
+)
+
+func analyzerACNP(name string, tierPriority int32, priority float64, rules ...controlplane.NetworkPolicyRule) *antreatypes.NetworkPolicy {
+	return &antreatypes.NetworkPolicy{
+		UID:             types.UID(name),
+		Name:            name,
+		SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: name, UID: types.UID(name)},
+		TierPriority:    ptr.To(tierPriority),
+		Priority:        ptr.To(priority),
+		Rules:           rules,
+		AppliedToGroups: []string{appliedToGroupAB.Name},
+	}
+}
+
+func analyzerK8sNP(name string, rules ...controlplane.NetworkPolicyRule) *antreatypes.NetworkPolicy {
+	return &antreatypes.NetworkPolicy{
+		UID:             types.UID(name),
+		Name:            name,
+		SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns1", Name: name, UID: types.UID(name)},
+		Rules:           rules,
+		AppliedToGroups: []string{appliedToGroupAB.Name},
+	}
+}
+
+func analyzerRule(name string, priority int32, action crdv1beta1.RuleAction, peer controlplane.NetworkPolicyPeer, services ...controlplane.Service) controlplane.NetworkPolicyRule {
+	return controlplane.NetworkPolicyRule{
+		Direction: controlplane.DirectionIn,
+		Name:      name,
+		Priority:  priority,
+		Action:    &action,
+		From:      peer,
+		Services:  services,
+	}
+}
+
+func analyzerK8sRule(peer controlplane.NetworkPolicyPeer, services ...controlplane.Service) controlplane.NetworkPolicyRule {
+	return analyzerRule("", defaultRulePriority, crdv1beta1.RuleActionAllow, peer, services...)
+}
+
+func tcpService(port int32, endPort *int32) controlplane.Service {
+	return controlplane.Service{Protocol: ptr.To(controlplane.ProtocolTCP), Port: ptr.To(intstr.FromInt32(port)), EndPort: endPort}
+}
+
+func ruleReference(policy *antreatypes.NetworkPolicy, index int32) controlplane.PolicyRuleReference {
+	for _, rule := range policy.Rules {
+		if rule.Priority == index || policy.SourceRef.Type == controlplane.K8sNetworkPolicy {
+			return controlplane.PolicyRuleReference{
+				NetworkPolicy: *policy.SourceRef,
+				RuleIndex:     index,
+				Rule:          controlplane.RuleRef{Direction: rule.Direction, Name: rule.Name, Action: rule.Action},
+			}
+		}
+	}
+	return controlplane.PolicyRuleReference{}
+}
+
+func TestAnalyzeNetworkPolicies(t *testing.T) {
+	peerC := controlplane.NetworkPolicyPeer{AddressGroups: []string{addressGroupC.Name}}
+	peerAC := controlplane.NetworkPolicyPeer{AddressGroups: []string{addressGroupAC.Name}}
+	peer10 := controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{{CIDR: cidr10}}}
+	peer10ExceptC := controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{{
+		CIDR:   cidr10,
+		Except: []controlplane.IPNet{{IP: ipStrToIPAddress("10.0.1.0"), PrefixLength: 24}},
+	}}}
+
+	acnpDropAll := analyzerACNP("acnp-drop-all", 100, 1, analyzerRule("drop-all", 0, crdv1beta1.RuleActionDrop, matchAllPeer))
+	acnpAllowC := analyzerACNP("acnp-allow-c", 100, 2, analyzerRule("allow-c", 0, crdv1beta1.RuleActionAllow, peerC, tcpService(80, nil)))
+	acnpAllowAC := analyzerACNP("acnp-allow-ac", 100, 1, analyzerRule("allow-ac", 0, crdv1beta1.RuleActionAllow, peerAC))
+	acnpDropC := analyzerACNP("acnp-drop-c", 100, 1, analyzerRule("drop-c", 0, crdv1beta1.RuleActionDrop, peerC, tcpService(80, ptr.To[int32](90))))
+	acnpAppTierAllowC := analyzerACNP("acnp-app-tier", 250, 1, analyzerRule("allow-c", 0, crdv1beta1.RuleActionAllow, peerC))
+	acnpDrop10 := analyzerACNP("acnp-drop-10", 100, 1, analyzerRule("drop-10", 0, crdv1beta1.RuleActionDrop, peer10))
+	acnpPassAll := analyzerACNP("acnp-pass-all", 100, 1, analyzerRule("pass-all", 0, crdv1beta1.RuleActionPass, matchAllPeer))
+	acnpDropCSamePriority := analyzerACNP("acnp-drop-c", 100, 1, analyzerRule("drop-c", 0, crdv1beta1.RuleActionDrop, peerC, tcpService(80, nil)))
+	acnpAuditDropCSamePriority := analyzerACNP("acnp-audit-drop-c", 100, 1, analyzerRule("drop-c", 0, crdv1beta1.RuleActionDrop, peerC, tcpService(80, nil)))
+	acnpAuditDropCSamePriority.EnforcementMode = crdv1beta1.EnforcementModeAudit
+	acnpAuditDropC := analyzerACNP("acnp-audit-drop-c", 100, 2, analyzerRule("drop-c", 0, crdv1beta1.RuleActionDrop, peerC, tcpService(80, nil)))
+	acnpAuditDropC.EnforcementMode = crdv1beta1.EnforcementModeAudit
+	k8sNPAllowC := analyzerK8sNP("k8s-allow-c", analyzerK8sRule(peerC, tcpService(80, nil)))
+	k8sNPAllowAC := analyzerK8sNP("k8s-allow-ac", analyzerK8sRule(peerAC))
+
+	tests := []struct {
+		name             string
+		policies         []*antreatypes.NetworkPolicy
+		expectedFindings []controlplane.NetworkPolicyAnalysisFinding
+	}{
+		{
+			name:     "rule shadowed by rule in higher tier",
+			policies: []*antreatypes.NetworkPolicy{acnpDropAll, acnpAppTierAllowC},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type:        controlplane.NetworkPolicyAnalysisFindingShadowed,
+				Rule:        ruleReference(acnpAppTierAllowC, 0),
+				RelatedRule: ruleReference(acnpDropAll, 0),
+			}},
+		},
+		{
+			name:     "rule shadowed by rule of policy with higher priority",
+			policies: []*antreatypes.NetworkPolicy{acnpDropC, acnpAllowC},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type:        controlplane.NetworkPolicyAnalysisFindingShadowed,
+				Rule:        ruleReference(acnpAllowC, 0),
+				RelatedRule: ruleReference(acnpDropC, 0),
+			}},
+		},
+		{
+			name: "rule shadowed by rule with lower index in same policy",
+			policies: []*antreatypes.NetworkPolicy{analyzerACNP("acnp", 100, 1,
+				analyzerRule("drop-ac", 0, crdv1beta1.RuleActionDrop, peerAC),
+				analyzerRule("allow-c", 1, crdv1beta1.RuleActionAllow, peerC),
+			)},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type: controlplane.NetworkPolicyAnalysisFindingShadowed,
+				Rule: controlplane.PolicyRuleReference{
+					NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp", UID: "acnp"},
+					RuleIndex:     1,
+					Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "allow-c", Action: ptr.To(crdv1beta1.RuleActionAllow)},
+				},
+				RelatedRule: controlplane.PolicyRuleReference{
+					NetworkPolicy: controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp", UID: "acnp"},
+					Rule:          controlplane.RuleRef{Direction: controlplane.DirectionIn, Name: "drop-ac", Action: ptr.To(crdv1beta1.RuleActionDrop)},
+				},
+			}},
+		},
+		{
+			name:     "K8s NetworkPolicy rule redundant with Antrea-native rule",
+			policies: []*antreatypes.NetworkPolicy{acnpAllowAC, k8sNPAllowC},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type:        controlplane.NetworkPolicyAnalysisFindingRedundant,
+				Rule:        ruleReference(k8sNPAllowC, 0),
+				RelatedRule: ruleReference(acnpAllowAC, 0),
+			}},
+		},
+		{
+			name:     "rule covered by IPBlock",
+			policies: []*antreatypes.NetworkPolicy{acnpDrop10, acnpAllowC},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type:        controlplane.NetworkPolicyAnalysisFindingShadowed,
+				Rule:        ruleReference(acnpAllowC, 0),
+				RelatedRule: ruleReference(acnpDrop10, 0),
+			}},
+		},
+		{
+			name: "rule not covered by IPBlock with except",
+			policies: []*antreatypes.NetworkPolicy{
+				analyzerACNP("acnp-drop-10", 100, 1, analyzerRule("drop-10", 0, crdv1beta1.RuleActionDrop, peer10ExceptC)),
+				acnpAllowC,
+			},
+		},
+		{
+			name: "rule not covered by rule with fewer ports",
+			policies: []*antreatypes.NetworkPolicy{
+				analyzerACNP("acnp-drop-c", 100, 1, analyzerRule("drop-c", 0, crdv1beta1.RuleActionDrop, peerC, tcpService(80, nil))),
+				analyzerACNP("acnp-allow-c", 100, 2, analyzerRule("allow-c", 0, crdv1beta1.RuleActionAllow, peerC, tcpService(80, ptr.To[int32](81)))),
+			},
+		},
+		{
+			name: "rule not covered by rule applied to fewer Pods",
+			policies: []*antreatypes.NetworkPolicy{
+				func() *antreatypes.NetworkPolicy {
+					policy := analyzerACNP("acnp-drop-all", 100, 1, analyzerRule("drop-all", 0, crdv1beta1.RuleActionDrop, matchAllPeer))
+					policy.AppliedToGroups = []string{appliedToGroupA.Name}
+					return policy
+				}(),
+				acnpAllowC,
+			},
+		},
+		{
+			name: "rule not covered by scheduled rule",
+			policies: []*antreatypes.NetworkPolicy{
+				func() *antreatypes.NetworkPolicy {
+					policy := analyzerACNP("acnp-drop-all", 100, 1, analyzerRule("drop-all", 0, crdv1beta1.RuleActionDrop, matchAllPeer))
+					policy.ActiveScheduledRules = []string{"drop-all"}
+					return policy
+				}(),
+				acnpAllowC,
+			},
+		},
+		{
+			name: "rule not covered by audited Drop rule",
+			policies: []*antreatypes.NetworkPolicy{
+				func() *antreatypes.NetworkPolicy {
+					policy := analyzerACNP("acnp-drop-all", 100, 1, analyzerRule("drop-all", 0, crdv1beta1.RuleActionDrop, matchAllPeer))
+					policy.EnforcementMode = crdv1beta1.EnforcementModeAudit
+					return policy
+				}(),
+				acnpAllowC,
+			},
+		},
+		{
+			name:     "audited Drop rule shadowed by Allow rule",
+			policies: []*antreatypes.NetworkPolicy{acnpAllowAC, acnpAuditDropC},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type:        controlplane.NetworkPolicyAnalysisFindingShadowed,
+				Rule:        ruleReference(acnpAuditDropC, 0),
+				RelatedRule: ruleReference(acnpAllowAC, 0),
+			}},
+		},
+		{
+			name:     "rule with same priority not conflicting with audited Drop rule",
+			policies: []*antreatypes.NetworkPolicy{acnpAllowAC, acnpAuditDropCSamePriority},
+		},
+		{
+			name:     "K8s NetworkPolicy rule not shadowed by Pass rule",
+			policies: []*antreatypes.NetworkPolicy{acnpPassAll, k8sNPAllowC},
+		},
+		{
+			name:     "Antrea-native rule shadowed by Pass rule",
+			policies: []*antreatypes.NetworkPolicy{acnpPassAll, acnpAllowC},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type:        controlplane.NetworkPolicyAnalysisFindingShadowed,
+				Rule:        ruleReference(acnpAllowC, 0),
+				RelatedRule: ruleReference(acnpPassAll, 0),
+			}},
+		},
+		{
+			name:     "conflicting rules with same priority",
+			policies: []*antreatypes.NetworkPolicy{acnpAllowAC, acnpDropCSamePriority},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type:        controlplane.NetworkPolicyAnalysisFindingConflicting,
+				Rule:        ruleReference(acnpDropCSamePriority, 0),
+				RelatedRule: ruleReference(acnpAllowAC, 0),
+			}},
+		},
+		{
+			name: "non-overlapping rules with same priority",
+			policies: []*antreatypes.NetworkPolicy{
+				acnpDropC,
+				analyzerACNP("acnp-allow-c", 100, 1, analyzerRule("allow-c", 0, crdv1beta1.RuleActionAllow, peerC, tcpService(8080, nil))),
+			},
+		},
+		{
+			name:     "redundant K8s NetworkPolicy rules",
+			policies: []*antreatypes.NetworkPolicy{k8sNPAllowAC, k8sNPAllowC},
+			expectedFindings: []controlplane.NetworkPolicyAnalysisFinding{{
+				Type:        controlplane.NetworkPolicyAnalysisFindingRedundant,
+				Rule:        ruleReference(k8sNPAllowC, 0),
+				RelatedRule: ruleReference(k8sNPAllowAC, 0),
+			}},
+		},
+		{
+			name: "rule not applied to any Pod",
+			policies: []*antreatypes.NetworkPolicy{
+				acnpDropAll,
+				func() *antreatypes.NetworkPolicy {
+					policy := analyzerACNP("acnp-allow-c", 100, 2, analyzerRule("allow-c", 0, crdv1beta1.RuleActionAllow, peerC))
+					policy.AppliedToGroups = []string{appliedToGroupEmpty.Name}
+					return policy
+				}(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			networkPolicyStore := store.NewNetworkPolicyStore()
+			appliedToGroupStore := store.NewAppliedToGroupStore()
+			addressGroupStore := store.NewAddressGroupStore()
+			for _, group := range []*antreatypes.AppliedToGroup{appliedToGroupAB, appliedToGroupA, appliedToGroupEmpty} {
+				require.NoError(t, appliedToGroupStore.Create(group))
+			}
+			for _, group := range []*antreatypes.AddressGroup{addressGroupC, addressGroupAC} {
+				require.NoError(t, addressGroupStore.Create(group))
+			}
+			for _, policy := range tt.policies {
+				require.NoError(t, networkPolicyStore.Create(policy))
+			}
+			analyzer := &policyAnalyzer{
+				networkPolicyStore:  networkPolicyStore,
+				appliedToGroupStore: appliedToGroupStore,
+				addressGroupStore:   addressGroupStore,
+			}
+			assert.Equal(t, tt.expectedFindings, analyzer.AnalyzeNetworkPolicies())
+		})
+	}
+}
+
+func TestIPBlockContainsBlock(t *testing.T) {
+	newIPBlock := func(cidr string, except ...string) *ipBlock {
+		_, ipNet, _ := net.ParseCIDR(cidr)
+		block := &ipBlock{cidr: ipNet}
+		for _, e := range except {
+			_, exceptNet, _ := net.ParseCIDR(e)
+			block.except = append(block.except, exceptNet)
+		}
+		return block
+	}
+	tests := []struct {
+		name     string
+		block    *ipBlock
+		other    *ipBlock
+		expected bool
+	}{
+		{name: "contained", block: newIPBlock("10.0.0.0/8"), other: newIPBlock("10.1.0.0/16"), expected: true},
+		{name: "larger", block: newIPBlock("10.1.0.0/16"), other: newIPBlock("10.0.0.0/8"), expected: false},
+		{name: "disjoint", block: newIPBlock("10.0.0.0/8"), other: newIPBlock("192.168.0.0/16"), expected: false},
+		{name: "different families", block: newIPBlock("0.0.0.0/0"), other: newIPBlock("fd00::/64"), expected: false},
+		{name: "except outside", block: newIPBlock("10.0.0.0/8", "10.2.0.0/16"), other: newIPBlock("10.1.0.0/16"), expected: true},
+		{name: "except inside", block: newIPBlock("10.0.0.0/8", "10.1.1.0/24"), other: newIPBlock("10.1.0.0/16"), expected: false},
+		{name: "except also excluded", block: newIPBlock("10.0.0.0/8", "10.1.1.0/24"), other: newIPBlock("10.1.0.0/16", "10.1.0.0/20"), expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.block.containsBlock(tt.other))
+		})
+	}
+}
+
+func TestServiceCovers(t *testing.T) {
+	tests := []struct {
+		name     string
+		service  controlplane.Service
+		other    controlplane.Service
+		expected bool
+	}{
+		{name: "same port", service: tcpService(80, nil), other: tcpService(80, nil), expected: true},
+		{name: "port in range", service: tcpService(80, ptr.To[int32](90)), other: tcpService(85, nil), expected: true},
+		{name: "range not in range", service: tcpService(80, ptr.To[int32](90)), other: tcpService(85, ptr.To[int32](95)), expected: false},
+		{name: "nil protocol is TCP", service: controlplane.Service{Port: ptr.To(intstr.FromInt32(80))}, other: tcpService(80, nil), expected: true},
+		{name: "different protocols", service: controlplane.Service{Protocol: ptr.To(controlplane.ProtocolUDP)}, other: tcpService(80, nil), expected: false},
+		{name: "all ports", service: controlplane.Service{Protocol: ptr.To(controlplane.ProtocolTCP)}, other: tcpService(80, nil), expected: true},
+		{
+			name:     "same named port",
+			service:  controlplane.Service{Port: ptr.To(intstr.FromString("http"))},
+			other:    controlplane.Service{Port: ptr.To(intstr.FromString("http"))},
+			expected: true,
+		},
+		{
+			name:     "named port and numbered port",
+			service:  controlplane.Service{Port: ptr.To(intstr.FromString("http"))},
+			other:    tcpService(80, nil),
+			expected: false,
+		},
+		{
+			name:     "source port range",
+			service:  controlplane.Service{SrcPort: ptr.To[int32](1000), SrcEndPort: ptr.To[int32](2000)},
+			other:    controlplane.Service{SrcPort: ptr.To[int32](1500)},
+			expected: true,
+		},
+		{
+			name:     "ICMP type",
+			service:  controlplane.Service{Protocol: ptr.To(controlplane.ProtocolICMP), ICMPType: ptr.To[int32](8)},
+			other:    controlplane.Service{Protocol: ptr.To(controlplane.ProtocolICMP), ICMPType: ptr.To[int32](8), ICMPCode: ptr.To[int32](0)},
+			expected: true,
+		},
+		{
+			name:     "ICMP all types",
+			service:  controlplane.Service{Protocol: ptr.To(controlplane.ProtocolICMP), ICMPType: ptr.To[int32](8)},
+			other:    controlplane.Service{Protocol: ptr.To(controlplane.ProtocolICMP)},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, serviceCovers(&tt.service, &tt.other))
+		})
+	}
+}
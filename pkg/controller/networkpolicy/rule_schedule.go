@@ -0,0 +1,156 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+const (
+	// ruleScheduleSyncPeriod is the interval at which the schedules of Antrea-native policy rules are evaluated.
+	// As schedules have a granularity of one minute, a rule is realized or withdrawn at most ruleScheduleSyncPeriod
+	// after the boundary of its time window.
+	ruleScheduleSyncPeriod = 10 * time.Second
+	ruleScheduleTimeLayout = "15:04"
+)
+
+// parseRuleScheduleTime parses a time of day in the "HH:MM" format and returns the number of minutes since midnight.
+func parseRuleScheduleTime(value string) (int, error) {
+	t, err := time.Parse(ruleScheduleTimeLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, it must be in the HH:MM format", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// validateRuleSchedule returns an error if the start or the end of the schedule is invalid, or if they are equal.
+func validateRuleSchedule(schedule *crdv1beta1.RuleSchedule) error {
+	start, err := parseRuleScheduleTime(schedule.Start)
+	if err != nil {
+		return err
+	}
+	end, err := parseRuleScheduleTime(schedule.End)
+	if err != nil {
+		return err
+	}
+	if start == end {
+		return fmt.Errorf("start and end of the schedule must be different")
+	}
+	return nil
+}
+
+// isRuleScheduleActive returns whether the given time is within the time window of the schedule. A nil schedule is
+// always active. An invalid schedule, which should have been rejected by the validation webhook, is considered
+// active as well, so that the rule is not ignored silently.
+func isRuleScheduleActive(schedule *crdv1beta1.RuleSchedule, now time.Time) bool {
+	if schedule == nil {
+		return true
+	}
+	start, err := parseRuleScheduleTime(schedule.Start)
+	if err != nil {
+		return true
+	}
+	end, err := parseRuleScheduleTime(schedule.End)
+	if err != nil {
+		return true
+	}
+	now = now.UTC()
+	current := now.Hour()*60 + now.Minute()
+	if start <= end {
+		return current >= start && current < end
+	}
+	// The time window spans midnight.
+	return current >= start || current < end
+}
+
+// getScheduledRuleNames returns the names of the rules which have a schedule, split by whether the schedule is
+// active at the given time.
+func getScheduledRuleNames(now time.Time, ruleLists ...[]crdv1beta1.Rule) (active, inactive []string) {
+	for _, rules := range ruleLists {
+		for i := range rules {
+			if rules[i].Schedule == nil {
+				continue
+			}
+			if isRuleScheduleActive(rules[i].Schedule, now) {
+				active = append(active, rules[i].Name)
+			} else {
+				inactive = append(inactive, rules[i].Name)
+			}
+		}
+	}
+	return active, inactive
+}
+
+// syncRuleSchedules enqueues the Antrea-native policies of which some scheduled rules have become active or inactive
+// since they were last processed, so that the internal NetworkPolicies are recomputed.
+func (n *NetworkPolicyController) syncRuleSchedules() {
+	now := n.clock.Now()
+	syncPolicy := func(key *controlplane.NetworkPolicyReference, ingress, egress []crdv1beta1.Rule) {
+		active, inactive := getScheduledRuleNames(now, ingress, egress)
+		if len(active) == 0 && len(inactive) == 0 {
+			return
+		}
+		obj, exists, _ := n.internalNetworkPolicyStore.Get(string(key.UID))
+		// If the policy has not been processed yet, its rules will be evaluated when it is.
+		if !exists {
+			return
+		}
+		if !slices.Equal(obj.(*antreatypes.NetworkPolicy).InactiveScheduledRules, inactive) {
+			klog.V(2).InfoS("Rule schedules changed, re-processing policy", "policy", key.ToString(), "inactiveRules", inactive)
+			n.enqueueInternalNetworkPolicy(key)
+		}
+	}
+	acnps, _ := n.acnpLister.List(labels.Everything())
+	for _, acnp := range acnps {
+		syncPolicy(getACNPReference(acnp), acnp.Spec.Ingress, acnp.Spec.Egress)
+	}
+	annps, _ := n.annpLister.List(labels.Everything())
+	for _, annp := range annps {
+		syncPolicy(getANNPReference(annp), annp.Spec.Ingress, annp.Spec.Egress)
+	}
+}
+
+// generateRuleScheduleConditions generates the ScheduledRulesActive condition of the given internal NetworkPolicy.
+// No condition is generated if none of its rules has a schedule.
+func generateRuleScheduleConditions(internalNP *antreatypes.NetworkPolicy) []crdv1beta1.NetworkPolicyCondition {
+	if len(internalNP.ActiveScheduledRules) == 0 && len(internalNP.InactiveScheduledRules) == 0 {
+		return nil
+	}
+	if len(internalNP.InactiveScheduledRules) == 0 {
+		return []crdv1beta1.NetworkPolicyCondition{{
+			Type:               crdv1beta1.NetworkPolicyConditionScheduledRulesActive,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+		}}
+	}
+	return []crdv1beta1.NetworkPolicyCondition{{
+		Type:               crdv1beta1.NetworkPolicyConditionScheduledRulesActive,
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "RulesOutOfSchedule",
+		Message:            fmt.Sprintf("Rules not enforced outside of their schedule: %s", strings.Join(internalNP.InactiveScheduledRules, ", ")),
+	}}
+}
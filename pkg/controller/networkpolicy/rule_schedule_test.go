@@ -0,0 +1,141 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func TestIsRuleScheduleActive(t *testing.T) {
+	at := func(hour, min int) time.Time {
+		return time.Date(2026, 1, 1, hour, min, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		schedule *crdv1beta1.RuleSchedule
+		now      time.Time
+		expected bool
+	}{
+		{"no-schedule", nil, at(10, 0), true},
+		{"before-window", &crdv1beta1.RuleSchedule{Start: "02:00", End: "04:00"}, at(1, 59), false},
+		{"start-of-window", &crdv1beta1.RuleSchedule{Start: "02:00", End: "04:00"}, at(2, 0), true},
+		{"end-of-window", &crdv1beta1.RuleSchedule{Start: "02:00", End: "04:00"}, at(4, 0), false},
+		{"overnight-window-before-midnight", &crdv1beta1.RuleSchedule{Start: "22:00", End: "02:00"}, at(23, 30), true},
+		{"overnight-window-after-midnight", &crdv1beta1.RuleSchedule{Start: "22:00", End: "02:00"}, at(1, 30), true},
+		{"overnight-window-outside", &crdv1beta1.RuleSchedule{Start: "22:00", End: "02:00"}, at(12, 0), false},
+		{"non-UTC-time", &crdv1beta1.RuleSchedule{Start: "02:00", End: "04:00"}, at(3, 0).In(time.FixedZone("UTC+8", 8*3600)), true},
+		{"invalid-schedule", &crdv1beta1.RuleSchedule{Start: "2am", End: "04:00"}, at(12, 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isRuleScheduleActive(tt.schedule, tt.now))
+		})
+	}
+}
+
+func TestValidateRuleSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		schedule    *crdv1beta1.RuleSchedule
+		expectedErr string
+	}{
+		{"valid", &crdv1beta1.RuleSchedule{Start: "02:00", End: "04:00"}, ""},
+		{"valid-overnight", &crdv1beta1.RuleSchedule{Start: "23:00", End: "01:00"}, ""},
+		{"invalid-start", &crdv1beta1.RuleSchedule{Start: "24:00", End: "01:00"}, `invalid time "24:00", it must be in the HH:MM format`},
+		{"invalid-end", &crdv1beta1.RuleSchedule{Start: "23:00", End: "1:00pm"}, `invalid time "1:00pm", it must be in the HH:MM format`},
+		{"empty-window", &crdv1beta1.RuleSchedule{Start: "02:00", End: "02:00"}, "start and end of the schedule must be different"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRuleSchedule(tt.schedule)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestSyncRuleSchedules(t *testing.T) {
+	_, npc := newController(nil, nil)
+	fakeClock := clocktesting.NewFakeClock(time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC))
+	npc.clock = fakeClock
+
+	annp := getANNP()
+	annp.UID = "uid-annp"
+	annp.Spec.Ingress[0].Name = "allow-ssh"
+	annp.Spec.Ingress[0].Schedule = &crdv1beta1.RuleSchedule{Start: "02:00", End: "04:00"}
+	annp.Spec.Egress[0].Name = "egress"
+	npc.annpStore.Add(annp)
+
+	getInternalNP := func() *antreatypes.NetworkPolicy {
+		obj, exists, _ := npc.internalNetworkPolicyStore.Get(string(annp.UID))
+		require.True(t, exists)
+		return obj.(*antreatypes.NetworkPolicy)
+	}
+	getRuleNames := func(np *antreatypes.NetworkPolicy) []string {
+		var names []string
+		for _, rule := range np.Rules {
+			names = append(names, rule.Name)
+		}
+		return names
+	}
+
+	require.NoError(t, npc.syncInternalNetworkPolicy(getANNPReference(annp)))
+	internalNP := getInternalNP()
+	assert.Equal(t, []string{"egress"}, getRuleNames(internalNP))
+	assert.Empty(t, internalNP.ActiveScheduledRules)
+	assert.Equal(t, []string{"allow-ssh"}, internalNP.InactiveScheduledRules)
+	conditions := generateRuleScheduleConditions(internalNP)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, conditions[0].Status)
+	assert.Equal(t, "Rules not enforced outside of their schedule: allow-ssh", conditions[0].Message)
+
+	// Nothing changes before the time window.
+	fakeClock.SetTime(time.Date(2026, 1, 1, 1, 59, 0, 0, time.UTC))
+	npc.syncRuleSchedules()
+	assert.Equal(t, 0, npc.internalNetworkPolicyQueue.Len())
+
+	// The policy is re-processed when the time window starts.
+	fakeClock.SetTime(time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC))
+	npc.syncRuleSchedules()
+	require.Equal(t, 1, npc.internalNetworkPolicyQueue.Len())
+	key, _ := npc.internalNetworkPolicyQueue.Get()
+	assert.Equal(t, *getANNPReference(annp), key)
+	npc.internalNetworkPolicyQueue.Done(key)
+
+	require.NoError(t, npc.syncInternalNetworkPolicy(&key))
+	internalNP = getInternalNP()
+	assert.Equal(t, []string{"allow-ssh", "egress"}, getRuleNames(internalNP))
+	assert.Equal(t, []string{"allow-ssh"}, internalNP.ActiveScheduledRules)
+	assert.Empty(t, internalNP.InactiveScheduledRules)
+	conditions = generateRuleScheduleConditions(internalNP)
+	require.Len(t, conditions, 1)
+	assert.Equal(t, crdv1beta1.NetworkPolicyConditionScheduledRulesActive, conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+
+	npc.syncRuleSchedules()
+	assert.Equal(t, 0, npc.internalNetworkPolicyQueue.Len())
+}
@@ -291,6 +291,7 @@ func (c *StatusController) syncHandler(key string) error {
 	}
 
 	conditions := GenerateNetworkPolicyCondition(internalNP.SyncError)
+	conditions = append(conditions, generateRuleScheduleConditions(internalNP)...)
 	// It means the NetworkPolicy has been processed, and marked as unrealizable. It will enter unrealizable phase
 	// instead of being further realized. Antrea-agents will not process further.
 	if internalNP.SyncError != nil {
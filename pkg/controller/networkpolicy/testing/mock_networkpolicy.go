@@ -14,11 +14,11 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier,PolicyRuleQuerier)
+// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier,PolicyAnalyzer,PolicyRuleQuerier)
 //
 // Generated by this command:
 //
-//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/controller/networkpolicy/testing/mock_networkpolicy.go -package testing antrea.io/antrea/pkg/controller/networkpolicy EndpointQuerier,PolicyRuleQuerier
+//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/controller/networkpolicy/testing/mock_networkpolicy.go -package testing antrea.io/antrea/pkg/controller/networkpolicy EndpointQuerier,PolicyAnalyzer,PolicyRuleQuerier
 //
 
 // Package testing is a generated GoMock package.
@@ -71,6 +71,44 @@ func (mr *MockEndpointQuerierMockRecorder) QueryNetworkPolicyRules(namespace, po
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryNetworkPolicyRules", reflect.TypeOf((*MockEndpointQuerier)(nil).QueryNetworkPolicyRules), namespace, podName)
 }
 
+// MockPolicyAnalyzer is a mock of PolicyAnalyzer interface.
+type MockPolicyAnalyzer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyAnalyzerMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyAnalyzerMockRecorder is the mock recorder for MockPolicyAnalyzer.
+type MockPolicyAnalyzerMockRecorder struct {
+	mock *MockPolicyAnalyzer
+}
+
+// NewMockPolicyAnalyzer creates a new mock instance.
+func NewMockPolicyAnalyzer(ctrl *gomock.Controller) *MockPolicyAnalyzer {
+	mock := &MockPolicyAnalyzer{ctrl: ctrl}
+	mock.recorder = &MockPolicyAnalyzerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyAnalyzer) EXPECT() *MockPolicyAnalyzerMockRecorder {
+	return m.recorder
+}
+
+// AnalyzeNetworkPolicies mocks base method.
+func (m *MockPolicyAnalyzer) AnalyzeNetworkPolicies() []controlplane.NetworkPolicyAnalysisFinding {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeNetworkPolicies")
+	ret0, _ := ret[0].([]controlplane.NetworkPolicyAnalysisFinding)
+	return ret0
+}
+
+// AnalyzeNetworkPolicies indicates an expected call of AnalyzeNetworkPolicies.
+func (mr *MockPolicyAnalyzerMockRecorder) AnalyzeNetworkPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeNetworkPolicies", reflect.TypeOf((*MockPolicyAnalyzer)(nil).AnalyzeNetworkPolicies))
+}
+
 // MockPolicyRuleQuerier is a mock of PolicyRuleQuerier interface.
 type MockPolicyRuleQuerier struct {
 	ctrl     *gomock.Controller
@@ -17,6 +17,7 @@ package networkpolicy
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net"
 	"reflect"
 	"regexp"
@@ -26,6 +27,7 @@ import (
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -86,6 +88,17 @@ var (
 	// allowedFQDNChars validates that the matchPattern field contains only valid DNS characters
 	// and the wildcard '*' character.
 	allowedFQDNChars = regexp.MustCompile("^[-0-9a-zA-Z.*]+$")
+	// allowedHTTPHeaderNameChars validates that the name of an HTTP header matcher is a valid
+	// HTTP token as defined in RFC 9110.
+	allowedHTTPHeaderNameChars = regexp.MustCompile("^[-!#$%&'*+.^_`|~0-9a-zA-Z]+$")
+	// allowedHTTPHeaderValueChars validates that the value of an HTTP header matcher contains
+	// only printable characters which do not need to be escaped in the L7 engine rules.
+	allowedHTTPHeaderValueChars = regexp.MustCompile(`^[^"\\;|\x00-\x1f\x7f]*$`)
+	// allowedGRPCServiceChars validates that the service of a gRPC matcher is a fully-qualified
+	// protobuf service name.
+	allowedGRPCServiceChars = regexp.MustCompile(`^[a-zA-Z_][0-9a-zA-Z_]*(\.[a-zA-Z_][0-9a-zA-Z_]*)*$`)
+	// allowedGRPCMethodChars validates that the method of a gRPC matcher is a protobuf identifier.
+	allowedGRPCMethodChars = regexp.MustCompile(`^[a-zA-Z_][0-9a-zA-Z_]*$`)
 )
 
 // RegisterAntreaPolicyValidator registers an Antrea-native policy validator
@@ -578,6 +591,18 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	if !allowed {
 		return warnings, reason, allowed
 	}
+	reason, allowed = v.validateRuleSchedules(ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
+	reason, allowed = v.validateRateLimits(specAppliedTo, ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
+	reason, allowed = v.validateLogSamplings(specAppliedTo, ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return warnings, err.Error(), false
 	}
@@ -947,20 +972,65 @@ func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []
 		if len(r.ToServices) != 0 {
 			return "layer 7 protocols can not be used with toServices", false
 		}
-		haveHTTP := false
+		// tcpOnlyProtocol is the name of the first layer 7 protocol of the rule which
+		// can only be carried over TCP, if any.
+		tcpOnlyProtocol := ""
+		haveDNS := false
 		for _, p := range r.L7Protocols {
+			if p.DNS != nil {
+				haveDNS = true
+				if p.DNS.QueryName != "" && !allowedFQDNChars.MatchString(p.DNS.QueryName) {
+					return fmt.Sprintf("invalid characters in DNS query name: %s", p.DNS.QueryName), false
+				}
+			}
 			if p.HTTP != nil {
-				haveHTTP = true
+				if tcpOnlyProtocol == "" {
+					tcpOnlyProtocol = "HTTP"
+				}
+				for _, header := range p.HTTP.Headers {
+					if !allowedHTTPHeaderNameChars.MatchString(header.Name) {
+						return fmt.Sprintf("invalid HTTP header name: %q", header.Name), false
+					}
+					if !allowedHTTPHeaderValueChars.MatchString(header.Value) {
+						return fmt.Sprintf("invalid characters in value of HTTP header %s", header.Name), false
+					}
+				}
+			}
+			if p.GRPC != nil {
+				if tcpOnlyProtocol == "" {
+					tcpOnlyProtocol = "gRPC"
+				}
+				if p.GRPC.Service != "" && !allowedGRPCServiceChars.MatchString(p.GRPC.Service) {
+					return fmt.Sprintf("invalid gRPC service: %q", p.GRPC.Service), false
+				}
+				if p.GRPC.Method != "" && !allowedGRPCMethodChars.MatchString(p.GRPC.Method) {
+					return fmt.Sprintf("invalid gRPC method: %q", p.GRPC.Method), false
+				}
+			}
+		}
+		if haveDNS {
+			for _, port := range r.Ports {
+				if port.Protocol != nil && *port.Protocol != v1.ProtocolTCP && *port.Protocol != v1.ProtocolUDP {
+					return "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset", false
+				}
+			}
+			for _, protocol := range r.Protocols {
+				if protocol.IGMP != nil || protocol.ICMP != nil {
+					return "DNS protocol can not be used with protocol IGMP or ICMP", false
+				}
 			}
 		}
+		if tcpOnlyProtocol == "" {
+			continue
+		}
 		for _, port := range r.Ports {
-			if haveHTTP && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
-				return "HTTP protocol can only be used when layer 4 protocol is TCP or unset", false
+			if port.Protocol != nil && *port.Protocol != v1.ProtocolTCP {
+				return fmt.Sprintf("%s protocol can only be used when layer 4 protocol is TCP or unset", tcpOnlyProtocol), false
 			}
 		}
 		for _, protocol := range r.Protocols {
-			if haveHTTP && (protocol.IGMP != nil || protocol.ICMP != nil) {
-				return "HTTP protocol can not be used with protocol IGMP or ICMP", false
+			if protocol.IGMP != nil || protocol.ICMP != nil {
+				return fmt.Sprintf("%s protocol can not be used with protocol IGMP or ICMP", tcpOnlyProtocol), false
 			}
 		}
 	}
@@ -979,6 +1049,143 @@ func (v *antreaPolicyValidator) validateFQDNSelectors(egressRules []crdv1beta1.R
 	return "", true
 }
 
+// validateRuleSchedules validates the schedule field set in Antrea-native policy rules.
+func (v *antreaPolicyValidator) validateRuleSchedules(ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		if r.Schedule == nil {
+			continue
+		}
+		if err := validateRuleSchedule(r.Schedule); err != nil {
+			return fmt.Sprintf("invalid schedule in rule %s: %v", r.Name, err), false
+		}
+	}
+	return "", true
+}
+
+// validateRateLimits validates the rateLimit field set in Antrea-native policy rules. It must be set
+// if and only if the action of the rule is RateLimit, and the action is only supported for unicast
+// traffic of workloads, as it is realized with OVS meters.
+func (v *antreaPolicyValidator) validateRateLimits(specAppliedTo []crdv1beta1.AppliedTo, ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		if *r.Action != crdv1beta1.RuleActionRateLimit {
+			if r.RateLimit != nil {
+				return fmt.Sprintf("rateLimit can only be set when action is RateLimit, rule %s", r.Name), false
+			}
+			continue
+		}
+		if r.RateLimit == nil {
+			return fmt.Sprintf("rateLimit must be set when action is RateLimit, rule %s", r.Name), false
+		}
+		if appliedToSelectsNodes(specAppliedTo) || appliedToSelectsNodes(r.AppliedTo) {
+			return "action RateLimit is not supported in policies applied to Nodes", false
+		}
+		for _, protocol := range r.Protocols {
+			if protocol.IGMP != nil {
+				return "protocol IGMP does not support RateLimit", false
+			}
+		}
+		for _, to := range r.To {
+			if to.IPBlock == nil {
+				continue
+			}
+			if toIPAddr, _, err := net.ParseCIDR(to.IPBlock.CIDR); err == nil && toIPAddr.IsMulticast() {
+				return "multicast does not support action RateLimit", false
+			}
+		}
+		if err := validateRateLimit(r.RateLimit); err != nil {
+			return fmt.Sprintf("invalid rateLimit in rule %s: %v", r.Name, err), false
+		}
+	}
+	return "", true
+}
+
+// appliedToSelectsNodes returns whether any of the appliedTo selects Nodes.
+func appliedToSelectsNodes(appliedTo []crdv1beta1.AppliedTo) bool {
+	for _, at := range appliedTo {
+		if at.NodeSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateLogSamplings validates the logSampling field set in Antrea-native policy rules. It can only be set in
+// Allow rules with logging enabled, and is only supported for unicast traffic of workloads, as the sampling is done
+// in the OVS pipeline.
+func (v *antreaPolicyValidator) validateLogSamplings(specAppliedTo []crdv1beta1.AppliedTo, ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	for _, r := range append(ingressRules, egressRules...) {
+		if r.LogSampling == nil {
+			continue
+		}
+		if !r.EnableLogging {
+			return fmt.Sprintf("logSampling can only be set when enableLogging is true, rule %s", r.Name), false
+		}
+		if *r.Action != crdv1beta1.RuleActionAllow {
+			return fmt.Sprintf("logSampling can only be set when action is Allow, rule %s", r.Name), false
+		}
+		if appliedToSelectsNodes(specAppliedTo) || appliedToSelectsNodes(r.AppliedTo) {
+			return "logSampling is not supported in policies applied to Nodes", false
+		}
+		for _, protocol := range r.Protocols {
+			if protocol.IGMP != nil {
+				return "protocol IGMP does not support logSampling", false
+			}
+		}
+		for _, to := range r.To {
+			if to.IPBlock == nil {
+				continue
+			}
+			if toIPAddr, _, err := net.ParseCIDR(to.IPBlock.CIDR); err == nil && toIPAddr.IsMulticast() {
+				return "multicast does not support logSampling", false
+			}
+		}
+		ratio, maxPerSecond := r.LogSampling.Ratio, r.LogSampling.MaxPerSecond
+		if (ratio == 0) == (maxPerSecond == 0) {
+			return fmt.Sprintf("exactly one of ratio and maxPerSecond must be set in logSampling, rule %s", r.Name), false
+		}
+		if ratio != 0 && (ratio < 2 || ratio > math.MaxUint16) {
+			return fmt.Sprintf("ratio %d in logSampling must be between 2 and %d, rule %s", ratio, math.MaxUint16, r.Name), false
+		}
+		if maxPerSecond < 0 {
+			return fmt.Sprintf("maxPerSecond %d in logSampling must be positive, rule %s", maxPerSecond, r.Name), false
+		}
+	}
+	return "", true
+}
+
+// validateRateLimit validates that the rate and burst of a RateLimit can be realized with an OVS meter, whose
+// rate and burst size are 32-bit integers, in packets or in kilobits.
+func validateRateLimit(rateLimit *crdv1beta1.RateLimit) error {
+	var minValue, maxValue int64
+	switch rateLimit.Unit {
+	case crdv1beta1.RateLimitUnitPacketsPerSecond:
+		minValue, maxValue = 1, math.MaxUint32
+	case crdv1beta1.RateLimitUnitBitsPerSecond:
+		minValue, maxValue = 1000, math.MaxUint32*1000
+	default:
+		return fmt.Errorf("unsupported unit %q", rateLimit.Unit)
+	}
+	validateQuantity := func(field, value string) error {
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: %v", field, value, err)
+		}
+		if v := q.Value(); v < minValue || v > maxValue {
+			return fmt.Errorf("%s %q must be between %d and %d for unit %s", field, value, minValue, maxValue, rateLimit.Unit)
+		}
+		return nil
+	}
+	if err := validateQuantity("rate", rateLimit.Rate); err != nil {
+		return err
+	}
+	if rateLimit.Burst != "" {
+		if err := validateQuantity("burst", rateLimit.Burst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // updateValidate validates the UPDATE events of Antrea-native policies.
 func (v *antreaPolicyValidator) updateValidate(curObj, oldObj interface{}, userInfo authenticationv1.UserInfo) ([]string, string, bool) {
 	return v.validatePolicy(curObj)
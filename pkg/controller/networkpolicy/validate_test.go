@@ -31,12 +31,13 @@ import (
 )
 
 var (
-	query       = crdv1beta1.IGMPQuery
-	report      = crdv1beta1.IGMPReportV1
-	allowAction = crdv1beta1.RuleActionAllow
-	dropAction  = crdv1beta1.RuleActionDrop
-	passAction  = crdv1beta1.RuleActionPass
-	portNum80   = int32(80)
+	query           = crdv1beta1.IGMPQuery
+	report          = crdv1beta1.IGMPReportV1
+	allowAction     = crdv1beta1.RuleActionAllow
+	dropAction      = crdv1beta1.RuleActionDrop
+	passAction      = crdv1beta1.RuleActionPass
+	rateLimitAction = crdv1beta1.RuleActionRateLimit
+	portNum80       = int32(80)
 )
 
 func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
@@ -1616,6 +1617,508 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "",
 		},
+		{
+			name: "acnp-rule-with-valid-schedule",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-schedule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:   &allowAction,
+							Name:     "allow-ssh",
+							Schedule: &crdv1beta1.RuleSchedule{Start: "22:00", End: "02:00"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rule-with-empty-schedule-window",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-schedule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:   &allowAction,
+							Name:     "allow-ssh",
+							Schedule: &crdv1beta1.RuleSchedule{Start: "02:00", End: "02:00"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid schedule in rule allow-ssh: start and end of the schedule must be different",
+		},
+		{
+			name: "acnp-rule-with-valid-rate-limit",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							Name:      "limit-http",
+							RateLimit: &crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitBitsPerSecond, Rate: "10M", Burst: "1M"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rule-with-rate-limit-in-packets",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							Name:      "limit-http",
+							RateLimit: &crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitPacketsPerSecond, Rate: "1000"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rate-limit-action-without-rate-limit",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &rateLimitAction,
+							Name:   "limit-http",
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit must be set when action is RateLimit, rule limit-http",
+		},
+		{
+			name: "acnp-rate-limit-with-allow-action",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &allowAction,
+							Name:      "limit-http",
+							RateLimit: &crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitPacketsPerSecond, Rate: "1000"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit can only be set when action is RateLimit, rule limit-http",
+		},
+		{
+			name: "acnp-rate-limit-applied-to-node",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							Name:      "limit-http",
+							RateLimit: &crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitPacketsPerSecond, Rate: "1000"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "action RateLimit is not supported in policies applied to Nodes",
+		},
+		{
+			name: "acnp-rate-limit-with-invalid-unit",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							Name:      "limit-http",
+							RateLimit: &crdv1beta1.RateLimit{Unit: "BytesPerSecond", Rate: "1000"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid rateLimit in rule limit-http: unsupported unit \"BytesPerSecond\"",
+		},
+		{
+			name: "acnp-rate-limit-with-invalid-rate",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							Name:      "limit-http",
+							RateLimit: &crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitBitsPerSecond, Rate: "10X"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid rateLimit in rule limit-http: invalid rate \"10X\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			name: "acnp-rate-limit-below-minimum",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:    &rateLimitAction,
+							Name:      "limit-http",
+							RateLimit: &crdv1beta1.RateLimit{Unit: crdv1beta1.RateLimitUnitBitsPerSecond, Rate: "10M", Burst: "100"},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid rateLimit in rule limit-http: burst \"100\" must be between 1000 and 4294967295000 for unit BitsPerSecond",
+		},
+		{
+			name: "acnp-rule-with-log-sampling-ratio",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							Name:          "allow-http",
+							EnableLogging: true,
+							LogSampling:   &crdv1beta1.LogSampling{Ratio: 100},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rule-with-log-sampling-max-per-second",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							Name:          "allow-http",
+							EnableLogging: true,
+							LogSampling:   &crdv1beta1.LogSampling{MaxPerSecond: 10},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-log-sampling-without-logging",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							Name:          "allow-http",
+							EnableLogging: false,
+							LogSampling:   &crdv1beta1.LogSampling{Ratio: 100},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "logSampling can only be set when enableLogging is true, rule allow-http",
+		},
+		{
+			name: "acnp-log-sampling-with-drop",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &dropAction,
+							Name:          "allow-http",
+							EnableLogging: true,
+							LogSampling:   &crdv1beta1.LogSampling{Ratio: 100},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "logSampling can only be set when action is Allow, rule allow-http",
+		},
+		{
+			name: "acnp-log-sampling-applied-to-node",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							Name:          "allow-http",
+							EnableLogging: true,
+							LogSampling:   &crdv1beta1.LogSampling{Ratio: 100},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "logSampling is not supported in policies applied to Nodes",
+		},
+		{
+			name: "acnp-log-sampling-with-ratio-and-max-per-second",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							Name:          "allow-http",
+							EnableLogging: true,
+							LogSampling:   &crdv1beta1.LogSampling{Ratio: 100, MaxPerSecond: 10},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "exactly one of ratio and maxPerSecond must be set in logSampling, rule allow-http",
+		},
+		{
+			name: "acnp-log-sampling-without-ratio-and-max-per-second",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							Name:          "allow-http",
+							EnableLogging: true,
+							LogSampling:   &crdv1beta1.LogSampling{},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "exactly one of ratio and maxPerSecond must be set in logSampling, rule allow-http",
+		},
+		{
+			name: "acnp-log-sampling-with-invalid-ratio",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							Name:          "allow-http",
+							EnableLogging: true,
+							LogSampling:   &crdv1beta1.LogSampling{Ratio: 1},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "ratio 1 in logSampling must be between 2 and 65535, rule allow-http",
+		},
+		{
+			name: "acnp-log-sampling-with-invalid-max-per-second",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "rule-with-log-sampling",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:        &allowAction,
+							Name:          "allow-http",
+							EnableLogging: true,
+							LogSampling:   &crdv1beta1.LogSampling{MaxPerSecond: -1},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "maxPerSecond -1 in logSampling must be positive, rule allow-http",
+		},
 		{
 			name:         "acnp-l7protocols-used-with-allow",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
@@ -1626,10 +2129,221 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Service: &crdv1beta1.NamespacedName{
-								Namespace: "foo1",
-								Name:      "bar1",
-							},
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+										Path:   "/admin",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-used-with-pass",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &passAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "layer 7 protocols only support Allow",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "HTTP protocol can only be used when layer 4 protocol is TCP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-used-with-ICMP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{},
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
+		},
+		{
+			name:         "acnp-l7protocols-used-with-toService",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host:   "test.com",
+										Method: "GET",
+									},
+								},
+							},
+							ToServices: []crdv1beta1.PeerService{
+								{
+									Name:      "foo",
+									Namespace: "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "layer 7 protocols can not be used with toServices",
+		},
+		{
+			name:         "L7NetworkPolicy-disabled",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: false},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: nil,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "layer 7 protocols can only be used when L7NetworkPolicy is enabled",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-headers",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
@@ -1638,9 +2352,9 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
 									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
-										Path:   "/admin",
+										Headers: []crdv1beta1.HTTPHeaderMatcher{
+											{Name: "X-Env", Value: "prod"},
+										},
 									},
 								},
 							},
@@ -1652,7 +2366,7 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			expectedReason: "",
 		},
 		{
-			name:         "acnp-l7protocols-used-with-pass",
+			name:         "acnp-l7protocols-HTTP-invalid-header-name",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1661,20 +2375,18 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Service: &crdv1beta1.NamespacedName{
-								Namespace: "foo1",
-								Name:      "bar1",
-							},
+							NamespaceSelector: &metav1.LabelSelector{},
 						},
 					},
 					Ingress: []crdv1beta1.Rule{
 						{
-							Action: &passAction,
+							Action: &allowAction,
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
 									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+										Headers: []crdv1beta1.HTTPHeaderMatcher{
+											{Name: "X Env", Value: "prod"},
+										},
 									},
 								},
 							},
@@ -1683,10 +2395,10 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "layer 7 protocols only support Allow",
+			expectedReason: `invalid HTTP header name: "X Env"`,
 		},
 		{
-			name:         "acnp-l7protocols-HTTP-used-with-UDP",
+			name:         "acnp-l7protocols-HTTP-invalid-header-value",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1695,25 +2407,80 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Service: &crdv1beta1.NamespacedName{
-								Namespace: "foo1",
-								Name:      "bar1",
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatcher{
+											{Name: "X-Env", Value: `prod"; pcre:"/.*/`},
+										},
+									},
+								},
 							},
 						},
 					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid characters in value of HTTP header X-Env",
+		},
+		{
+			name:         "acnp-l7protocols-gRPC",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
 					Ingress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
-							Ports: []crdv1beta1.NetworkPolicyPort{
+							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									Protocol: &k8sProtocolUDP,
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld.Greeter",
+										Method:  "SayHello",
+									},
 								},
 							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-gRPC-invalid-service",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld/Greeter",
+										Method:  "SayHello",
 									},
 								},
 							},
@@ -1722,10 +2489,10 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "HTTP protocol can only be used when layer 4 protocol is TCP or unset",
+			expectedReason: `invalid gRPC service: "helloworld/Greeter"`,
 		},
 		{
-			name:         "acnp-l7protocols-HTTP-used-with-ICMP",
+			name:         "acnp-l7protocols-gRPC-invalid-method",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1734,25 +2501,53 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							Service: &crdv1beta1.NamespacedName{
-								Namespace: "foo1",
-								Name:      "bar1",
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld.Greeter",
+										Method:  "Say.Hello",
+									},
+								},
 							},
 						},
 					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: `invalid gRPC method: "Say.Hello"`,
+		},
+		{
+			name:         "acnp-l7protocols-gRPC-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
 					Ingress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
-							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+							Ports: []crdv1beta1.NetworkPolicyPort{
 								{
-									ICMP: &crdv1beta1.ICMPProtocol{},
+									Protocol: &k8sProtocolUDP,
 								},
 							},
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld.Greeter",
+										Method:  "",
 									},
 								},
 							},
@@ -1761,10 +2556,10 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
+			expectedReason: "gRPC protocol can only be used when layer 4 protocol is TCP or unset",
 		},
 		{
-			name:         "acnp-l7protocols-used-with-toService",
+			name:         "acnp-l7protocols-DNS-used-with-UDP",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1773,26 +2568,90 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				Spec: crdv1beta1.ClusterNetworkPolicySpec{
 					AppliedTo: []crdv1beta1.AppliedTo{
 						{
-							NamespaceSelector: &metav1.LabelSelector{
-								MatchLabels: map[string]string{"foo1": "bar1"},
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName:  "*.foo.com",
+										QueryTypes: []string{"A", "AAAA"},
+									},
+								},
 							},
 						},
 					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-invalid-query-name",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
 					Egress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: &crdv1beta1.HTTPProtocol{
-										Host:   "test.com",
-										Method: "GET",
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName:  "foo.com/bar",
+										QueryTypes: []string{"A", "AAAA"},
 									},
 								},
 							},
-							ToServices: []crdv1beta1.PeerService{
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid characters in DNS query name: foo.com/bar",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-used-with-SCTP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
 								{
-									Name:      "foo",
-									Namespace: "bar",
+									Protocol: &k8sProtocolSCTP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName:  "*.foo.com",
+										QueryTypes: []string{"A", "AAAA"},
+									},
 								},
 							},
 						},
@@ -1800,11 +2659,11 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "layer 7 protocols can not be used with toServices",
+			expectedReason: "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset",
 		},
 		{
-			name:         "L7NetworkPolicy-disabled",
-			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: false},
+			name:         "acnp-l7protocols-DNS-used-with-ICMP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
 			policy: &crdv1beta1.ClusterNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "egress-rule-l7protocols",
@@ -1818,9 +2677,17 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 					Egress: []crdv1beta1.Rule{
 						{
 							Action: &allowAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{},
+								},
+							},
 							L7Protocols: []crdv1beta1.L7Protocol{
 								{
-									HTTP: nil,
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName:  "*.foo.com",
+										QueryTypes: []string{"A", "AAAA"},
+									},
 								},
 							},
 						},
@@ -1828,7 +2695,7 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "layer 7 protocols can only be used when L7NetworkPolicy is enabled",
+			expectedReason: "DNS protocol can not be used with protocol IGMP or ICMP",
 		},
 		{
 			name: "igmp-icmp-both-specified",
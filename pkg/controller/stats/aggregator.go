@@ -22,15 +22,18 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -47,6 +50,7 @@ const (
 // - pkg/apiserver/registry/stats/antreaclusternetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/antreanetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/multicastgroup.statsProvider
+// - pkg/apiserver/registry/stats/unusedrule.statsProvider
 type Aggregator struct {
 	// networkPolicyStats caches the statistics of K8s NetworkPolicies collected from the antrea-agents.
 	networkPolicyStats cache.Indexer
@@ -67,6 +71,12 @@ type Aggregator struct {
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the Antrea NetworkPolicy shared informer has been synced at least once.
 	annpListerSynced cache.InformerSynced
+	// acnpLister and annpLister are used to get the rules of Antrea-native policies, including the ones which have not
+	// matched any traffic.
+	acnpLister crdlisters.ClusterNetworkPolicyLister
+	annpLister crdlisters.NetworkPolicyLister
+	// clock is used to get the time at which stats start and rules match traffic.
+	clock clock.PassiveClock
 }
 
 // uidIndexFunc is an index function that indexes based on an object's UID.
@@ -83,6 +93,7 @@ func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInform
 		networkPolicyStats: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc}),
 		dataCh:             make(chan *controlplane.NodeStatsSummary, 1000),
 		npListerSynced:     networkPolicyInformer.Informer().HasSynced,
+		clock:              clock.RealClock{},
 	}
 	// Add handlers for NetworkPolicy events.
 	// They are the source of truth of the NetworkPolicyStats, i.e., a NetworkPolicyStats is present only if the
@@ -101,6 +112,7 @@ func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInform
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		aggregator.antreaClusterNetworkPolicyStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{uidIndex: uidIndexFunc})
 		aggregator.acnpListerSynced = acnpInformer.Informer().HasSynced
+		aggregator.acnpLister = acnpInformer.Lister()
 		acnpInformer.Informer().AddEventHandlerWithResyncPeriod(
 			cache.ResourceEventHandlerFuncs{
 				AddFunc:    aggregator.addACNP,
@@ -112,6 +124,7 @@ func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInform
 
 		aggregator.antreaNetworkPolicyStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc})
 		aggregator.annpListerSynced = annpInformer.Informer().HasSynced
+		aggregator.annpLister = annpInformer.Lister()
 		annpInformer.Informer().AddEventHandlerWithResyncPeriod(
 			cache.ResourceEventHandlerFuncs{
 				AddFunc:    aggregator.addANNP,
@@ -137,7 +150,7 @@ func (a *Aggregator) addNetworkPolicy(obj interface{}) {
 			UID:       np.UID,
 			// To indicate the duration that the stats cover, the CreationTimestamp is set to the time that the stats
 			// start, instead of the CreationTimestamp of the NetworkPolicy.
-			CreationTimestamp: metav1.Time{Time: time.Now()},
+			CreationTimestamp: metav1.Time{Time: a.clock.Now()},
 		},
 	}
 	a.networkPolicyStats.Add(stats)
@@ -177,7 +190,7 @@ func (a *Aggregator) addACNP(obj interface{}) {
 			UID:  acnp.UID,
 			// To indicate the duration that the stats covers, the CreationTimestamp is set to the time that the stats
 			// start, instead of the CreationTimestamp of the ClusterNetworkPolicy.
-			CreationTimestamp: metav1.Time{Time: time.Now()},
+			CreationTimestamp: metav1.Time{Time: a.clock.Now()},
 		},
 	}
 	a.antreaClusterNetworkPolicyStats.Add(stats)
@@ -217,7 +230,7 @@ func (a *Aggregator) addANNP(obj interface{}) {
 			UID:       annp.UID,
 			// To indicate the duration that the stats covers, the CreationTimestamp is set to the time that the stats
 			// start, instead of the CreationTimestamp of the Antrea NetworkPolicy.
-			CreationTimestamp: metav1.Time{Time: time.Now()},
+			CreationTimestamp: metav1.Time{Time: a.clock.Now()},
 		},
 	}
 	a.antreaNetworkPolicyStats.Add(stats)
@@ -340,6 +353,71 @@ func (a *Aggregator) GetNetworkPolicyStats(namespace, name string) (*statsv1alph
 	return obj.(*statsv1alpha1.NetworkPolicyStats), true
 }
 
+// ListUnusedRules returns the rules of Antrea-native policies which have not matched any traffic since the provided
+// time. The rules of a policy are only returned if its stats started before that time, otherwise it cannot be known
+// whether they matched traffic in the whole window.
+func (a *Aggregator) ListUnusedRules(since time.Time) []statsv1alpha1.UnusedRule {
+	if !features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		return nil
+	}
+	var unusedRules []statsv1alpha1.UnusedRule
+	acnps, _ := a.acnpLister.List(labels.Everything())
+	for _, acnp := range acnps {
+		obj, exists, _ := a.antreaClusterNetworkPolicyStats.GetByKey(acnp.Name)
+		if !exists {
+			continue
+		}
+		stats := obj.(*statsv1alpha1.AntreaClusterNetworkPolicyStats)
+		unusedRules = appendUnusedRules(unusedRules, controlplane.AntreaClusterNetworkPolicy, &acnp.ObjectMeta, stats.CreationTimestamp, stats.RuleTrafficStats, acnp.Spec.Ingress, acnp.Spec.Egress, since)
+	}
+	annps, _ := a.annpLister.List(labels.Everything())
+	for _, annp := range annps {
+		obj, exists, _ := a.antreaNetworkPolicyStats.GetByKey(k8s.NamespacedName(annp.Namespace, annp.Name))
+		if !exists {
+			continue
+		}
+		stats := obj.(*statsv1alpha1.AntreaNetworkPolicyStats)
+		unusedRules = appendUnusedRules(unusedRules, controlplane.AntreaNetworkPolicy, &annp.ObjectMeta, stats.CreationTimestamp, stats.RuleTrafficStats, annp.Spec.Ingress, annp.Spec.Egress, since)
+	}
+	return unusedRules
+}
+
+// appendUnusedRules appends the ingress and egress rules of a policy which have not matched any traffic since the
+// provided time to unusedRules.
+func appendUnusedRules(unusedRules []statsv1alpha1.UnusedRule, policyType controlplane.NetworkPolicyType, policyMeta *metav1.ObjectMeta, statsStart metav1.Time, ruleStats []statsv1alpha1.RuleTrafficStats, ingressRules, egressRules []crdv1beta1.Rule, since time.Time) []statsv1alpha1.UnusedRule {
+	if statsStart.Time.After(since) {
+		return unusedRules
+	}
+	lastHitTimes := make(map[string]*metav1.Time, len(ruleStats))
+	for i := range ruleStats {
+		lastHitTimes[ruleStats[i].Name] = ruleStats[i].LastHitTime
+	}
+	for _, rules := range [][]crdv1beta1.Rule{ingressRules, egressRules} {
+		for _, rule := range rules {
+			// Rules are reported by name, unnamed rules cannot be told apart.
+			if rule.Name == "" {
+				continue
+			}
+			lastHitTime := lastHitTimes[rule.Name]
+			if lastHitTime != nil && !lastHitTime.Time.Before(since) {
+				continue
+			}
+			unusedRules = append(unusedRules, statsv1alpha1.UnusedRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              fmt.Sprintf("%s.%s", policyMeta.UID, rule.Name),
+					CreationTimestamp: statsStart,
+				},
+				PolicyType:      string(policyType),
+				PolicyNamespace: policyMeta.Namespace,
+				PolicyName:      policyMeta.Name,
+				RuleName:        rule.Name,
+				LastHitTime:     lastHitTime.DeepCopy(),
+			})
+		}
+	}
+	return unusedRules
+}
+
 // Collect collects the node summary asynchronously to avoid the competition for the statsLock and to save clients
 // from pending on it.
 func (a *Aggregator) Collect(summary *controlplane.NodeStatsSummary) {
@@ -371,6 +449,7 @@ func (a *Aggregator) Run(stopCh <-chan struct{}) {
 }
 
 func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
+	now := metav1.NewTime(a.clock.Now())
 	for idx := range summary.NetworkPolicies {
 		stats := &summary.NetworkPolicies[idx]
 		// The policy might have been removed, skip processing it if missing.
@@ -422,7 +501,7 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 				if stats.TrafficStats.Bytes > 0 {
 					addUp(&curStats.TrafficStats, &stats.TrafficStats)
 				} else {
-					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats)
+					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats, now)
 				}
 				a.antreaClusterNetworkPolicyStats.Update(curStats)
 			}
@@ -439,7 +518,7 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 				if stats.TrafficStats.Bytes > 0 {
 					addUp(&curStats.TrafficStats, &stats.TrafficStats)
 				} else {
-					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats)
+					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats, now)
 				}
 				a.antreaNetworkPolicyStats.Update(curStats)
 			}
@@ -451,9 +530,17 @@ func addUp(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
 	stats.Sessions += inc.Sessions
 	stats.Packets += inc.Packets
 	stats.Bytes += inc.Bytes
+	stats.DroppedPackets += inc.DroppedPackets
 }
 
-func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *statsv1alpha1.TrafficStats, inc []statsv1alpha1.RuleTrafficStats) {
+// hasTraffic returns whether the provided stats increment indicates that traffic was matched.
+func hasTraffic(inc *statsv1alpha1.TrafficStats) bool {
+	return inc.Packets > 0 || inc.Sessions > 0
+}
+
+// addRulesUp accumulates the rule traffic stats increments to the current rule traffic stats, and sets the last hit
+// time of the rules which matched traffic to now.
+func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *statsv1alpha1.TrafficStats, inc []statsv1alpha1.RuleTrafficStats, now metav1.Time) {
 	incMap := make(map[string]*statsv1alpha1.TrafficStats)
 	for i, v := range inc {
 		incMap[v.Name] = &inc[i].TrafficStats
@@ -467,9 +554,13 @@ func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *stats
 		stats, exist := incMap[v.Name]
 		if exist {
 			(*ruleStats)[i].TrafficStats = statsv1alpha1.TrafficStats{
-				Packets:  v.TrafficStats.Packets + stats.Packets,
-				Bytes:    v.TrafficStats.Bytes + stats.Bytes,
-				Sessions: v.TrafficStats.Sessions + stats.Sessions,
+				Packets:        v.TrafficStats.Packets + stats.Packets,
+				Bytes:          v.TrafficStats.Bytes + stats.Bytes,
+				Sessions:       v.TrafficStats.Sessions + stats.Sessions,
+				DroppedPackets: v.TrafficStats.DroppedPackets + stats.DroppedPackets,
+			}
+			if hasTraffic(stats) {
+				(*ruleStats)[i].LastHitTime = now.DeepCopy()
 			}
 		}
 		delete(incMap, v.Name)
@@ -480,6 +571,9 @@ func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *stats
 			Name:         k,
 			TrafficStats: *v,
 		}
+		if hasTraffic(v) {
+			rs.LastHitTime = now.DeepCopy()
+		}
 		*ruleStats = append(*ruleStats, rs)
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -56,6 +57,8 @@ var (
 	annp2 = &crdv1beta1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "baz", UID: "uid6"},
 	}
+
+	testTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
 )
 
 // runWrapper wraps the Run method of the Aggregator and is used to avoid race conditions in tests.
@@ -132,9 +135,10 @@ func TestAggregatorCollectListGet(t *testing.T) {
 								{
 									Name: "rule3",
 									TrafficStats: statsv1alpha1.TrafficStats{
-										Bytes:    22,
-										Packets:  52,
-										Sessions: 22,
+										Bytes:          22,
+										Packets:        52,
+										Sessions:       22,
+										DroppedPackets: 2,
 									},
 								},
 							},
@@ -177,9 +181,10 @@ func TestAggregatorCollectListGet(t *testing.T) {
 								{
 									Name: "rule3",
 									TrafficStats: statsv1alpha1.TrafficStats{
-										Bytes:    20,
-										Packets:  8,
-										Sessions: 5,
+										Bytes:          20,
+										Packets:        8,
+										Sessions:       5,
+										DroppedPackets: 3,
 									},
 								},
 							},
@@ -235,9 +240,10 @@ func TestAggregatorCollectListGet(t *testing.T) {
 						Name: acnp1.Name,
 					},
 					TrafficStats: statsv1alpha1.TrafficStats{
-						Bytes:    62,
-						Packets:  65,
-						Sessions: 29,
+						Bytes:          62,
+						Packets:        65,
+						Sessions:       29,
+						DroppedPackets: 5,
 					},
 					RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
 						{
@@ -247,14 +253,17 @@ func TestAggregatorCollectListGet(t *testing.T) {
 								Packets:  5,
 								Sessions: 2,
 							},
+							LastHitTime: &testTime,
 						},
 						{
 							Name: "rule3",
 							TrafficStats: statsv1alpha1.TrafficStats{
-								Bytes:    42,
-								Packets:  60,
-								Sessions: 27,
+								Bytes:          42,
+								Packets:        60,
+								Sessions:       27,
+								DroppedPackets: 5,
 							},
+							LastHitTime: &testTime,
 						},
 					},
 				},
@@ -289,6 +298,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 								Packets:  5,
 								Sessions: 2,
 							},
+							LastHitTime: &testTime,
 						},
 						{
 							Name: "rule4",
@@ -297,6 +307,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 								Packets:  10,
 								Sessions: 5,
 							},
+							LastHitTime: &testTime,
 						},
 					},
 				},
@@ -523,6 +534,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 			crdClient := fakeversioned.NewSimpleClientset(append(tt.existingAntreaClusterNetworkPolicies, tt.existingAntreaNetworkPolicies...)...)
 			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
 			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies())
+			a.clock = clocktesting.NewFakePassiveClock(testTime.Time)
 			informerFactory.Start(stopCh)
 			crdInformerFactory.Start(stopCh)
 			expectedPolicyCount := len(tt.expectedNetworkPolicyStats) + len(tt.expectedAntreaClusterNetworkPolicyStats) + len(tt.expectedAntreaNetworkPolicyStats)
@@ -627,3 +639,101 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestListUnusedRules(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicy, true)
+
+	acnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "acnp", UID: "uid-acnp"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			Ingress: []crdv1beta1.Rule{{Name: "rule1"}, {Name: ""}},
+			Egress:  []crdv1beta1.Rule{{Name: "rule2"}},
+		},
+	}
+	annp := &crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "annp", UID: "uid-annp"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			Ingress: []crdv1beta1.Rule{{Name: "rule3"}},
+		},
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
+	crdClient := fakeversioned.NewSimpleClientset(acnp, annp)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies())
+	fakeClock := clocktesting.NewFakeClock(testTime.Time)
+	a.clock = fakeClock
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+
+	// The stats of the policies start at testTime, rule1 and rule2 match traffic 2 hours later, but the increment of
+	// rule2 is empty.
+	err := wait.PollUntilContextTimeout(context.Background(), 100*time.Millisecond, time.Second, true, func(ctx context.Context) (done bool, err error) {
+		return len(a.ListAntreaNetworkPolicyStats("")) == 1 && len(a.ListAntreaClusterNetworkPolicyStats()) == 1, nil
+	})
+	require.NoError(t, err)
+	fakeClock.Step(2 * time.Hour)
+	hitTime := metav1.NewTime(fakeClock.Now())
+	summary := &controlplane.NodeStatsSummary{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		AntreaClusterNetworkPolicies: []controlplane.NetworkPolicyStats{
+			{
+				NetworkPolicy: controlplane.NetworkPolicyReference{UID: acnp.UID},
+				RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
+					{Name: "rule1", TrafficStats: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1}},
+					{Name: "rule2"},
+				},
+			},
+		},
+	}
+	runWrapper(t, a, 2, []*controlplane.NodeStatsSummary{summary})
+
+	rule1 := statsv1alpha1.UnusedRule{
+		ObjectMeta:  metav1.ObjectMeta{Name: "uid-acnp.rule1", CreationTimestamp: testTime},
+		PolicyType:  "AntreaClusterNetworkPolicy",
+		PolicyName:  "acnp",
+		RuleName:    "rule1",
+		LastHitTime: &hitTime,
+	}
+	rule2 := statsv1alpha1.UnusedRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "uid-acnp.rule2", CreationTimestamp: testTime},
+		PolicyType: "AntreaClusterNetworkPolicy",
+		PolicyName: "acnp",
+		RuleName:   "rule2",
+	}
+	rule3 := statsv1alpha1.UnusedRule{
+		ObjectMeta:      metav1.ObjectMeta{Name: "uid-annp.rule3", CreationTimestamp: testTime},
+		PolicyType:      "AntreaNetworkPolicy",
+		PolicyNamespace: "foo",
+		PolicyName:      "annp",
+		RuleName:        "rule3",
+	}
+	tests := []struct {
+		name          string
+		since         time.Time
+		expectedRules []statsv1alpha1.UnusedRule
+	}{
+		{
+			name:          "stats started after the window",
+			since:         testTime.Add(-time.Hour),
+			expectedRules: nil,
+		},
+		{
+			name:          "rule hit in the window",
+			since:         testTime.Add(time.Hour),
+			expectedRules: []statsv1alpha1.UnusedRule{rule2, rule3},
+		},
+		{
+			name:          "rule hit before the window",
+			since:         testTime.Add(3 * time.Hour),
+			expectedRules: []statsv1alpha1.UnusedRule{rule1, rule2, rule3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tt.expectedRules, a.ListUnusedRules(tt.since))
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 // SpanMeta describes the span information of an object.
@@ -116,6 +117,13 @@ type NetworkPolicy struct {
 	// AppliedToPerRule tracks if appliedTo is set per rule basis rather than in policy spec.
 	// Must be false for K8s NetworkPolicy.
 	AppliedToPerRule bool
+	// EnforcementMode specifies whether the Drop and Reject actions of the rules are enforced.
+	// It is empty for K8s NetworkPolicy and AdminNetworkPolicy.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode
+	// ActiveScheduledRules and InactiveScheduledRules are the names of the rules which have a schedule, and which are
+	// respectively inside and outside of their time window. Inactive rules are not included in Rules.
+	ActiveScheduledRules   []string
+	InactiveScheduledRules []string
 	// SyncError is the Error encountered when syncing this NetworkPolicy.
 	SyncError error
 }
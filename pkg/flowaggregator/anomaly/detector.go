@@ -0,0 +1,194 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package anomaly implements baseline-based detectors which flag unusual traffic patterns in the
+// flow records received by the Flow Aggregator. Each detector computes a value per source Pod
+// over fixed intervals, and compares it to a baseline of the values observed for the same Pod
+// during the previous intervals.
+package anomaly
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+)
+
+const (
+	// baselineSmoothingFactor is the weight of the most recent interval in the exponentially
+	// weighted moving average used as baseline. With a value of 0.1, the baseline reflects
+	// approximately the last 20 intervals.
+	baselineSmoothingFactor = 0.1
+	// maxIdleIntervals is the number of consecutive intervals without any flow record after
+	// which a Pod is no longer tracked by a detector.
+	maxIdleIntervals = 60
+)
+
+// Alert describes an anomaly detected for a Pod.
+type Alert struct {
+	Time           time.Time `json:"time"`
+	Detector       string    `json:"detector"`
+	PodNamespace   string    `json:"podNamespace"`
+	PodName        string    `json:"podName"`
+	PodUID         string    `json:"podUID,omitempty"`
+	Value          float64   `json:"value"`
+	BaselineMean   float64   `json:"baselineMean"`
+	BaselineStdDev float64   `json:"baselineStdDev"`
+	Message        string    `json:"message"`
+}
+
+// Detector is implemented by all anomaly detectors. New detectors can be added by implementing
+// this interface and adding them to NewDetectors. Detectors are not safe for concurrent use.
+type Detector interface {
+	// Name returns the name of the detector, which is included in alerts.
+	Name() string
+	// AddRecord accounts for a flow record in the current interval.
+	AddRecord(record *flowpb.Flow)
+	// Evaluate ends the current interval, and returns an alert for each Pod for which the
+	// value observed during the interval is anomalous. Baselines are then updated with the
+	// observed values.
+	Evaluate(now time.Time) []Alert
+}
+
+// Params are the parameters shared by all baseline-based detectors.
+type Params struct {
+	Interval        time.Duration
+	WarmupIntervals int
+	Sensitivity     float64
+	MaxPods         int
+	// MinValue is the minimum value for which an alert can be raised.
+	MinValue float64
+}
+
+// baseline is an exponentially weighted moving average and variance of the values observed for
+// a Pod.
+type baseline struct {
+	mean     float64
+	variance float64
+	samples  int
+}
+
+func (b *baseline) stdDev() float64 {
+	return math.Sqrt(b.variance)
+}
+
+func (b *baseline) update(value float64) {
+	b.samples++
+	// For the first samples, the arithmetic mean is used, so that the baseline is not skewed by
+	// the first value.
+	alpha := max(baselineSmoothingFactor, 1/float64(b.samples))
+	diff := value - b.mean
+	incr := alpha * diff
+	b.mean += incr
+	b.variance = (1 - alpha) * (b.variance + diff*incr)
+}
+
+type podKey struct {
+	namespace string
+	name      string
+}
+
+type podState struct {
+	uid           string
+	baseline      baseline
+	value         float64
+	idleIntervals int
+}
+
+// podTracker accumulates a value per Pod for the current interval, and maintains the baseline of
+// each Pod. It implements the logic shared by all detectors.
+type podTracker struct {
+	name   string
+	params Params
+	pods   map[podKey]*podState
+	// describe returns the alert message for an anomalous value.
+	describe func(value float64, b *baseline) string
+	// onRemove is called when a Pod is no longer tracked, if not nil.
+	onRemove func(key podKey)
+}
+
+func newPodTracker(name string, params Params, describe func(value float64, b *baseline) string) *podTracker {
+	return &podTracker{
+		name:     name,
+		params:   params,
+		pods:     make(map[podKey]*podState),
+		describe: describe,
+	}
+}
+
+// sourcePod returns the key for the source Pod of the record. The second return value is false
+// if the source of the record is not a Pod.
+func sourcePod(record *flowpb.Flow) (podKey, bool) {
+	k8s := record.GetK8S()
+	key := podKey{namespace: k8s.GetSourcePodNamespace(), name: k8s.GetSourcePodName()}
+	return key, key.namespace != "" && key.name != ""
+}
+
+// add adds value to the current interval for the Pod. It returns false if the Pod is not
+// tracked because the maximum number of Pods has been reached.
+func (t *podTracker) add(key podKey, uid string, value float64) bool {
+	state, ok := t.pods[key]
+	if !ok {
+		if len(t.pods) >= t.params.MaxPods {
+			return false
+		}
+		state = &podState{}
+		t.pods[key] = state
+	}
+	if uid != "" {
+		state.uid = uid
+	}
+	state.value += value
+	return true
+}
+
+func (t *podTracker) evaluate(now time.Time) []Alert {
+	var alerts []Alert
+	for key, state := range t.pods {
+		value := state.value
+		b := &state.baseline
+		if b.samples >= t.params.WarmupIntervals && value >= t.params.MinValue && value > b.mean+t.params.Sensitivity*b.stdDev() {
+			alerts = append(alerts, Alert{
+				Time:           now,
+				Detector:       t.name,
+				PodNamespace:   key.namespace,
+				PodName:        key.name,
+				PodUID:         state.uid,
+				Value:          value,
+				BaselineMean:   b.mean,
+				BaselineStdDev: b.stdDev(),
+				Message:        t.describe(value, b),
+			})
+		}
+		b.update(value)
+		state.value = 0
+		if value == 0 {
+			state.idleIntervals++
+		} else {
+			state.idleIntervals = 0
+		}
+		if state.idleIntervals >= maxIdleIntervals {
+			delete(t.pods, key)
+			if t.onRemove != nil {
+				t.onRemove(key)
+			}
+		}
+	}
+	return alerts
+}
+
+func formatBaseline(b *baseline) string {
+	return fmt.Sprintf("%.1f ± %.1f", b.mean, b.stdDev())
+}
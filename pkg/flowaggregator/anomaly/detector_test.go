@@ -0,0 +1,207 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anomaly
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+var testParams = Params{
+	Interval:        time.Minute,
+	WarmupIntervals: 5,
+	Sensitivity:     3,
+	MaxPods:         10,
+	MinValue:        10,
+}
+
+func newTestRecord(srcPod string, dstIP string, dstPort uint32, flowType flowpb.FlowType, octets uint64, isNewConnection bool) *flowpb.Flow {
+	record := &flowpb.Flow{
+		Ip: &flowpb.IP{
+			Source:      netip.MustParseAddr("10.0.0.1").AsSlice(),
+			Destination: netip.MustParseAddr(dstIP).AsSlice(),
+		},
+		Transport: &flowpb.Transport{
+			ProtocolNumber:  6,
+			DestinationPort: dstPort,
+		},
+		K8S: &flowpb.Kubernetes{
+			FlowType:           flowType,
+			SourcePodNamespace: "ns1",
+			SourcePodName:      srcPod,
+			SourcePodUid:       "uid-" + srcPod,
+		},
+		Stats: &flowpb.Stats{PacketTotalCount: 20, PacketDeltaCount: 10, OctetDeltaCount: octets},
+	}
+	if isNewConnection {
+		record.Stats.PacketTotalCount = record.Stats.PacketDeltaCount
+	}
+	return record
+}
+
+func TestBaseline(t *testing.T) {
+	b := baseline{}
+	b.update(10)
+	assert.Equal(t, 10.0, b.mean)
+	assert.Equal(t, 0.0, b.stdDev())
+	for i := 0; i < 100; i++ {
+		b.update(10)
+	}
+	assert.InDelta(t, 10.0, b.mean, 0.001)
+	assert.InDelta(t, 0.0, b.stdDev(), 0.001)
+	b.update(20)
+	assert.InDelta(t, 11.0, b.mean, 0.001)
+	assert.InDelta(t, 3.0, b.stdDev(), 0.001)
+	assert.Equal(t, 102, b.samples)
+
+	// The arithmetic mean and variance are used for the first samples.
+	b = baseline{}
+	for _, v := range []float64{2, 4, 6} {
+		b.update(v)
+	}
+	assert.InDelta(t, 4.0, b.mean, 0.001)
+	assert.InDelta(t, 8.0/3, b.variance, 0.001)
+}
+
+func TestConnectionSpikeDetector(t *testing.T) {
+	d := NewConnectionSpikeDetector(testParams)
+	now := time.Now()
+	addConnections := func(pod string, n int) {
+		for i := 0; i < n; i++ {
+			d.AddRecord(newTestRecord(pod, "10.0.0.2", 80, flowpb.FlowType_FLOW_TYPE_INTER_NODE, 100, true))
+			// Records for existing connections are ignored.
+			d.AddRecord(newTestRecord(pod, "10.0.0.2", 80, flowpb.FlowType_FLOW_TYPE_INTER_NODE, 100, false))
+		}
+	}
+	// A spike during the warmup period does not trigger an alert.
+	addConnections("pod1", 100)
+	assert.Empty(t, d.Evaluate(now))
+	for i := 1; i < testParams.WarmupIntervals; i++ {
+		addConnections("pod1", 5)
+		addConnections("pod2", 1)
+		assert.Empty(t, d.Evaluate(now))
+	}
+	// pod2 has a stable baseline, but the number of connections is below MinValue.
+	addConnections("pod1", 200)
+	addConnections("pod2", 5)
+	alerts := d.Evaluate(now)
+	require.Len(t, alerts, 1)
+	alert := alerts[0]
+	assert.Equal(t, now, alert.Time)
+	assert.Equal(t, DetectorConnectionSpike, alert.Detector)
+	assert.Equal(t, "ns1", alert.PodNamespace)
+	assert.Equal(t, "pod1", alert.PodName)
+	assert.Equal(t, "uid-pod1", alert.PodUID)
+	assert.Equal(t, 200.0, alert.Value)
+	assert.Less(t, alert.BaselineMean, 200.0)
+	assert.Contains(t, alert.Message, "Pod opened 200 connections in 1m0s, baseline is ")
+}
+
+func TestNewDestinationsDetector(t *testing.T) {
+	d := NewNewDestinationsDetector(testParams)
+	now := time.Now()
+	for i := 0; i < testParams.WarmupIntervals; i++ {
+		// The same destinations are contacted during each interval, so they are only new
+		// during the first one.
+		for port := uint32(1); port <= 20; port++ {
+			d.AddRecord(newTestRecord("pod1", "10.0.0.2", port, flowpb.FlowType_FLOW_TYPE_INTER_NODE, 100, true))
+		}
+		assert.Empty(t, d.Evaluate(now))
+	}
+	assert.Equal(t, 4.0, d.tracker.pods[podKey{"ns1", "pod1"}].baseline.mean)
+	// Contacting the same destination multiple times only counts once.
+	for i := 0; i < 3; i++ {
+		for port := uint32(1); port <= 50; port++ {
+			d.AddRecord(newTestRecord("pod1", "10.0.0.3", port, flowpb.FlowType_FLOW_TYPE_INTER_NODE, 100, true))
+		}
+	}
+	alerts := d.Evaluate(now)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, DetectorNewDestinations, alerts[0].Detector)
+	assert.Equal(t, 50.0, alerts[0].Value)
+	// The destinations are now known.
+	d.AddRecord(newTestRecord("pod1", "10.0.0.3", 1, flowpb.FlowType_FLOW_TYPE_INTER_NODE, 100, true))
+	assert.Empty(t, d.Evaluate(now))
+	assert.Equal(t, 0.0, d.tracker.pods[podKey{"ns1", "pod1"}].value)
+}
+
+func TestExternalEgressDetector(t *testing.T) {
+	d := NewExternalEgressDetector(testParams)
+	now := time.Now()
+	for i := 0; i < testParams.WarmupIntervals; i++ {
+		d.AddRecord(newTestRecord("pod1", "8.8.8.8", 443, flowpb.FlowType_FLOW_TYPE_TO_EXTERNAL, 1000, false))
+		assert.Empty(t, d.Evaluate(now))
+	}
+	// Traffic to Pods is ignored.
+	d.AddRecord(newTestRecord("pod1", "10.0.0.2", 443, flowpb.FlowType_FLOW_TYPE_INTER_NODE, 1000000, false))
+	assert.Empty(t, d.Evaluate(now))
+	d.AddRecord(newTestRecord("pod1", "8.8.8.8", 443, flowpb.FlowType_FLOW_TYPE_TO_EXTERNAL, 1000000, false))
+	alerts := d.Evaluate(now)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, DetectorExternalEgress, alerts[0].Detector)
+	assert.Equal(t, 1000000.0, alerts[0].Value)
+	assert.Contains(t, alerts[0].Message, "Pod sent 1000000 bytes to external destinations in 1m0s")
+}
+
+func TestPodTracker(t *testing.T) {
+	params := testParams
+	params.MaxPods = 2
+	tracker := newPodTracker("test", params, func(float64, *baseline) string { return "" })
+	var removed []podKey
+	tracker.onRemove = func(key podKey) {
+		removed = append(removed, key)
+	}
+	assert.True(t, tracker.add(podKey{"ns1", "pod1"}, "", 1))
+	assert.True(t, tracker.add(podKey{"ns1", "pod2"}, "", 1))
+	assert.False(t, tracker.add(podKey{"ns1", "pod3"}, "", 1), "Maximum number of Pods should be enforced")
+	assert.True(t, tracker.add(podKey{"ns1", "pod1"}, "", 1))
+	tracker.evaluate(time.Now())
+
+	// Pods which are idle for maxIdleIntervals are removed.
+	for i := 0; i < maxIdleIntervals; i++ {
+		tracker.add(podKey{"ns1", "pod1"}, "", 1)
+		tracker.evaluate(time.Now())
+	}
+	assert.Equal(t, []podKey{{"ns1", "pod2"}}, removed)
+	assert.Len(t, tracker.pods, 1)
+	assert.True(t, tracker.add(podKey{"ns1", "pod3"}, "", 1))
+}
+
+func TestNewDetectors(t *testing.T) {
+	opt := &options.Options{
+		Config:                   &flowaggregatorconfig.FlowAggregatorConfig{},
+		AnomalyDetectionInterval: time.Minute,
+	}
+	flowaggregatorconfig.SetConfigDefaults(opt.Config)
+	detectors := NewDetectors(opt)
+	var names []string
+	for _, d := range detectors {
+		names = append(names, d.Name())
+	}
+	assert.Equal(t, []string{DetectorNewDestinations, DetectorConnectionSpike, DetectorExternalEgress}, names)
+
+	*opt.Config.AnomalyDetection.ConnectionSpike.Enable = false
+	detectors = NewDetectors(opt)
+	require.Len(t, detectors, 2)
+	assert.Equal(t, float64(flowaggregatorconfig.DefaultAnomalyDetectionExternalEgressMin), detectors[1].(*ExternalEgressDetector).tracker.params.MinValue)
+}
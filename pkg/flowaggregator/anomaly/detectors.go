@@ -0,0 +1,180 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anomaly
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	DetectorNewDestinations = "NewDestinations"
+	DetectorConnectionSpike = "ConnectionSpike"
+	DetectorExternalEgress  = "ExternalEgress"
+
+	// maxKnownDestinations is the maximum number of destinations remembered for each Pod by
+	// the NewDestinations detector. When the limit is reached, the destinations are forgotten
+	// and learned again.
+	maxKnownDestinations = 10000
+)
+
+// isNewConnection returns true if the record is the first record exported for the connection.
+func isNewConnection(record *flowpb.Flow) bool {
+	stats := record.GetStats()
+	return stats.GetPacketDeltaCount() == stats.GetPacketTotalCount()
+}
+
+// NewDestinationsDetector counts, for each Pod, the number of distinct destinations which the
+// Pod had not contacted before. A large number of new destinations is typical of network
+// scanning.
+type NewDestinationsDetector struct {
+	tracker *podTracker
+	known   map[podKey]sets.Set[string]
+}
+
+func NewNewDestinationsDetector(params Params) *NewDestinationsDetector {
+	d := &NewDestinationsDetector{
+		known: make(map[podKey]sets.Set[string]),
+	}
+	d.tracker = newPodTracker(DetectorNewDestinations, params, func(value float64, b *baseline) string {
+		return fmt.Sprintf("Pod contacted %.0f new destinations in %v, baseline is %s", value, params.Interval, formatBaseline(b))
+	})
+	d.tracker.onRemove = func(key podKey) {
+		delete(d.known, key)
+	}
+	return d
+}
+
+func (d *NewDestinationsDetector) Name() string {
+	return DetectorNewDestinations
+}
+
+func (d *NewDestinationsDetector) AddRecord(record *flowpb.Flow) {
+	key, ok := sourcePod(record)
+	if !ok || !isNewConnection(record) {
+		return
+	}
+	destination := fmt.Sprintf("%s/%d/%d", net.IP(record.GetIp().GetDestination()), record.GetTransport().GetProtocolNumber(), record.GetTransport().GetDestinationPort())
+	known := d.known[key]
+	if known.Has(destination) {
+		return
+	}
+	if !d.tracker.add(key, record.GetK8S().GetSourcePodUid(), 1) {
+		return
+	}
+	if known == nil || known.Len() >= maxKnownDestinations {
+		known = sets.New[string]()
+		d.known[key] = known
+	}
+	known.Insert(destination)
+}
+
+func (d *NewDestinationsDetector) Evaluate(now time.Time) []Alert {
+	return d.tracker.evaluate(now)
+}
+
+// ConnectionSpikeDetector counts the number of new connections opened by each Pod.
+type ConnectionSpikeDetector struct {
+	tracker *podTracker
+}
+
+func NewConnectionSpikeDetector(params Params) *ConnectionSpikeDetector {
+	return &ConnectionSpikeDetector{
+		tracker: newPodTracker(DetectorConnectionSpike, params, func(value float64, b *baseline) string {
+			return fmt.Sprintf("Pod opened %.0f connections in %v, baseline is %s", value, params.Interval, formatBaseline(b))
+		}),
+	}
+}
+
+func (d *ConnectionSpikeDetector) Name() string {
+	return DetectorConnectionSpike
+}
+
+func (d *ConnectionSpikeDetector) AddRecord(record *flowpb.Flow) {
+	key, ok := sourcePod(record)
+	if !ok || !isNewConnection(record) {
+		return
+	}
+	d.tracker.add(key, record.GetK8S().GetSourcePodUid(), 1)
+}
+
+func (d *ConnectionSpikeDetector) Evaluate(now time.Time) []Alert {
+	return d.tracker.evaluate(now)
+}
+
+// ExternalEgressDetector counts the number of bytes sent by each Pod to destinations outside of
+// the cluster.
+type ExternalEgressDetector struct {
+	tracker *podTracker
+}
+
+func NewExternalEgressDetector(params Params) *ExternalEgressDetector {
+	return &ExternalEgressDetector{
+		tracker: newPodTracker(DetectorExternalEgress, params, func(value float64, b *baseline) string {
+			return fmt.Sprintf("Pod sent %.0f bytes to external destinations in %v, baseline is %s", value, params.Interval, formatBaseline(b))
+		}),
+	}
+}
+
+func (d *ExternalEgressDetector) Name() string {
+	return DetectorExternalEgress
+}
+
+func (d *ExternalEgressDetector) AddRecord(record *flowpb.Flow) {
+	if record.GetK8S().GetFlowType() != flowpb.FlowType_FLOW_TYPE_TO_EXTERNAL {
+		return
+	}
+	key, ok := sourcePod(record)
+	if !ok {
+		return
+	}
+	d.tracker.add(key, record.GetK8S().GetSourcePodUid(), float64(record.GetStats().GetOctetDeltaCount()))
+}
+
+func (d *ExternalEgressDetector) Evaluate(now time.Time) []Alert {
+	return d.tracker.evaluate(now)
+}
+
+// NewDetectors returns the detectors enabled in the anomaly detection configuration.
+func NewDetectors(opt *options.Options) []Detector {
+	conf := &opt.Config.AnomalyDetection
+	params := func(detectorConf *flowaggregatorconfig.AnomalyDetectorConfig) Params {
+		return Params{
+			Interval:        opt.AnomalyDetectionInterval,
+			WarmupIntervals: int(conf.WarmupIntervals),
+			Sensitivity:     float64(conf.Sensitivity),
+			MaxPods:         int(conf.MaxPods),
+			MinValue:        float64(detectorConf.MinValue),
+		}
+	}
+	var detectors []Detector
+	if *conf.NewDestinations.Enable {
+		detectors = append(detectors, NewNewDestinationsDetector(params(&conf.NewDestinations)))
+	}
+	if *conf.ConnectionSpike.Enable {
+		detectors = append(detectors, NewConnectionSpikeDetector(params(&conf.ConnectionSpike)))
+	}
+	if *conf.ExternalEgress.Enable {
+		detectors = append(detectors, NewExternalEgressDetector(params(&conf.ExternalEgress)))
+	}
+	return detectors
+}
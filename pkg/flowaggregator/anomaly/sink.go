@@ -0,0 +1,92 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anomaly
+
+import (
+	"encoding/json"
+	"io"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+// AlertSink is implemented by the destinations of alerts.
+type AlertSink interface {
+	SendAlert(alert *Alert) error
+	Close() error
+}
+
+// EventSink emits a Kubernetes Event of type Warning for the source Pod of each alert.
+type EventSink struct {
+	recorder record.EventRecorder
+}
+
+func NewEventSink(recorder record.EventRecorder) *EventSink {
+	return &EventSink{recorder: recorder}
+}
+
+// EventReason returns the reason of the Kubernetes Events emitted for the detector.
+func EventReason(detector string) string {
+	return "FlowAnomaly" + detector
+}
+
+func (s *EventSink) SendAlert(alert *Alert) error {
+	pod := &corev1.ObjectReference{
+		Kind:       "Pod",
+		APIVersion: "v1",
+		Namespace:  alert.PodNamespace,
+		Name:       alert.PodName,
+		UID:        types.UID(alert.PodUID),
+	}
+	s.recorder.Event(pod, corev1.EventTypeWarning, EventReason(alert.Detector), alert.Message)
+	return nil
+}
+
+func (s *EventSink) Close() error {
+	return nil
+}
+
+// LogSink writes alerts to a local log file, with one JSON object per line. The log file is
+// rotated based on its size.
+type LogSink struct {
+	writer  io.WriteCloser
+	encoder *json.Encoder
+}
+
+func NewLogSink(path string, maxSize int, maxBackups int) *LogSink {
+	return newLogSinkWithWriter(&lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    maxSize,
+		MaxBackups: maxBackups,
+	})
+}
+
+func newLogSinkWithWriter(writer io.WriteCloser) *LogSink {
+	return &LogSink{
+		writer:  writer,
+		encoder: json.NewEncoder(writer),
+	}
+}
+
+func (s *LogSink) SendAlert(alert *Alert) error {
+	// Alerts are rare, so they are written directly without buffering.
+	return s.encoder.Encode(alert)
+}
+
+func (s *LogSink) Close() error {
+	return s.writer.Close()
+}
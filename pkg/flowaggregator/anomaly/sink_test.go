@@ -0,0 +1,68 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anomaly
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+)
+
+var testAlert = Alert{
+	Time:           time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC),
+	Detector:       DetectorConnectionSpike,
+	PodNamespace:   "ns1",
+	PodName:        "pod1",
+	Value:          200,
+	BaselineMean:   5,
+	BaselineStdDev: 1,
+	Message:        "Pod opened 200 connections in 1m0s, baseline is 5.0 ± 1.0",
+}
+
+func TestEventSink(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	sink := NewEventSink(recorder)
+	require.NoError(t, sink.SendAlert(&testAlert))
+	require.NoError(t, sink.Close())
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Warning FlowAnomalyConnectionSpike Pod opened 200 connections in 1m0s, baseline is 5.0 ± 1.0", <-recorder.Events)
+}
+
+func TestLogSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.log")
+	sink := NewLogSink(path, 1, 1)
+	require.NoError(t, sink.SendAlert(&testAlert))
+	alert2 := testAlert
+	alert2.PodName = "pod2"
+	alert2.PodUID = "uid-pod2"
+	require.NoError(t, sink.SendAlert(&alert2))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, `{"time":"2026-10-18T09:30:00Z","detector":"ConnectionSpike","podNamespace":"ns1","podName":"pod1","value":200,"baselineMean":5,"baselineStdDev":1,"message":"Pod opened 200 connections in 1m0s, baseline is 5.0 ± 1.0"}`, lines[0])
+	var decoded Alert
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &decoded))
+	assert.Equal(t, alert2, decoded)
+}
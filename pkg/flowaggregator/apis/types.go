@@ -55,22 +55,38 @@ func (r FlowRecordsResponse) SortRows() bool {
 
 // RecordMetricsResponse is the response struct of recordmetrics command.
 type RecordMetricsResponse struct {
-	NumRecordsExported     int64 `json:"numRecordsExported,omitempty"`
-	NumRecordsReceived     int64 `json:"numRecordsReceived,omitempty"`
-	NumRecordsDropped      int64 `json:"numRecordsDropped,omitempty"`
-	NumFlows               int64 `json:"numFlows,omitempty"`
-	NumConnToCollector     int64 `json:"numConnToCollector,omitempty"`
-	WithClickHouseExporter bool  `json:"withClickHouseExporter,omitempty"`
-	WithS3Exporter         bool  `json:"withS3Exporter,omitempty"`
-	WithLogExporter        bool  `json:"withLogExporter,omitempty"`
-	WithIPFIXExporter      bool  `json:"withIPFIXExporter,omitempty"`
+	NumRecordsExported      int64 `json:"numRecordsExported,omitempty"`
+	NumRecordsReceived      int64 `json:"numRecordsReceived,omitempty"`
+	NumRecordsDropped       int64 `json:"numRecordsDropped,omitempty"`
+	NumFlows                int64 `json:"numFlows,omitempty"`
+	NumConnToCollector      int64 `json:"numConnToCollector,omitempty"`
+	WithClickHouseExporter  bool  `json:"withClickHouseExporter,omitempty"`
+	WithS3Exporter          bool  `json:"withS3Exporter,omitempty"`
+	WithLogExporter         bool  `json:"withLogExporter,omitempty"`
+	WithIPFIXExporter       bool  `json:"withIPFIXExporter,omitempty"`
+	WithKafkaExporter       bool  `json:"withKafkaExporter,omitempty"`
+	WithOTLPExporter        bool  `json:"withOTLPExporter,omitempty"`
+	WithFlowMetricsExporter bool  `json:"withFlowMetricsExporter,omitempty"`
+	// DiskQueues is indexed by exporter name.
+	DiskQueues map[string]DiskQueueMetrics `json:"diskQueues,omitempty"`
+}
+
+// DiskQueueMetrics describes the backlog of records buffered on disk for an exporter.
+type DiskQueueMetrics struct {
+	NumRecords        int64 `json:"numRecords"`
+	SizeBytes         int64 `json:"sizeBytes"`
+	NumRecordsDropped int64 `json:"numRecordsDropped"`
 }
 
 func (r RecordMetricsResponse) GetTableHeader() []string {
-	return []string{"RECORDS-EXPORTED", "RECORDS-RECEIVED", "RECORDS-DROPPED", "FLOWS", "EXPORTERS-CONNECTED", "CLICKHOUSE-EXPORTER", "S3-EXPORTER", "LOG-EXPORTER", "IPFIX-EXPORTER"}
+	return []string{"RECORDS-EXPORTED", "RECORDS-RECEIVED", "RECORDS-DROPPED", "FLOWS", "EXPORTERS-CONNECTED", "CLICKHOUSE-EXPORTER", "S3-EXPORTER", "LOG-EXPORTER", "IPFIX-EXPORTER", "KAFKA-EXPORTER", "OTLP-EXPORTER", "FLOW-METRICS-EXPORTER", "DISK-QUEUE-RECORDS"}
 }
 
 func (r RecordMetricsResponse) GetTableRow(maxColumnLength int) []string {
+	var numQueuedRecords int64
+	for _, queue := range r.DiskQueues {
+		numQueuedRecords += queue.NumRecords
+	}
 	return []string{
 		strconv.Itoa(int(r.NumRecordsExported)),
 		strconv.Itoa(int(r.NumRecordsReceived)),
@@ -81,9 +97,58 @@ func (r RecordMetricsResponse) GetTableRow(maxColumnLength int) []string {
 		strconv.FormatBool(r.WithS3Exporter),
 		strconv.FormatBool(r.WithLogExporter),
 		strconv.FormatBool(r.WithIPFIXExporter),
+		strconv.FormatBool(r.WithKafkaExporter),
+		strconv.FormatBool(r.WithOTLPExporter),
+		strconv.FormatBool(r.WithFlowMetricsExporter),
+		strconv.Itoa(int(numQueuedRecords)),
 	}
 }
 
 func (r RecordMetricsResponse) SortRows() bool {
 	return true
 }
+
+// FlowGraphNode is a node of the flow graph: a workload, a Service, or an external endpoint
+// (with Kind "External" and the IP address as Name).
+type FlowGraphNode struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// String returns the node in the "<kind>/<namespace>/<name>" format, or "<kind>/<name>" if the
+// node does not belong to a Namespace.
+func (n FlowGraphNode) String() string {
+	if n.Namespace == "" {
+		return n.Kind + "/" + n.Name
+	}
+	return n.Kind + "/" + n.Namespace + "/" + n.Name
+}
+
+// FlowGraphEdgeResponse is the response struct of flowgraph command. Each response is an edge of
+// the graph, with the traffic observed over the requested window.
+type FlowGraphEdgeResponse struct {
+	Source      FlowGraphNode `json:"source"`
+	Destination FlowGraphNode `json:"destination"`
+	Bytes       int64         `json:"bytes"`
+	Packets     int64         `json:"packets"`
+	Connections int64         `json:"connections"`
+}
+
+func (r FlowGraphEdgeResponse) GetTableHeader() []string {
+	return []string{"SOURCE", "DESTINATION", "BYTES", "PACKETS", "CONNECTIONS"}
+}
+
+func (r FlowGraphEdgeResponse) GetTableRow(maxColumnLength int) []string {
+	return []string{
+		r.Source.String(),
+		r.Destination.String(),
+		strconv.FormatInt(r.Bytes, 10),
+		strconv.FormatInt(r.Packets, 10),
+		strconv.FormatInt(r.Connections, 10),
+	}
+}
+
+func (r FlowGraphEdgeResponse) SortRows() bool {
+	return true
+}
@@ -32,6 +32,7 @@ import (
 	"antrea.io/antrea/pkg/apis"
 	systeminstall "antrea.io/antrea/pkg/apis/system/install"
 	"antrea.io/antrea/pkg/apiserver/handlers/loglevel"
+	"antrea.io/antrea/pkg/flowaggregator/apiserver/handlers/flowgraph"
 	"antrea.io/antrea/pkg/flowaggregator/apiserver/handlers/flowrecords"
 	"antrea.io/antrea/pkg/flowaggregator/apiserver/handlers/recordmetrics"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
@@ -71,6 +72,7 @@ func (s *flowAggregatorAPIServer) Run(ctx context.Context) error {
 func installHandlers(s *genericapiserver.GenericAPIServer, faq querier.FlowAggregatorQuerier) {
 	s.Handler.NonGoRestfulMux.HandleFunc("/flowrecords", flowrecords.HandleFunc(faq))
 	s.Handler.NonGoRestfulMux.HandleFunc("/recordmetrics", recordmetrics.HandleFunc(faq))
+	s.Handler.NonGoRestfulMux.HandleFunc("/flowgraph", flowgraph.HandleFunc(faq))
 	s.Handler.NonGoRestfulMux.HandleFunc("/loglevel", loglevel.HandleFunc())
 }
 
@@ -0,0 +1,127 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowgraph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"antrea.io/antrea/pkg/flowaggregator/apis"
+	"antrea.io/antrea/pkg/flowaggregator/querier"
+)
+
+const (
+	formatJSON = "json"
+	formatDOT  = "dot"
+)
+
+func nodeResponse(n querier.FlowGraphNode) apis.FlowGraphNode {
+	return apis.FlowGraphNode{Kind: n.Kind, Namespace: n.Namespace, Name: n.Name}
+}
+
+// dotQuote returns s as a quoted DOT identifier.
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// renderDOT renders the graph in the Graphviz DOT language. Each workload, Service or external
+// endpoint is a node, and each edge is labelled with the traffic observed over the window.
+func renderDOT(graph *querier.FlowGraph) []byte {
+	var b bytes.Buffer
+	b.WriteString("digraph flowgraph {\n")
+	fmt.Fprintf(&b, "  label=%s;\n", dotQuote(fmt.Sprintf("Flow graph (window: %v)", graph.Window)))
+	b.WriteString("  node [shape=box];\n")
+	nodes := make(map[apis.FlowGraphNode]bool)
+	writeNode := func(n apis.FlowGraphNode) {
+		if nodes[n] {
+			return
+		}
+		nodes[n] = true
+		label := n.Name + `\n` + n.Kind
+		if n.Namespace != "" {
+			label += " (" + n.Namespace + ")"
+		}
+		shape := ""
+		switch n.Kind {
+		case querier.FlowGraphNodeKindService:
+			shape = ", shape=ellipse"
+		case querier.FlowGraphNodeKindExternal:
+			shape = ", shape=diamond"
+		}
+		// The label is not quoted with dotQuote, as "\n" is the DOT line break.
+		fmt.Fprintf(&b, "  %s [label=\"%s\"%s];\n", dotQuote(n.String()), strings.ReplaceAll(label, `"`, `\"`), shape)
+	}
+	for _, e := range graph.Edges {
+		writeNode(nodeResponse(e.Source))
+		writeNode(nodeResponse(e.Destination))
+	}
+	for _, e := range graph.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=\"%d bytes\\n%d packets\\n%d connections\"];\n",
+			dotQuote(nodeResponse(e.Source).String()), dotQuote(nodeResponse(e.Destination).String()), e.Bytes, e.Packets, e.Connections)
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+// HandleFunc returns the function which can handle the /flowgraph API request.
+func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var window time.Duration
+		if s := r.URL.Query().Get("window"); s != "" {
+			var err error
+			window, err = time.ParseDuration(s)
+			if err != nil || window < 0 {
+				http.Error(w, "Invalid window: "+s, http.StatusBadRequest)
+				return
+			}
+		}
+		namespace := r.URL.Query().Get("namespace")
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = formatJSON
+		}
+		if format != formatJSON && format != formatDOT {
+			http.Error(w, "Unsupported format: "+format, http.StatusBadRequest)
+			return
+		}
+		graph, err := faq.GetFlowGraph(window, namespace)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if format == formatDOT {
+			w.Header().Set("Content-Type", "text/vnd.graphviz")
+			w.Write(renderDOT(graph))
+			return
+		}
+		resps := make([]apis.FlowGraphEdgeResponse, 0, len(graph.Edges))
+		for _, e := range graph.Edges {
+			resps = append(resps, apis.FlowGraphEdgeResponse{
+				Source:      nodeResponse(e.Source),
+				Destination: nodeResponse(e.Destination),
+				Bytes:       e.Bytes,
+				Packets:     e.Packets,
+				Connections: e.Connections,
+			})
+		}
+		if err := json.NewEncoder(w).Encode(resps); err != nil {
+			http.Error(w, "Failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
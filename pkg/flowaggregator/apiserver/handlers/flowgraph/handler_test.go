@@ -0,0 +1,135 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"antrea.io/antrea/pkg/flowaggregator/apis"
+	"antrea.io/antrea/pkg/flowaggregator/querier"
+	queriertest "antrea.io/antrea/pkg/flowaggregator/querier/testing"
+)
+
+var testGraph = &querier.FlowGraph{
+	Window: 5 * time.Minute,
+	Edges: []querier.FlowGraphEdge{
+		{
+			Source:      querier.FlowGraphNode{Kind: "Deployment", Namespace: "default", Name: "client"},
+			Destination: querier.FlowGraphNode{Kind: "Service", Namespace: "default", Name: "web"},
+			Bytes:       1000,
+			Packets:     10,
+			Connections: 1,
+		},
+		{
+			Source:      querier.FlowGraphNode{Kind: "Deployment", Namespace: "default", Name: "client"},
+			Destination: querier.FlowGraphNode{Kind: "External", Name: "192.168.1.1"},
+			Bytes:       2000,
+			Packets:     20,
+			Connections: 2,
+		},
+	},
+}
+
+func TestFlowGraphQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	faq := queriertest.NewMockFlowAggregatorQuerier(ctrl)
+	faq.EXPECT().GetFlowGraph(5*time.Minute, "default").Return(testGraph, nil)
+
+	handler := HandleFunc(faq)
+	req, err := http.NewRequest(http.MethodGet, "/flowgraph?window=5m&namespace=default", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var received []apis.FlowGraphEdgeResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+	assert.Equal(t, []apis.FlowGraphEdgeResponse{
+		{
+			Source:      apis.FlowGraphNode{Kind: "Deployment", Namespace: "default", Name: "client"},
+			Destination: apis.FlowGraphNode{Kind: "Service", Namespace: "default", Name: "web"},
+			Bytes:       1000,
+			Packets:     10,
+			Connections: 1,
+		},
+		{
+			Source:      apis.FlowGraphNode{Kind: "Deployment", Namespace: "default", Name: "client"},
+			Destination: apis.FlowGraphNode{Kind: "External", Name: "192.168.1.1"},
+			Bytes:       2000,
+			Packets:     20,
+			Connections: 2,
+		},
+	}, received)
+}
+
+func TestFlowGraphQueryDOT(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	faq := queriertest.NewMockFlowAggregatorQuerier(ctrl)
+	faq.EXPECT().GetFlowGraph(time.Duration(0), "").Return(testGraph, nil)
+
+	handler := HandleFunc(faq)
+	req, err := http.NewRequest(http.MethodGet, "/flowgraph?format=dot", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/vnd.graphviz", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, `digraph flowgraph {
+  label="Flow graph (window: 5m0s)";
+  node [shape=box];
+  "Deployment/default/client" [label="client\nDeployment (default)"];
+  "Service/default/web" [label="web\nService (default)", shape=ellipse];
+  "External/192.168.1.1" [label="192.168.1.1\nExternal", shape=diamond];
+  "Deployment/default/client" -> "Service/default/web" [label="1000 bytes\n10 packets\n1 connections"];
+  "Deployment/default/client" -> "External/192.168.1.1" [label="2000 bytes\n20 packets\n2 connections"];
+}
+`, recorder.Body.String())
+}
+
+func TestFlowGraphQueryErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	faq := queriertest.NewMockFlowAggregatorQuerier(ctrl)
+
+	for _, tc := range []struct {
+		query        string
+		expectedCode int
+	}{
+		{query: "window=foo", expectedCode: http.StatusBadRequest},
+		{query: "window=-5m", expectedCode: http.StatusBadRequest},
+		{query: "format=yaml", expectedCode: http.StatusBadRequest},
+		{query: "", expectedCode: http.StatusNotFound},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			if tc.expectedCode == http.StatusNotFound {
+				faq.EXPECT().GetFlowGraph(time.Duration(0), "").Return(nil, fmt.Errorf("flow graph is not enabled"))
+			}
+			handler := HandleFunc(faq)
+			req, err := http.NewRequest(http.MethodGet, "/flowgraph?"+tc.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+		})
+	}
+}
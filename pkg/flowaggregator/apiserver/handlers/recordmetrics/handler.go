@@ -29,15 +29,28 @@ func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		metrics := faq.GetRecordMetrics()
 		metricsResponse := apis.RecordMetricsResponse{
-			NumRecordsExported:     metrics.NumRecordsExported,
-			NumRecordsReceived:     metrics.NumRecordsReceived,
-			NumRecordsDropped:      metrics.NumRecordsDropped,
-			NumFlows:               metrics.NumFlows,
-			NumConnToCollector:     metrics.NumConnToCollector,
-			WithClickHouseExporter: metrics.WithClickHouseExporter,
-			WithS3Exporter:         metrics.WithS3Exporter,
-			WithLogExporter:        metrics.WithLogExporter,
-			WithIPFIXExporter:      metrics.WithIPFIXExporter,
+			NumRecordsExported:      metrics.NumRecordsExported,
+			NumRecordsReceived:      metrics.NumRecordsReceived,
+			NumRecordsDropped:       metrics.NumRecordsDropped,
+			NumFlows:                metrics.NumFlows,
+			NumConnToCollector:      metrics.NumConnToCollector,
+			WithClickHouseExporter:  metrics.WithClickHouseExporter,
+			WithS3Exporter:          metrics.WithS3Exporter,
+			WithLogExporter:         metrics.WithLogExporter,
+			WithIPFIXExporter:       metrics.WithIPFIXExporter,
+			WithKafkaExporter:       metrics.WithKafkaExporter,
+			WithOTLPExporter:        metrics.WithOTLPExporter,
+			WithFlowMetricsExporter: metrics.WithFlowMetricsExporter,
+		}
+		if len(metrics.DiskQueues) > 0 {
+			metricsResponse.DiskQueues = make(map[string]apis.DiskQueueMetrics, len(metrics.DiskQueues))
+			for name, queue := range metrics.DiskQueues {
+				metricsResponse.DiskQueues[name] = apis.DiskQueueMetrics{
+					NumRecords:        queue.NumRecords,
+					SizeBytes:         queue.Size,
+					NumRecordsDropped: queue.NumRecordsDropped,
+				}
+			}
 		}
 		err := json.NewEncoder(w).Encode(metricsResponse)
 		if err != nil {
@@ -32,15 +32,22 @@ func TestRecordMetricsQuery(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	faq := queriertest.NewMockFlowAggregatorQuerier(ctrl)
 	faq.EXPECT().GetRecordMetrics().Return(querier.Metrics{
-		NumRecordsExported:     20,
-		NumRecordsReceived:     15,
-		NumRecordsDropped:      5,
-		NumFlows:               30,
-		NumConnToCollector:     1,
-		WithClickHouseExporter: true,
-		WithS3Exporter:         true,
-		WithLogExporter:        true,
-		WithIPFIXExporter:      true,
+		NumRecordsExported:      20,
+		NumRecordsReceived:      15,
+		NumRecordsDropped:       5,
+		NumFlows:                30,
+		NumConnToCollector:      1,
+		WithClickHouseExporter:  true,
+		WithS3Exporter:          true,
+		WithLogExporter:         true,
+		WithIPFIXExporter:       true,
+		WithKafkaExporter:       true,
+		WithOTLPExporter:        true,
+		WithFlowMetricsExporter: true,
+		DiskQueues: map[string]querier.DiskQueueMetrics{
+			"clickHouse": {NumRecords: 100, Size: 20000, NumRecordsDropped: 10},
+			"kafka":      {NumRecords: 50, Size: 10000},
+		},
 	})
 
 	handler := HandleFunc(faq)
@@ -54,17 +61,24 @@ func TestRecordMetricsQuery(t *testing.T) {
 	err = json.Unmarshal(recorder.Body.Bytes(), &received)
 	assert.Nil(t, err)
 	assert.Equal(t, apis.RecordMetricsResponse{
-		NumRecordsExported:     20,
-		NumRecordsReceived:     15,
-		NumRecordsDropped:      5,
-		NumFlows:               30,
-		NumConnToCollector:     1,
-		WithClickHouseExporter: true,
-		WithS3Exporter:         true,
-		WithLogExporter:        true,
-		WithIPFIXExporter:      true,
+		NumRecordsExported:      20,
+		NumRecordsReceived:      15,
+		NumRecordsDropped:       5,
+		NumFlows:                30,
+		NumConnToCollector:      1,
+		WithClickHouseExporter:  true,
+		WithS3Exporter:          true,
+		WithLogExporter:         true,
+		WithIPFIXExporter:       true,
+		WithKafkaExporter:       true,
+		WithOTLPExporter:        true,
+		WithFlowMetricsExporter: true,
+		DiskQueues: map[string]apis.DiskQueueMetrics{
+			"clickHouse": {NumRecords: 100, SizeBytes: 20000, NumRecordsDropped: 10},
+			"kafka":      {NumRecords: 50, SizeBytes: 10000},
+		},
 	}, received)
 
-	assert.Equal(t, received.GetTableRow(0), []string{"20", "15", "5", "30", "1", "true", "true", "true", "true"})
+	assert.Equal(t, received.GetTableRow(0), []string{"20", "15", "5", "30", "1", "true", "true", "true", "true", "true", "true", "true", "150"})
 
 }
@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
@@ -30,7 +31,9 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/util/env"
@@ -43,7 +46,25 @@ const (
 	CAConfigMapKey  = "ca.crt"
 	// #nosec G101: false positive triggered by variable name which includes "Secret"
 	ClientSecretName = "flow-aggregator-client-tls"
+	// The Secret storing the CA certificate, the server certificate and the client certificate,
+	// which are shared by all the Flow Aggregator replicas.
+	// #nosec G101: false positive triggered by variable name which includes "Secret"
+	ServerSecretName = "flow-aggregator-server-tls"
 	ServiceName      = "flow-aggregator"
+
+	serverSecretCACertKey     = "ca.crt"
+	serverSecretCAKeyKey      = "ca.key"
+	serverSecretCertKey       = "tls.crt"
+	serverSecretKeyKey        = "tls.key"
+	serverSecretClientCertKey = "client.crt"
+	serverSecretClientKeyKey  = "client.key"
+	// Set on the Server Secret when it is generated by the Flow Aggregator, in which case it can
+	// be regenerated when the certificates are no longer valid. A Secret provisioned by the user
+	// is never overwritten.
+	serverSecretGeneratedAnnotation = "flowaggregator.antrea.io/generated"
+
+	// Certificates expiring within this period are regenerated on startup.
+	certRenewBefore = time.Hour * 24 * 7
 )
 
 var (
@@ -155,6 +176,182 @@ func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer b
 	return certPEM.Bytes(), certKeyPEM.Bytes(), nil
 }
 
+// tlsMaterial contains the certificates and keys shared by all the Flow Aggregator replicas.
+type tlsMaterial struct {
+	caCert     []byte
+	serverCert []byte
+	serverKey  []byte
+	clientCert []byte
+	clientKey  []byte
+}
+
+func newTLSMaterial(flowAggregatorAddress string) (*tlsMaterial, []byte, error) {
+	parentCert, privateKey, caCert, err := generateCACertKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error when generating CA certificate: %w", err)
+	}
+	serverCert, serverKey, err := generateCertKey(parentCert, privateKey, true, flowAggregatorAddress)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error when creating server certificate: %w", err)
+	}
+	clientCert, clientKey, err := generateCertKey(parentCert, privateKey, false, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error when creating client certificate: %w", err)
+	}
+	caKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+	return &tlsMaterial{
+		caCert:     caCert,
+		serverCert: serverCert,
+		serverKey:  serverKey,
+		clientCert: clientCert,
+		clientKey:  clientKey,
+	}, caKey, nil
+}
+
+func tlsMaterialFromSecret(secret *v1.Secret) *tlsMaterial {
+	return &tlsMaterial{
+		caCert:     secret.Data[serverSecretCACertKey],
+		serverCert: secret.Data[serverSecretCertKey],
+		serverKey:  secret.Data[serverSecretKeyKey],
+		clientCert: secret.Data[serverSecretClientCertKey],
+		clientKey:  secret.Data[serverSecretClientKeyKey],
+	}
+}
+
+func (m *tlsMaterial) toSecretData(caKey []byte) map[string][]byte {
+	return map[string][]byte{
+		serverSecretCACertKey:     m.caCert,
+		serverSecretCAKeyKey:      caKey,
+		serverSecretCertKey:       m.serverCert,
+		serverSecretKeyKey:        m.serverKey,
+		serverSecretClientCertKey: m.clientCert,
+		serverSecretClientKeyKey:  m.clientKey,
+	}
+}
+
+// validate checks that the server and client certificates are signed by the CA, match their
+// private keys, are valid for the Flow Aggregator server names and do not expire before
+// notAfter.
+func (m *tlsMaterial) validate(flowAggregatorAddress string, notAfter time.Time) error {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(m.caCert) {
+		return fmt.Errorf("invalid CA certificate")
+	}
+	verifyPair := func(name string, certPEM, keyPEM []byte, usage x509.ExtKeyUsage) (*x509.Certificate, error) {
+		pair, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s certificate or key: %w", name, err)
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s certificate: %w", name, err)
+		}
+		if _, err := cert.Verify(x509.VerifyOptions{
+			Roots:       roots,
+			CurrentTime: notAfter,
+			KeyUsages:   []x509.ExtKeyUsage{usage},
+		}); err != nil {
+			return nil, fmt.Errorf("%s certificate cannot be verified with the CA certificate until %s: %w", name, notAfter.Format(time.RFC3339), err)
+		}
+		return cert, nil
+	}
+	serverCert, err := verifyPair("server", m.serverCert, m.serverKey, x509.ExtKeyUsageServerAuth)
+	if err != nil {
+		return err
+	}
+	serverNames := getFlowAggregatorServerNames()
+	if flowAggregatorAddress != "" {
+		serverNames = append(serverNames, flowAggregatorAddress)
+	}
+	for _, name := range serverNames {
+		if err := serverCert.VerifyHostname(name); err != nil {
+			return fmt.Errorf("server certificate is not valid for %s: %w", name, err)
+		}
+	}
+	if _, err := verifyPair("client", m.clientCert, m.clientKey, x509.ExtKeyUsageClientAuth); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getOrCreateTLSMaterial returns the certificates stored in the Server Secret, after creating the
+// Secret if it does not exist. The Secret is created (or replaced, when it was generated by the
+// Flow Aggregator and its certificates are no longer valid) with an atomic API request, so that
+// when multiple replicas are starting at the same time, exactly one of them generates the
+// certificates, and the other ones retry and use the certificates generated by that replica.
+// An error is returned if the Secret was provisioned by the user and its certificates are not
+// valid.
+func getOrCreateTLSMaterial(ctx context.Context, flowAggregatorAddress string, k8sClient kubernetes.Interface) (*tlsMaterial, error) {
+	namespace := getFlowAggregatorNamespace()
+	secretClient := k8sClient.CoreV1().Secrets(namespace)
+	var material *tlsMaterial
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: 100 * time.Millisecond, Factor: 2, Steps: 5}, func() (bool, error) {
+		secret, err := secretClient.Get(ctx, ServerSecretName, metav1.GetOptions{})
+		exists := true
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return false, fmt.Errorf("error getting Secret %s: %w", ServerSecretName, err)
+			}
+			exists = false
+		}
+		if exists {
+			existing := tlsMaterialFromSecret(secret)
+			validateErr := existing.validate(flowAggregatorAddress, time.Now().Add(certRenewBefore))
+			if validateErr == nil {
+				klog.InfoS("Using certificates from Secret", "secret", klog.KObj(secret))
+				material = existing
+				return true, nil
+			}
+			if secret.Annotations[serverSecretGeneratedAnnotation] != "true" {
+				return false, fmt.Errorf("invalid certificates in Secret %s, which was not generated by the Flow Aggregator: %w", ServerSecretName, validateErr)
+			}
+			klog.InfoS("Regenerating certificates", "secret", klog.KObj(secret), "reason", validateErr)
+		}
+		generated, caKey, err := newTLSMaterial(flowAggregatorAddress)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			secret = &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ServerSecretName,
+					Namespace: namespace,
+					Labels: map[string]string{
+						"app": "flow-aggregator",
+					},
+					Annotations: map[string]string{
+						serverSecretGeneratedAnnotation: "true",
+					},
+				},
+				Type: v1.SecretTypeOpaque,
+				Data: generated.toSecretData(caKey),
+			}
+			_, err = secretClient.Create(ctx, secret, metav1.CreateOptions{})
+		} else {
+			// The ResourceVersion of the Secret is preserved, so that the update fails if
+			// another replica has replaced the certificates in the meantime.
+			secret.Data = generated.toSecretData(caKey)
+			_, err = secretClient.Update(ctx, secret, metav1.UpdateOptions{})
+		}
+		if errors.IsAlreadyExists(err) || errors.IsConflict(err) {
+			klog.InfoS("Secret was updated by another replica, retrying", "secret", klog.KRef(namespace, ServerSecretName))
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("error writing Secret %s: %w", ServerSecretName, err)
+		}
+		material = generated
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return material, nil
+}
+
 func syncCAAndClientCert(caCert, clientCert, clientKey []byte, k8sClient kubernetes.Interface) error {
 	klog.Info("Syncing CA certificate, client certificate and client key with ConfigMap")
 	namespace := getFlowAggregatorNamespace()
@@ -177,16 +374,20 @@ func syncCAAndClientCert(caCert, clientCert, clientKey []byte, k8sClient kuberne
 			},
 		}
 	}
-	caConfigMap.Data = map[string]string{
-		CAConfigMapKey: string(caCert),
-	}
-	if exists {
-		if _, err := k8sClient.CoreV1().ConfigMaps(caConfigMapNamespace).Update(context.TODO(), caConfigMap, metav1.UpdateOptions{}); err != nil {
-			return fmt.Errorf("error updating ConfigMap %s: %v", CAConfigMapName, err)
+	// All the replicas sync the same certificates, so the ConfigMap and the Secret may
+	// already be up-to-date.
+	if !exists || caConfigMap.Data[CAConfigMapKey] != string(caCert) {
+		caConfigMap.Data = map[string]string{
+			CAConfigMapKey: string(caCert),
 		}
-	} else {
-		if _, err := k8sClient.CoreV1().ConfigMaps(caConfigMapNamespace).Create(context.TODO(), caConfigMap, metav1.CreateOptions{}); err != nil {
-			return fmt.Errorf("error creating ConfigMap %s: %v", CAConfigMapName, err)
+		if exists {
+			if _, err := k8sClient.CoreV1().ConfigMaps(caConfigMapNamespace).Update(context.TODO(), caConfigMap, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("error updating ConfigMap %s: %w", CAConfigMapName, err)
+			}
+		} else {
+			if _, err := k8sClient.CoreV1().ConfigMaps(caConfigMapNamespace).Create(context.TODO(), caConfigMap, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("error creating ConfigMap %s: %w", CAConfigMapName, err)
+			}
 		}
 	}
 
@@ -206,41 +407,41 @@ func syncCAAndClientCert(caCert, clientCert, clientKey []byte, k8sClient kuberne
 			Type: v1.SecretTypeTLS,
 		}
 	}
+	if exists && bytes.Equal(secret.Data["tls.crt"], clientCert) && bytes.Equal(secret.Data["tls.key"], clientKey) {
+		return nil
+	}
 	secret.Data = map[string][]byte{
 		"tls.crt": clientCert,
 		"tls.key": clientKey,
 	}
 	if exists {
 		if _, err := k8sClient.CoreV1().Secrets(clientSecretNamespace).Update(context.TODO(), secret, metav1.UpdateOptions{}); err != nil {
-			return fmt.Errorf("failed to update Secret %s: %v", ClientSecretName, err)
+			return fmt.Errorf("failed to update Secret %s: %w", ClientSecretName, err)
 		}
 	} else {
 		if _, err := k8sClient.CoreV1().Secrets(clientSecretNamespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
-			return fmt.Errorf("failed to create Secret %s: %v", ClientSecretName, err)
+			return fmt.Errorf("failed to create Secret %s: %w", ClientSecretName, err)
 		}
 	}
 	return nil
 }
 
-// generateCerts generates new certificates for the Flow Aggregator and the Flow Exporter (client),
-// and syncs the CA ConfigMap and the client cert Secret using the provided K8s client.
+// generateCerts gets the certificates for the Flow Aggregator and the Flow Exporter (client) from
+// the Server Secret, generating them if needed, and syncs the CA ConfigMap and the client cert
+// Secret using the provided K8s client. All the replicas use the same certificates.
 // generateCerts returns the CA certificate, the server private key and the server certificate.
 func generateCerts(flowAggregatorAddress string, k8sClient kubernetes.Interface) ([]byte, []byte, []byte, error) {
-	parentCert, privateKey, caCert, err := generateCACertKey()
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error when generating CA certificate: %w", err)
-	}
-	serverCert, serverKey, err := generateCertKey(parentCert, privateKey, true, flowAggregatorAddress)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error when creating server certificate: %w", err)
-	}
-
-	clientCert, clientKey, err := generateCertKey(parentCert, privateKey, false, "")
+	material, err := getOrCreateTLSMaterial(context.TODO(), flowAggregatorAddress, k8sClient)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error when creating client certificate: %w", err)
+		return nil, nil, nil, fmt.Errorf("error when getting certificates: %w", err)
 	}
-	if err := syncCAAndClientCert(caCert, clientCert, clientKey, k8sClient); err != nil {
+	// Other replicas may be syncing the same certificates concurrently.
+	if err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return errors.IsConflict(err) || errors.IsAlreadyExists(err)
+	}, func() error {
+		return syncCAAndClientCert(material.caCert, material.clientCert, material.clientKey, k8sClient)
+	}); err != nil {
 		return nil, nil, nil, fmt.Errorf("error when synchronizing client certificate: %w", err)
 	}
-	return caCert, serverKey, serverCert, nil
+	return material.caCert, material.serverKey, material.serverCert, nil
 }
@@ -0,0 +1,160 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package flowaggregator
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type generatedCerts struct {
+	caCert     []byte
+	serverKey  []byte
+	serverCert []byte
+}
+
+func TestGenerateCertsMultipleReplicas(t *testing.T) {
+	const numReplicas = 5
+	k8sClient := fake.NewSimpleClientset()
+	results := make([]generatedCerts, numReplicas)
+	var wg sync.WaitGroup
+	for i := range numReplicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			caCert, serverKey, serverCert, err := generateCerts("", k8sClient)
+			require.NoError(t, err)
+			results[i] = generatedCerts{caCert: caCert, serverKey: serverKey, serverCert: serverCert}
+		}()
+	}
+	wg.Wait()
+
+	for i := 1; i < numReplicas; i++ {
+		assert.Equal(t, results[0], results[i], "all replicas should use the same certificates")
+	}
+
+	ctx := context.Background()
+	caConfigMap, err := k8sClient.CoreV1().ConfigMaps(DefaultNamespace).Get(ctx, CAConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, string(results[0].caCert), caConfigMap.Data[CAConfigMapKey])
+	serverSecret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(ctx, ServerSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "true", serverSecret.Annotations[serverSecretGeneratedAnnotation])
+	clientSecret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(ctx, ClientSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	material := &tlsMaterial{
+		caCert:     []byte(caConfigMap.Data[CAConfigMapKey]),
+		serverCert: results[0].serverCert,
+		serverKey:  results[0].serverKey,
+		clientCert: clientSecret.Data["tls.crt"],
+		clientKey:  clientSecret.Data["tls.key"],
+	}
+	assert.NoError(t, material.validate("", validFrom.Add(maxAge/2)), "client certificate should be signed by the shared CA")
+
+	// A restarted replica keeps using the same certificates.
+	caCert, serverKey, serverCert, err := generateCerts("", k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, results[0], generatedCerts{caCert: caCert, serverKey: serverKey, serverCert: serverCert})
+}
+
+func TestGenerateCertsExistingSecret(t *testing.T) {
+	validMaterial, caKey, err := newTLSMaterial("")
+	require.NoError(t, err)
+	newSecret := func(data map[string][]byte, generated bool) *v1.Secret {
+		secret := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServerSecretName,
+				Namespace: DefaultNamespace,
+			},
+			Data: data,
+		}
+		if generated {
+			secret.Annotations = map[string]string{serverSecretGeneratedAnnotation: "true"}
+		}
+		return secret
+	}
+	invalidData := map[string][]byte{
+		serverSecretCACertKey: []byte("invalid"),
+	}
+
+	testCases := []struct {
+		name                  string
+		secret                *v1.Secret
+		flowAggregatorAddress string
+		expectedErr           string
+		expectRegenerated     bool
+	}{
+		{
+			name:   "valid Secret provisioned by the user",
+			secret: newSecret(validMaterial.toSecretData(nil), false),
+		},
+		{
+			name:        "invalid Secret provisioned by the user",
+			secret:      newSecret(invalidData, false),
+			expectedErr: "invalid certificates in Secret flow-aggregator-server-tls, which was not generated by the Flow Aggregator: invalid CA certificate",
+		},
+		{
+			name:                  "Secret provisioned by the user without the Flow Aggregator address",
+			secret:                newSecret(validMaterial.toSecretData(nil), false),
+			flowAggregatorAddress: "10.10.10.10",
+			expectedErr:           "server certificate is not valid for 10.10.10.10",
+		},
+		{
+			name:              "invalid generated Secret",
+			secret:            newSecret(invalidData, true),
+			expectRegenerated: true,
+		},
+		{
+			name:                  "generated Secret without the Flow Aggregator address",
+			secret:                newSecret(validMaterial.toSecretData(caKey), true),
+			flowAggregatorAddress: "flow-aggregator.example.com",
+			expectRegenerated:     true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := fake.NewSimpleClientset(tc.secret)
+			caCert, serverKey, serverCert, err := generateCerts(tc.flowAggregatorAddress, k8sClient)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				secret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(context.Background(), ServerSecretName, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, tc.secret.Data, secret.Data, "Secret provisioned by the user should not be overwritten")
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectRegenerated {
+				assert.NotEqual(t, validMaterial.caCert, caCert)
+				material := &tlsMaterial{caCert: caCert, serverCert: serverCert, serverKey: serverKey, clientCert: validMaterial.clientCert, clientKey: validMaterial.clientKey}
+				assert.ErrorContains(t, material.validate(tc.flowAggregatorAddress, validFrom.Add(maxAge/2)), "client certificate cannot be verified")
+				secret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(context.Background(), ServerSecretName, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, caCert, secret.Data[serverSecretCACertKey])
+			} else {
+				assert.Equal(t, validMaterial.caCert, caCert)
+				assert.Equal(t, validMaterial.serverKey, serverKey)
+				assert.Equal(t, validMaterial.serverCert, serverCert)
+			}
+		})
+	}
+}
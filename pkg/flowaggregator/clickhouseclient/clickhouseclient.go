@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
@@ -111,6 +112,9 @@ type ClickHouseExportProcess struct {
 	deque deque.Deque[*flowrecord.FlowRecord]
 	// dequeMutex is for concurrency between adding and removing records from deque.
 	dequeMutex sync.Mutex
+	// commitMutex serializes commits, which can be triggered both by the periodic commit
+	// goroutine and by Flush.
+	commitMutex sync.Mutex
 	// queueSize is the max size of deque
 	queueSize int
 	// stopCh is the channel to receive stop message
@@ -120,6 +124,9 @@ type ClickHouseExportProcess struct {
 	// commitTicker is a ticker, containing a channel used to trigger batchCommitAll() for every commitInterval period
 	commitTicker         *time.Ticker
 	exportProcessRunning bool
+	// commitFailed is set when the last periodic commit failed, and reset on the next
+	// successful commit.
+	commitFailed atomic.Bool
 	// mutex protects configuration state from concurrent access
 	mutex       sync.Mutex
 	clusterUUID string
@@ -239,6 +246,7 @@ func (ch *ClickHouseExportProcess) flowRecordPeriodicCommit() {
 			if err == nil {
 				committedRec += committed
 			}
+			ch.commitFailed.Store(err != nil)
 		case <-logTicker.C:
 			klog.V(4).InfoS("Total number of records committed to DB", "count", committedRec)
 			committedRec = 0
@@ -250,6 +258,31 @@ func (ch *ClickHouseExportProcess) flowRecordPeriodicCommit() {
 // Returns the number of records successfully committed, and error if encountered.
 // Cached records will be removed only after successful commit.
 func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, error) {
+	ch.commitMutex.Lock()
+	defer ch.commitMutex.Unlock()
+	return ch.commitAll(ctx)
+}
+
+// Flush synchronously commits all flow records cached in local deque, and returns after the
+// INSERT transaction has been committed. Unlike with periodic commits, cached records are dropped
+// in case of error: the caller is expected to keep its own copy of the records until Flush
+// succeeds, and to add them again before retrying.
+func (ch *ClickHouseExportProcess) Flush() error {
+	ch.commitMutex.Lock()
+	defer ch.commitMutex.Unlock()
+	ctx, cancelFn := context.WithTimeout(context.Background(), queueFlushTimeout)
+	defer cancelFn()
+	if _, err := ch.commitAll(ctx); err != nil {
+		ch.dequeMutex.Lock()
+		defer ch.dequeMutex.Unlock()
+		ch.deque.Clear()
+		return err
+	}
+	return nil
+}
+
+// commitAll implements batchCommitAll. Caller of this function should acquire commitMutex.
+func (ch *ClickHouseExportProcess) commitAll(ctx context.Context) (int, error) {
 	ch.dequeMutex.Lock()
 	currSize := ch.deque.Len()
 	ch.dequeMutex.Unlock()
@@ -266,7 +299,9 @@ func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, err
 	}
 	if err != nil {
 		klog.ErrorS(err, "Error when preparing insert statement")
-		_ = tx.Rollback()
+		if tx != nil {
+			_ = tx.Rollback()
+		}
 		return 0, err
 	}
 
@@ -355,6 +390,11 @@ func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, err
 	return len(recordsToExport), nil
 }
 
+// IsHealthy returns false if the last attempt to commit records to the database failed.
+func (ch *ClickHouseExportProcess) IsHealthy() bool {
+	return !ch.commitFailed.Load()
+}
+
 // pushRecordsToFrontOfQueue pushes records to the front of deque without exceeding its capacity.
 // Items with lower index (older records) will be dropped first if deque is to be filled.
 func (ch *ClickHouseExportProcess) pushRecordsToFrontOfQueue(records []*flowrecord.FlowRecord) {
@@ -181,13 +181,24 @@ func (p *preprocessor) processMsg(msg *entities.Message) {
 			case "egressNetworkPolicyRuleAction":
 				flow.K8S.EgressNetworkPolicyRuleAction = flowpb.NetworkPolicyRuleAction(ie.GetUnsigned8Value())
 			case "tcpState":
-				state := ie.GetStringValue()
-				if state != "" {
-					flow.Transport.Protocol = &flowpb.Transport_TCP{
-						TCP: &flowpb.TCP{
-							StateName: state,
-						},
-					}
+				if state := ie.GetStringValue(); state != "" {
+					getTCP(flow).StateName = state
+				}
+			case "tcpRoundTripTime":
+				if v := ie.GetUnsigned32Value(); v != 0 {
+					getTCP(flow).RttUs = v
+				}
+			case "tcpRoundTripTimeVariance":
+				if v := ie.GetUnsigned32Value(); v != 0 {
+					getTCP(flow).RttVarUs = v
+				}
+			case "tcpRetransmissionCount":
+				if v := ie.GetUnsigned32Value(); v != 0 {
+					getTCP(flow).Retransmissions = v
+				}
+			case "tcpReceiveWindowLimitedTime":
+				if v := ie.GetUnsigned64Value(); v != 0 {
+					getTCP(flow).RwndLimitedTimeUs = v
 				}
 			case "flowType":
 				flow.K8S.FlowType = flowpb.FlowType(ie.GetUnsigned8Value())
@@ -215,3 +226,16 @@ func (p *preprocessor) processMsg(msg *entities.Message) {
 		p.outCh <- flow
 	}
 }
+
+// getTCP returns the TCP message of the flow, creating it if needed. Information elements
+// for TCP are only set by the exporters for TCP connections.
+func getTCP(flow *flowpb.Flow) *flowpb.TCP {
+	if tcp := flow.Transport.GetTCP(); tcp != nil {
+		return tcp
+	}
+	tcp := &flowpb.TCP{}
+	flow.Transport.Protocol = &flowpb.Transport_TCP{
+		TCP: tcp,
+	}
+	return tcp
+}
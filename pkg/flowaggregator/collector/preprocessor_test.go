@@ -28,10 +28,11 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	"antrea.io/antrea/pkg/ipfix"
 )
 
 func init() {
-	ipfixregistry.LoadRegistry()
+	ipfix.LoadRegistry()
 }
 
 func createTestElement(name string, enterpriseID uint32) ipfixentities.InfoElementWithValue {
@@ -195,6 +196,22 @@ func createTestElements(isIPv4 bool) []ipfixentities.InfoElementWithValue {
 	egressNodeNameElem.SetStringValue("test-egress-node")
 	elements = append(elements, egressNodeNameElem)
 
+	tcpRTTElem := createTestElement("tcpRoundTripTime", ipfixregistry.AntreaEnterpriseID)
+	tcpRTTElem.SetUnsigned32Value(1500)
+	elements = append(elements, tcpRTTElem)
+
+	tcpRTTVarElem := createTestElement("tcpRoundTripTimeVariance", ipfixregistry.AntreaEnterpriseID)
+	tcpRTTVarElem.SetUnsigned32Value(300)
+	elements = append(elements, tcpRTTVarElem)
+
+	tcpRetransmissionCountElem := createTestElement("tcpRetransmissionCount", ipfixregistry.AntreaEnterpriseID)
+	tcpRetransmissionCountElem.SetUnsigned32Value(2)
+	elements = append(elements, tcpRetransmissionCountElem)
+
+	tcpReceiveWindowLimitedTimeElem := createTestElement("tcpReceiveWindowLimitedTime", ipfixregistry.AntreaEnterpriseID)
+	tcpReceiveWindowLimitedTimeElem.SetUnsigned64Value(12000)
+	elements = append(elements, tcpReceiveWindowLimitedTimeElem)
+
 	// These IEs don't come at the end in the IPFIX records sent by the Flow Exporter, but the
 	// order doesn't matter for the preprocessor's conversion logic.
 	if isIPv4 {
@@ -266,7 +283,11 @@ func createExpectedFlowRecord(isIPv4 bool) *flowpb.Flow {
 			ProtocolNumber:  6,
 			Protocol: &flowpb.Transport_TCP{
 				TCP: &flowpb.TCP{
-					StateName: "TIME_WAIT",
+					StateName:         "TIME_WAIT",
+					RttUs:             1500,
+					RttVarUs:          300,
+					Retransmissions:   2,
+					RwndLimitedTimeUs: 12000,
 				},
 			},
 		},
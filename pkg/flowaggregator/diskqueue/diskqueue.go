@@ -0,0 +1,526 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diskqueue implements a FIFO queue of records persisted on disk, which is used by the
+// Flow Aggregator to buffer flow records when a destination is unavailable.
+package diskqueue
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	segmentFileSuffix  = ".seg"
+	checkpointFileName = "checkpoint"
+	// Each record is prefixed with its length and its CRC32 checksum, both encoded as 32-bit
+	// big-endian integers.
+	recordHeaderSize = 8
+	// MaxRecordSize is the maximum size of a record payload.
+	MaxRecordSize = 16 << 20
+	// Segments are rotated once they reach maxSize / segmentsPerQueue, within the
+	// [minSegmentSize, maxSegmentSize] range.
+	segmentsPerQueue = 8
+	minSegmentSize   = 64 << 10
+	maxSegmentSize   = 64 << 20
+)
+
+// segment is a file storing a contiguous sequence of records. Segment files are named after their
+// IDs, which are strictly increasing.
+type segment struct {
+	id         uint64
+	size       int64
+	numRecords int64
+}
+
+// position identifies a record boundary in the queue.
+type position struct {
+	segmentID uint64
+	// offset is the byte offset in the segment file.
+	offset int64
+	// index is the number of records located before offset in the segment file.
+	index int64
+}
+
+// checkpoint is persisted to disk when calling Commit.
+type checkpoint struct {
+	SegmentID uint64 `json:"segmentID"`
+	Offset    int64  `json:"offset"`
+}
+
+// Stats contains statistics about the records stored in a Queue.
+type Stats struct {
+	// NumRecords is the number of records which have not been committed yet.
+	NumRecords int64
+	// Size is the size in bytes of the records which have not been committed yet.
+	Size int64
+	// NumRecordsDropped is the number of records which were dropped because the queue was
+	// full, since the queue was opened.
+	NumRecordsDropped int64
+}
+
+// Queue is a FIFO queue of records persisted on disk. Records are appended to segment files and
+// are read sequentially using Next. The read position is only persisted when calling Commit:
+// when the queue is re-opened, or after calling Rewind, records which have been read but not
+// committed are read again. When the total size of the segment files exceeds the maximum size of
+// the queue, the oldest segment is deleted, including records which have not been committed.
+// All methods are thread-safe.
+type Queue struct {
+	mutex       sync.Mutex
+	dir         string
+	maxSize     int64
+	segmentSize int64
+	// segments are ordered by ID. The last segment is the one records are appended to.
+	segments   []*segment
+	writeFile  *os.File
+	readFile   *os.File
+	readFileID uint64
+	read       position
+	committed  position
+	numDropped int64
+}
+
+func segmentFileName(id uint64) string {
+	return fmt.Sprintf("%020d%s", id, segmentFileSuffix)
+}
+
+// Open opens the queue stored in dir, creating the directory if needed. maxSize is the maximum
+// size in bytes of all the records stored in the queue.
+func Open(dir string, maxSize int64) (*Queue, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("invalid maximum queue size %d", maxSize)
+	}
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("error when creating queue directory: %w", err)
+	}
+	q := &Queue{
+		dir:         dir,
+		maxSize:     maxSize,
+		segmentSize: min(max(maxSize/segmentsPerQueue, minSegmentSize), maxSegmentSize),
+	}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	if len(q.segments) == 0 {
+		if err := q.createSegment(q.committed.segmentID); err != nil {
+			return nil, err
+		}
+		q.committed = position{segmentID: q.committed.segmentID}
+	} else {
+		last := q.segments[len(q.segments)-1]
+		f, err := os.OpenFile(q.segmentPath(last.id), os.O_WRONLY|os.O_APPEND, 0)
+		if err != nil {
+			return nil, fmt.Errorf("error when opening segment file: %w", err)
+		}
+		q.writeFile = f
+	}
+	q.read = q.committed
+	if err := q.writeCheckpoint(); err != nil {
+		q.closeFiles()
+		return nil, err
+	}
+	stats := q.statsLocked()
+	klog.InfoS("Opened disk queue", "dir", dir, "records", stats.NumRecords, "size", stats.Size)
+	return q, nil
+}
+
+// load loads existing segment files and the checkpoint from disk. Segments which are located
+// before the checkpoint are deleted. If no segment file is left, q.committed.segmentID is set to
+// the ID which should be used for the first segment.
+func (q *Queue) load() error {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return fmt.Errorf("error when reading queue directory: %w", err)
+	}
+	var ids []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentFileSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentFileSuffix), 10, 64)
+		if err != nil {
+			klog.InfoS("Ignoring unexpected file in queue directory", "dir", q.dir, "file", name)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var cp checkpoint
+	data, err := os.ReadFile(filepath.Join(q.dir, checkpointFileName))
+	if err == nil {
+		if err := json.Unmarshal(data, &cp); err != nil {
+			klog.ErrorS(err, "Invalid checkpoint file in queue directory, replaying all records", "dir", q.dir)
+			cp = checkpoint{}
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error when reading checkpoint file: %w", err)
+	}
+
+	q.committed = position{segmentID: cp.SegmentID}
+	for _, id := range ids {
+		path := q.segmentPath(id)
+		if id < cp.SegmentID {
+			if err := os.Remove(path); err != nil {
+				return fmt.Errorf("error when removing segment file: %w", err)
+			}
+			continue
+		}
+		size, numRecords, err := scanSegment(path, -1)
+		if err != nil {
+			return err
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("error when reading segment file: %w", err)
+		}
+		if info.Size() != size {
+			// This can happen if the Flow Aggregator was stopped while writing a record.
+			klog.InfoS("Truncating segment file with invalid records", "file", path, "size", info.Size(), "validSize", size)
+			if err := os.Truncate(path, size); err != nil {
+				return fmt.Errorf("error when truncating segment file: %w", err)
+			}
+		}
+		q.segments = append(q.segments, &segment{id: id, size: size, numRecords: numRecords})
+	}
+	if len(q.segments) == 0 {
+		if len(ids) > 0 {
+			q.committed.segmentID = max(ids[len(ids)-1]+1, cp.SegmentID)
+		}
+		return nil
+	}
+	first := q.segments[0]
+	if first.id != cp.SegmentID {
+		// The segment the checkpoint refers to was dropped.
+		q.committed = position{segmentID: first.id}
+		return nil
+	}
+	offset, index, err := scanSegment(q.segmentPath(first.id), min(cp.Offset, first.size))
+	if err != nil {
+		return err
+	}
+	q.committed = position{segmentID: first.id, offset: offset, index: index}
+	return nil
+}
+
+// scanSegment reads the records in the segment file, up to limit bytes if limit is not negative,
+// and returns the offset of the end of the last valid record, as well as the number of valid
+// records.
+func scanSegment(path string, limit int64) (int64, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error when opening segment file: %w", err)
+	}
+	defer f.Close()
+	reader := bufio.NewReader(f)
+	var offset, numRecords int64
+	for limit < 0 || offset < limit {
+		payload, err := readRecord(reader)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				klog.ErrorS(err, "Invalid record in segment file", "file", path, "offset", offset)
+			}
+			break
+		}
+		offset += recordHeaderSize + int64(len(payload))
+		numRecords++
+	}
+	return offset, numRecords, nil
+}
+
+func readRecord(reader io.Reader) ([]byte, error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("truncated record header")
+		}
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(header[0:4])
+	checksum := binary.BigEndian.Uint32(header[4:8])
+	if length > MaxRecordSize {
+		return nil, fmt.Errorf("invalid record length %d", length)
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return nil, fmt.Errorf("truncated record payload")
+	}
+	if crc32.ChecksumIEEE(payload) != checksum {
+		return nil, fmt.Errorf("invalid record checksum")
+	}
+	return payload, nil
+}
+
+func (q *Queue) segmentPath(id uint64) string {
+	return filepath.Join(q.dir, segmentFileName(id))
+}
+
+func (q *Queue) createSegment(id uint64) error {
+	f, err := os.OpenFile(q.segmentPath(id), os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return fmt.Errorf("error when creating segment file: %w", err)
+	}
+	q.writeFile = f
+	q.segments = append(q.segments, &segment{id: id})
+	return nil
+}
+
+func (q *Queue) getSegment(id uint64) (int, *segment) {
+	for i, s := range q.segments {
+		if s.id == id {
+			return i, s
+		}
+	}
+	return -1, nil
+}
+
+// Append appends a record to the queue.
+func (q *Queue) Append(data []byte) error {
+	if len(data) > MaxRecordSize {
+		return fmt.Errorf("record size %d exceeds maximum record size", len(data))
+	}
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	recordSize := int64(recordHeaderSize + len(data))
+	last := q.segments[len(q.segments)-1]
+	if last.size > 0 && last.size+recordSize > q.segmentSize {
+		if err := q.rotate(); err != nil {
+			return err
+		}
+		last = q.segments[len(q.segments)-1]
+	}
+	buf := make([]byte, recordSize)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(data))
+	copy(buf[recordHeaderSize:], data)
+	if _, err := q.writeFile.Write(buf); err != nil {
+		// Discard the partially-written record, if any, so that next records can be read.
+		if truncateErr := q.writeFile.Truncate(last.size); truncateErr != nil {
+			klog.ErrorS(truncateErr, "Error when truncating segment file", "file", q.writeFile.Name())
+		}
+		return fmt.Errorf("error when writing record to segment file: %w", err)
+	}
+	last.size += recordSize
+	last.numRecords++
+	q.dropOldSegments()
+	return nil
+}
+
+func (q *Queue) rotate() error {
+	last := q.segments[len(q.segments)-1]
+	if err := q.writeFile.Sync(); err != nil {
+		return fmt.Errorf("error when syncing segment file: %w", err)
+	}
+	if err := q.writeFile.Close(); err != nil {
+		return fmt.Errorf("error when closing segment file: %w", err)
+	}
+	q.writeFile = nil
+	return q.createSegment(last.id + 1)
+}
+
+// dropOldSegments deletes the oldest segments until the size of the queue is below the maximum
+// size. The last segment is never deleted.
+func (q *Queue) dropOldSegments() {
+	for len(q.segments) > 1 && q.totalSize() > q.maxSize {
+		oldest := q.segments[0]
+		numDropped := oldest.numRecords
+		if q.committed.segmentID == oldest.id {
+			numDropped -= q.committed.index
+		}
+		next := q.segments[1]
+		q.committed = position{segmentID: next.id}
+		if q.read.segmentID == oldest.id {
+			q.read = q.committed
+		}
+		if q.readFile != nil && q.readFileID == oldest.id {
+			q.readFile.Close()
+			q.readFile = nil
+		}
+		if err := os.Remove(q.segmentPath(oldest.id)); err != nil {
+			klog.ErrorS(err, "Error when removing segment file", "file", q.segmentPath(oldest.id))
+		}
+		q.segments = q.segments[1:]
+		q.numDropped += numDropped
+		klog.V(2).InfoS("Disk queue is full, dropped oldest records", "dir", q.dir, "count", numDropped)
+	}
+}
+
+func (q *Queue) totalSize() int64 {
+	var size int64
+	for _, s := range q.segments {
+		size += s.size
+	}
+	return size
+}
+
+// Next returns the next record which has not been read yet, and advances the read position. It
+// returns io.EOF if all the records have been read.
+func (q *Queue) Next() ([]byte, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for {
+		i, s := q.getSegment(q.read.segmentID)
+		if s == nil {
+			return nil, io.EOF
+		}
+		if q.read.offset >= s.size {
+			if i == len(q.segments)-1 {
+				return nil, io.EOF
+			}
+			q.read = position{segmentID: q.segments[i+1].id}
+			continue
+		}
+		if q.readFile == nil || q.readFileID != s.id {
+			if q.readFile != nil {
+				q.readFile.Close()
+			}
+			f, err := os.Open(q.segmentPath(s.id))
+			if err != nil {
+				q.readFile = nil
+				return nil, fmt.Errorf("error when opening segment file: %w", err)
+			}
+			q.readFile = f
+			q.readFileID = s.id
+		}
+		payload, err := readRecord(io.NewSectionReader(q.readFile, q.read.offset, s.size-q.read.offset))
+		if err != nil {
+			// Segment files are validated when the queue is opened, so this should not
+			// happen unless files are modified externally. We skip the rest of the
+			// segment, as we cannot find the next record boundary.
+			klog.ErrorS(err, "Invalid record in segment file, skipping the rest of the file", "file", q.segmentPath(s.id), "offset", q.read.offset)
+			q.read = position{segmentID: s.id, offset: s.size, index: s.numRecords}
+			continue
+		}
+		q.read.offset += recordHeaderSize + int64(len(payload))
+		q.read.index++
+		return payload, nil
+	}
+}
+
+// Rewind resets the read position to the last committed position.
+func (q *Queue) Rewind() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.read = q.committed
+}
+
+// Commit persists the read position, and deletes the segment files which have been read
+// entirely.
+func (q *Queue) Commit() error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.committed = q.read
+	for len(q.segments) > 1 {
+		oldest := q.segments[0]
+		if oldest.id == q.committed.segmentID && q.committed.offset < oldest.size {
+			break
+		}
+		if oldest.id == q.committed.segmentID {
+			q.committed = position{segmentID: q.segments[1].id}
+			q.read = q.committed
+		}
+		if q.readFile != nil && q.readFileID == oldest.id {
+			q.readFile.Close()
+			q.readFile = nil
+		}
+		if err := os.Remove(q.segmentPath(oldest.id)); err != nil {
+			return fmt.Errorf("error when removing segment file: %w", err)
+		}
+		q.segments = q.segments[1:]
+	}
+	if err := q.writeFile.Sync(); err != nil {
+		return fmt.Errorf("error when syncing segment file: %w", err)
+	}
+	return q.writeCheckpoint()
+}
+
+func (q *Queue) writeCheckpoint() error {
+	data, err := json.Marshal(checkpoint{SegmentID: q.committed.segmentID, Offset: q.committed.offset})
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(q.dir, checkpointFileName)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0640); err != nil {
+		return fmt.Errorf("error when writing checkpoint file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("error when writing checkpoint file: %w", err)
+	}
+	return nil
+}
+
+func (q *Queue) statsLocked() Stats {
+	stats := Stats{NumRecordsDropped: q.numDropped}
+	for _, s := range q.segments {
+		if s.id < q.committed.segmentID {
+			continue
+		}
+		stats.NumRecords += s.numRecords
+		stats.Size += s.size
+		if s.id == q.committed.segmentID {
+			stats.NumRecords -= q.committed.index
+			stats.Size -= q.committed.offset
+		}
+	}
+	return stats
+}
+
+// Stats returns statistics about the records stored in the queue.
+func (q *Queue) Stats() Stats {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.statsLocked()
+}
+
+func (q *Queue) closeFiles() {
+	if q.readFile != nil {
+		q.readFile.Close()
+		q.readFile = nil
+	}
+	if q.writeFile != nil {
+		q.writeFile.Close()
+		q.writeFile = nil
+	}
+}
+
+// Close syncs the segment files and closes them. Records which have been read but not committed
+// will be read again the next time the queue is opened. The queue cannot be used after calling
+// Close.
+func (q *Queue) Close() error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.writeFile == nil {
+		return nil
+	}
+	defer q.closeFiles()
+	if err := q.writeFile.Sync(); err != nil {
+		return fmt.Errorf("error when syncing segment file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskqueue
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecord(i int, size int) []byte {
+	record := make([]byte, size)
+	copy(record, fmt.Sprintf("record-%d", i))
+	return record
+}
+
+func appendRecords(t *testing.T, q *Queue, start, end int, size int) {
+	for i := start; i < end; i++ {
+		require.NoError(t, q.Append(testRecord(i, size)))
+	}
+}
+
+func readRecords(t *testing.T, q *Queue, n int) [][]byte {
+	var records [][]byte
+	for i := 0; i < n; i++ {
+		record, err := q.Next()
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+	return records
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+segmentFileSuffix))
+	require.NoError(t, err)
+	return files
+}
+
+func TestQueueAppendAndNext(t *testing.T) {
+	q, err := Open(t.TempDir(), 1<<20)
+	require.NoError(t, err)
+	defer q.Close()
+
+	_, err = q.Next()
+	assert.ErrorIs(t, err, io.EOF)
+
+	appendRecords(t, q, 0, 10, 100)
+	assert.Equal(t, Stats{NumRecords: 10, Size: 10 * (100 + recordHeaderSize)}, q.Stats())
+	records := readRecords(t, q, 10)
+	for i := range records {
+		assert.Equal(t, testRecord(i, 100), records[i])
+	}
+	_, err = q.Next()
+	assert.ErrorIs(t, err, io.EOF)
+	// Records are only removed from the queue when committed.
+	assert.Equal(t, int64(10), q.Stats().NumRecords)
+
+	q.Rewind()
+	assert.Equal(t, testRecord(0, 100), readRecords(t, q, 1)[0])
+	require.NoError(t, q.Commit())
+	assert.Equal(t, Stats{NumRecords: 9, Size: 9 * (100 + recordHeaderSize)}, q.Stats())
+	q.Rewind()
+	assert.Equal(t, testRecord(1, 100), readRecords(t, q, 1)[0])
+
+	assert.Error(t, q.Append(make([]byte, MaxRecordSize+1)))
+}
+
+func TestQueueReopen(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(dir, 1<<20)
+	require.NoError(t, err)
+	appendRecords(t, q, 0, 10, 100)
+	readRecords(t, q, 4)
+	require.NoError(t, q.Commit())
+	// These records are not committed and should be read again after re-opening the queue.
+	readRecords(t, q, 3)
+	require.NoError(t, q.Close())
+
+	q, err = Open(dir, 1<<20)
+	require.NoError(t, err)
+	defer q.Close()
+	assert.Equal(t, int64(6), q.Stats().NumRecords)
+	appendRecords(t, q, 10, 12, 100)
+	records := readRecords(t, q, 8)
+	for i := range records {
+		assert.Equal(t, testRecord(i+4, 100), records[i])
+	}
+	_, err = q.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestQueueTruncatedRecord(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(dir, 1<<20)
+	require.NoError(t, err)
+	appendRecords(t, q, 0, 3, 100)
+	require.NoError(t, q.Close())
+
+	// Simulate a record which was only partially written.
+	files := segmentFiles(t, dir)
+	require.Len(t, files, 1)
+	f, err := os.OpenFile(files[0], os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 1, 0, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	q, err = Open(dir, 1<<20)
+	require.NoError(t, err)
+	defer q.Close()
+	assert.Equal(t, int64(3), q.Stats().NumRecords)
+	appendRecords(t, q, 3, 4, 100)
+	records := readRecords(t, q, 4)
+	for i := range records {
+		assert.Equal(t, testRecord(i, 100), records[i])
+	}
+}
+
+func TestQueueSegments(t *testing.T) {
+	dir := t.TempDir()
+	const recordSize = 1000
+	// With this size, segments are rotated every 65 records.
+	const maxSize = 4 * minSegmentSize
+	q, err := Open(dir, maxSize)
+	require.NoError(t, err)
+
+	appendRecords(t, q, 0, 200, recordSize)
+	assert.Len(t, segmentFiles(t, dir), 4)
+	// Segments which have been read entirely are deleted on commit.
+	readRecords(t, q, 140)
+	require.NoError(t, q.Commit())
+	assert.Len(t, segmentFiles(t, dir), 2)
+	assert.Equal(t, int64(60), q.Stats().NumRecords)
+
+	// When the queue is full, the oldest records are dropped.
+	appendRecords(t, q, 200, 600, recordSize)
+	stats := q.Stats()
+	assert.LessOrEqual(t, stats.Size, int64(maxSize))
+	assert.Positive(t, stats.NumRecordsDropped)
+	assert.Equal(t, int64(460), stats.NumRecords+stats.NumRecordsDropped)
+	records := readRecords(t, q, int(stats.NumRecords))
+	for i := range records {
+		assert.Equal(t, testRecord(600-len(records)+i, recordSize), records[i])
+	}
+	_, err = q.Next()
+	assert.ErrorIs(t, err, io.EOF)
+	require.NoError(t, q.Commit())
+	assert.Equal(t, int64(0), q.Stats().NumRecords)
+	require.NoError(t, q.Close())
+
+	// The checkpoint is preserved across restarts.
+	q, err = Open(dir, maxSize)
+	require.NoError(t, err)
+	defer q.Close()
+	assert.Equal(t, int64(0), q.Stats().NumRecords)
+	_, err = q.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/anomaly"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// AnomalyDetectionExporter runs the anomaly detectors over the flow records received by the Flow
+// Aggregator. Detectors are evaluated at the end of each interval, when the exporter is flushed,
+// and the resulting alerts are sent to all the configured sinks.
+type AnomalyDetectionExporter struct {
+	recorder      record.EventRecorder
+	clock         clock.Clock
+	config        flowaggregatorconfig.AnomalyDetectionConfig
+	opt           *options.Options
+	detectors     []anomaly.Detector
+	sinks         []anomaly.AlertSink
+	intervalStart time.Time
+}
+
+func NewAnomalyDetectionExporter(opt *options.Options, recorder record.EventRecorder) *AnomalyDetectionExporter {
+	return newAnomalyDetectionExporterWithClock(opt, recorder, clock.RealClock{})
+}
+
+func newAnomalyDetectionExporterWithClock(opt *options.Options, recorder record.EventRecorder, clock clock.Clock) *AnomalyDetectionExporter {
+	e := &AnomalyDetectionExporter{
+		recorder: recorder,
+		clock:    clock,
+	}
+	e.setOptions(opt)
+	return e
+}
+
+func (e *AnomalyDetectionExporter) setOptions(opt *options.Options) {
+	e.opt = opt
+	e.config = opt.Config.AnomalyDetection
+	klog.InfoS("Anomaly detection configuration", "interval", opt.AnomalyDetectionInterval, "warmupIntervals", e.config.WarmupIntervals, "sensitivity", e.config.Sensitivity, "maxPods", e.config.MaxPods, "events", *e.config.Events, "alertLog", *e.config.AlertLog.Enable)
+}
+
+func (e *AnomalyDetectionExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	for _, d := range e.detectors {
+		d.AddRecord(record)
+	}
+	return nil
+}
+
+func (e *AnomalyDetectionExporter) Start() {
+	e.start()
+}
+
+func (e *AnomalyDetectionExporter) Stop() {
+	e.stop()
+}
+
+func (e *AnomalyDetectionExporter) start() {
+	// Baselines are learned again every time the exporter is started.
+	e.detectors = anomaly.NewDetectors(e.opt)
+	if *e.config.Events {
+		e.sinks = append(e.sinks, anomaly.NewEventSink(e.recorder))
+	}
+	if *e.config.AlertLog.Enable {
+		e.sinks = append(e.sinks, anomaly.NewLogSink(
+			e.config.AlertLog.Path,
+			// these are all valid conversions from int32 to int
+			int(e.config.AlertLog.MaxSize),
+			int(e.config.AlertLog.MaxBackups),
+		))
+	}
+	e.intervalStart = e.clock.Now()
+}
+
+func (e *AnomalyDetectionExporter) stop() {
+	for _, sink := range e.sinks {
+		if err := sink.Close(); err != nil {
+			klog.ErrorS(err, "Error when closing anomaly alert sink")
+		}
+	}
+	e.sinks = nil
+	e.detectors = nil
+}
+
+func (e *AnomalyDetectionExporter) UpdateOptions(opt *options.Options) {
+	if reflect.DeepEqual(e.config, opt.Config.AnomalyDetection) {
+		return
+	}
+	klog.InfoS("Updating anomaly detection")
+	e.stop()
+	e.setOptions(opt)
+	e.start()
+}
+
+// Flush evaluates the detectors if the current interval has ended. It is called frequently by
+// the Flow Aggregator, so the actual interval duration may exceed the configured one by up to
+// the flush period.
+func (e *AnomalyDetectionExporter) Flush() error {
+	now := e.clock.Now()
+	if now.Sub(e.intervalStart) < e.opt.AnomalyDetectionInterval {
+		return nil
+	}
+	e.intervalStart = now
+	var errs []error
+	for _, d := range e.detectors {
+		alerts := d.Evaluate(now)
+		for i := range alerts {
+			alert := &alerts[i]
+			klog.InfoS("Flow anomaly detected", "detector", alert.Detector, "pod", klog.KRef(alert.PodNamespace, alert.PodName), "value", alert.Value, "baselineMean", alert.BaselineMean, "baselineStdDev", alert.BaselineStdDev)
+			for _, sink := range e.sinks {
+				if err := sink.SendAlert(alert); err != nil {
+					errs = append(errs, fmt.Errorf("error when sending anomaly alert: %w", err))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+func newAnomalyDetectionTestOptions(t *testing.T) *options.Options {
+	opt := &options.Options{
+		Config:                   &flowaggregatorconfig.FlowAggregatorConfig{},
+		AnomalyDetectionInterval: time.Minute,
+	}
+	flowaggregatorconfig.SetConfigDefaults(opt.Config)
+	opt.Config.AnomalyDetection.Enable = true
+	opt.Config.AnomalyDetection.WarmupIntervals = 3
+	opt.Config.AnomalyDetection.AlertLog.Path = filepath.Join(t.TempDir(), "alerts.log")
+	return opt
+}
+
+func TestAnomalyDetectionExporter(t *testing.T) {
+	opt := newAnomalyDetectionTestOptions(t)
+	recorder := record.NewFakeRecorder(10)
+	clock := clocktesting.NewFakeClock(time.Now())
+	e := newAnomalyDetectionExporterWithClock(opt, recorder, clock)
+	e.Start()
+
+	addConnections := func(n int) {
+		for i := 0; i < n; i++ {
+			record := flowaggregatortesting.PrepareTestFlowRecord(true)
+			record.Stats.PacketTotalCount = record.Stats.PacketDeltaCount
+			require.NoError(t, e.AddRecord(record, false))
+		}
+	}
+	for i := 0; i < 3; i++ {
+		addConnections(10)
+		clock.Step(time.Minute)
+		require.NoError(t, e.Flush())
+	}
+	addConnections(500)
+	// The interval has not ended yet.
+	clock.Step(30 * time.Second)
+	require.NoError(t, e.Flush())
+	assert.Empty(t, recorder.Events)
+	clock.Step(30 * time.Second)
+	require.NoError(t, e.Flush())
+	require.Len(t, recorder.Events, 1)
+	assert.True(t, strings.HasPrefix(<-recorder.Events, "Warning FlowAnomalyConnectionSpike Pod opened 500 connections in 1m0s"))
+	e.Stop()
+
+	data, err := os.ReadFile(opt.Config.AnomalyDetection.AlertLog.Path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"detector":"ConnectionSpike","podNamespace":"antrea-test","podName":"perftest-a"`)
+}
+
+func TestAnomalyDetectionExporterUpdateOptions(t *testing.T) {
+	opt := newAnomalyDetectionTestOptions(t)
+	recorder := record.NewFakeRecorder(10)
+	e := newAnomalyDetectionExporterWithClock(opt, recorder, clocktesting.NewFakeClock(time.Now()))
+	e.Start()
+	defer e.Stop()
+	assert.Len(t, e.detectors, 3)
+	assert.Len(t, e.sinks, 2)
+
+	detectors := e.detectors
+	e.UpdateOptions(opt)
+	assert.Equal(t, detectors, e.detectors, "Detectors should not be reset if the configuration is unchanged")
+
+	newOpt := newAnomalyDetectionTestOptions(t)
+	newOpt.Config.AnomalyDetection.NewDestinations.Enable = ptr.To(false)
+	newOpt.Config.AnomalyDetection.Events = ptr.To(false)
+	e.UpdateOptions(newOpt)
+	assert.Len(t, e.detectors, 2)
+	assert.Len(t, e.sinks, 1)
+}
@@ -125,3 +125,20 @@ func (e *ClickHouseExporter) UpdateOptions(opt *options.Options) {
 func (e *ClickHouseExporter) Flush() error {
 	return nil
 }
+
+// FlushSync commits the records added so far to ClickHouse, and only returns once they have been
+// committed. It is used by the disk queue, which must not discard records before that.
+func (e *ClickHouseExporter) FlushSync() error {
+	return e.chExportProcess.Flush()
+}
+
+// FlushInterval returns the configured commit interval.
+func (e *ClickHouseExporter) FlushInterval() time.Duration {
+	return e.chExportProcess.GetCommitInterval()
+}
+
+// IsHealthy returns false if records could not be committed to ClickHouse recently. Records are
+// committed asynchronously, so errors are not reported by AddRecord.
+func (e *ClickHouseExporter) IsHealthy() bool {
+	return e.chExportProcess.IsHealthy()
+}
@@ -0,0 +1,242 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	"antrea.io/antrea/pkg/flowaggregator/diskqueue"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	// diskQueueBatchSize is the maximum number of records handed to the wrapped exporter
+	// between 2 calls to its Flush method.
+	diskQueueBatchSize = 1000
+	// diskQueueSyncBatchSize is the maximum number of records handed to a syncFlusher
+	// exporter between 2 calls to its FlushSync method. It is larger than diskQueueBatchSize
+	// because each call results in a ClickHouse transaction or in S3 files.
+	diskQueueSyncBatchSize = 100000
+	// diskQueueMaxFlushDuration is the maximum duration of a call to Flush. If the backlog
+	// cannot be replayed entirely within that time, replay resumes on the next call.
+	diskQueueMaxFlushDuration = 1 * time.Second
+
+	diskQueueRecordFlagIPv6 = 0x1
+)
+
+// healthChecker can be implemented by exporters which export records asynchronously, and hence
+// cannot report export errors through AddRecord and Flush.
+type healthChecker interface {
+	IsHealthy() bool
+}
+
+// syncFlusher can be implemented by exporters which write records to their destination
+// asynchronously, in which case Flush returns before the records have been written. FlushSync
+// writes all the records added so far, and only returns nil once they have been written durably.
+// The records are discarded by the exporter if FlushSync fails. FlushInterval is the interval
+// between asynchronous writes, which is also used as the minimum interval between 2 calls to
+// FlushSync.
+type syncFlusher interface {
+	FlushSync() error
+	FlushInterval() time.Duration
+}
+
+// DiskQueueExporter wraps an exporter with a write-ahead queue persisted on disk. AddRecord only
+// appends the record to the queue. Records are handed to the wrapped exporter when Flush is
+// called, and they are only removed from the queue once the wrapped exporter has been flushed
+// successfully. For exporters which write records asynchronously (ClickHouse, S3, Kafka, OTLP),
+// records are only removed once the exporter has confirmed that they have been written, using
+// FlushSync. When the destination is unavailable, records accumulate in the queue (up to its
+// maximum size), and they are replayed when the destination becomes available again, including
+// after a restart. Records may be exported more than once.
+type DiskQueueExporter struct {
+	name      string
+	exporter  Interface
+	queue     *diskqueue.Queue
+	clock     clock.Clock
+	available bool
+	// lastSyncFlush is the last time records were replayed to a syncFlusher exporter.
+	lastSyncFlush time.Time
+}
+
+func NewDiskQueueExporter(name string, exporter Interface, dir string, maxSize int64) (*DiskQueueExporter, error) {
+	return newDiskQueueExporterWithClock(name, exporter, dir, maxSize, clock.RealClock{})
+}
+
+func newDiskQueueExporterWithClock(name string, exporter Interface, dir string, maxSize int64, clock clock.Clock) (*DiskQueueExporter, error) {
+	queue, err := diskqueue.Open(dir, maxSize)
+	if err != nil {
+		return nil, fmt.Errorf("error when opening disk queue for %s exporter: %w", name, err)
+	}
+	return &DiskQueueExporter{
+		name:      name,
+		exporter:  exporter,
+		queue:     queue,
+		clock:     clock,
+		available: true,
+	}, nil
+}
+
+func encodeQueueRecord(record *flowpb.Flow, isRecordIPv6 bool) ([]byte, error) {
+	var flags byte
+	if isRecordIPv6 {
+		flags |= diskQueueRecordFlagIPv6
+	}
+	return proto.MarshalOptions{}.MarshalAppend([]byte{flags}, record)
+}
+
+func decodeQueueRecord(data []byte) (*flowpb.Flow, bool, error) {
+	if len(data) == 0 {
+		return nil, false, fmt.Errorf("empty record")
+	}
+	record := &flowpb.Flow{}
+	if err := proto.Unmarshal(data[1:], record); err != nil {
+		return nil, false, err
+	}
+	return record, data[0]&diskQueueRecordFlagIPv6 != 0, nil
+}
+
+func (e *DiskQueueExporter) Start() {
+	e.exporter.Start()
+}
+
+// Stop stops the wrapped exporter and closes the queue. Records which have not been exported yet
+// are preserved on disk.
+func (e *DiskQueueExporter) Stop() {
+	e.exporter.Stop()
+	if err := e.queue.Close(); err != nil {
+		klog.ErrorS(err, "Error when closing disk queue", "exporter", e.name)
+	}
+}
+
+func (e *DiskQueueExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	data, err := encodeQueueRecord(record, isRecordIPv6)
+	if err != nil {
+		return fmt.Errorf("error when serializing flow record: %w", err)
+	}
+	if err := e.queue.Append(data); err != nil {
+		return fmt.Errorf("error when adding flow record to disk queue: %w", err)
+	}
+	return nil
+}
+
+func (e *DiskQueueExporter) UpdateOptions(opt *options.Options) {
+	e.exporter.UpdateOptions(opt)
+}
+
+// Flush replays the records from the queue to the wrapped exporter, in batches. Each batch is
+// removed from the queue after the wrapped exporter has been flushed successfully. In case of
+// error, the batch is kept in the queue and replayed on the next call. Records are replayed to a
+// syncFlusher exporter at most once per FlushInterval.
+func (e *DiskQueueExporter) Flush() error {
+	if sf, ok := e.exporter.(syncFlusher); ok {
+		now := e.clock.Now()
+		if now.Sub(e.lastSyncFlush) < sf.FlushInterval() {
+			return nil
+		}
+		e.lastSyncFlush = now
+	} else if hc, ok := e.exporter.(healthChecker); ok && !hc.IsHealthy() {
+		e.setAvailable(false, nil)
+		return nil
+	}
+	deadline := e.clock.Now().Add(diskQueueMaxFlushDuration)
+	for {
+		n, err := e.exportBatch()
+		if err != nil {
+			e.queue.Rewind()
+			e.setAvailable(false, err)
+			return nil
+		}
+		e.setAvailable(true, nil)
+		if n < e.batchSize() || !e.clock.Now().Before(deadline) {
+			return nil
+		}
+	}
+}
+
+func (e *DiskQueueExporter) batchSize() int {
+	if _, ok := e.exporter.(syncFlusher); ok {
+		return diskQueueSyncBatchSize
+	}
+	return diskQueueBatchSize
+}
+
+func (e *DiskQueueExporter) exportBatch() (int, error) {
+	batchSize := e.batchSize()
+	n := 0
+	for n < batchSize {
+		data, err := e.queue.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+		record, isRecordIPv6, err := decodeQueueRecord(data)
+		if err != nil {
+			klog.ErrorS(err, "Skipping invalid flow record in disk queue", "exporter", e.name)
+			continue
+		}
+		if err := e.exporter.AddRecord(record, isRecordIPv6); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if n > 0 {
+		if err := e.flushExporter(); err != nil {
+			return n, err
+		}
+	}
+	if err := e.queue.Commit(); err != nil {
+		return n, fmt.Errorf("error when committing disk queue: %w", err)
+	}
+	return n, nil
+}
+
+func (e *DiskQueueExporter) flushExporter() error {
+	if sf, ok := e.exporter.(syncFlusher); ok {
+		return sf.FlushSync()
+	}
+	return e.exporter.Flush()
+}
+
+// setAvailable logs transitions between the available and unavailable states of the destination,
+// to avoid logging an error every time Flush is called while the destination is unavailable.
+func (e *DiskQueueExporter) setAvailable(available bool, err error) {
+	if available == e.available {
+		return
+	}
+	e.available = available
+	stats := e.queue.Stats()
+	if available {
+		klog.InfoS("Destination is available again, replaying flow records from disk queue", "exporter", e.name, "records", stats.NumRecords)
+	} else {
+		klog.ErrorS(err, "Destination is unavailable, buffering flow records in disk queue", "exporter", e.name, "records", stats.NumRecords)
+	}
+}
+
+// Stats returns statistics about the records stored in the queue. It is safe to call Stats
+// concurrently with other methods.
+func (e *DiskQueueExporter) Stats() diskqueue.Stats {
+	return e.queue.Stats()
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
+	exportertesting "antrea.io/antrea/pkg/flowaggregator/exporter/testing"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+const testDiskQueueMaxSize = 16 << 20
+
+// protoEq returns a gomock Matcher for a flow record.
+func protoEq(record *flowpb.Flow) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		other, ok := x.(*flowpb.Flow)
+		return ok && proto.Equal(record, other)
+	})
+}
+
+type healthCheckingExporter struct {
+	*exportertesting.MockInterface
+	healthy bool
+}
+
+func (e *healthCheckingExporter) IsHealthy() bool {
+	return e.healthy
+}
+
+func newTestDiskQueueExporter(t *testing.T, dir string, exporter Interface) *DiskQueueExporter {
+	e, err := newDiskQueueExporterWithClock("test", exporter, dir, testDiskQueueMaxSize, clocktesting.NewFakeClock(time.Now()))
+	require.NoError(t, err)
+	return e
+}
+
+func TestDiskQueueExporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExporter := exportertesting.NewMockInterface(ctrl)
+	e := newTestDiskQueueExporter(t, t.TempDir(), mockExporter)
+
+	recordIPv4 := flowaggregatortesting.PrepareTestFlowRecord(true)
+	recordIPv6 := flowaggregatortesting.PrepareTestFlowRecord(false)
+
+	// Records are not handed to the wrapped exporter until Flush is called.
+	require.NoError(t, e.AddRecord(recordIPv4, false))
+	require.NoError(t, e.AddRecord(recordIPv6, true))
+	assert.Equal(t, int64(2), e.Stats().NumRecords)
+
+	gomock.InOrder(
+		mockExporter.EXPECT().AddRecord(protoEq(recordIPv4), false),
+		mockExporter.EXPECT().AddRecord(protoEq(recordIPv6), true),
+		mockExporter.EXPECT().Flush(),
+	)
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(0), e.Stats().NumRecords)
+
+	// The wrapped exporter is not flushed when there is nothing to replay.
+	require.NoError(t, e.Flush())
+}
+
+func TestDiskQueueExporterErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExporter := exportertesting.NewMockInterface(ctrl)
+	e := newTestDiskQueueExporter(t, t.TempDir(), mockExporter)
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, e.AddRecord(record, false))
+	require.NoError(t, e.AddRecord(record, false))
+
+	// Error when adding a record.
+	mockExporter.EXPECT().AddRecord(protoEq(record), false)
+	mockExporter.EXPECT().AddRecord(protoEq(record), false).Return(fmt.Errorf("connection refused"))
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(2), e.Stats().NumRecords)
+	assert.False(t, e.available)
+
+	// Error when flushing the wrapped exporter.
+	mockExporter.EXPECT().AddRecord(protoEq(record), false).Times(2)
+	mockExporter.EXPECT().Flush().Return(fmt.Errorf("connection refused"))
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(2), e.Stats().NumRecords)
+
+	// New records are queued after the existing ones.
+	require.NoError(t, e.AddRecord(record, false))
+	mockExporter.EXPECT().AddRecord(protoEq(record), false).Times(3)
+	mockExporter.EXPECT().Flush()
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(0), e.Stats().NumRecords)
+	assert.True(t, e.available)
+}
+
+func TestDiskQueueExporterRestart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExporter := exportertesting.NewMockInterface(ctrl)
+	dir := t.TempDir()
+	e := newTestDiskQueueExporter(t, dir, mockExporter)
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, e.AddRecord(record, false))
+	require.NoError(t, e.AddRecord(record, false))
+	mockExporter.EXPECT().Stop()
+	e.Stop()
+
+	e = newTestDiskQueueExporter(t, dir, mockExporter)
+	assert.Equal(t, int64(2), e.Stats().NumRecords)
+	mockExporter.EXPECT().AddRecord(protoEq(record), false).Times(2)
+	mockExporter.EXPECT().Flush()
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(0), e.Stats().NumRecords)
+	mockExporter.EXPECT().Stop()
+	e.Stop()
+}
+
+func TestDiskQueueExporterHealthCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExporter := &healthCheckingExporter{MockInterface: exportertesting.NewMockInterface(ctrl)}
+	e := newTestDiskQueueExporter(t, t.TempDir(), mockExporter)
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, e.AddRecord(record, false))
+	// Records are not replayed while the wrapped exporter is unhealthy.
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(1), e.Stats().NumRecords)
+
+	mockExporter.healthy = true
+	mockExporter.EXPECT().AddRecord(protoEq(record), false)
+	mockExporter.EXPECT().Flush()
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(0), e.Stats().NumRecords)
+}
+
+func TestDiskQueueExporterBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExporter := exportertesting.NewMockInterface(ctrl)
+	e := newTestDiskQueueExporter(t, t.TempDir(), mockExporter)
+
+	const numRecords = 2*diskQueueBatchSize + 10
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	for i := 0; i < numRecords; i++ {
+		require.NoError(t, e.AddRecord(record, false))
+	}
+	// The wrapped exporter is flushed after each batch.
+	mockExporter.EXPECT().AddRecord(gomock.Any(), false).Times(numRecords)
+	mockExporter.EXPECT().Flush().Times(3)
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(0), e.Stats().NumRecords)
+}
+
+func TestDiskQueueExporterClickHouseReplay(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	prepareClickHouseConnectionSaved := clickhouseclient.PrepareClickHouseConnection
+	clickhouseclient.PrepareClickHouseConnection = func(input clickhouseclient.ClickHouseConfig) (*sql.DB, error) {
+		return db, nil
+	}
+	defer func() {
+		clickhouseclient.PrepareClickHouseConnection = prepareClickHouseConnectionSaved
+	}()
+	chConfig := clickhouseclient.ClickHouseConfig{
+		Username:       "default",
+		Password:       "default",
+		DatabaseURL:    "tcp://clickhouse-clickhouse.flow-visibility.svc:9000",
+		CommitInterval: 8 * time.Second,
+	}
+	chExportProcess, err := clickhouseclient.NewClickHouseClient(chConfig, uuid.New().String())
+	require.NoError(t, err)
+	chExporter := &ClickHouseExporter{chConfig: &chConfig, chExportProcess: chExportProcess}
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	e, err := newDiskQueueExporterWithClock("clickhouse", chExporter, t.TempDir(), testDiskQueueMaxSize, fakeClock)
+	require.NoError(t, err)
+
+	require.NoError(t, e.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+	require.NoError(t, e.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(false), true))
+
+	// ClickHouse is unavailable: the records must be kept in the queue.
+	mock.ExpectBegin().WillReturnError(fmt.Errorf("connection refused"))
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(2), e.Stats().NumRecords)
+	assert.False(t, e.available)
+
+	// The records are not replayed again before the commit interval has elapsed.
+	fakeClock.Step(time.Second)
+	require.NoError(t, e.Flush())
+
+	// The transaction fails: the records must be kept in the queue.
+	fakeClock.Step(chConfig.CommitInterval)
+	mock.ExpectBegin()
+	mock.ExpectPrepare("INSERT INTO flows").ExpectExec().WillReturnError(fmt.Errorf("connection reset by peer"))
+	mock.ExpectRollback()
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(2), e.Stats().NumRecords)
+	assert.False(t, e.available)
+
+	// ClickHouse is available again: both records are replayed in a single transaction, and
+	// removed from the queue once the transaction has been committed.
+	fakeClock.Step(chConfig.CommitInterval)
+	mock.ExpectBegin()
+	stmt := mock.ExpectPrepare("INSERT INTO flows")
+	stmt.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	stmt.ExpectExec().WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, e.Flush())
+	assert.Equal(t, int64(0), e.Stats().NumRecords)
+	assert.True(t, e.available)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,306 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/flowaggregator/querier"
+)
+
+const (
+	// flowGraphNumBuckets is the number of time buckets covering the flow graph window. The
+	// window requested by queries is rounded up to a multiple of the bucket duration.
+	flowGraphNumBuckets = 60
+)
+
+// PodStore is the subset of the objectstore.PodStore interface used to resolve the workload
+// owning a Pod.
+type PodStore interface {
+	GetPodByIPAndTime(ip string, startTime time.Time) (*corev1.Pod, bool)
+}
+
+type flowGraphEdgeKey struct {
+	source      querier.FlowGraphNode
+	destination querier.FlowGraphNode
+}
+
+type flowGraphCounters struct {
+	bytes       int64
+	packets     int64
+	connections int64
+}
+
+type flowGraphBucket struct {
+	start time.Time
+	edges map[flowGraphEdgeKey]*flowGraphCounters
+}
+
+// FlowGraphExporter maintains an in-memory graph of the traffic between workloads and
+// Services, using the flow records received by the Flow Aggregator. Traffic is accounted for in
+// time buckets, so that the graph can be queried over a sliding window. Unlike other exporters,
+// FlowGraphExporter is safe for concurrent use, as the graph is queried by the API server.
+type FlowGraphExporter struct {
+	podStore PodStore
+	clock    clock.Clock
+
+	mutex          sync.RWMutex
+	window         time.Duration
+	bucketDuration time.Duration
+	maxEdges       int
+	// buckets is ordered from the oldest to the most recent bucket.
+	buckets []*flowGraphBucket
+	// edges stores the last time each edge was updated, and is used to enforce maxEdges.
+	edges map[flowGraphEdgeKey]time.Time
+}
+
+func NewFlowGraphExporter(opt *options.Options, podStore PodStore) *FlowGraphExporter {
+	return newFlowGraphExporterWithClock(opt, podStore, clock.RealClock{})
+}
+
+func newFlowGraphExporterWithClock(opt *options.Options, podStore PodStore, clock clock.Clock) *FlowGraphExporter {
+	klog.InfoS("Flow graph configuration", "window", opt.FlowGraphWindow, "maxEdges", opt.Config.FlowGraph.MaxEdges)
+	e := &FlowGraphExporter{
+		podStore: podStore,
+		clock:    clock,
+	}
+	e.setOptions(opt)
+	return e
+}
+
+func (e *FlowGraphExporter) setOptions(opt *options.Options) {
+	e.window = opt.FlowGraphWindow
+	e.bucketDuration = opt.FlowGraphWindow / flowGraphNumBuckets
+	e.maxEdges = int(opt.Config.FlowGraph.MaxEdges)
+	e.reset()
+}
+
+func (e *FlowGraphExporter) reset() {
+	e.buckets = nil
+	e.edges = make(map[flowGraphEdgeKey]time.Time)
+}
+
+// podWorkload returns the kind and name of the workload which owns the Pod. Pods created by a
+// Deployment are owned by a ReplicaSet, whose name is the name of the Deployment followed by the
+// pod-template-hash label value.
+func podWorkload(pod *corev1.Pod) (string, string) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return querier.FlowGraphNodeKindPod, pod.Name
+	}
+	if owner.Kind == "ReplicaSet" {
+		if hash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; ok {
+			if name, found := strings.CutSuffix(owner.Name, "-"+hash); found {
+				return querier.FlowGraphNodeKindDeployment, name
+			}
+		}
+	}
+	return owner.Kind, owner.Name
+}
+
+func (e *FlowGraphExporter) podNode(namespace, name string, ip []byte, startTime time.Time) querier.FlowGraphNode {
+	if namespace == "" || name == "" {
+		return querier.FlowGraphNode{Kind: querier.FlowGraphNodeKindExternal, Name: net.IP(ip).String()}
+	}
+	node := querier.FlowGraphNode{Kind: querier.FlowGraphNodeKindPod, Namespace: namespace, Name: name}
+	pod, exist := e.podStore.GetPodByIPAndTime(net.IP(ip).String(), startTime)
+	if !exist || pod.Namespace != namespace || pod.Name != name {
+		klog.V(4).InfoS("Cannot find Pod information, using Pod as flow graph node", "pod", klog.KRef(namespace, name))
+		return node
+	}
+	node.Kind, node.Name = podWorkload(pod)
+	return node
+}
+
+// serviceNode returns the node for a Service, given a ServicePortName of the form
+// "namespace/name:port".
+func serviceNode(servicePortName string, defaultNamespace string) querier.FlowGraphNode {
+	namespacedName, _, _ := strings.Cut(servicePortName, ":")
+	namespace, name, found := strings.Cut(namespacedName, "/")
+	if !found {
+		namespace, name = defaultNamespace, namespacedName
+	}
+	return querier.FlowGraphNode{Kind: querier.FlowGraphNodeKindService, Namespace: namespace, Name: name}
+}
+
+func (e *FlowGraphExporter) edgeKey(record *flowpb.Flow) flowGraphEdgeKey {
+	k8s := record.GetK8S()
+	startTime := record.GetStartTs().AsTime()
+	key := flowGraphEdgeKey{
+		source: e.podNode(k8s.GetSourcePodNamespace(), k8s.GetSourcePodName(), record.GetIp().GetSource(), startTime),
+	}
+	if k8s.GetDestinationServicePortName() != "" {
+		key.destination = serviceNode(k8s.GetDestinationServicePortName(), k8s.GetDestinationPodNamespace())
+	} else {
+		key.destination = e.podNode(k8s.GetDestinationPodNamespace(), k8s.GetDestinationPodName(), record.GetIp().GetDestination(), startTime)
+	}
+	return key
+}
+
+// currentBucket returns the bucket for the provided time, creating it if needed.
+func (e *FlowGraphExporter) currentBucket(now time.Time) *flowGraphBucket {
+	start := now.Truncate(e.bucketDuration)
+	if n := len(e.buckets); n > 0 && !e.buckets[n-1].start.Before(start) {
+		return e.buckets[n-1]
+	}
+	b := &flowGraphBucket{
+		start: start,
+		edges: make(map[flowGraphEdgeKey]*flowGraphCounters),
+	}
+	e.buckets = append(e.buckets, b)
+	return b
+}
+
+func (e *FlowGraphExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	// The edge is computed before acquiring the lock, as it may require Pod lookups.
+	key := e.edgeKey(record)
+	stats := record.GetStats()
+	reverseStats := record.GetReverseStats()
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	now := e.clock.Now()
+	if _, ok := e.edges[key]; !ok && len(e.edges) >= e.maxEdges {
+		klog.V(2).InfoS("Maximum number of flow graph edges reached, ignoring flow record", "maxEdges", e.maxEdges)
+		return nil
+	}
+	e.edges[key] = now
+	b := e.currentBucket(now)
+	counters, ok := b.edges[key]
+	if !ok {
+		counters = &flowGraphCounters{}
+		b.edges[key] = counters
+	}
+	counters.bytes += int64(stats.GetOctetDeltaCount() + reverseStats.GetOctetDeltaCount())
+	counters.packets += int64(stats.GetPacketDeltaCount() + reverseStats.GetPacketDeltaCount())
+	if isNewConnection(record) {
+		counters.connections++
+	}
+	return nil
+}
+
+// Graph returns the edges of the graph over the provided window, or over the configured window
+// if window is 0 or larger than the configured window. If namespace is not empty, only edges
+// with at least one node in the Namespace are returned. Edges are sorted by source and
+// destination.
+func (e *FlowGraphExporter) Graph(window time.Duration, namespace string) *querier.FlowGraph {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	if window <= 0 || window > e.window {
+		window = e.window
+	}
+	since := e.clock.Now().Add(-window)
+	totals := make(map[flowGraphEdgeKey]*flowGraphCounters)
+	for _, b := range e.buckets {
+		if !b.start.Add(e.bucketDuration).After(since) {
+			continue
+		}
+		for key, counters := range b.edges {
+			if namespace != "" && key.source.Namespace != namespace && key.destination.Namespace != namespace {
+				continue
+			}
+			total, ok := totals[key]
+			if !ok {
+				total = &flowGraphCounters{}
+				totals[key] = total
+			}
+			total.bytes += counters.bytes
+			total.packets += counters.packets
+			total.connections += counters.connections
+		}
+	}
+	graph := &querier.FlowGraph{
+		Window: window,
+		Edges:  make([]querier.FlowGraphEdge, 0, len(totals)),
+	}
+	for key, total := range totals {
+		graph.Edges = append(graph.Edges, querier.FlowGraphEdge{
+			Source:      key.source,
+			Destination: key.destination,
+			Bytes:       total.bytes,
+			Packets:     total.packets,
+			Connections: total.connections,
+		})
+	}
+	nodeLess := func(a, b querier.FlowGraphNode) bool {
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source != graph.Edges[j].Source {
+			return nodeLess(graph.Edges[i].Source, graph.Edges[j].Source)
+		}
+		return nodeLess(graph.Edges[i].Destination, graph.Edges[j].Destination)
+	})
+	return graph
+}
+
+func (e *FlowGraphExporter) Start() {
+	// Nothing to do, the graph is maintained in memory.
+}
+
+func (e *FlowGraphExporter) Stop() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.reset()
+}
+
+func (e *FlowGraphExporter) UpdateOptions(opt *options.Options) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if opt.FlowGraphWindow != e.window {
+		// The bucket duration depends on the window, so existing buckets cannot be reused.
+		klog.InfoS("Resetting flow graph because of window change")
+		e.setOptions(opt)
+	} else {
+		e.maxEdges = int(opt.Config.FlowGraph.MaxEdges)
+	}
+	klog.InfoS("New flow graph configuration", "window", opt.FlowGraphWindow, "maxEdges", opt.Config.FlowGraph.MaxEdges)
+}
+
+// Flush removes the buckets and the edges which are no longer part of the window.
+func (e *FlowGraphExporter) Flush() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	since := e.clock.Now().Add(-e.window)
+	i := 0
+	for i < len(e.buckets) && !e.buckets[i].start.Add(e.bucketDuration).After(since) {
+		i++
+	}
+	e.buckets = e.buckets[i:]
+	for key, lastUpdated := range e.edges {
+		if !lastUpdated.After(since) {
+			delete(e.edges, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/flowaggregator/querier"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+// fakePodStore stores Pods by IP address, regardless of time.
+type fakePodStore map[string]*corev1.Pod
+
+func (s fakePodStore) GetPodByIPAndTime(ip string, _ time.Time) (*corev1.Pod, bool) {
+	pod, ok := s[ip]
+	return pod, ok
+}
+
+func newFlowGraphTestPod(namespace, name string, labels map[string]string, ownerKind, ownerName string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    labels,
+		},
+	}
+	if ownerKind != "" {
+		pod.OwnerReferences = []metav1.OwnerReference{{
+			Kind:       ownerKind,
+			Name:       ownerName,
+			Controller: ptr.To(true),
+		}}
+	}
+	return pod
+}
+
+var flowGraphTestPods = fakePodStore{
+	"10.0.0.1": newFlowGraphTestPod("ns1", "client-7d4b9c8f6-x2x4z", map[string]string{"pod-template-hash": "7d4b9c8f6"}, "ReplicaSet", "client-7d4b9c8f6"),
+	"10.0.0.2": newFlowGraphTestPod("ns2", "db-0", nil, "StatefulSet", "db"),
+	"10.0.0.3": newFlowGraphTestPod("ns2", "standalone", nil, "", ""),
+}
+
+func newFlowGraphTestOptions(window time.Duration) *options.Options {
+	opt := &options.Options{
+		Config:          &flowaggregatorconfig.FlowAggregatorConfig{},
+		FlowGraphWindow: window,
+	}
+	flowaggregatorconfig.SetConfigDefaults(opt.Config)
+	opt.Config.FlowGraph.Enable = true
+	return opt
+}
+
+func prepareFlowGraphTestRecord(srcIP, srcPod, srcNamespace, dstIP, dstPod, dstNamespace, servicePortName string, isNewConnection bool) *flowpb.Flow {
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	record.Ip.Source = netip.MustParseAddr(srcIP).AsSlice()
+	record.Ip.Destination = netip.MustParseAddr(dstIP).AsSlice()
+	record.K8S.SourcePodName = srcPod
+	record.K8S.SourcePodNamespace = srcNamespace
+	record.K8S.DestinationPodName = dstPod
+	record.K8S.DestinationPodNamespace = dstNamespace
+	record.K8S.DestinationServicePortName = servicePortName
+	record.Stats = &flowpb.Stats{PacketTotalCount: 20, PacketDeltaCount: 10, OctetDeltaCount: 1000}
+	record.ReverseStats = &flowpb.Stats{PacketDeltaCount: 5, OctetDeltaCount: 500}
+	if isNewConnection {
+		record.Stats.PacketTotalCount = record.Stats.PacketDeltaCount
+	}
+	return record
+}
+
+var (
+	clientNode     = querier.FlowGraphNode{Kind: "Deployment", Namespace: "ns1", Name: "client"}
+	dbNode         = querier.FlowGraphNode{Kind: "StatefulSet", Namespace: "ns2", Name: "db"}
+	standaloneNode = querier.FlowGraphNode{Kind: "Pod", Namespace: "ns2", Name: "standalone"}
+	webNode        = querier.FlowGraphNode{Kind: "Service", Namespace: "ns2", Name: "web"}
+	externalNode   = querier.FlowGraphNode{Kind: "External", Name: "192.168.1.1"}
+	unknownPodNode = querier.FlowGraphNode{Kind: "Pod", Namespace: "ns3", Name: "unknown"}
+)
+
+func TestFlowGraphExporter(t *testing.T) {
+	e := newFlowGraphExporterWithClock(newFlowGraphTestOptions(15*time.Minute), flowGraphTestPods, clocktesting.NewFakeClock(time.Now()))
+	records := []*flowpb.Flow{
+		prepareFlowGraphTestRecord("10.0.0.1", "client-7d4b9c8f6-x2x4z", "ns1", "10.0.0.2", "db-0", "ns2", "", true),
+		prepareFlowGraphTestRecord("10.0.0.1", "client-7d4b9c8f6-x2x4z", "ns1", "10.0.0.2", "db-0", "ns2", "", false),
+		prepareFlowGraphTestRecord("10.0.0.1", "client-7d4b9c8f6-x2x4z", "ns1", "10.0.0.3", "standalone", "ns2", "ns2/web:http", true),
+		prepareFlowGraphTestRecord("10.0.0.3", "standalone", "ns2", "192.168.1.1", "", "", "", true),
+		// The Pod cannot be found in the store.
+		prepareFlowGraphTestRecord("10.0.0.4", "unknown", "ns3", "10.0.0.2", "db-0", "ns2", "", true),
+	}
+	for _, record := range records {
+		require.NoError(t, e.AddRecord(record, false))
+	}
+
+	graph := e.Graph(0, "")
+	assert.Equal(t, 15*time.Minute, graph.Window)
+	assert.Equal(t, []querier.FlowGraphEdge{
+		{Source: clientNode, Destination: webNode, Bytes: 1500, Packets: 15, Connections: 1},
+		{Source: clientNode, Destination: dbNode, Bytes: 3000, Packets: 30, Connections: 1},
+		{Source: standaloneNode, Destination: externalNode, Bytes: 1500, Packets: 15, Connections: 1},
+		{Source: unknownPodNode, Destination: dbNode, Bytes: 1500, Packets: 15, Connections: 1},
+	}, graph.Edges)
+
+	graph = e.Graph(0, "ns1")
+	assert.Len(t, graph.Edges, 2)
+	graph = e.Graph(0, "ns3")
+	assert.Equal(t, []querier.FlowGraphEdge{
+		{Source: unknownPodNode, Destination: dbNode, Bytes: 1500, Packets: 15, Connections: 1},
+	}, graph.Edges)
+}
+
+func TestFlowGraphExporterWindow(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC))
+	e := newFlowGraphExporterWithClock(newFlowGraphTestOptions(15*time.Minute), flowGraphTestPods, clock)
+	clientToDB := prepareFlowGraphTestRecord("10.0.0.1", "client-7d4b9c8f6-x2x4z", "ns1", "10.0.0.2", "db-0", "ns2", "", true)
+	clientToWeb := prepareFlowGraphTestRecord("10.0.0.1", "client-7d4b9c8f6-x2x4z", "ns1", "10.0.0.3", "standalone", "ns2", "ns2/web:http", true)
+
+	require.NoError(t, e.AddRecord(clientToDB, false))
+	clock.Step(10 * time.Minute)
+	require.NoError(t, e.AddRecord(clientToWeb, false))
+	require.NoError(t, e.AddRecord(clientToDB, false))
+
+	graph := e.Graph(5*time.Minute, "")
+	assert.Equal(t, 5*time.Minute, graph.Window)
+	assert.Equal(t, []querier.FlowGraphEdge{
+		{Source: clientNode, Destination: webNode, Bytes: 1500, Packets: 15, Connections: 1},
+		{Source: clientNode, Destination: dbNode, Bytes: 1500, Packets: 15, Connections: 1},
+	}, graph.Edges)
+	// The window cannot exceed the configured window.
+	graph = e.Graph(time.Hour, "")
+	assert.Equal(t, 15*time.Minute, graph.Window)
+	assert.Equal(t, []querier.FlowGraphEdge{
+		{Source: clientNode, Destination: webNode, Bytes: 1500, Packets: 15, Connections: 1},
+		{Source: clientNode, Destination: dbNode, Bytes: 3000, Packets: 30, Connections: 2},
+	}, graph.Edges)
+
+	// The first bucket is no longer part of the window.
+	clock.Step(6 * time.Minute)
+	require.NoError(t, e.Flush())
+	assert.Len(t, e.buckets, 1)
+	assert.Len(t, e.edges, 2)
+	graph = e.Graph(0, "")
+	assert.Equal(t, int64(1500), graph.Edges[1].Bytes)
+
+	clock.Step(10 * time.Minute)
+	require.NoError(t, e.Flush())
+	assert.Empty(t, e.buckets)
+	assert.Empty(t, e.edges)
+	assert.Empty(t, e.Graph(0, "").Edges)
+}
+
+func TestFlowGraphExporterMaxEdges(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	opt := newFlowGraphTestOptions(15 * time.Minute)
+	opt.Config.FlowGraph.MaxEdges = 1
+	e := newFlowGraphExporterWithClock(opt, flowGraphTestPods, clock)
+	clientToDB := prepareFlowGraphTestRecord("10.0.0.1", "client-7d4b9c8f6-x2x4z", "ns1", "10.0.0.2", "db-0", "ns2", "", true)
+	clientToWeb := prepareFlowGraphTestRecord("10.0.0.1", "client-7d4b9c8f6-x2x4z", "ns1", "10.0.0.3", "standalone", "ns2", "ns2/web:http", true)
+
+	require.NoError(t, e.AddRecord(clientToDB, false))
+	require.NoError(t, e.AddRecord(clientToWeb, false))
+	require.NoError(t, e.AddRecord(clientToDB, false))
+	graph := e.Graph(0, "")
+	assert.Equal(t, []querier.FlowGraphEdge{
+		{Source: clientNode, Destination: dbNode, Bytes: 3000, Packets: 30, Connections: 2},
+	}, graph.Edges)
+
+	// Once the existing edge expires, a new edge can be added.
+	clock.Step(16 * time.Minute)
+	require.NoError(t, e.Flush())
+	require.NoError(t, e.AddRecord(clientToWeb, false))
+	graph = e.Graph(0, "")
+	assert.Equal(t, []querier.FlowGraphEdge{
+		{Source: clientNode, Destination: webNode, Bytes: 1500, Packets: 15, Connections: 1},
+	}, graph.Edges)
+}
+
+func TestFlowGraphExporterUpdateOptions(t *testing.T) {
+	e := newFlowGraphExporterWithClock(newFlowGraphTestOptions(15*time.Minute), flowGraphTestPods, clocktesting.NewFakeClock(time.Now()))
+	record := prepareFlowGraphTestRecord("10.0.0.1", "client-7d4b9c8f6-x2x4z", "ns1", "10.0.0.2", "db-0", "ns2", "", true)
+	require.NoError(t, e.AddRecord(record, false))
+
+	opt := newFlowGraphTestOptions(15 * time.Minute)
+	opt.Config.FlowGraph.MaxEdges = 100
+	e.UpdateOptions(opt)
+	assert.Equal(t, 100, e.maxEdges)
+	assert.Len(t, e.Graph(0, "").Edges, 1)
+
+	// The graph is reset when the window changes.
+	e.UpdateOptions(newFlowGraphTestOptions(time.Hour))
+	assert.Equal(t, time.Hour, e.window)
+	assert.Equal(t, time.Minute, e.bucketDuration)
+	assert.Empty(t, e.Graph(0, "").Edges)
+}
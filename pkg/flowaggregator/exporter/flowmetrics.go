@@ -0,0 +1,324 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	kmetrics "k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/flowlogger"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	metricNamespaceAntrea         = "antrea"
+	metricSubsystemFlowAggregator = "flow_aggregator"
+
+	// flowMetricsOverflowLabelValue is the label value used for the series which accounts for
+	// all the flow records received once the maximum number of series has been reached.
+	flowMetricsOverflowLabelValue = "__overflow__"
+)
+
+// All flow metrics share the same label names, which correspond to all the supported labels.
+// Labels which are not configured are left empty, which Prometheus treats the same as a missing
+// label. This means that series never need to be re-registered when the configuration changes.
+var flowMetricsLabelNames = func() []string {
+	labelNames := make([]string, 0, len(flowaggregatorconfig.FlowMetricsLabels))
+	for _, label := range flowaggregatorconfig.FlowMetricsLabels {
+		labelNames = append(labelNames, string(label))
+	}
+	return labelNames
+}()
+
+var (
+	flowMetricsOnce sync.Once
+
+	FlowBytesTotal = kmetrics.NewCounterVec(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "flow_bytes_total",
+			Help:           "Number of bytes (in both directions) observed in flow records.",
+			StabilityLevel: kmetrics.ALPHA,
+		},
+		flowMetricsLabelNames,
+	)
+	FlowPacketsTotal = kmetrics.NewCounterVec(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "flow_packets_total",
+			Help:           "Number of packets (in both directions) observed in flow records.",
+			StabilityLevel: kmetrics.ALPHA,
+		},
+		flowMetricsLabelNames,
+	)
+	FlowConnectionsTotal = kmetrics.NewCounterVec(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "flow_connections_total",
+			Help:           "Number of connections observed in flow records.",
+			StabilityLevel: kmetrics.ALPHA,
+		},
+		flowMetricsLabelNames,
+	)
+	FlowDeniedConnectionsTotal = kmetrics.NewCounterVec(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "flow_denied_connections_total",
+			Help:           "Number of connections observed in flow records which were denied (dropped or rejected) by a NetworkPolicy.",
+			StabilityLevel: kmetrics.ALPHA,
+		},
+		flowMetricsLabelNames,
+	)
+	FlowMetricsSeriesCount = kmetrics.NewGauge(
+		&kmetrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "flow_metrics_series_count",
+			Help:           "Number of label value combinations currently tracked for flow metrics.",
+			StabilityLevel: kmetrics.ALPHA,
+		},
+	)
+)
+
+func initializeFlowMetrics() {
+	flowMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(FlowBytesTotal)
+		legacyregistry.MustRegister(FlowPacketsTotal)
+		legacyregistry.MustRegister(FlowConnectionsTotal)
+		legacyregistry.MustRegister(FlowDeniedConnectionsTotal)
+		legacyregistry.MustRegister(FlowMetricsSeriesCount)
+	})
+}
+
+type flowMetricsSeries struct {
+	labelValues []string
+	lastUpdated time.Time
+}
+
+// FlowMetricsExporter aggregates flow records into Prometheus counters, which are exposed on
+// the metrics endpoint of the Flow Aggregator API server. The number of series is bounded by
+// the maxSeries configuration parameter.
+type FlowMetricsExporter struct {
+	config    flowaggregatorconfig.FlowMetricsConfig
+	seriesTTL time.Duration
+	clock     clock.Clock
+	// series is keyed by the concatenation of the label values.
+	series map[string]*flowMetricsSeries
+}
+
+func NewFlowMetricsExporter(opt *options.Options) *FlowMetricsExporter {
+	return newFlowMetricsExporterWithClock(opt, clock.RealClock{})
+}
+
+func newFlowMetricsExporterWithClock(opt *options.Options, clock clock.Clock) *FlowMetricsExporter {
+	initializeFlowMetrics()
+	config := opt.Config.FlowMetrics
+	klog.InfoS("Flow metrics configuration", "labels", config.Labels, "maxSeries", config.MaxSeries, "seriesTTL", opt.FlowMetricsSeriesTTL)
+	return &FlowMetricsExporter{
+		config:    config,
+		seriesTTL: opt.FlowMetricsSeriesTTL,
+		clock:     clock,
+		series:    make(map[string]*flowMetricsSeries),
+	}
+}
+
+func flowMetricsLabelValue(record *flowpb.Flow, label flowaggregatorconfig.FlowMetricsLabel) string {
+	k8s := record.GetK8S()
+	if k8s == nil {
+		if label == flowaggregatorconfig.FlowMetricsLabelProtocol {
+			return flowlogger.PrettyPrintProtocolIdentifier(uint8(record.Transport.GetProtocolNumber()))
+		}
+		return ""
+	}
+	switch label {
+	case flowaggregatorconfig.FlowMetricsLabelSourceNamespace:
+		return k8s.SourcePodNamespace
+	case flowaggregatorconfig.FlowMetricsLabelSourceNode:
+		return k8s.SourceNodeName
+	case flowaggregatorconfig.FlowMetricsLabelDestinationNamespace:
+		return k8s.DestinationPodNamespace
+	case flowaggregatorconfig.FlowMetricsLabelDestinationNode:
+		return k8s.DestinationNodeName
+	case flowaggregatorconfig.FlowMetricsLabelDestinationService:
+		return k8s.DestinationServicePortName
+	case flowaggregatorconfig.FlowMetricsLabelIngressPolicyType:
+		return flowlogger.PrettyPrintPolicyType(uint8(k8s.IngressNetworkPolicyType))
+	case flowaggregatorconfig.FlowMetricsLabelIngressPolicyNamespace:
+		return k8s.IngressNetworkPolicyNamespace
+	case flowaggregatorconfig.FlowMetricsLabelIngressPolicyName:
+		return k8s.IngressNetworkPolicyName
+	case flowaggregatorconfig.FlowMetricsLabelIngressRuleAction:
+		return flowlogger.PrettyPrintRuleAction(uint8(k8s.IngressNetworkPolicyRuleAction))
+	case flowaggregatorconfig.FlowMetricsLabelEgressPolicyType:
+		return flowlogger.PrettyPrintPolicyType(uint8(k8s.EgressNetworkPolicyType))
+	case flowaggregatorconfig.FlowMetricsLabelEgressPolicyNamespace:
+		return k8s.EgressNetworkPolicyNamespace
+	case flowaggregatorconfig.FlowMetricsLabelEgressPolicyName:
+		return k8s.EgressNetworkPolicyName
+	case flowaggregatorconfig.FlowMetricsLabelEgressRuleAction:
+		return flowlogger.PrettyPrintRuleAction(uint8(k8s.EgressNetworkPolicyRuleAction))
+	case flowaggregatorconfig.FlowMetricsLabelEgressName:
+		return k8s.EgressName
+	case flowaggregatorconfig.FlowMetricsLabelFlowType:
+		if k8s.FlowType == flowpb.FlowType_FLOW_TYPE_UNSPECIFIED {
+			return ""
+		}
+		return enumName(k8s.FlowType.String(), "FLOW_TYPE_")
+	case flowaggregatorconfig.FlowMetricsLabelProtocol:
+		return flowlogger.PrettyPrintProtocolIdentifier(uint8(record.Transport.GetProtocolNumber()))
+	}
+	return ""
+}
+
+// labelValues returns the value of each label in flowMetricsLabelNames, in the same order.
+// Labels which are not part of the configuration have an empty value.
+func (e *FlowMetricsExporter) labelValues(record *flowpb.Flow) []string {
+	values := make([]string, len(flowaggregatorconfig.FlowMetricsLabels))
+	for i, label := range flowaggregatorconfig.FlowMetricsLabels {
+		if slices.Contains(e.config.Labels, label) {
+			values[i] = flowMetricsLabelValue(record, label)
+		}
+	}
+	return values
+}
+
+func (e *FlowMetricsExporter) overflowLabelValues() []string {
+	values := make([]string, len(flowaggregatorconfig.FlowMetricsLabels))
+	for i, label := range flowaggregatorconfig.FlowMetricsLabels {
+		if slices.Contains(e.config.Labels, label) {
+			values[i] = flowMetricsOverflowLabelValue
+		}
+	}
+	return values
+}
+
+// getSeries returns the series to which the record should be accounted for, creating it if
+// needed. Once maxSeries is reached, new label value combinations are accounted for in the
+// overflow series.
+func (e *FlowMetricsExporter) getSeries(record *flowpb.Flow) *flowMetricsSeries {
+	labelValues := e.labelValues(record)
+	key := strings.Join(labelValues, "\x00")
+	if s, ok := e.series[key]; ok {
+		return s
+	}
+	if len(e.series) >= int(e.config.MaxSeries) {
+		labelValues = e.overflowLabelValues()
+		key = strings.Join(labelValues, "\x00")
+		if s, ok := e.series[key]; ok {
+			return s
+		}
+		klog.V(2).InfoS("Maximum number of flow metrics series reached, using overflow series", "maxSeries", e.config.MaxSeries)
+	}
+	s := &flowMetricsSeries{labelValues: labelValues}
+	e.series[key] = s
+	FlowMetricsSeriesCount.Set(float64(len(e.series)))
+	return s
+}
+
+// isNewConnection returns true if this is the first record exported for the connection, in
+// which case the delta counts are equal to the total counts.
+func isNewConnection(record *flowpb.Flow) bool {
+	stats := record.GetStats()
+	return stats.GetPacketDeltaCount() == stats.GetPacketTotalCount()
+}
+
+func isDeniedConnection(record *flowpb.Flow) bool {
+	isDenied := func(action flowpb.NetworkPolicyRuleAction) bool {
+		return action == flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP || action == flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT
+	}
+	k8s := record.GetK8S()
+	return isDenied(k8s.GetIngressNetworkPolicyRuleAction()) || isDenied(k8s.GetEgressNetworkPolicyRuleAction())
+}
+
+func (e *FlowMetricsExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	s := e.getSeries(record)
+	s.lastUpdated = e.clock.Now()
+	stats := record.GetStats()
+	reverseStats := record.GetReverseStats()
+	FlowBytesTotal.WithLabelValues(s.labelValues...).Add(float64(stats.GetOctetDeltaCount() + reverseStats.GetOctetDeltaCount()))
+	FlowPacketsTotal.WithLabelValues(s.labelValues...).Add(float64(stats.GetPacketDeltaCount() + reverseStats.GetPacketDeltaCount()))
+	if isNewConnection(record) {
+		FlowConnectionsTotal.WithLabelValues(s.labelValues...).Inc()
+		if isDeniedConnection(record) {
+			FlowDeniedConnectionsTotal.WithLabelValues(s.labelValues...).Inc()
+		}
+	}
+	return nil
+}
+
+func (e *FlowMetricsExporter) deleteSeries(key string) {
+	s := e.series[key]
+	FlowBytesTotal.DeleteLabelValues(s.labelValues...)
+	FlowPacketsTotal.DeleteLabelValues(s.labelValues...)
+	FlowConnectionsTotal.DeleteLabelValues(s.labelValues...)
+	FlowDeniedConnectionsTotal.DeleteLabelValues(s.labelValues...)
+	delete(e.series, key)
+}
+
+func (e *FlowMetricsExporter) deleteAllSeries() {
+	for key := range e.series {
+		e.deleteSeries(key)
+	}
+	FlowMetricsSeriesCount.Set(0)
+}
+
+func (e *FlowMetricsExporter) Start() {
+	// Nothing to do, metrics are registered when the exporter is created.
+}
+
+func (e *FlowMetricsExporter) Stop() {
+	// Series are removed so that stale metrics are not reported while the exporter is
+	// disabled.
+	e.deleteAllSeries()
+}
+
+func (e *FlowMetricsExporter) UpdateOptions(opt *options.Options) {
+	config := opt.Config.FlowMetrics
+	if !slices.Equal(e.config.Labels, config.Labels) || e.config.MaxSeries != config.MaxSeries {
+		klog.InfoS("Resetting flow metrics because of configuration change")
+		e.deleteAllSeries()
+	}
+	e.config = config
+	e.seriesTTL = opt.FlowMetricsSeriesTTL
+	klog.InfoS("New flow metrics configuration", "labels", config.Labels, "maxSeries", config.MaxSeries, "seriesTTL", opt.FlowMetricsSeriesTTL)
+}
+
+// Flush removes the series which have not been updated for seriesTTL.
+func (e *FlowMetricsExporter) Flush() error {
+	if e.seriesTTL == 0 {
+		return nil
+	}
+	now := e.clock.Now()
+	for key, s := range e.series {
+		if now.Sub(s.lastUpdated) >= e.seriesTTL {
+			e.deleteSeries(key)
+		}
+	}
+	FlowMetricsSeriesCount.Set(float64(len(e.series)))
+	return nil
+}
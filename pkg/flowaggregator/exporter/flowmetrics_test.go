@@ -0,0 +1,179 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/component-base/metrics/testutil"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+func newFlowMetricsTestOptions(mutateFn func(config *flowaggregatorconfig.FlowMetricsConfig)) *options.Options {
+	opt := &options.Options{
+		Config:               &flowaggregatorconfig.FlowAggregatorConfig{},
+		FlowMetricsSeriesTTL: time.Hour,
+	}
+	flowaggregatorconfig.SetConfigDefaults(opt.Config)
+	opt.Config.FlowMetrics.Enable = true
+	if mutateFn != nil {
+		mutateFn(&opt.Config.FlowMetrics)
+	}
+	return opt
+}
+
+func newFlowMetricsTestExporter(t *testing.T, opt *options.Options, clock *clocktesting.FakeClock) *FlowMetricsExporter {
+	e := newFlowMetricsExporterWithClock(opt, clock)
+	// Metrics are registered globally, so we make sure to remove all series at the end of
+	// each test.
+	t.Cleanup(e.Stop)
+	return e
+}
+
+// getFlowMetricValues returns the values of the provided flow metric, summed by the value of
+// the provided label.
+func getFlowMetricValues(t *testing.T, metricName string, labelName flowaggregatorconfig.FlowMetricsLabel) map[string]float64 {
+	families, err := legacyregistry.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	values := make(map[string]float64)
+	for _, family := range families {
+		if family.GetName() != "antrea_flow_aggregator_"+metricName {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == string(labelName) {
+					values[label.GetValue()] += m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return values
+}
+
+func prepareFlowMetricsTestRecord(sourceNamespace string, packetTotalCount, packetDeltaCount uint64) *flowpb.Flow {
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	record.K8S.SourcePodNamespace = sourceNamespace
+	record.K8S.IngressNetworkPolicyRuleAction = flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW
+	record.Stats.PacketTotalCount = packetTotalCount
+	record.Stats.PacketDeltaCount = packetDeltaCount
+	return record
+}
+
+func TestFlowMetrics_AddRecord(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	e := newFlowMetricsTestExporter(t, newFlowMetricsTestOptions(nil), clock)
+
+	record1 := prepareFlowMetricsTestRecord("ns1", 10, 10)
+	record2 := prepareFlowMetricsTestRecord("ns1", 25, 15)
+	record3 := prepareFlowMetricsTestRecord("ns2", 5, 5)
+	record3.K8S.IngressNetworkPolicyRuleAction = flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP
+	for _, record := range []*flowpb.Flow{record1, record2, record3} {
+		require.NoError(t, e.AddRecord(record, false))
+	}
+
+	stats, reverseStats := record1.Stats, record1.ReverseStats
+	octetDeltaCount := float64(stats.OctetDeltaCount + reverseStats.OctetDeltaCount)
+	assert.Equal(t, map[string]float64{
+		"ns1": 2 * octetDeltaCount,
+		"ns2": octetDeltaCount,
+	}, getFlowMetricValues(t, "flow_bytes_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace))
+	assert.Equal(t, map[string]float64{
+		"ns1": float64(10 + 15 + 2*reverseStats.PacketDeltaCount),
+		"ns2": float64(5 + reverseStats.PacketDeltaCount),
+	}, getFlowMetricValues(t, "flow_packets_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace))
+	// record2 is not the first record for its connection.
+	assert.Equal(t, map[string]float64{
+		"ns1": 1,
+		"ns2": 1,
+	}, getFlowMetricValues(t, "flow_connections_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace))
+	assert.Equal(t, map[string]float64{
+		"ns2": 1,
+	}, getFlowMetricValues(t, "flow_denied_connections_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace))
+	// Labels which are not configured are left empty.
+	assert.Equal(t, map[string]float64{
+		"": 3 * octetDeltaCount,
+	}, getFlowMetricValues(t, "flow_bytes_total", flowaggregatorconfig.FlowMetricsLabelProtocol))
+	assert.Equal(t, map[string]float64{
+		record1.K8S.DestinationServicePortName: 3 * octetDeltaCount,
+	}, getFlowMetricValues(t, "flow_bytes_total", flowaggregatorconfig.FlowMetricsLabelDestinationService))
+}
+
+func TestFlowMetrics_MaxSeries(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	opt := newFlowMetricsTestOptions(func(config *flowaggregatorconfig.FlowMetricsConfig) {
+		config.Labels = []flowaggregatorconfig.FlowMetricsLabel{flowaggregatorconfig.FlowMetricsLabelSourceNamespace}
+		config.MaxSeries = 2
+	})
+	e := newFlowMetricsTestExporter(t, opt, clock)
+
+	for _, namespace := range []string{"ns1", "ns2", "ns3", "ns4", "ns1"} {
+		require.NoError(t, e.AddRecord(prepareFlowMetricsTestRecord(namespace, 1, 1), false))
+	}
+	assert.Equal(t, map[string]float64{
+		"ns1":                         2,
+		"ns2":                         1,
+		flowMetricsOverflowLabelValue: 2,
+	}, getFlowMetricValues(t, "flow_connections_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace))
+	seriesCount, err := testutil.GetGaugeMetricValue(FlowMetricsSeriesCount)
+	require.NoError(t, err)
+	assert.Equal(t, float64(3), seriesCount)
+}
+
+func TestFlowMetrics_SeriesTTL(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	e := newFlowMetricsTestExporter(t, newFlowMetricsTestOptions(nil), clock)
+
+	require.NoError(t, e.AddRecord(prepareFlowMetricsTestRecord("ns1", 1, 1), false))
+	clock.Step(30 * time.Minute)
+	require.NoError(t, e.AddRecord(prepareFlowMetricsTestRecord("ns2", 1, 1), false))
+	require.NoError(t, e.Flush())
+	assert.Len(t, getFlowMetricValues(t, "flow_connections_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace), 2)
+
+	clock.Step(30 * time.Minute)
+	require.NoError(t, e.Flush())
+	assert.Equal(t, map[string]float64{
+		"ns2": 1,
+	}, getFlowMetricValues(t, "flow_connections_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace))
+}
+
+func TestFlowMetrics_UpdateOptions(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	e := newFlowMetricsTestExporter(t, newFlowMetricsTestOptions(nil), clock)
+	require.NoError(t, e.AddRecord(prepareFlowMetricsTestRecord("ns1", 1, 1), false))
+
+	// Changing only the TTL does not reset the metrics.
+	e.UpdateOptions(newFlowMetricsTestOptions(nil))
+	assert.Len(t, getFlowMetricValues(t, "flow_connections_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace), 1)
+
+	e.UpdateOptions(newFlowMetricsTestOptions(func(config *flowaggregatorconfig.FlowMetricsConfig) {
+		config.Labels = []flowaggregatorconfig.FlowMetricsLabel{flowaggregatorconfig.FlowMetricsLabelProtocol}
+	}))
+	assert.Empty(t, getFlowMetricValues(t, "flow_connections_total", flowaggregatorconfig.FlowMetricsLabelSourceNamespace))
+
+	require.NoError(t, e.AddRecord(prepareFlowMetricsTestRecord("ns1", 1, 1), false))
+	assert.Equal(t, map[string]float64{
+		"TCP": 1,
+	}, getFlowMetricValues(t, "flow_connections_total", flowaggregatorconfig.FlowMetricsLabelProtocol))
+}
@@ -371,6 +371,10 @@ func (e *IPFIXExporter) makeIPFIXRecord(flow *flowpb.Flow, isIPv6 bool) ipfixent
 	}
 	next().SetUnsigned8Value(uint8(flow.K8S.EgressNetworkPolicyRuleAction))
 	next().SetStringValue(flow.Transport.GetTCP().GetStateName()) // Use Getter functions in case transport is not TCP
+	next().SetUnsigned32Value(flow.Transport.GetTCP().GetRttUs())
+	next().SetUnsigned32Value(flow.Transport.GetTCP().GetRttVarUs())
+	next().SetUnsigned32Value(flow.Transport.GetTCP().GetRetransmissions())
+	next().SetUnsigned64Value(flow.Transport.GetTCP().GetRwndLimitedTimeUs())
 	next().SetUnsigned8Value(uint8(flow.K8S.FlowType))
 	if e.includeK8sNames {
 		next().SetStringValue(flow.K8S.EgressName)
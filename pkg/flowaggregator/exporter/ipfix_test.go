@@ -40,6 +40,7 @@ import (
 	"antrea.io/antrea/pkg/flowaggregator/infoelements"
 	"antrea.io/antrea/pkg/flowaggregator/options"
 	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+	"antrea.io/antrea/pkg/ipfix"
 	ipfixtesting "antrea.io/antrea/pkg/ipfix/testing"
 	"antrea.io/antrea/pkg/util/tlstest"
 )
@@ -51,7 +52,7 @@ const (
 )
 
 func init() {
-	ipfixregistry.LoadRegistry()
+	ipfix.LoadRegistry()
 }
 
 func createElement(name string, enterpriseID uint32) ipfixentities.InfoElementWithValue {
@@ -0,0 +1,369 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	kafkaCertDir = "/etc/flow-aggregator/certs/kafka"
+
+	kafkaUsernameEnvKey = "KAFKA_USERNAME"
+	kafkaPasswordEnvKey = "KAFKA_PASSWORD"
+
+	// kafkaBatchTimeout bounds how long the writer waits for more messages before sending an
+	// incomplete batch. Batching is driven by the exporter (see Flush), so we keep it short.
+	kafkaBatchTimeout = 10 * time.Millisecond
+	// kafkaMaxPendingBatches is the maximum number of batches waiting to be published. When the
+	// brokers are slow or unavailable, new batches are dropped on Flush once this limit is reached.
+	kafkaMaxPendingBatches = 10
+)
+
+// kafkaBatch is a batch of messages to be published by the background goroutine.
+type kafkaBatch struct {
+	messages []kafka.Message
+	// If not nil, resultCh receives the result of publishing this batch and all the batches
+	// published since the previous batch with a resultCh.
+	resultCh chan<- error
+}
+
+// kafkaWriter is the subset of the kafka.Writer methods used by KafkaExporter. It can be
+// substituted in unit tests.
+type kafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// this is used for unit testing
+var newKafkaWriter = func(config flowaggregatorconfig.KafkaConfig) (kafkaWriter, error) {
+	return createKafkaWriter(config)
+}
+
+type KafkaExporter struct {
+	clusterID    string
+	config       flowaggregatorconfig.KafkaConfig
+	writeTimeout time.Duration
+	writer       kafkaWriter
+	messages     []kafka.Message
+	// batchCh queues the batches to be published by the background goroutine, so that a slow
+	// or unavailable broker does not block the caller. It is nil when the exporter is stopped.
+	batchCh chan kafkaBatch
+	// doneCh is closed when the background goroutine has published all queued batches.
+	doneCh chan struct{}
+	// numDropped is the number of records dropped by Flush since the last call to FlushSync.
+	numDropped int
+}
+
+func NewKafkaExporter(clusterID string, opt *options.Options) (*KafkaExporter, error) {
+	config := opt.Config.Kafka
+	klog.InfoS("Kafka configuration", "brokers", config.Brokers, "topic", config.Topic, "recordFormat", config.RecordFormat, "partitionKey", config.PartitionKey, "compression", config.Compression, "maxBatchSize", config.MaxBatchSize, "writeTimeout", opt.KafkaWriteTimeout, "tls", config.TLS.Enable, "saslMechanism", config.SASL.Mechanism)
+	// The writer is created here so that TLS and SASL errors are reported when the exporter is
+	// created. It does not connect to the brokers until records are published.
+	writer, err := newKafkaWriter(config)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaExporter{
+		clusterID:    clusterID,
+		config:       config,
+		writeTimeout: opt.KafkaWriteTimeout,
+		writer:       writer,
+	}, nil
+}
+
+func parseKafkaCompression(compression string) (kafka.Compression, error) {
+	switch compression {
+	case "", "none":
+		return 0, nil
+	case "gzip":
+		return kafka.Gzip, nil
+	case "snappy":
+		return kafka.Snappy, nil
+	case "lz4":
+		return kafka.Lz4, nil
+	case "zstd":
+		return kafka.Zstd, nil
+	}
+	return 0, fmt.Errorf("unsupported compression codec: %s", compression)
+}
+
+func createKafkaTLSConfig(config flowaggregatorconfig.KafkaTLSConfig) (*tls.Config, error) {
+	if !config.Enable {
+		return nil, nil
+	}
+	return newClientTLSConfig(clientTLSConfig{
+		certDir:            kafkaCertDir,
+		caSecretName:       config.CASecretName,
+		serverName:         config.ServerName,
+		clientSecretName:   config.ClientSecretName,
+		minVersion:         config.MinVersion,
+		insecureSkipVerify: config.InsecureSkipVerify,
+	})
+}
+
+func createKafkaSASLMechanism(config flowaggregatorconfig.KafkaSASLConfig) (sasl.Mechanism, error) {
+	if config.Mechanism == "" {
+		return nil, nil
+	}
+	username := os.Getenv(kafkaUsernameEnvKey)
+	password := os.Getenv(kafkaPasswordEnvKey)
+	switch config.Mechanism {
+	case flowaggregatorconfig.KafkaSASLMechanismPlain:
+		return plain.Mechanism{Username: username, Password: password}, nil
+	case flowaggregatorconfig.KafkaSASLMechanismSCRAMSHA256:
+		return scram.Mechanism(scram.SHA256, username, password)
+	case flowaggregatorconfig.KafkaSASLMechanismSCRAMSHA512:
+		return scram.Mechanism(scram.SHA512, username, password)
+	}
+	return nil, fmt.Errorf("unsupported SASL mechanism: %s", config.Mechanism)
+}
+
+func createKafkaWriter(config flowaggregatorconfig.KafkaConfig) (*kafka.Writer, error) {
+	compression, err := parseKafkaCompression(config.Compression)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := createKafkaTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+	mechanism, err := createKafkaSASLMechanism(config.SASL)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating SASL mechanism: %w", err)
+	}
+	return &kafka.Writer{
+		Addr:  kafka.TCP(config.Brokers...),
+		Topic: config.Topic,
+		// Messages with the same key are always assigned to the same partition.
+		Balancer:     &kafka.Hash{},
+		BatchSize:    int(config.MaxBatchSize),
+		BatchTimeout: kafkaBatchTimeout,
+		RequiredAcks: kafka.RequireAll,
+		Compression:  compression,
+		Transport: &kafka.Transport{
+			TLS:  tlsConfig,
+			SASL: mechanism,
+		},
+	}, nil
+}
+
+func (e *KafkaExporter) partitionKey(record *flowpb.Flow) []byte {
+	if e.config.PartitionKey == flowaggregatorconfig.KafkaPartitionKeyNamespace {
+		var namespace string
+		if k8s := record.GetK8S(); k8s != nil {
+			namespace = k8s.SourcePodNamespace
+			if namespace == "" {
+				namespace = k8s.DestinationPodNamespace
+			}
+		}
+		return []byte(e.clusterID + "/" + namespace)
+	}
+	return []byte(e.clusterID)
+}
+
+func (e *KafkaExporter) marshalRecord(record *flowpb.Flow) ([]byte, error) {
+	if e.config.RecordFormat == flowaggregatorconfig.KafkaRecordFormatJSON {
+		return protojson.Marshal(record)
+	}
+	return proto.Marshal(record)
+}
+
+func (e *KafkaExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	value, err := e.marshalRecord(record)
+	if err != nil {
+		return fmt.Errorf("error when serializing flow record: %w", err)
+	}
+	e.messages = append(e.messages, kafka.Message{
+		Key:   e.partitionKey(record),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: "clusterID", Value: []byte(e.clusterID)},
+		},
+	})
+	// Hand the full batch to the background goroutine if there is room for it. Otherwise, the
+	// records stay buffered until the next call to Flush or FlushSync.
+	if len(e.messages) >= int(e.config.MaxBatchSize) && e.batchCh != nil {
+		select {
+		case e.batchCh <- kafkaBatch{messages: e.messages}:
+			e.messages = nil
+		default:
+		}
+	}
+	return nil
+}
+
+func (e *KafkaExporter) Start() {
+	e.start()
+}
+
+func (e *KafkaExporter) Stop() {
+	e.stop()
+}
+
+func (e *KafkaExporter) start() {
+	if e.writer == nil {
+		writer, err := newKafkaWriter(e.config)
+		if err != nil {
+			// Records will be dropped on Flush until the configuration is fixed.
+			klog.ErrorS(err, "Error when creating Kafka writer")
+			return
+		}
+		e.writer = writer
+	}
+	if e.batchCh != nil {
+		return
+	}
+	e.batchCh = make(chan kafkaBatch, kafkaMaxPendingBatches)
+	e.doneCh = make(chan struct{})
+	go publishKafkaBatches(e.writer, e.batchCh, e.doneCh, e.writeTimeout, e.config.Topic)
+}
+
+func (e *KafkaExporter) stop() {
+	e.flush()
+	if e.batchCh != nil {
+		// Wait for the queued batches to be published before closing the writer.
+		close(e.batchCh)
+		<-e.doneCh
+		e.batchCh = nil
+		e.doneCh = nil
+	}
+	if e.writer == nil {
+		return
+	}
+	if err := e.writer.Close(); err != nil {
+		klog.ErrorS(err, "Error when closing Kafka writer")
+	}
+	e.writer = nil
+}
+
+// publishKafkaBatches publishes the batches received from batchCh until it is closed. Batches that
+// cannot be published within writeTimeout are discarded, so that memory usage stays bounded when
+// the brokers are unavailable.
+func publishKafkaBatches(writer kafkaWriter, batchCh <-chan kafkaBatch, doneCh chan<- struct{}, writeTimeout time.Duration, topic string) {
+	defer close(doneCh)
+	numFailed := 0
+	var lastErr error
+	for batch := range batchCh {
+		if len(batch.messages) > 0 {
+			ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+			err := writer.WriteMessages(ctx, batch.messages...)
+			cancel()
+			if err != nil {
+				klog.ErrorS(err, "Error when publishing flow records to Kafka, dropping them", "count", len(batch.messages), "topic", topic)
+				numFailed += len(batch.messages)
+				lastErr = err
+			} else {
+				klog.V(4).InfoS("Published flow records to Kafka", "count", len(batch.messages), "topic", topic)
+			}
+		}
+		if batch.resultCh != nil {
+			if numFailed > 0 {
+				batch.resultCh <- fmt.Errorf("error when publishing %d flow records to Kafka: %w", numFailed, lastErr)
+			} else {
+				batch.resultCh <- nil
+			}
+			numFailed = 0
+			lastErr = nil
+		}
+	}
+}
+
+func (e *KafkaExporter) UpdateOptions(opt *options.Options) {
+	config := opt.Config.Kafka
+	if reflect.DeepEqual(e.config, config) && e.writeTimeout == opt.KafkaWriteTimeout {
+		return
+	}
+	klog.InfoS("Updating Kafka exporter")
+	e.stop()
+	e.config = config
+	e.writeTimeout = opt.KafkaWriteTimeout
+	klog.InfoS("New Kafka configuration", "brokers", config.Brokers, "topic", config.Topic, "recordFormat", config.RecordFormat, "partitionKey", config.PartitionKey, "compression", config.Compression, "maxBatchSize", config.MaxBatchSize, "writeTimeout", opt.KafkaWriteTimeout, "tls", config.TLS.Enable, "saslMechanism", config.SASL.Mechanism)
+	e.start()
+}
+
+// Flush hands all the buffered flow records to the background goroutine, which publishes them to
+// Kafka. It never blocks: records are logged and discarded if there is no writer or if too many
+// batches are already pending, so that a failing broker does not affect the other exporters.
+func (e *KafkaExporter) Flush() error {
+	e.flush()
+	return nil
+}
+
+func (e *KafkaExporter) flush() {
+	if len(e.messages) == 0 {
+		return
+	}
+	messages := e.messages
+	e.messages = nil
+	if e.batchCh == nil {
+		klog.ErrorS(nil, "No Kafka writer available, dropping flow records", "count", len(messages))
+		e.numDropped += len(messages)
+		return
+	}
+	select {
+	case e.batchCh <- kafkaBatch{messages: messages}:
+	default:
+		klog.ErrorS(nil, "Too many flow record batches pending publication to Kafka, dropping flow records", "count", len(messages))
+		e.numDropped += len(messages)
+	}
+}
+
+// FlushSync publishes all the flow records added so far, and waits until they have been
+// published. It returns an error if any of them could not be published. It is used by the disk
+// queue, which keeps the records until they have been published successfully.
+func (e *KafkaExporter) FlushSync() error {
+	messages := e.messages
+	e.messages = nil
+	numDropped := e.numDropped
+	e.numDropped = 0
+	if e.batchCh == nil {
+		if len(messages)+numDropped == 0 {
+			return nil
+		}
+		return fmt.Errorf("no Kafka writer available, dropped %d flow records", len(messages)+numDropped)
+	}
+	resultCh := make(chan error, 1)
+	e.batchCh <- kafkaBatch{messages: messages, resultCh: resultCh}
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	if numDropped > 0 {
+		return fmt.Errorf("dropped %d flow records because too many batches were pending publication to Kafka", numDropped)
+	}
+	return nil
+}
+
+// FlushInterval returns 0, as records are not published periodically but whenever Flush is called.
+func (e *KafkaExporter) FlushInterval() time.Duration {
+	return 0
+}
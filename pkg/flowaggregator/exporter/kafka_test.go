@@ -0,0 +1,392 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/segmentio/kafka-go"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+type fakeKafkaWriter struct {
+	mutex     sync.Mutex
+	config    flowaggregatorconfig.KafkaConfig
+	messages  []kafka.Message
+	writeErr  error
+	closed    bool
+	numWrites int
+	// If not nil, WriteMessages blocks until it is closed.
+	unblockCh chan struct{}
+}
+
+func (w *fakeKafkaWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	w.mutex.Lock()
+	w.numWrites++
+	unblockCh := w.unblockCh
+	w.mutex.Unlock()
+	if unblockCh != nil {
+		<-unblockCh
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.writeErr != nil {
+		return w.writeErr
+	}
+	w.messages = append(w.messages, msgs...)
+	return nil
+}
+
+func (w *fakeKafkaWriter) Close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.closed = true
+	return nil
+}
+
+func (w *fakeKafkaWriter) setErr(err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.writeErr = err
+}
+
+func (w *fakeKafkaWriter) getMessages() []kafka.Message {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.messages
+}
+
+func (w *fakeKafkaWriter) getNumWrites() int {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.numWrites
+}
+
+func (w *fakeKafkaWriter) isClosed() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.closed
+}
+
+// mockKafkaWriters replaces newKafkaWriter with a function which returns fake writers, and
+// returns a pointer to the list of all fake writers created so far.
+func mockKafkaWriters(t *testing.T) *[]*fakeKafkaWriter {
+	var writers []*fakeKafkaWriter
+	newKafkaWriterSaved := newKafkaWriter
+	t.Cleanup(func() { newKafkaWriter = newKafkaWriterSaved })
+	newKafkaWriter = func(config flowaggregatorconfig.KafkaConfig) (kafkaWriter, error) {
+		w := &fakeKafkaWriter{config: config}
+		writers = append(writers, w)
+		return w, nil
+	}
+	return &writers
+}
+
+func newKafkaTestOptions(mutateFn func(config *flowaggregatorconfig.KafkaConfig)) *options.Options {
+	opt := &options.Options{
+		Config:            &flowaggregatorconfig.FlowAggregatorConfig{},
+		KafkaWriteTimeout: 10 * time.Second,
+	}
+	flowaggregatorconfig.SetConfigDefaults(opt.Config)
+	opt.Config.Kafka.Enable = true
+	opt.Config.Kafka.Brokers = []string{"kafka.example.com:9092"}
+	opt.Config.Kafka.Topic = "flows"
+	if mutateFn != nil {
+		mutateFn(&opt.Config.Kafka)
+	}
+	return opt
+}
+
+func TestKafka_AddRecord(t *testing.T) {
+	const clusterID = "test-cluster"
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+
+	testCases := []struct {
+		name         string
+		recordFormat flowaggregatorconfig.KafkaRecordFormat
+		partitionKey flowaggregatorconfig.KafkaPartitionKey
+		expectedKey  string
+	}{
+		{
+			name:         "Protobuf with ClusterID key",
+			recordFormat: flowaggregatorconfig.KafkaRecordFormatProtobuf,
+			partitionKey: flowaggregatorconfig.KafkaPartitionKeyClusterID,
+			expectedKey:  clusterID,
+		},
+		{
+			name:         "JSON with Namespace key",
+			recordFormat: flowaggregatorconfig.KafkaRecordFormatJSON,
+			partitionKey: flowaggregatorconfig.KafkaPartitionKeyNamespace,
+			expectedKey:  clusterID + "/" + record.K8S.SourcePodNamespace,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			writers := mockKafkaWriters(t)
+			opt := newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+				config.RecordFormat = tc.recordFormat
+				config.PartitionKey = tc.partitionKey
+			})
+			kafkaExporter, err := NewKafkaExporter(clusterID, opt)
+			require.NoError(t, err)
+			kafkaExporter.Start()
+			defer kafkaExporter.Stop()
+			require.Len(t, *writers, 1)
+			writer := (*writers)[0]
+
+			require.NoError(t, kafkaExporter.AddRecord(record, false))
+			// Records are buffered until Flush is called.
+			assert.Empty(t, writer.getMessages())
+			require.NoError(t, kafkaExporter.Flush())
+			// Records are published in the background.
+			require.Eventually(t, func() bool {
+				return len(writer.getMessages()) == 1
+			}, time.Second, 10*time.Millisecond)
+			messages := writer.getMessages()
+			assert.Equal(t, tc.expectedKey, string(messages[0].Key))
+			assert.Equal(t, []kafka.Header{{Key: "clusterID", Value: []byte(clusterID)}}, messages[0].Headers)
+
+			decodedRecord := &flowpb.Flow{}
+			if tc.recordFormat == flowaggregatorconfig.KafkaRecordFormatJSON {
+				require.NoError(t, protojson.Unmarshal(messages[0].Value, decodedRecord))
+			} else {
+				require.NoError(t, proto.Unmarshal(messages[0].Value, decodedRecord))
+			}
+			assert.Empty(t, cmp.Diff(record, decodedRecord, protocmp.Transform()))
+		})
+	}
+}
+
+func TestKafka_Batching(t *testing.T) {
+	writers := mockKafkaWriters(t)
+	opt := newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+		config.MaxBatchSize = 3
+	})
+	kafkaExporter, err := NewKafkaExporter("test-cluster", opt)
+	require.NoError(t, err)
+	kafkaExporter.Start()
+	writer := (*writers)[0]
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	for range 2 {
+		require.NoError(t, kafkaExporter.AddRecord(record, false))
+	}
+	assert.Empty(t, writer.getMessages())
+	// The batch is published as soon as it is full.
+	require.NoError(t, kafkaExporter.AddRecord(record, false))
+	require.Eventually(t, func() bool {
+		return len(writer.getMessages()) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	// Remaining records are published when the exporter is stopped.
+	require.NoError(t, kafkaExporter.AddRecord(record, false))
+	kafkaExporter.Stop()
+	assert.Len(t, writer.getMessages(), 4)
+	assert.True(t, writer.isClosed())
+}
+
+func TestKafka_PublishError(t *testing.T) {
+	writers := mockKafkaWriters(t)
+	kafkaExporter, err := NewKafkaExporter("test-cluster", newKafkaTestOptions(nil))
+	require.NoError(t, err)
+	kafkaExporter.Start()
+	writer := (*writers)[0]
+	writer.setErr(fmt.Errorf("broker unavailable"))
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, kafkaExporter.AddRecord(record, false))
+	// Publishing errors are not returned to the caller, so that the other exporters are not affected.
+	assert.NoError(t, kafkaExporter.Flush())
+	// Records are dropped on error.
+	kafkaExporter.Stop()
+	assert.Equal(t, 1, writer.getNumWrites())
+	assert.Empty(t, writer.getMessages())
+	assert.Empty(t, kafkaExporter.messages)
+}
+
+func TestKafka_QueueFull(t *testing.T) {
+	writers := mockKafkaWriters(t)
+	kafkaExporter, err := NewKafkaExporter("test-cluster", newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+		config.MaxBatchSize = 1
+	}))
+	require.NoError(t, err)
+	writer := (*writers)[0]
+	writer.unblockCh = make(chan struct{})
+	kafkaExporter.Start()
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	// The first batch is being published, and blocks the background goroutine.
+	require.NoError(t, kafkaExporter.AddRecord(record, false))
+	require.Eventually(t, func() bool {
+		return writer.getNumWrites() == 1
+	}, time.Second, 10*time.Millisecond)
+	// AddRecord does not block when the queue is full: the extra record stays buffered.
+	for range kafkaMaxPendingBatches + 1 {
+		require.NoError(t, kafkaExporter.AddRecord(record, false))
+	}
+	assert.Len(t, kafkaExporter.messages, 1)
+	// Flush does not block either: the extra batch is dropped.
+	require.NoError(t, kafkaExporter.Flush())
+	assert.Empty(t, kafkaExporter.messages)
+
+	close(writer.unblockCh)
+	kafkaExporter.Stop()
+	assert.Len(t, writer.getMessages(), kafkaMaxPendingBatches+1)
+}
+
+func TestKafka_FlushSync(t *testing.T) {
+	writers := mockKafkaWriters(t)
+	kafkaExporter, err := NewKafkaExporter("test-cluster", newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+		config.MaxBatchSize = 2
+	}))
+	require.NoError(t, err)
+	kafkaExporter.Start()
+	defer kafkaExporter.Stop()
+	writer := (*writers)[0]
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	for range 3 {
+		require.NoError(t, kafkaExporter.AddRecord(record, false))
+	}
+	// FlushSync returns once all the records, including the batch handed to the background
+	// goroutine by AddRecord, have been published.
+	require.NoError(t, kafkaExporter.FlushSync())
+	assert.Len(t, writer.getMessages(), 3)
+	assert.Empty(t, kafkaExporter.messages)
+}
+
+func TestKafka_FlushSyncError(t *testing.T) {
+	writers := mockKafkaWriters(t)
+	kafkaExporter, err := NewKafkaExporter("test-cluster", newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+		config.MaxBatchSize = 2
+	}))
+	require.NoError(t, err)
+	kafkaExporter.Start()
+	defer kafkaExporter.Stop()
+	writer := (*writers)[0]
+	writer.setErr(fmt.Errorf("broker unavailable"))
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	for range 3 {
+		require.NoError(t, kafkaExporter.AddRecord(record, false))
+	}
+	// The failure of the batch published in the background is reported as well.
+	assert.EqualError(t, kafkaExporter.FlushSync(), "error when publishing 3 flow records to Kafka: broker unavailable")
+
+	writer.setErr(nil)
+	require.NoError(t, kafkaExporter.AddRecord(record, false))
+	require.NoError(t, kafkaExporter.FlushSync())
+	assert.Len(t, writer.getMessages(), 1)
+}
+
+func TestKafka_UpdateOptions(t *testing.T) {
+	writers := mockKafkaWriters(t)
+	kafkaExporter, err := NewKafkaExporter("test-cluster", newKafkaTestOptions(nil))
+	require.NoError(t, err)
+	kafkaExporter.Start()
+	defer kafkaExporter.Stop()
+	require.Len(t, *writers, 1)
+	writer1 := (*writers)[0]
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, kafkaExporter.AddRecord(record, false))
+
+	// No change in configuration: the writer is not re-created.
+	kafkaExporter.UpdateOptions(newKafkaTestOptions(nil))
+	assert.Len(t, *writers, 1)
+	assert.Empty(t, writer1.getMessages())
+
+	kafkaExporter.UpdateOptions(newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+		config.Topic = "new-flows"
+	}))
+	// Buffered records are published with the old writer before switching to the new one.
+	assert.Len(t, writer1.getMessages(), 1)
+	assert.True(t, writer1.isClosed())
+	require.Len(t, *writers, 2)
+	writer2 := (*writers)[1]
+	assert.Equal(t, "new-flows", writer2.config.Topic)
+
+	require.NoError(t, kafkaExporter.AddRecord(record, false))
+	require.NoError(t, kafkaExporter.Flush())
+	require.Eventually(t, func() bool {
+		return len(writer2.getMessages()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, writer1.getMessages(), 1)
+}
+
+func TestCreateKafkaWriter(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	t.Cleanup(func() { defaultFS = afero.NewOsFs() })
+
+	t.Run("plain", func(t *testing.T) {
+		opt := newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+			config.Compression = "zstd"
+		})
+		writer, err := createKafkaWriter(opt.Config.Kafka)
+		require.NoError(t, err)
+		assert.Equal(t, "flows", writer.Topic)
+		assert.Equal(t, kafka.Zstd, writer.Compression)
+		assert.Equal(t, 1000, writer.BatchSize)
+		transport := writer.Transport.(*kafka.Transport)
+		assert.Nil(t, transport.TLS)
+		assert.Nil(t, transport.SASL)
+	})
+	t.Run("tls and sasl", func(t *testing.T) {
+		t.Setenv(kafkaUsernameEnvKey, "user")
+		t.Setenv(kafkaPasswordEnvKey, "password")
+		caCertPEM, _ := generateLocalhostCert(t, false)
+		require.NoError(t, afero.WriteFile(defaultFS, filepath.Join(kafkaCertDir, "ca.crt"), caCertPEM, 0644))
+		opt := newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+			config.TLS.Enable = true
+			config.TLS.CASecretName = "kafka-ca"
+			config.TLS.ServerName = "kafka.example.com"
+			config.SASL.Mechanism = flowaggregatorconfig.KafkaSASLMechanismSCRAMSHA512
+		})
+		writer, err := createKafkaWriter(opt.Config.Kafka)
+		require.NoError(t, err)
+		transport := writer.Transport.(*kafka.Transport)
+		require.NotNil(t, transport.TLS)
+		assert.NotNil(t, transport.TLS.RootCAs)
+		assert.Equal(t, "kafka.example.com", transport.TLS.ServerName)
+		require.NotNil(t, transport.SASL)
+		assert.Equal(t, "SCRAM-SHA-512", transport.SASL.Name())
+	})
+	t.Run("missing client cert", func(t *testing.T) {
+		opt := newKafkaTestOptions(func(config *flowaggregatorconfig.KafkaConfig) {
+			config.TLS.Enable = true
+			config.TLS.ClientSecretName = "kafka-client"
+		})
+		_, err := createKafkaWriter(opt.Config.Kafka)
+		assert.ErrorContains(t, err, `ensure Secret "kafka-client" exists in this Namespace and has the 'tls.crt' key`)
+	})
+}
@@ -0,0 +1,587 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcgzip "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/flowlogger"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	otlpCertDir = "/etc/flow-aggregator/certs/otlp"
+
+	otlpHTTPLogsPath = "/v1/logs"
+	otlpScopeName    = "antrea.io/flow-aggregator"
+	otlpServiceName  = "antrea-flow-aggregator"
+
+	// otlpMaxPendingBatches is the maximum number of batches waiting to be exported. When the
+	// receiver is slow or unavailable, new batches are dropped on Flush once this limit is reached.
+	otlpMaxPendingBatches = 10
+)
+
+// otlpBatch is a batch of log records to be exported by the background goroutine.
+type otlpBatch struct {
+	logRecords []*logspb.LogRecord
+	// If not nil, resultCh receives the result of exporting this batch and all the batches
+	// exported since the previous batch with a resultCh.
+	resultCh chan<- error
+}
+
+// otlpLogsClient sends OTLP export requests to a receiver, using either gRPC or HTTP.
+type otlpLogsClient interface {
+	Export(ctx context.Context, request *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error)
+	Close() error
+}
+
+// this is used for unit testing
+var newOTLPLogsClient = func(config flowaggregatorconfig.OTLPConfig) (otlpLogsClient, error) {
+	return createOTLPLogsClient(config)
+}
+
+type OTLPExporter struct {
+	resource   *resourcepb.Resource
+	config     flowaggregatorconfig.OTLPConfig
+	timeout    time.Duration
+	client     otlpLogsClient
+	logRecords []*logspb.LogRecord
+	// batchCh queues the batches to be exported by the background goroutine, so that a slow or
+	// unavailable receiver does not block the caller. It is nil when the exporter is stopped.
+	batchCh chan otlpBatch
+	// doneCh is closed when the background goroutine has exported all queued batches.
+	doneCh chan struct{}
+	// numDropped is the number of records dropped by Flush since the last call to FlushSync.
+	numDropped int
+}
+
+func NewOTLPExporter(clusterUUID uuid.UUID, clusterID string, opt *options.Options) (*OTLPExporter, error) {
+	config := opt.Config.OTLP
+	klog.InfoS("OTLP configuration", "endpoint", config.Endpoint, "protocol", config.Protocol, "compression", config.Compression, "maxBatchSize", config.MaxBatchSize, "timeout", opt.OTLPTimeout, "tls", config.TLS.Enable)
+	client, err := newOTLPLogsClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &OTLPExporter{
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{
+				stringAttribute("service.name", otlpServiceName),
+				stringAttribute("k8s.cluster.uid", clusterUUID.String()),
+				stringAttribute("antrea.cluster.id", clusterID),
+			},
+		},
+		config:  config,
+		timeout: opt.OTLPTimeout,
+		client:  client,
+	}, nil
+}
+
+func createOTLPLogsClient(config flowaggregatorconfig.OTLPConfig) (otlpLogsClient, error) {
+	var tlsConfig *clientTLSConfig
+	if config.TLS.Enable {
+		tlsConfig = &clientTLSConfig{
+			certDir:            otlpCertDir,
+			caSecretName:       config.TLS.CASecretName,
+			serverName:         config.TLS.ServerName,
+			clientSecretName:   config.TLS.ClientSecretName,
+			minVersion:         config.TLS.MinVersion,
+			insecureSkipVerify: config.TLS.InsecureSkipVerify,
+		}
+	}
+	switch config.Protocol {
+	case flowaggregatorconfig.OTLPProtocolGRPC:
+		return newOTLPGRPCClient(config, tlsConfig)
+	case flowaggregatorconfig.OTLPProtocolHTTP:
+		return newOTLPHTTPClient(config, tlsConfig)
+	}
+	return nil, fmt.Errorf("unsupported OTLP protocol: %s", config.Protocol)
+}
+
+type otlpGRPCClient struct {
+	conn     *grpc.ClientConn
+	client   collogspb.LogsServiceClient
+	metadata metadata.MD
+	callOpts []grpc.CallOption
+}
+
+func newOTLPGRPCClient(config flowaggregatorconfig.OTLPConfig, tlsConfig *clientTLSConfig) (*otlpGRPCClient, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		c, err := newClientTLSConfig(*tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(c)
+	}
+	// The connection is established lazily, when the first request is sent.
+	conn, err := grpc.NewClient(config.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("error when creating gRPC client for OTLP receiver: %w", err)
+	}
+	var callOpts []grpc.CallOption
+	if config.Compression == "gzip" {
+		callOpts = append(callOpts, grpc.UseCompressor(grpcgzip.Name))
+	}
+	return &otlpGRPCClient{
+		conn:     conn,
+		client:   collogspb.NewLogsServiceClient(conn),
+		metadata: metadata.New(config.Headers),
+		callOpts: callOpts,
+	}, nil
+}
+
+func (c *otlpGRPCClient) Export(ctx context.Context, request *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	if len(c.metadata) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, c.metadata)
+	}
+	return c.client.Export(ctx, request, c.callOpts...)
+}
+
+func (c *otlpGRPCClient) Close() error {
+	return c.conn.Close()
+}
+
+type otlpHTTPClient struct {
+	client   *http.Client
+	url      string
+	headers  map[string]string
+	compress bool
+}
+
+func newOTLPHTTPClient(config flowaggregatorconfig.OTLPConfig, tlsConfig *clientTLSConfig) (*otlpHTTPClient, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	scheme := "http"
+	if tlsConfig != nil {
+		c, err := newClientTLSConfig(*tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = c
+		scheme = "https"
+	}
+	return &otlpHTTPClient{
+		client:   &http.Client{Transport: transport},
+		url:      scheme + "://" + config.Endpoint + otlpHTTPLogsPath,
+		headers:  config.Headers,
+		compress: config.Compression == "gzip",
+	}, nil
+}
+
+func (c *otlpHTTPClient) Export(ctx context.Context, request *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	body, err := proto.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("error when serializing OTLP request: %w", err)
+	}
+	if c.compress {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(body); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		body = buf.Bytes()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	if c.compress {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error when reading OTLP response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("OTLP receiver returned unexpected status: %s", resp.Status)
+	}
+	response := &collogspb.ExportLogsServiceResponse{}
+	if err := proto.Unmarshal(respBody, response); err != nil {
+		return nil, fmt.Errorf("error when parsing OTLP response: %w", err)
+	}
+	return response, nil
+}
+
+func (c *otlpHTTPClient) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func intAttribute(key string, value int64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value}}}
+}
+
+func labelsAttribute(key string, labels map[string]string) *commonpb.KeyValue {
+	values := make([]*commonpb.KeyValue, 0, len(labels))
+	for k, v := range labels {
+		values = append(values, stringAttribute(k, v))
+	}
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{Values: values}}}}
+}
+
+// enumName converts a Protobuf enum value name to a shorter lowercase form, e.g.
+// FLOW_TYPE_INTRA_NODE becomes intra_node.
+func enumName(name string, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// attributeBuilder is used to build the list of attributes of a log record, while omitting
+// empty values.
+type attributeBuilder []*commonpb.KeyValue
+
+func (b *attributeBuilder) addString(key, value string) {
+	if value != "" {
+		*b = append(*b, stringAttribute(key, value))
+	}
+}
+
+func (b *attributeBuilder) addInt(key string, value int64) {
+	if value != 0 {
+		*b = append(*b, intAttribute(key, value))
+	}
+}
+
+func (b *attributeBuilder) addIP(key string, value []byte) {
+	if len(value) > 0 {
+		*b = append(*b, stringAttribute(key, net.IP(value).String()))
+	}
+}
+
+func (b *attributeBuilder) addLabels(key string, labels *flowpb.Labels) {
+	if len(labels.GetLabels()) > 0 {
+		*b = append(*b, labelsAttribute(key, labels.GetLabels()))
+	}
+}
+
+func (b *attributeBuilder) addStats(prefix string, stats *flowpb.Stats) {
+	if stats == nil {
+		return
+	}
+	b.addInt(prefix+".packet_total_count", int64(stats.PacketTotalCount))
+	b.addInt(prefix+".packet_delta_count", int64(stats.PacketDeltaCount))
+	b.addInt(prefix+".octet_total_count", int64(stats.OctetTotalCount))
+	b.addInt(prefix+".octet_delta_count", int64(stats.OctetDeltaCount))
+}
+
+// flowToLogRecord maps a flow record to an OTLP log record. Standard OpenTelemetry semantic
+// conventions are used for network attributes, and other attributes use the k8s. and antrea.
+// prefixes.
+func flowToLogRecord(record *flowpb.Flow, isRecordIPv6 bool) *logspb.LogRecord {
+	var attrs attributeBuilder
+	sourceIP := net.IP(record.Ip.GetSource())
+	destinationIP := net.IP(record.Ip.GetDestination())
+	sourcePort := record.Transport.GetSourcePort()
+	destinationPort := record.Transport.GetDestinationPort()
+	protocol := flowlogger.PrettyPrintProtocolIdentifier(uint8(record.Transport.GetProtocolNumber()))
+
+	attrs.addString("source.address", sourceIP.String())
+	attrs.addInt("source.port", int64(sourcePort))
+	attrs.addString("destination.address", destinationIP.String())
+	attrs.addInt("destination.port", int64(destinationPort))
+	attrs.addString("network.transport", strings.ToLower(protocol))
+	if isRecordIPv6 {
+		attrs.addString("network.type", "ipv6")
+	} else {
+		attrs.addString("network.type", "ipv4")
+	}
+	if startTs := record.GetStartTs(); startTs != nil {
+		attrs.addString("antrea.flow.start_time", startTs.AsTime().UTC().Format(time.RFC3339))
+	}
+	attrs.addString("antrea.flow.end_reason", enumName(record.EndReason.String(), "FLOW_END_REASON_"))
+	attrs.addString("antrea.flow.direction", enumName(record.FlowDirection.String(), "FLOW_DIRECTION_"))
+	attrs.addString("antrea.tcp.state", record.Transport.GetTCP().GetStateName())
+	attrs.addInt("antrea.tcp.rtt_us", int64(record.Transport.GetTCP().GetRttUs()))
+	attrs.addInt("antrea.tcp.rtt_var_us", int64(record.Transport.GetTCP().GetRttVarUs()))
+	attrs.addInt("antrea.tcp.retransmissions", int64(record.Transport.GetTCP().GetRetransmissions()))
+	attrs.addInt("antrea.tcp.rwnd_limited_time_us", int64(record.Transport.GetTCP().GetRwndLimitedTimeUs()))
+
+	if k8s := record.GetK8S(); k8s != nil {
+		attrs.addString("antrea.flow.type", enumName(k8s.FlowType.String(), "FLOW_TYPE_"))
+
+		attrs.addString("k8s.source.pod.namespace", k8s.SourcePodNamespace)
+		attrs.addString("k8s.source.pod.name", k8s.SourcePodName)
+		attrs.addString("k8s.source.pod.uid", k8s.SourcePodUid)
+		attrs.addLabels("k8s.source.pod.labels", k8s.SourcePodLabels)
+		attrs.addString("k8s.source.node.name", k8s.SourceNodeName)
+		attrs.addString("k8s.source.node.uid", k8s.SourceNodeUid)
+
+		attrs.addString("k8s.destination.pod.namespace", k8s.DestinationPodNamespace)
+		attrs.addString("k8s.destination.pod.name", k8s.DestinationPodName)
+		attrs.addString("k8s.destination.pod.uid", k8s.DestinationPodUid)
+		attrs.addLabels("k8s.destination.pod.labels", k8s.DestinationPodLabels)
+		attrs.addString("k8s.destination.node.name", k8s.DestinationNodeName)
+		attrs.addString("k8s.destination.node.uid", k8s.DestinationNodeUid)
+		attrs.addIP("k8s.destination.service.cluster_ip", k8s.DestinationClusterIp)
+		attrs.addInt("k8s.destination.service.port", int64(k8s.DestinationServicePort))
+		attrs.addString("k8s.destination.service.port_name", k8s.DestinationServicePortName)
+		attrs.addString("k8s.destination.service.uid", k8s.DestinationServiceUid)
+
+		attrs.addString("antrea.ingress_network_policy.type", flowlogger.PrettyPrintPolicyType(uint8(k8s.IngressNetworkPolicyType)))
+		attrs.addString("antrea.ingress_network_policy.namespace", k8s.IngressNetworkPolicyNamespace)
+		attrs.addString("antrea.ingress_network_policy.name", k8s.IngressNetworkPolicyName)
+		attrs.addString("antrea.ingress_network_policy.uid", k8s.IngressNetworkPolicyUid)
+		attrs.addString("antrea.ingress_network_policy.rule_name", k8s.IngressNetworkPolicyRuleName)
+		attrs.addString("antrea.ingress_network_policy.rule_action", flowlogger.PrettyPrintRuleAction(uint8(k8s.IngressNetworkPolicyRuleAction)))
+
+		attrs.addString("antrea.egress_network_policy.type", flowlogger.PrettyPrintPolicyType(uint8(k8s.EgressNetworkPolicyType)))
+		attrs.addString("antrea.egress_network_policy.namespace", k8s.EgressNetworkPolicyNamespace)
+		attrs.addString("antrea.egress_network_policy.name", k8s.EgressNetworkPolicyName)
+		attrs.addString("antrea.egress_network_policy.uid", k8s.EgressNetworkPolicyUid)
+		attrs.addString("antrea.egress_network_policy.rule_name", k8s.EgressNetworkPolicyRuleName)
+		attrs.addString("antrea.egress_network_policy.rule_action", flowlogger.PrettyPrintRuleAction(uint8(k8s.EgressNetworkPolicyRuleAction)))
+
+		attrs.addString("antrea.egress.name", k8s.EgressName)
+		attrs.addString("antrea.egress.uid", k8s.EgressUid)
+		attrs.addIP("antrea.egress.ip", k8s.EgressIp)
+		attrs.addString("antrea.egress.node.name", k8s.EgressNodeName)
+		attrs.addString("antrea.egress.node.uid", k8s.EgressNodeUid)
+	}
+
+	attrs.addStats("antrea.stats", record.GetStats())
+	attrs.addStats("antrea.reverse_stats", record.GetReverseStats())
+
+	attrs.addString("antrea.app.protocol_name", record.GetApp().GetProtocolName())
+	attrs.addString("antrea.app.http_vals", string(record.GetApp().GetHttpVals()))
+
+	logRecord := &logspb.LogRecord{
+		ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         "INFO",
+		Body: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{
+			StringValue: fmt.Sprintf("%s flow from %s to %s", protocol, net.JoinHostPort(sourceIP.String(), fmt.Sprint(sourcePort)), net.JoinHostPort(destinationIP.String(), fmt.Sprint(destinationPort))),
+		}},
+		Attributes: attrs,
+	}
+	if endTs := record.GetEndTs(); endTs != nil {
+		logRecord.TimeUnixNano = uint64(endTs.AsTime().UnixNano())
+	}
+	return logRecord
+}
+
+func (e *OTLPExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	e.logRecords = append(e.logRecords, flowToLogRecord(record, isRecordIPv6))
+	// Hand the full batch to the background goroutine if there is room for it. Otherwise, the
+	// records stay buffered until the next call to Flush or FlushSync.
+	if len(e.logRecords) >= int(e.config.MaxBatchSize) && e.batchCh != nil {
+		select {
+		case e.batchCh <- otlpBatch{logRecords: e.logRecords}:
+			e.logRecords = nil
+		default:
+		}
+	}
+	return nil
+}
+
+func (e *OTLPExporter) Start() {
+	e.start()
+}
+
+func (e *OTLPExporter) Stop() {
+	e.stop()
+}
+
+func (e *OTLPExporter) start() {
+	if e.client == nil {
+		client, err := newOTLPLogsClient(e.config)
+		if err != nil {
+			// Records will be dropped on Flush until the configuration is fixed.
+			klog.ErrorS(err, "Error when creating OTLP client")
+			return
+		}
+		e.client = client
+	}
+	if e.batchCh == nil {
+		e.batchCh = make(chan otlpBatch, otlpMaxPendingBatches)
+		e.doneCh = make(chan struct{})
+		go exportOTLPBatches(e.client, e.resource, e.batchCh, e.doneCh, e.timeout)
+	}
+}
+
+func (e *OTLPExporter) stop() {
+	e.flush()
+	if e.batchCh != nil {
+		close(e.batchCh)
+		<-e.doneCh
+		e.batchCh = nil
+		e.doneCh = nil
+	}
+	if e.client == nil {
+		return
+	}
+	if err := e.client.Close(); err != nil {
+		klog.ErrorS(err, "Error when closing OTLP client")
+	}
+	e.client = nil
+}
+
+func (e *OTLPExporter) UpdateOptions(opt *options.Options) {
+	config := opt.Config.OTLP
+	if reflect.DeepEqual(e.config, config) && e.timeout == opt.OTLPTimeout {
+		return
+	}
+	klog.InfoS("Updating OTLP exporter")
+	e.stop()
+	e.config = config
+	e.timeout = opt.OTLPTimeout
+	klog.InfoS("New OTLP configuration", "endpoint", config.Endpoint, "protocol", config.Protocol, "compression", config.Compression, "maxBatchSize", config.MaxBatchSize, "timeout", opt.OTLPTimeout, "tls", config.TLS.Enable)
+	e.start()
+}
+
+// exportOTLPBatches exports the batches received from batchCh until it is closed. Batches that
+// cannot be exported within timeout are discarded, so that memory usage stays bounded when the
+// receiver is unavailable.
+func exportOTLPBatches(client otlpLogsClient, resource *resourcepb.Resource, batchCh <-chan otlpBatch, doneCh chan<- struct{}, timeout time.Duration) {
+	defer close(doneCh)
+	numFailed := 0
+	var lastErr error
+	for batch := range batchCh {
+		if len(batch.logRecords) > 0 {
+			if err := exportOTLPLogRecords(client, resource, batch.logRecords, timeout); err != nil {
+				klog.ErrorS(err, "Error when exporting flow records to OTLP receiver, dropping them", "count", len(batch.logRecords))
+				numFailed += len(batch.logRecords)
+				lastErr = err
+			}
+		}
+		if batch.resultCh != nil {
+			if numFailed > 0 {
+				batch.resultCh <- fmt.Errorf("error when exporting %d flow records to OTLP receiver: %w", numFailed, lastErr)
+			} else {
+				batch.resultCh <- nil
+			}
+			numFailed = 0
+			lastErr = nil
+		}
+	}
+}
+
+func exportOTLPLogRecords(client otlpLogsClient, resource *resourcepb.Resource, logRecords []*logspb.LogRecord, timeout time.Duration) error {
+	request := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: otlpScopeName},
+				LogRecords: logRecords,
+			}},
+		}},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	response, err := client.Export(ctx, request)
+	if err != nil {
+		return err
+	}
+	if partialSuccess := response.GetPartialSuccess(); partialSuccess.GetRejectedLogRecords() > 0 {
+		klog.InfoS("Some flow records were rejected by OTLP receiver", "count", partialSuccess.GetRejectedLogRecords(), "message", partialSuccess.GetErrorMessage())
+	}
+	klog.V(4).InfoS("Exported flow records to OTLP receiver", "count", len(logRecords))
+	return nil
+}
+
+// Flush hands all the buffered flow records to the background goroutine, which exports them to
+// the OTLP receiver. It never blocks: records are logged and discarded if there is no client or
+// if too many batches are already pending, so that a failing receiver does not affect the other
+// exporters.
+func (e *OTLPExporter) Flush() error {
+	e.flush()
+	return nil
+}
+
+func (e *OTLPExporter) flush() {
+	if len(e.logRecords) == 0 {
+		return
+	}
+	logRecords := e.logRecords
+	e.logRecords = nil
+	if e.batchCh == nil {
+		klog.ErrorS(nil, "No OTLP client available, dropping flow records", "count", len(logRecords))
+		e.numDropped += len(logRecords)
+		return
+	}
+	select {
+	case e.batchCh <- otlpBatch{logRecords: logRecords}:
+	default:
+		klog.ErrorS(nil, "Too many flow record batches pending export to OTLP receiver, dropping flow records", "count", len(logRecords))
+		e.numDropped += len(logRecords)
+	}
+}
+
+// FlushSync exports all the flow records added so far, and waits until they have been exported.
+// It returns an error if any of them could not be exported. It is used by the disk queue, which
+// keeps the records until they have been exported successfully.
+func (e *OTLPExporter) FlushSync() error {
+	logRecords := e.logRecords
+	e.logRecords = nil
+	numDropped := e.numDropped
+	e.numDropped = 0
+	if e.batchCh == nil {
+		if len(logRecords)+numDropped == 0 {
+			return nil
+		}
+		return fmt.Errorf("no OTLP client available, dropped %d flow records", len(logRecords)+numDropped)
+	}
+	resultCh := make(chan error, 1)
+	e.batchCh <- otlpBatch{logRecords: logRecords, resultCh: resultCh}
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	if numDropped > 0 {
+		return fmt.Errorf("dropped %d flow records because too many batches were pending export to OTLP receiver", numDropped)
+	}
+	return nil
+}
+
+// FlushInterval returns 0, as records are not exported periodically but whenever Flush is called.
+func (e *OTLPExporter) FlushInterval() time.Duration {
+	return 0
+}
@@ -0,0 +1,374 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+// fakeOTLPReceiver stores the export requests received by the gRPC or HTTP receiver stand-in.
+type fakeOTLPReceiver struct {
+	collogspb.UnimplementedLogsServiceServer
+	mutex    sync.Mutex
+	requests []*collogspb.ExportLogsServiceRequest
+	headers  []map[string]string
+}
+
+func (r *fakeOTLPReceiver) addRequest(request *collogspb.ExportLogsServiceRequest, headers map[string]string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.requests = append(r.requests, request)
+	r.headers = append(r.headers, headers)
+}
+
+func (r *fakeOTLPReceiver) getRequests() []*collogspb.ExportLogsServiceRequest {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.requests
+}
+
+func (r *fakeOTLPReceiver) getHeaders() []map[string]string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.headers
+}
+
+func (r *fakeOTLPReceiver) Export(ctx context.Context, request *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	headers := make(map[string]string)
+	md, _ := metadata.FromIncomingContext(ctx)
+	for k, v := range md {
+		headers[k] = strings.Join(v, ",")
+	}
+	r.addRequest(request, headers)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (r *fakeOTLPReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost || req.URL.Path != otlpHTTPLogsPath || req.Header.Get("Content-Type") != "application/x-protobuf" {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	var body io.Reader = req.Body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gr, err := gzip.NewReader(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body = gr
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	request := &collogspb.ExportLogsServiceRequest{}
+	if err := proto.Unmarshal(data, request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	headers := make(map[string]string)
+	for k := range req.Header {
+		headers[strings.ToLower(k)] = req.Header.Get(k)
+	}
+	r.addRequest(request, headers)
+	respData, _ := proto.Marshal(&collogspb.ExportLogsServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(respData)
+}
+
+// startOTLPReceiver starts a local OTLP receiver stand-in for the provided protocol, and returns
+// its endpoint.
+func startOTLPReceiver(t *testing.T, protocol flowaggregatorconfig.OTLPProtocol) (*fakeOTLPReceiver, string) {
+	receiver := &fakeOTLPReceiver{}
+	if protocol == flowaggregatorconfig.OTLPProtocolHTTP {
+		server := httptest.NewServer(receiver)
+		t.Cleanup(server.Close)
+		return receiver, strings.TrimPrefix(server.URL, "http://")
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(server, receiver)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return receiver, listener.Addr().String()
+}
+
+func newOTLPTestOptions(endpoint string, mutateFn func(config *flowaggregatorconfig.OTLPConfig)) *options.Options {
+	opt := &options.Options{
+		Config:      &flowaggregatorconfig.FlowAggregatorConfig{},
+		OTLPTimeout: 10 * time.Second,
+	}
+	flowaggregatorconfig.SetConfigDefaults(opt.Config)
+	opt.Config.OTLP.Enable = true
+	opt.Config.OTLP.Endpoint = endpoint
+	if mutateFn != nil {
+		mutateFn(&opt.Config.OTLP)
+	}
+	return opt
+}
+
+func getAttributes(attrs []*commonpb.KeyValue) map[string]*commonpb.AnyValue {
+	m := make(map[string]*commonpb.AnyValue, len(attrs))
+	for _, attr := range attrs {
+		m[attr.Key] = attr.Value
+	}
+	return m
+}
+
+func TestOTLP_AddRecord(t *testing.T) {
+	clusterUUID := uuid.New()
+	const clusterID = "test-cluster"
+
+	for _, protocol := range []flowaggregatorconfig.OTLPProtocol{flowaggregatorconfig.OTLPProtocolGRPC, flowaggregatorconfig.OTLPProtocolHTTP} {
+		for _, compression := range []string{"none", "gzip"} {
+			t.Run(string(protocol)+"-"+compression, func(t *testing.T) {
+				receiver, endpoint := startOTLPReceiver(t, protocol)
+				opt := newOTLPTestOptions(endpoint, func(config *flowaggregatorconfig.OTLPConfig) {
+					config.Protocol = protocol
+					config.Compression = compression
+					config.Headers = map[string]string{"x-tenant": "antrea"}
+				})
+				otlpExporter, err := NewOTLPExporter(clusterUUID, clusterID, opt)
+				require.NoError(t, err)
+				otlpExporter.Start()
+				defer otlpExporter.Stop()
+
+				record := flowaggregatortesting.PrepareTestFlowRecord(true)
+				require.NoError(t, otlpExporter.AddRecord(record, false))
+				// Records are buffered until Flush is called.
+				assert.Empty(t, receiver.getRequests())
+				require.NoError(t, otlpExporter.Flush())
+
+				require.Eventually(t, func() bool {
+					return len(receiver.getRequests()) == 1
+				}, time.Second, 10*time.Millisecond)
+				requests := receiver.getRequests()
+				assert.Equal(t, "antrea", receiver.getHeaders()[0]["x-tenant"])
+				require.Len(t, requests[0].ResourceLogs, 1)
+				resourceLogs := requests[0].ResourceLogs[0]
+				resourceAttrs := getAttributes(resourceLogs.Resource.Attributes)
+				assert.Equal(t, otlpServiceName, resourceAttrs["service.name"].GetStringValue())
+				assert.Equal(t, clusterUUID.String(), resourceAttrs["k8s.cluster.uid"].GetStringValue())
+				assert.Equal(t, clusterID, resourceAttrs["antrea.cluster.id"].GetStringValue())
+				require.Len(t, resourceLogs.ScopeLogs, 1)
+				assert.Equal(t, otlpScopeName, resourceLogs.ScopeLogs[0].Scope.Name)
+				require.Len(t, resourceLogs.ScopeLogs[0].LogRecords, 1)
+
+				logRecord := resourceLogs.ScopeLogs[0].LogRecords[0]
+				assert.Equal(t, uint64(record.EndTs.AsTime().UnixNano()), logRecord.TimeUnixNano)
+				assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_INFO, logRecord.SeverityNumber)
+				attrs := getAttributes(logRecord.Attributes)
+				assert.Equal(t, "10.10.0.79", attrs["source.address"].GetStringValue())
+				assert.Equal(t, int64(record.Transport.SourcePort), attrs["source.port"].GetIntValue())
+				assert.Equal(t, "10.10.0.80", attrs["destination.address"].GetStringValue())
+				assert.Equal(t, "tcp", attrs["network.transport"].GetStringValue())
+				assert.Equal(t, "ipv4", attrs["network.type"].GetStringValue())
+				assert.Equal(t, record.K8S.SourcePodNamespace, attrs["k8s.source.pod.namespace"].GetStringValue())
+				assert.Equal(t, record.K8S.SourcePodName, attrs["k8s.source.pod.name"].GetStringValue())
+				assert.Equal(t, record.K8S.DestinationServicePortName, attrs["k8s.destination.service.port_name"].GetStringValue())
+				assert.Equal(t, record.K8S.IngressNetworkPolicyName, attrs["antrea.ingress_network_policy.name"].GetStringValue())
+				assert.Equal(t, record.K8S.EgressNetworkPolicyRuleName, attrs["antrea.egress_network_policy.rule_name"].GetStringValue())
+				assert.Equal(t, record.K8S.EgressName, attrs["antrea.egress.name"].GetStringValue())
+				assert.Equal(t, int64(record.Transport.GetTCP().RttUs), attrs["antrea.tcp.rtt_us"].GetIntValue())
+				assert.Equal(t, int64(record.Transport.GetTCP().Retransmissions), attrs["antrea.tcp.retransmissions"].GetIntValue())
+				assert.Equal(t, int64(record.Stats.PacketTotalCount), attrs["antrea.stats.packet_total_count"].GetIntValue())
+				assert.Equal(t, int64(record.ReverseStats.OctetDeltaCount), attrs["antrea.reverse_stats.octet_delta_count"].GetIntValue())
+				require.NotNil(t, attrs["k8s.source.pod.labels"])
+				assert.NotEmpty(t, attrs["k8s.source.pod.labels"].GetKvlistValue().GetValues())
+			})
+		}
+	}
+}
+
+func TestOTLP_Batching(t *testing.T) {
+	receiver, endpoint := startOTLPReceiver(t, flowaggregatorconfig.OTLPProtocolGRPC)
+	opt := newOTLPTestOptions(endpoint, func(config *flowaggregatorconfig.OTLPConfig) {
+		config.MaxBatchSize = 3
+	})
+	otlpExporter, err := NewOTLPExporter(uuid.New(), "test-cluster", opt)
+	require.NoError(t, err)
+	otlpExporter.Start()
+
+	countLogRecords := func() int {
+		count := 0
+		for _, request := range receiver.getRequests() {
+			count += len(request.ResourceLogs[0].ScopeLogs[0].LogRecords)
+		}
+		return count
+	}
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	for range 2 {
+		require.NoError(t, otlpExporter.AddRecord(record, false))
+	}
+	assert.Equal(t, 0, countLogRecords())
+	// The batch is exported as soon as it is full.
+	require.NoError(t, otlpExporter.AddRecord(record, false))
+	require.Eventually(t, func() bool {
+		return countLogRecords() == 3
+	}, time.Second, 10*time.Millisecond)
+
+	// Remaining records are exported when the exporter is stopped.
+	require.NoError(t, otlpExporter.AddRecord(record, false))
+	otlpExporter.Stop()
+	assert.Equal(t, 4, countLogRecords())
+	assert.Len(t, receiver.getRequests(), 2)
+}
+
+// startUnavailableOTLPReceiver starts an HTTP OTLP receiver stand-in which fails all requests
+// while available is false, and returns its endpoint.
+func startUnavailableOTLPReceiver(t *testing.T, available *atomic.Bool) (*fakeOTLPReceiver, string) {
+	receiver := &fakeOTLPReceiver{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !available.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		receiver.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return receiver, strings.TrimPrefix(server.URL, "http://")
+}
+
+func TestOTLP_ExportError(t *testing.T) {
+	var available atomic.Bool
+	receiver, endpoint := startUnavailableOTLPReceiver(t, &available)
+	opt := newOTLPTestOptions(endpoint, func(config *flowaggregatorconfig.OTLPConfig) {
+		config.Protocol = flowaggregatorconfig.OTLPProtocolHTTP
+	})
+	otlpExporter, err := NewOTLPExporter(uuid.New(), "test-cluster", opt)
+	require.NoError(t, err)
+	otlpExporter.Start()
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, otlpExporter.AddRecord(record, false))
+	// Export errors are not reported to the caller: records are logged and dropped.
+	require.NoError(t, otlpExporter.Flush())
+	assert.Empty(t, otlpExporter.logRecords)
+	otlpExporter.Stop()
+	assert.Empty(t, receiver.getRequests())
+}
+
+func TestOTLP_FlushSync(t *testing.T) {
+	var available atomic.Bool
+	receiver, endpoint := startUnavailableOTLPReceiver(t, &available)
+	opt := newOTLPTestOptions(endpoint, func(config *flowaggregatorconfig.OTLPConfig) {
+		config.Protocol = flowaggregatorconfig.OTLPProtocolHTTP
+		config.MaxBatchSize = 2
+	})
+	otlpExporter, err := NewOTLPExporter(uuid.New(), "test-cluster", opt)
+	require.NoError(t, err)
+	otlpExporter.Start()
+	defer otlpExporter.Stop()
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	for range 3 {
+		require.NoError(t, otlpExporter.AddRecord(record, false))
+	}
+	// The failure of the batch exported in the background is reported as well.
+	assert.ErrorContains(t, otlpExporter.FlushSync(), "error when exporting 3 flow records to OTLP receiver: OTLP receiver returned unexpected status: 503 Service Unavailable")
+	assert.Empty(t, receiver.getRequests())
+
+	available.Store(true)
+	for range 3 {
+		require.NoError(t, otlpExporter.AddRecord(record, false))
+	}
+	// FlushSync returns once all the records have been exported.
+	require.NoError(t, otlpExporter.FlushSync())
+	assert.Len(t, receiver.getRequests(), 2)
+}
+
+func TestOTLP_QueueFull(t *testing.T) {
+	receiver, endpoint := startOTLPReceiver(t, flowaggregatorconfig.OTLPProtocolGRPC)
+	opt := newOTLPTestOptions(endpoint, func(config *flowaggregatorconfig.OTLPConfig) {
+		config.MaxBatchSize = 1
+	})
+	otlpExporter, err := NewOTLPExporter(uuid.New(), "test-cluster", opt)
+	require.NoError(t, err)
+	// Do not start the background goroutine, so that the queue is not drained.
+	otlpExporter.batchCh = make(chan otlpBatch, otlpMaxPendingBatches)
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	// AddRecord does not block when the queue is full: the extra record stays buffered.
+	for range otlpMaxPendingBatches + 1 {
+		require.NoError(t, otlpExporter.AddRecord(record, false))
+	}
+	assert.Len(t, otlpExporter.batchCh, otlpMaxPendingBatches)
+	assert.Len(t, otlpExporter.logRecords, 1)
+	// Flush does not block either: the extra batch is dropped.
+	require.NoError(t, otlpExporter.Flush())
+	assert.Empty(t, otlpExporter.logRecords)
+	assert.Equal(t, 1, otlpExporter.numDropped)
+	assert.Empty(t, receiver.getRequests())
+}
+
+func TestOTLP_UpdateOptions(t *testing.T) {
+	receiver1, endpoint1 := startOTLPReceiver(t, flowaggregatorconfig.OTLPProtocolGRPC)
+	receiver2, endpoint2 := startOTLPReceiver(t, flowaggregatorconfig.OTLPProtocolHTTP)
+	otlpExporter, err := NewOTLPExporter(uuid.New(), "test-cluster", newOTLPTestOptions(endpoint1, nil))
+	require.NoError(t, err)
+	otlpExporter.Start()
+	defer otlpExporter.Stop()
+	client1 := otlpExporter.client
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, otlpExporter.AddRecord(record, false))
+
+	// No change in configuration: the client is not re-created.
+	otlpExporter.UpdateOptions(newOTLPTestOptions(endpoint1, nil))
+	assert.Same(t, client1, otlpExporter.client)
+	assert.Empty(t, receiver1.getRequests())
+
+	otlpExporter.UpdateOptions(newOTLPTestOptions(endpoint2, func(config *flowaggregatorconfig.OTLPConfig) {
+		config.Protocol = flowaggregatorconfig.OTLPProtocolHTTP
+	}))
+	// Buffered records are exported with the old client before switching to the new one.
+	assert.Len(t, receiver1.getRequests(), 1)
+	assert.IsType(t, &otlpHTTPClient{}, otlpExporter.client)
+
+	require.NoError(t, otlpExporter.AddRecord(record, false))
+	require.NoError(t, otlpExporter.Flush())
+	require.Eventually(t, func() bool {
+		return len(receiver2.getRequests()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, receiver1.getRequests(), 1)
+}
@@ -15,6 +15,8 @@
 package exporter
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"k8s.io/klog/v2"
 
@@ -37,7 +39,7 @@ func buildS3Input(opt *options.Options) s3uploader.S3Input {
 
 func NewS3Exporter(clusterUUID uuid.UUID, opt *options.Options) (*S3Exporter, error) {
 	s3Input := buildS3Input(opt)
-	klog.InfoS("S3Uploader configuration", "bucketName", s3Input.Config.BucketName, "bucketPrefix", s3Input.Config.BucketPrefix, "region", s3Input.Config.Region, "recordFormat", s3Input.Config.RecordFormat, "compress", *s3Input.Config.Compress, "maxRecordsPerFile", s3Input.Config.MaxRecordsPerFile, "uploadInterval", s3Input.UploadInterval)
+	klog.InfoS("S3Uploader configuration", "bucketName", s3Input.Config.BucketName, "bucketPrefix", s3Input.Config.BucketPrefix, "region", s3Input.Config.Region, "recordFormat", s3Input.Config.RecordFormat, "compress", *s3Input.Config.Compress, "maxRecordsPerFile", s3Input.Config.MaxRecordsPerFile, "uploadInterval", s3Input.UploadInterval, "partitionByTime", s3Input.Config.PartitionByTime)
 	s3UploadProcess, err := s3uploader.NewS3UploadProcess(s3Input, clusterUUID.String())
 	if err != nil {
 		return nil, err
@@ -82,9 +84,26 @@ func (e *S3Exporter) UpdateOptions(opt *options.Options) {
 			return
 		}
 	}
-	klog.InfoS("New S3Uploader configuration", "bucketName", s3Input.Config.BucketName, "bucketPrefix", s3Input.Config.BucketPrefix, "region", s3Input.Config.Region, "recordFormat", s3Input.Config.RecordFormat, "compress", *s3Input.Config.Compress, "maxRecordsPerFile", s3Input.Config.MaxRecordsPerFile, "uploadInterval", s3Input.Config.UploadInterval)
+	klog.InfoS("New S3Uploader configuration", "bucketName", s3Input.Config.BucketName, "bucketPrefix", s3Input.Config.BucketPrefix, "region", s3Input.Config.Region, "recordFormat", s3Input.Config.RecordFormat, "compress", *s3Input.Config.Compress, "maxRecordsPerFile", s3Input.Config.MaxRecordsPerFile, "uploadInterval", s3Input.Config.UploadInterval, "partitionByTime", s3Input.Config.PartitionByTime)
 }
 
 func (e *S3Exporter) Flush() error {
 	return nil
 }
+
+// FlushSync uploads the records added so far to S3, and only returns once they have been uploaded.
+// It is used by the disk queue, which must not discard records before that.
+func (e *S3Exporter) FlushSync() error {
+	return e.s3UploadProcess.Flush()
+}
+
+// FlushInterval returns the configured upload interval.
+func (e *S3Exporter) FlushInterval() time.Duration {
+	return e.s3UploadProcess.GetUploadInterval()
+}
+
+// IsHealthy returns false if records could not be uploaded to S3 recently. Records are uploaded
+// asynchronously, so errors are not reported by AddRecord.
+func (e *S3Exporter) IsHealthy() bool {
+	return e.s3UploadProcess.IsHealthy()
+}
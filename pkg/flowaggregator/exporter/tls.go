@@ -0,0 +1,77 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// clientTLSConfig contains the TLS settings of an exporter which connects to a remote server.
+// The CA certificate and the client certificate are read from Secrets which are mounted in
+// certDir, using the ca.crt, tls.crt and tls.key keys.
+type clientTLSConfig struct {
+	certDir            string
+	caSecretName       string
+	serverName         string
+	clientSecretName   string
+	minVersion         string
+	insecureSkipVerify bool
+}
+
+func newClientTLSConfig(config clientTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         config.serverName,
+		InsecureSkipVerify: config.insecureSkipVerify,
+		// config.minVersion has already been validated during FA config validation.
+		MinVersion: options.TLSVersionOrDie(config.minVersion),
+	}
+	if config.caSecretName != "" {
+		caPath := filepath.Join(config.certDir, "ca.crt")
+		caBytes, err := afero.ReadFile(defaultFS, caPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading CA cert %q, ensure Secret %q exists in this Namespace and has the 'ca.crt' key: %w", caPath, config.caSecretName, err)
+		}
+		caPool := x509.NewCertPool()
+		if ok := caPool.AppendCertsFromPEM(caBytes); !ok {
+			return nil, fmt.Errorf("failed to parse CA cert %q", caPath)
+		}
+		tlsConfig.RootCAs = caPool
+	}
+	if config.clientSecretName != "" {
+		certPath := filepath.Join(config.certDir, "tls.crt")
+		keyPath := filepath.Join(config.certDir, "tls.key")
+		certBytes, err := afero.ReadFile(defaultFS, certPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading client cert %q, ensure Secret %q exists in this Namespace and has the 'tls.crt' key: %w", certPath, config.clientSecretName, err)
+		}
+		keyBytes, err := afero.ReadFile(defaultFS, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading client key %q, ensure Secret %q exists in this Namespace and has the 'tls.key' key: %w", keyPath, config.clientSecretName, err)
+		}
+		cert, err := tls.X509KeyPair(certBytes, keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client cert and key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
@@ -29,8 +29,12 @@ import (
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 
 	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
@@ -44,7 +48,12 @@ import (
 	"antrea.io/antrea/pkg/util/objectstore"
 )
 
-const aggregationWorkerNum = 2
+const (
+	aggregationWorkerNum = 2
+	// eventSourceComponent is the component name for the Kubernetes Events emitted by the
+	// Flow Aggregator.
+	eventSourceComponent = "flow-aggregator"
+)
 
 // these are used for unit testing
 var (
@@ -60,6 +69,24 @@ var (
 	newLogExporter = func(opt *options.Options) (exporter.Interface, error) {
 		return exporter.NewLogExporter(opt)
 	}
+	newKafkaExporter = func(clusterID string, opt *options.Options) (exporter.Interface, error) {
+		return exporter.NewKafkaExporter(clusterID, opt)
+	}
+	newOTLPExporter = func(clusterUUID uuid.UUID, clusterID string, opt *options.Options) (exporter.Interface, error) {
+		return exporter.NewOTLPExporter(clusterUUID, clusterID, opt)
+	}
+	newFlowMetricsExporter = func(opt *options.Options) exporter.Interface {
+		return exporter.NewFlowMetricsExporter(opt)
+	}
+	newFlowGraphExporter = func(opt *options.Options, podStore objectstore.PodStore) exporter.Interface {
+		return exporter.NewFlowGraphExporter(opt, podStore)
+	}
+	newAnomalyDetectionExporter = func(opt *options.Options, recorder record.EventRecorder) exporter.Interface {
+		return exporter.NewAnomalyDetectionExporter(opt, recorder)
+	}
+	newDiskQueueExporter = func(name string, exp exporter.Interface, dir string, maxSize int64) (exporter.Interface, error) {
+		return exporter.NewDiskQueueExporter(name, exp, dir, maxSize)
+	}
 )
 
 type flowAggregator struct {
@@ -77,6 +104,8 @@ type flowAggregator struct {
 	includePodLabels            bool
 	includeK8sUIDs              bool
 	k8sClient                   kubernetes.Interface
+	eventBroadcaster            record.EventBroadcaster
+	eventRecorder               record.EventRecorder
 	podStore                    objectstore.PodStore
 	nodeStore                   objectstore.NodeStore
 	serviceStore                objectstore.ServiceStore
@@ -91,6 +120,13 @@ type flowAggregator struct {
 	clickHouseExporter          exporter.Interface
 	s3Exporter                  exporter.Interface
 	logExporter                 exporter.Interface
+	kafkaExporter               exporter.Interface
+	otlpExporter                exporter.Interface
+	flowMetricsExporter         exporter.Interface
+	flowGraphExporter           exporter.Interface
+	anomalyDetectionExporter    exporter.Interface
+	diskQueue                   flowaggregatorconfig.DiskQueueConfig
+	diskQueueMaxSize            int64
 	logTickerDuration           time.Duration
 	recordCh                    chan *flowpb.Flow
 	exportersMutex              sync.Mutex
@@ -156,6 +192,8 @@ func NewFlowAggregator(
 		configWatcher:               configWatcher,
 		configData:                  data,
 		APIServer:                   opt.Config.APIServer,
+		diskQueue:                   opt.Config.DiskQueue,
+		diskQueueMaxSize:            opt.DiskQueueMaxSize,
 		logTickerDuration:           time.Minute,
 		// We support buffering a small amount of flow records.
 		recordCh: make(chan *flowpb.Flow, 128),
@@ -174,6 +212,9 @@ func NewFlowAggregator(
 		if err != nil {
 			return nil, fmt.Errorf("error when creating ClickHouse export process: %v", err)
 		}
+		if fa.clickHouseExporter, err = fa.withDiskQueue("clickHouse", fa.clickHouseExporter); err != nil {
+			return nil, err
+		}
 	}
 	if opt.Config.S3Uploader.Enable {
 		var err error
@@ -181,6 +222,9 @@ func NewFlowAggregator(
 		if err != nil {
 			return nil, fmt.Errorf("error when creating S3 export process: %v", err)
 		}
+		if fa.s3Exporter, err = fa.withDiskQueue("s3Uploader", fa.s3Exporter); err != nil {
+			return nil, err
+		}
 	}
 	if opt.Config.FlowLogger.Enable {
 		var err error
@@ -189,8 +233,42 @@ func NewFlowAggregator(
 			return nil, fmt.Errorf("error when creating log export process: %v", err)
 		}
 	}
+	if opt.Config.Kafka.Enable {
+		var err error
+		fa.kafkaExporter, err = newKafkaExporter(clusterID, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating Kafka export process: %v", err)
+		}
+		if fa.kafkaExporter, err = fa.withDiskQueue("kafka", fa.kafkaExporter); err != nil {
+			return nil, err
+		}
+	}
+	if opt.Config.OTLP.Enable {
+		var err error
+		fa.otlpExporter, err = newOTLPExporter(clusterUUID, clusterID, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating OTLP export process: %v", err)
+		}
+		if fa.otlpExporter, err = fa.withDiskQueue("otlp", fa.otlpExporter); err != nil {
+			return nil, err
+		}
+	}
+	if opt.Config.FlowMetrics.Enable {
+		fa.flowMetricsExporter = newFlowMetricsExporter(opt)
+	}
+	if opt.Config.FlowGraph.Enable {
+		fa.flowGraphExporter = newFlowGraphExporter(opt, podStore)
+	}
+	fa.eventBroadcaster = record.NewBroadcaster()
+	fa.eventRecorder = fa.eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: eventSourceComponent})
+	if opt.Config.AnomalyDetection.Enable {
+		fa.anomalyDetectionExporter = newAnomalyDetectionExporter(opt, fa.eventRecorder)
+	}
 	if opt.Config.FlowCollector.Enable {
-		fa.ipfixExporter = newIPFIXExporter(clusterUUID, clusterID, opt, registry)
+		fa.ipfixExporter, err = fa.withDiskQueue("flowCollector", newIPFIXExporter(clusterUUID, clusterID, opt, registry))
+		if err != nil {
+			return nil, err
+		}
 	}
 	klog.InfoS("FlowAggregator initialized", "mode", opt.AggregatorMode, "clusterID", fa.clusterID)
 	return fa, nil
@@ -252,6 +330,13 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 		klog.InfoS("Object stores synced")
 	}()
 
+	if fa.eventBroadcaster != nil {
+		fa.eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+			Interface: fa.k8sClient.CoreV1().Events(""),
+		})
+		defer fa.eventBroadcaster.Shutdown()
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -288,6 +373,21 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 	if fa.logExporter != nil {
 		fa.logExporter.Start()
 	}
+	if fa.kafkaExporter != nil {
+		fa.kafkaExporter.Start()
+	}
+	if fa.otlpExporter != nil {
+		fa.otlpExporter.Start()
+	}
+	if fa.flowMetricsExporter != nil {
+		fa.flowMetricsExporter.Start()
+	}
+	if fa.flowGraphExporter != nil {
+		fa.flowGraphExporter.Start()
+	}
+	if fa.anomalyDetectionExporter != nil {
+		fa.anomalyDetectionExporter.Start()
+	}
 
 	wg.Add(1)
 	go func() {
@@ -337,6 +437,21 @@ func (fa *flowAggregator) flowExportLoop(stopCh <-chan struct{}) {
 		if fa.logExporter != nil {
 			fa.logExporter.Stop()
 		}
+		if fa.kafkaExporter != nil {
+			fa.kafkaExporter.Stop()
+		}
+		if fa.otlpExporter != nil {
+			fa.otlpExporter.Stop()
+		}
+		if fa.flowMetricsExporter != nil {
+			fa.flowMetricsExporter.Stop()
+		}
+		if fa.flowGraphExporter != nil {
+			fa.flowGraphExporter.Stop()
+		}
+		if fa.anomalyDetectionExporter != nil {
+			fa.anomalyDetectionExporter.Stop()
+		}
 	}()
 	switch fa.aggregatorMode {
 	case flowaggregatorconfig.AggregatorModeAggregate:
@@ -482,39 +597,71 @@ func (fa *flowAggregator) flowExportLoopAggregate(stopCh <-chan struct{}) {
 	}
 }
 
+// sendRecord adds the record to all the active exporters. An error returned by an exporter does not
+// prevent the record from being added to the other exporters, so that a failing destination does
+// not affect the others. The errors of all exporters are returned together.
 func (fa *flowAggregator) sendRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
-	if fa.ipfixExporter != nil {
-		if err := fa.ipfixExporter.AddRecord(record, isRecordIPv6); err != nil {
-			return err
-		}
-	}
-	if fa.clickHouseExporter != nil {
-		if err := fa.clickHouseExporter.AddRecord(record, isRecordIPv6); err != nil {
-			return err
-		}
-	}
-	if fa.s3Exporter != nil {
-		if err := fa.s3Exporter.AddRecord(record, isRecordIPv6); err != nil {
-			return err
+	var errs []error
+	for _, exp := range []struct {
+		name     string
+		exporter exporter.Interface
+	}{
+		{"IPFIX", fa.ipfixExporter},
+		{"ClickHouse", fa.clickHouseExporter},
+		{"S3", fa.s3Exporter},
+		{"log", fa.logExporter},
+		{"Kafka", fa.kafkaExporter},
+		{"OTLP", fa.otlpExporter},
+		{"flow metrics", fa.flowMetricsExporter},
+		{"flow graph", fa.flowGraphExporter},
+		{"anomaly detection", fa.anomalyDetectionExporter},
+	} {
+		if exp.exporter == nil {
+			continue
 		}
-	}
-	if fa.logExporter != nil {
-		if err := fa.logExporter.AddRecord(record, isRecordIPv6); err != nil {
-			return err
+		if err := exp.exporter.AddRecord(record, isRecordIPv6); err != nil {
+			errs = append(errs, fmt.Errorf("error when adding record to %s exporter: %w", exp.name, err))
 		}
 	}
 	fa.numRecordsExported.Add(1)
-	return nil
+	return errors.Join(errs...)
 }
 
 func (fa *flowAggregator) flushExporters() error {
-	if fa.ipfixExporter != nil {
-		if err := fa.ipfixExporter.Flush(); err != nil {
-			return err
+	var errs []error
+	for _, exp := range []exporter.Interface{
+		fa.ipfixExporter,
+		fa.clickHouseExporter,
+		fa.s3Exporter,
+		fa.kafkaExporter,
+		fa.otlpExporter,
+		fa.flowMetricsExporter,
+		fa.flowGraphExporter,
+		fa.anomalyDetectionExporter,
+	} {
+		if exp == nil {
+			continue
+		}
+		if err := exp.Flush(); err != nil {
+			errs = append(errs, err)
 		}
 	}
-	// Other exporters don't leverage Flush for now, so we skip them.
-	return nil
+	return errors.Join(errs...)
+}
+
+// withDiskQueue wraps the provided exporter with a disk queue, if the disk queue is enabled. Each
+// exporter uses its own sub-directory, so that a slow or unavailable destination does not affect
+// other destinations.
+func (fa *flowAggregator) withDiskQueue(name string, exp exporter.Interface) (exporter.Interface, error) {
+	if !fa.diskQueue.Enable {
+		return exp, nil
+	}
+	dir := filepath.Join(fa.diskQueue.Path, name)
+	wrapped, err := newDiskQueueExporter(name, exp, dir, fa.diskQueueMaxSize)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating disk queue for %s exporter: %w", name, err)
+	}
+	return wrapped, nil
 }
 
 func (fa *flowAggregator) sendAggregatedRecord(key intermediate.FlowKey, record *intermediate.AggregationFlowRecord) error {
@@ -533,8 +680,10 @@ func (fa *flowAggregator) sendAggregatedRecord(key intermediate.FlowKey, record
 		}
 		fa.aggregationProcess.SetExternalFieldsFilled(record, true)
 	}
-	if err := fa.sendRecord(record.Record, !isRecordIPv4); err != nil {
-		return err
+	// The error is not returned, as it would stop ForAllExpiredFlowRecordsDo from processing the
+	// other expired records.
+	if err := fa.sendRecord(record.Record, !isRecordIPv4); err != nil && !errors.Is(err, exporter.ErrIPFIXExporterBackoff) {
+		klog.ErrorS(err, "Error when sending aggregated flow record")
 	}
 	if err := fa.aggregationProcess.ResetStatAndThroughputElementsInRecord(record.Record); err != nil {
 		return err
@@ -689,9 +838,43 @@ func (fa *flowAggregator) GetRecordMetrics() querier.Metrics {
 	metrics.WithS3Exporter = fa.s3Exporter != nil
 	metrics.WithLogExporter = fa.logExporter != nil
 	metrics.WithIPFIXExporter = fa.ipfixExporter != nil
+	metrics.WithKafkaExporter = fa.kafkaExporter != nil
+	metrics.WithOTLPExporter = fa.otlpExporter != nil
+	metrics.WithFlowMetricsExporter = fa.flowMetricsExporter != nil
+	for name, exp := range map[string]exporter.Interface{
+		"flowCollector": fa.ipfixExporter,
+		"clickHouse":    fa.clickHouseExporter,
+		"s3Uploader":    fa.s3Exporter,
+		"kafka":         fa.kafkaExporter,
+		"otlp":          fa.otlpExporter,
+	} {
+		diskQueueExporter, ok := exp.(*exporter.DiskQueueExporter)
+		if !ok {
+			continue
+		}
+		stats := diskQueueExporter.Stats()
+		if metrics.DiskQueues == nil {
+			metrics.DiskQueues = make(map[string]querier.DiskQueueMetrics)
+		}
+		metrics.DiskQueues[name] = querier.DiskQueueMetrics{
+			NumRecords:        stats.NumRecords,
+			Size:              stats.Size,
+			NumRecordsDropped: stats.NumRecordsDropped,
+		}
+	}
 	return metrics
 }
 
+func (fa *flowAggregator) GetFlowGraph(window time.Duration, namespace string) (*querier.FlowGraph, error) {
+	fa.exportersMutex.Lock()
+	defer fa.exportersMutex.Unlock()
+	flowGraphExporter, ok := fa.flowGraphExporter.(*exporter.FlowGraphExporter)
+	if !ok {
+		return nil, fmt.Errorf("flow graph is not enabled")
+	}
+	return flowGraphExporter.Graph(window, namespace), nil
+}
+
 func (fa *flowAggregator) watchConfiguration(stopCh <-chan struct{}) {
 	klog.InfoS("Watching for FlowAggregator configuration file")
 	for {
@@ -758,7 +941,12 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 	if opt.Config.FlowCollector.Enable {
 		if fa.ipfixExporter == nil {
 			klog.InfoS("Enabling Flow-Collector")
-			fa.ipfixExporter = newIPFIXExporter(fa.clusterUUID, fa.clusterID, opt, fa.registry)
+			var err error
+			fa.ipfixExporter, err = fa.withDiskQueue("flowCollector", newIPFIXExporter(fa.clusterUUID, fa.clusterID, opt, fa.registry))
+			if err != nil {
+				klog.ErrorS(err, "Error when creating IPFIX export process")
+				return
+			}
 			fa.ipfixExporter.Start()
 			klog.InfoS("Enabled Flow-Collector")
 		} else {
@@ -781,6 +969,10 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 				klog.ErrorS(err, "Error when creating ClickHouse export process")
 				return
 			}
+			if fa.clickHouseExporter, err = fa.withDiskQueue("clickHouse", fa.clickHouseExporter); err != nil {
+				klog.ErrorS(err, "Error when creating ClickHouse export process")
+				return
+			}
 			fa.clickHouseExporter.Start()
 			klog.InfoS("Enabled ClickHouse")
 		} else {
@@ -803,6 +995,10 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 				klog.ErrorS(err, "Error when creating S3 export process")
 				return
 			}
+			if fa.s3Exporter, err = fa.withDiskQueue("s3Uploader", fa.s3Exporter); err != nil {
+				klog.ErrorS(err, "Error when creating S3 export process")
+				return
+			}
 			fa.s3Exporter.Start()
 			klog.InfoS("Enabled S3Uploader")
 		} else {
@@ -838,6 +1034,109 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 			klog.InfoS("Disabled FlowLogger")
 		}
 	}
+	if opt.Config.Kafka.Enable {
+		if fa.kafkaExporter == nil {
+			klog.InfoS("Enabling Kafka")
+			var err error
+			fa.kafkaExporter, err = newKafkaExporter(fa.clusterID, opt)
+			if err != nil {
+				klog.ErrorS(err, "Error when creating Kafka export process")
+				return
+			}
+			if fa.kafkaExporter, err = fa.withDiskQueue("kafka", fa.kafkaExporter); err != nil {
+				klog.ErrorS(err, "Error when creating Kafka export process")
+				return
+			}
+			fa.kafkaExporter.Start()
+			klog.InfoS("Enabled Kafka")
+		} else {
+			fa.kafkaExporter.UpdateOptions(opt)
+		}
+	} else {
+		if fa.kafkaExporter != nil {
+			klog.InfoS("Disabling Kafka")
+			fa.kafkaExporter.Stop()
+			fa.kafkaExporter = nil
+			klog.InfoS("Disabled Kafka")
+		}
+	}
+	if opt.Config.OTLP.Enable {
+		if fa.otlpExporter == nil {
+			klog.InfoS("Enabling OTLP")
+			var err error
+			fa.otlpExporter, err = newOTLPExporter(fa.clusterUUID, fa.clusterID, opt)
+			if err != nil {
+				klog.ErrorS(err, "Error when creating OTLP export process")
+				return
+			}
+			if fa.otlpExporter, err = fa.withDiskQueue("otlp", fa.otlpExporter); err != nil {
+				klog.ErrorS(err, "Error when creating OTLP export process")
+				return
+			}
+			fa.otlpExporter.Start()
+			klog.InfoS("Enabled OTLP")
+		} else {
+			fa.otlpExporter.UpdateOptions(opt)
+		}
+	} else {
+		if fa.otlpExporter != nil {
+			klog.InfoS("Disabling OTLP")
+			fa.otlpExporter.Stop()
+			fa.otlpExporter = nil
+			klog.InfoS("Disabled OTLP")
+		}
+	}
+	if opt.Config.FlowMetrics.Enable {
+		if fa.flowMetricsExporter == nil {
+			klog.InfoS("Enabling flow metrics")
+			fa.flowMetricsExporter = newFlowMetricsExporter(opt)
+			fa.flowMetricsExporter.Start()
+			klog.InfoS("Enabled flow metrics")
+		} else {
+			fa.flowMetricsExporter.UpdateOptions(opt)
+		}
+	} else {
+		if fa.flowMetricsExporter != nil {
+			klog.InfoS("Disabling flow metrics")
+			fa.flowMetricsExporter.Stop()
+			fa.flowMetricsExporter = nil
+			klog.InfoS("Disabled flow metrics")
+		}
+	}
+	if opt.Config.FlowGraph.Enable {
+		if fa.flowGraphExporter == nil {
+			klog.InfoS("Enabling flow graph")
+			fa.flowGraphExporter = newFlowGraphExporter(opt, fa.podStore)
+			fa.flowGraphExporter.Start()
+			klog.InfoS("Enabled flow graph")
+		} else {
+			fa.flowGraphExporter.UpdateOptions(opt)
+		}
+	} else {
+		if fa.flowGraphExporter != nil {
+			klog.InfoS("Disabling flow graph")
+			fa.flowGraphExporter.Stop()
+			fa.flowGraphExporter = nil
+			klog.InfoS("Disabled flow graph")
+		}
+	}
+	if opt.Config.AnomalyDetection.Enable {
+		if fa.anomalyDetectionExporter == nil {
+			klog.InfoS("Enabling anomaly detection")
+			fa.anomalyDetectionExporter = newAnomalyDetectionExporter(opt, fa.eventRecorder)
+			fa.anomalyDetectionExporter.Start()
+			klog.InfoS("Enabled anomaly detection")
+		} else {
+			fa.anomalyDetectionExporter.UpdateOptions(opt)
+		}
+	} else {
+		if fa.anomalyDetectionExporter != nil {
+			klog.InfoS("Disabling anomaly detection")
+			fa.anomalyDetectionExporter.Stop()
+			fa.anomalyDetectionExporter = nil
+			klog.InfoS("Disabled anomaly detection")
+		}
+	}
 	if opt.Config.RecordContents.PodLabels != fa.includePodLabels {
 		fa.includePodLabels = opt.Config.RecordContents.PodLabels
 		klog.InfoS("Updated recordContents.podLabels configuration", "value", fa.includePodLabels)
@@ -863,6 +1162,9 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 	if opt.Config.FlowAggregatorAddress != fa.flowAggregatorAddress {
 		unsupportedUpdates = append(unsupportedUpdates, "flowAggregatorAddress")
 	}
+	if opt.Config.DiskQueue != fa.diskQueue {
+		unsupportedUpdates = append(unsupportedUpdates, "diskQueue")
+	}
 	if len(unsupportedUpdates) > 0 {
 		klog.ErrorS(nil, "Ignoring unsupported configuration updates, please restart FlowAggregator", "keys", unsupportedUpdates)
 	}
@@ -16,6 +16,7 @@ package flowaggregator
 
 import (
 	"bytes"
+	"fmt"
 	"net/netip"
 	"os"
 	"path/filepath"
@@ -48,6 +49,7 @@ import (
 	intermediatetesting "antrea.io/antrea/pkg/flowaggregator/intermediate/testing"
 	"antrea.io/antrea/pkg/flowaggregator/options"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
 	"antrea.io/antrea/pkg/ipfix"
 	ipfixtesting "antrea.io/antrea/pkg/ipfix/testing"
 	objectstoretest "antrea.io/antrea/pkg/util/objectstore/testing"
@@ -400,21 +402,33 @@ func mockExporters(t *testing.T, ctrl *gomock.Controller, expectedClusterUUID *u
 	*exportertesting.MockInterface,
 	*exportertesting.MockInterface,
 	*exportertesting.MockInterface,
+	*exportertesting.MockInterface,
+	*exportertesting.MockInterface,
+	*exportertesting.MockInterface,
 ) {
 	mockIPFIXExporter := exportertesting.NewMockInterface(ctrl)
 	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
 	mockS3Exporter := exportertesting.NewMockInterface(ctrl)
 	mockLogExporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+	mockOTLPExporter := exportertesting.NewMockInterface(ctrl)
+	mockFlowMetricsExporter := exportertesting.NewMockInterface(ctrl)
 
 	newIPFIXExporterSaved := newIPFIXExporter
 	newClickHouseExporterSaved := newClickHouseExporter
 	newS3ExporterSaved := newS3Exporter
 	newLogExporterSaved := newLogExporter
+	newKafkaExporterSaved := newKafkaExporter
+	newOTLPExporterSaved := newOTLPExporter
+	newFlowMetricsExporterSaved := newFlowMetricsExporter
 	t.Cleanup(func() {
 		newIPFIXExporter = newIPFIXExporterSaved
 		newClickHouseExporter = newClickHouseExporterSaved
 		newS3Exporter = newS3ExporterSaved
 		newLogExporter = newLogExporterSaved
+		newKafkaExporter = newKafkaExporterSaved
+		newOTLPExporter = newOTLPExporterSaved
+		newFlowMetricsExporter = newFlowMetricsExporterSaved
 	})
 	newIPFIXExporter = func(clusterUUID uuid.UUID, clusterID string, opts *options.Options, registry ipfix.IPFIXRegistry) exporter.Interface {
 		if expectedClusterUUID != nil {
@@ -440,14 +454,32 @@ func mockExporters(t *testing.T, ctrl *gomock.Controller, expectedClusterUUID *u
 	newLogExporter = func(opt *options.Options) (exporter.Interface, error) {
 		return mockLogExporter, nil
 	}
+	newKafkaExporter = func(clusterID string, opts *options.Options) (exporter.Interface, error) {
+		if expectedClusterID != nil {
+			assert.Equal(t, *expectedClusterID, clusterID)
+		}
+		return mockKafkaExporter, nil
+	}
+	newOTLPExporter = func(clusterUUID uuid.UUID, clusterID string, opts *options.Options) (exporter.Interface, error) {
+		if expectedClusterUUID != nil {
+			assert.Equal(t, *expectedClusterUUID, clusterUUID)
+		}
+		if expectedClusterID != nil {
+			assert.Equal(t, *expectedClusterID, clusterID)
+		}
+		return mockOTLPExporter, nil
+	}
+	newFlowMetricsExporter = func(opt *options.Options) exporter.Interface {
+		return mockFlowMetricsExporter
+	}
 
-	return mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter
+	return mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter, mockKafkaExporter, mockOTLPExporter, mockFlowMetricsExporter
 }
 
 func TestFlowAggregator_updateFlowAggregator(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
-	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter := mockExporters(t, ctrl, nil, nil)
+	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter, mockKafkaExporter, mockOTLPExporter, mockFlowMetricsExporter := mockExporters(t, ctrl, nil, nil)
 
 	t.Run("updateIPFIX", func(t *testing.T) {
 		flowAggregator := &flowAggregator{
@@ -603,6 +635,133 @@ func TestFlowAggregator_updateFlowAggregator(t *testing.T) {
 		mockLogExporter.EXPECT().UpdateOptions(opt)
 		flowAggregator.updateFlowAggregator(opt)
 	})
+	t.Run("enableKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable:  true,
+					Brokers: []string{"10.10.10.10:9092"},
+					Topic:   "flows",
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().Start()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("disableKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			kafkaExporter: mockKafkaExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable: false,
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().Stop()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("updateKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			kafkaExporter: mockKafkaExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable:  true,
+					Brokers: []string{"10.10.10.10:9092"},
+					Topic:   "flows",
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().UpdateOptions(opt)
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("enableOTLP", func(t *testing.T) {
+		flowAggregator := &flowAggregator{}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				OTLP: flowaggregatorconfig.OTLPConfig{
+					Enable:   true,
+					Endpoint: "10.10.10.10:4317",
+				},
+			},
+		}
+		mockOTLPExporter.EXPECT().Start()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("disableOTLP", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			otlpExporter: mockOTLPExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				OTLP: flowaggregatorconfig.OTLPConfig{
+					Enable: false,
+				},
+			},
+		}
+		mockOTLPExporter.EXPECT().Stop()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("updateOTLP", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			otlpExporter: mockOTLPExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				OTLP: flowaggregatorconfig.OTLPConfig{
+					Enable:   true,
+					Endpoint: "10.10.10.10:4317",
+				},
+			},
+		}
+		mockOTLPExporter.EXPECT().UpdateOptions(opt)
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("enableFlowMetrics", func(t *testing.T) {
+		flowAggregator := &flowAggregator{}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				FlowMetrics: flowaggregatorconfig.FlowMetricsConfig{
+					Enable: true,
+				},
+			},
+		}
+		mockFlowMetricsExporter.EXPECT().Start()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("disableFlowMetrics", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			flowMetricsExporter: mockFlowMetricsExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				FlowMetrics: flowaggregatorconfig.FlowMetricsConfig{
+					Enable: false,
+				},
+			},
+		}
+		mockFlowMetricsExporter.EXPECT().Stop()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("updateFlowMetrics", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			flowMetricsExporter: mockFlowMetricsExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				FlowMetrics: flowaggregatorconfig.FlowMetricsConfig{
+					Enable:    true,
+					MaxSeries: 100,
+				},
+			},
+		}
+		mockFlowMetricsExporter.EXPECT().UpdateOptions(opt)
+		flowAggregator.updateFlowAggregator(opt)
+	})
 	t.Run("includePodLabels", func(t *testing.T) {
 		flowAggregator := &flowAggregator{}
 		require.False(t, flowAggregator.includePodLabels)
@@ -642,7 +801,7 @@ func TestFlowAggregator_Run(t *testing.T) {
 	mockNodeStore.EXPECT().HasSynced().Return(true)
 	mockServiceStore := objectstoretest.NewMockServiceStore(ctrl)
 	mockServiceStore.EXPECT().HasSynced().Return(true)
-	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter := mockExporters(t, ctrl, nil, nil)
+	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter, mockKafkaExporter, mockOTLPExporter, mockFlowMetricsExporter := mockExporters(t, ctrl, nil, nil)
 	mockCollector := collectortesting.NewMockInterface(ctrl)
 	mockAggregationProcess := intermediatetesting.NewMockAggregationProcess(ctrl)
 
@@ -690,6 +849,12 @@ func TestFlowAggregator_Run(t *testing.T) {
 	mockS3Exporter.EXPECT().Stop()
 	mockLogExporter.EXPECT().Start()
 	mockLogExporter.EXPECT().Stop()
+	mockKafkaExporter.EXPECT().Start()
+	mockKafkaExporter.EXPECT().Stop()
+	mockOTLPExporter.EXPECT().Start()
+	mockOTLPExporter.EXPECT().Stop()
+	mockFlowMetricsExporter.EXPECT().Start()
+	mockFlowMetricsExporter.EXPECT().Stop()
 
 	// this is not really relevant; but in practice there will be one call
 	// to mockClickHouseExporter.UpdateOptions because of the hack used to
@@ -698,6 +863,9 @@ func TestFlowAggregator_Run(t *testing.T) {
 	mockClickHouseExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 	mockS3Exporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 	mockLogExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
+	mockKafkaExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
+	mockOTLPExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
+	mockFlowMetricsExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 
 	stopCh := make(chan struct{})
 	var wg sync.WaitGroup
@@ -756,6 +924,36 @@ func TestFlowAggregator_Run(t *testing.T) {
 			Enable: false,
 		},
 	})
+	enableKafkaOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		Kafka: flowaggregatorconfig.KafkaConfig{
+			Enable: true,
+		},
+	})
+	disableKafkaOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		Kafka: flowaggregatorconfig.KafkaConfig{
+			Enable: false,
+		},
+	})
+	enableOTLPOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		OTLP: flowaggregatorconfig.OTLPConfig{
+			Enable: true,
+		},
+	})
+	disableOTLPOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		OTLP: flowaggregatorconfig.OTLPConfig{
+			Enable: false,
+		},
+	})
+	enableFlowMetricsOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		FlowMetrics: flowaggregatorconfig.FlowMetricsConfig{
+			Enable: true,
+		},
+	})
+	disableFlowMetricsOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		FlowMetrics: flowaggregatorconfig.FlowMetricsConfig{
+			Enable: false,
+		},
+	})
 
 	// we do a few operations: the main purpose is to ensure that cleanup
 	// (i.e., stopping the exporters) is done properly.
@@ -767,8 +965,14 @@ func TestFlowAggregator_Run(t *testing.T) {
 	// 6. The S3Uploader is then disabled, so we expect a call to mockS3Exporter.Stop()
 	// 7. The FlowLogger is then enabled, so we expect a call to mockLogExporter.Start()
 	// 8. The FlowLogger is then disabled, so we expect a call to mockLogExporter.Stop()
-	// 9. The IPFIXExporter is then re-enabled, so we expect a second call to mockIPFIXExporter.Start()
-	// 10. Finally, when Run() is stopped, we expect a second call to mockIPFIXExporter.Stop()
+	// 9. The KafkaExporter is then enabled, so we expect a call to mockKafkaExporter.Start()
+	// 10. The KafkaExporter is then disabled, so we expect a call to mockKafkaExporter.Stop()
+	// 11. The OTLPExporter is then enabled, so we expect a call to mockOTLPExporter.Start()
+	// 12. The OTLPExporter is then disabled, so we expect a call to mockOTLPExporter.Stop()
+	// 13. The FlowMetricsExporter is then enabled, so we expect a call to mockFlowMetricsExporter.Start()
+	// 14. The FlowMetricsExporter is then disabled, so we expect a call to mockFlowMetricsExporter.Stop()
+	// 15. The IPFIXExporter is then re-enabled, so we expect a second call to mockIPFIXExporter.Start()
+	// 16. Finally, when Run() is stopped, we expect a second call to mockIPFIXExporter.Stop()
 	updateOptions(disableIPFIXOptions)
 	updateOptions(enableClickHouseOptions)
 	updateOptions(disableClickHouseOptions)
@@ -776,6 +980,12 @@ func TestFlowAggregator_Run(t *testing.T) {
 	updateOptions(disableS3UploaderOptions)
 	updateOptions(enableFlowLoggerOptions)
 	updateOptions(disableFlowLoggerOptions)
+	updateOptions(enableKafkaOptions)
+	updateOptions(disableKafkaOptions)
+	updateOptions(enableOTLPOptions)
+	updateOptions(disableOTLPOptions)
+	updateOptions(enableFlowMetricsOptions)
+	updateOptions(disableFlowMetricsOptions)
 	updateOptions(enableIPFIXOptions)
 
 	close(stopCh)
@@ -913,25 +1123,34 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
 	mockS3Exporter := exportertesting.NewMockInterface(ctrl)
 	mockLogExporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+	mockOTLPExporter := exportertesting.NewMockInterface(ctrl)
+	mockFlowMetricsExporter := exportertesting.NewMockInterface(ctrl)
 	want := querier.Metrics{
-		NumRecordsExported:     10,
-		NumRecordsReceived:     1,
-		NumRecordsDropped:      1,
-		NumFlows:               1,
-		NumConnToCollector:     1,
-		WithClickHouseExporter: true,
-		WithS3Exporter:         true,
-		WithLogExporter:        true,
-		WithIPFIXExporter:      true,
+		NumRecordsExported:      10,
+		NumRecordsReceived:      1,
+		NumRecordsDropped:       1,
+		NumFlows:                1,
+		NumConnToCollector:      1,
+		WithClickHouseExporter:  true,
+		WithS3Exporter:          true,
+		WithLogExporter:         true,
+		WithIPFIXExporter:       true,
+		WithKafkaExporter:       true,
+		WithOTLPExporter:        true,
+		WithFlowMetricsExporter: true,
 	}
 
 	fa := &flowAggregator{
-		grpcCollector:      mockCollector,
-		aggregationProcess: mockAggregationProcess,
-		clickHouseExporter: mockClickHouseExporter,
-		s3Exporter:         mockS3Exporter,
-		logExporter:        mockLogExporter,
-		ipfixExporter:      mockIPFIXExporter,
+		grpcCollector:       mockCollector,
+		aggregationProcess:  mockAggregationProcess,
+		clickHouseExporter:  mockClickHouseExporter,
+		s3Exporter:          mockS3Exporter,
+		logExporter:         mockLogExporter,
+		ipfixExporter:       mockIPFIXExporter,
+		kafkaExporter:       mockKafkaExporter,
+		otlpExporter:        mockOTLPExporter,
+		flowMetricsExporter: mockFlowMetricsExporter,
 	}
 	fa.numRecordsExported.Store(10)
 	fa.numRecordsDropped.Store(1)
@@ -944,6 +1163,97 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestFlowAggregator_withDiskQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+
+	fa := &flowAggregator{}
+	exp, err := fa.withDiskQueue("clickHouse", mockClickHouseExporter)
+	require.NoError(t, err)
+	assert.Equal(t, mockClickHouseExporter, exp)
+
+	dir := t.TempDir()
+	fa = &flowAggregator{
+		diskQueue: flowaggregatorconfig.DiskQueueConfig{
+			Enable: true,
+			Path:   dir,
+		},
+		diskQueueMaxSize: 1 << 20,
+		kafkaExporter:    mockKafkaExporter,
+	}
+	fa.clickHouseExporter, err = fa.withDiskQueue("clickHouse", mockClickHouseExporter)
+	require.NoError(t, err)
+	require.IsType(t, &exporter.DiskQueueExporter{}, fa.clickHouseExporter)
+	assert.DirExists(t, filepath.Join(dir, "clickHouse"))
+	defer func() {
+		mockClickHouseExporter.EXPECT().Stop()
+		fa.clickHouseExporter.Stop()
+	}()
+
+	// Records are buffered on disk until the exporters are flushed.
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, fa.clickHouseExporter.AddRecord(record, false))
+
+	mockCollector := collectortesting.NewMockInterface(ctrl)
+	fa.grpcCollector = mockCollector
+	mockCollector.EXPECT().GetNumRecordsReceived().Return(int64(1))
+	mockCollector.EXPECT().GetNumConnsToCollector().Return(int64(1))
+	metrics := fa.GetRecordMetrics()
+	require.Contains(t, metrics.DiskQueues, "clickHouse")
+	assert.Equal(t, int64(1), metrics.DiskQueues["clickHouse"].NumRecords)
+	assert.NotContains(t, metrics.DiskQueues, "kafka")
+
+	mockClickHouseExporter.EXPECT().AddRecord(gomock.Any(), false)
+	mockClickHouseExporter.EXPECT().Flush()
+	mockKafkaExporter.EXPECT().Flush().Return(fmt.Errorf("error"))
+	assert.Error(t, fa.flushExporters())
+	assert.Equal(t, int64(0), fa.clickHouseExporter.(*exporter.DiskQueueExporter).Stats().NumRecords)
+}
+
+func TestFlowAggregator_sendRecordWithExporterError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+	mockOTLPExporter := exportertesting.NewMockInterface(ctrl)
+	fa := &flowAggregator{
+		kafkaExporter: mockKafkaExporter,
+		otlpExporter:  mockOTLPExporter,
+	}
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	// An error from one exporter must not prevent the record from being added to the others.
+	mockKafkaExporter.EXPECT().AddRecord(record, false).Return(fmt.Errorf("broker unavailable"))
+	mockOTLPExporter.EXPECT().AddRecord(record, false)
+	err := fa.sendRecord(record, false)
+	assert.EqualError(t, err, "error when adding record to Kafka exporter: broker unavailable")
+	assert.Equal(t, int64(1), fa.numRecordsExported.Load())
+}
+
+func TestFlowAggregator_GetFlowGraph(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPodStore := objectstoretest.NewMockPodStore(ctrl)
+	fa := &flowAggregator{}
+	_, err := fa.GetFlowGraph(0, "")
+	assert.EqualError(t, err, "flow graph is not enabled")
+
+	opt := &options.Options{
+		Config:          &flowaggregatorconfig.FlowAggregatorConfig{},
+		FlowGraphWindow: 15 * time.Minute,
+	}
+	flowaggregatorconfig.SetConfigDefaults(opt.Config)
+	fa.flowGraphExporter = exporter.NewFlowGraphExporter(opt, mockPodStore)
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	record.K8S.DestinationServicePortName = "antrea-test-b/perftest:tcp"
+	mockPodStore.EXPECT().GetPodByIPAndTime("10.10.0.79", gomock.Any()).Return(nil, false)
+	require.NoError(t, fa.flowGraphExporter.AddRecord(record, false))
+	graph, err := fa.GetFlowGraph(0, "antrea-test")
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, graph.Window)
+	require.Len(t, graph.Edges, 1)
+	assert.Equal(t, querier.FlowGraphNode{Kind: "Pod", Namespace: "antrea-test", Name: "perftest-a"}, graph.Edges[0].Source)
+	assert.Equal(t, querier.FlowGraphNode{Kind: "Service", Namespace: "antrea-test-b", Name: "perftest"}, graph.Edges[0].Destination)
+}
+
 func TestFlowAggregator_InitCollectors(t *testing.T) {
 	tests := []struct {
 		name                        string
@@ -1097,6 +1407,18 @@ func TestNewFlowAggregator(t *testing.T) {
 				Enable: true,
 				Path:   "/tmp/antrea-flows.log",
 			},
+			Kafka: flowaggregatorconfig.KafkaConfig{
+				Enable:  true,
+				Brokers: []string{"10.10.10.10:9092"},
+				Topic:   "flows",
+			},
+			OTLP: flowaggregatorconfig.OTLPConfig{
+				Enable:   true,
+				Endpoint: "10.10.10.10:4317",
+			},
+			FlowMetrics: flowaggregatorconfig.FlowMetricsConfig{
+				Enable: true,
+			},
 			ClusterID: clusterID,
 		}
 	}
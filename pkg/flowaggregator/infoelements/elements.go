@@ -72,6 +72,13 @@ var (
 		"reversePacketTotalCountFromDestinationNode",
 	}
 
+	AntreaTCPMetricsElementList = []string{
+		"tcpRoundTripTime",
+		"tcpRoundTripTimeVariance",
+		"tcpRetransmissionCount",
+		"tcpReceiveWindowLimitedTime",
+	}
+
 	AntreaLabelsElementList = []string{
 		"sourcePodLabels",
 		"destinationPodLabels",
@@ -167,7 +174,9 @@ func AntreaInfoElements(includeK8sNames, includeK8sUIDs, isIPv6 bool) []string {
 		ies = append(ies, "egressNetworkPolicyRuleName")
 	}
 	ies = append(ies, "egressNetworkPolicyRuleAction")
-	ies = append(ies, "tcpState", "flowType")
+	ies = append(ies, "tcpState")
+	ies = append(ies, AntreaTCPMetricsElementList...)
+	ies = append(ies, "flowType")
 	if includeK8sNames {
 		ies = append(ies, "egressName")
 	}
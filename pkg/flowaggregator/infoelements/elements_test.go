@@ -41,6 +41,10 @@ func TestAntreaInfoElements(t *testing.T) {
 		"egressNetworkPolicyRuleName",
 		"egressNetworkPolicyRuleAction",
 		"tcpState",
+		"tcpRoundTripTime",
+		"tcpRoundTripTimeVariance",
+		"tcpRetransmissionCount",
+		"tcpReceiveWindowLimitedTime",
 		"flowType",
 		"egressName",
 		"egressIP",
@@ -65,6 +69,10 @@ func TestAntreaInfoElements(t *testing.T) {
 		"egressNetworkPolicyRuleName",
 		"egressNetworkPolicyRuleAction",
 		"tcpState",
+		"tcpRoundTripTime",
+		"tcpRoundTripTimeVariance",
+		"tcpRetransmissionCount",
+		"tcpReceiveWindowLimitedTime",
 		"flowType",
 		"egressUUID",
 		"egressIP",
@@ -80,6 +88,10 @@ func TestAntreaInfoElements(t *testing.T) {
 		"egressNetworkPolicyType",
 		"egressNetworkPolicyRuleAction",
 		"tcpState",
+		"tcpRoundTripTime",
+		"tcpRoundTripTimeVariance",
+		"tcpRetransmissionCount",
+		"tcpReceiveWindowLimitedTime",
 		"flowType",
 		"egressIP",
 		"appProtocolName",
@@ -113,6 +125,10 @@ func TestAntreaInfoElements(t *testing.T) {
 		"egressNetworkPolicyRuleName",
 		"egressNetworkPolicyRuleAction",
 		"tcpState",
+		"tcpRoundTripTime",
+		"tcpRoundTripTimeVariance",
+		"tcpRetransmissionCount",
+		"tcpReceiveWindowLimitedTime",
 		"flowType",
 		"egressName",
 		"egressUUID",
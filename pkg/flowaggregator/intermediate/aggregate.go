@@ -190,6 +190,10 @@ func (a *aggregationProcess) GetRecords(flowKey *FlowKey) []map[string]interface
 			"destinationTransportPort":          uint16(f.Transport.DestinationPort),
 			"protocolIdentifier":                uint8(f.Transport.ProtocolNumber),
 			"tcpState":                          f.Transport.GetTCP().GetStateName(),
+			"tcpRoundTripTime":                  f.Transport.GetTCP().GetRttUs(),
+			"tcpRoundTripTimeVariance":          f.Transport.GetTCP().GetRttVarUs(),
+			"tcpRetransmissionCount":            f.Transport.GetTCP().GetRetransmissions(),
+			"tcpReceiveWindowLimitedTime":       f.Transport.GetTCP().GetRwndLimitedTimeUs(),
 			"flowStartSeconds":                  uint32(f.StartTs.Seconds),
 			"flowEndSeconds":                    uint32(f.EndTs.Seconds),
 			"flowEndSecondsFromSourceNode":      uint32(f.Aggregation.EndTsFromSource.Seconds),
@@ -536,11 +540,7 @@ func (a *aggregationProcess) aggregateRecords(incomingRecord, existingRecord *fl
 	if existingRecord.EndReason != flowpb.FlowEndReason_FLOW_END_REASON_END_OF_FLOW {
 		existingRecord.EndReason = incomingRecord.EndReason
 	}
-	// Update tcpState when flow end timestamp is the latest.
-	if isLatest {
-		// This code will need to change if more fields are added to Transport.Protocol.
-		existingRecord.Transport.Protocol = incomingRecord.Transport.Protocol
-	}
+	aggregateTransport(incomingRecord.Transport, existingRecord.Transport, isLatest)
 	if incomingRecord.App.HttpVals != nil {
 		updatedHttpVals, err := fillHttpVals(incomingRecord.App.HttpVals, existingRecord.App.HttpVals)
 		if err != nil {
@@ -724,6 +724,35 @@ func (a *aggregationProcess) updateFlowEndSecondsFromNodes(incomingRecord, exist
 	return existingVal
 }
 
+// aggregateTransport updates the transport fields of the existing record using the incoming record.
+func aggregateTransport(incoming, existing *flowpb.Transport, isLatest bool) {
+	// TCP metrics are only reported by the Nodes which have access to the socket of the
+	// connection, so we keep the last reported ones when the incoming record has none.
+	if incomingTCP, existingTCP := incoming.GetTCP(), existing.GetTCP(); incomingTCP != nil && existingTCP != nil {
+		if isLatest && !hasTCPMetrics(incomingTCP) {
+			copyTCPMetrics(existingTCP, incomingTCP)
+		} else if !isLatest && !hasTCPMetrics(existingTCP) {
+			copyTCPMetrics(incomingTCP, existingTCP)
+		}
+	}
+	// Update tcpState when flow end timestamp is the latest.
+	if isLatest {
+		// This code will need to change if more fields are added to Transport.Protocol.
+		existing.Protocol = incoming.Protocol
+	}
+}
+
+func hasTCPMetrics(tcp *flowpb.TCP) bool {
+	return tcp.RttUs != 0 || tcp.RttVarUs != 0 || tcp.Retransmissions != 0 || tcp.RwndLimitedTimeUs != 0
+}
+
+func copyTCPMetrics(from, to *flowpb.TCP) {
+	to.RttUs = from.RttUs
+	to.RttVarUs = from.RttVarUs
+	to.Retransmissions = from.Retransmissions
+	to.RwndLimitedTimeUs = from.RwndLimitedTimeUs
+}
+
 // isRecordFromSrc returns true if record belongs to inter-node flow and from source node.
 func isRecordFromSrc(record *flowpb.Flow) bool {
 	return record.K8S.SourcePodName != "" && record.K8S.DestinationPodName == ""
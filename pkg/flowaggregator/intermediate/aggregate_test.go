@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	clocktesting "k8s.io/utils/clock/testing"
 
@@ -783,3 +784,62 @@ func runAggregationAndCheckResult(t *testing.T, ap *aggregationProcess, clock *c
 	assert.EqualValues(t, 915, aggRecord.Record.Aggregation.ThroughputFromDestination)
 	assert.EqualValues(t, 915, aggRecord.Record.Aggregation.ReverseThroughputFromDestination)
 }
+
+func TestAggregateTransport(t *testing.T) {
+	newTransport := func(state string, rtt uint32) *flowpb.Transport {
+		return &flowpb.Transport{
+			ProtocolNumber: 6,
+			Protocol: &flowpb.Transport_TCP{
+				TCP: &flowpb.TCP{
+					StateName:         state,
+					RttUs:             rtt,
+					RttVarUs:          rtt / 10,
+					Retransmissions:   rtt / 100,
+					RwndLimitedTimeUs: uint64(rtt) * 2,
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name     string
+		incoming *flowpb.Transport
+		existing *flowpb.Transport
+		isLatest bool
+		expected *flowpb.Transport
+	}{
+		{
+			name:     "latest record with metrics",
+			incoming: newTransport("TIME_WAIT", 2000),
+			existing: newTransport("ESTABLISHED", 1000),
+			isLatest: true,
+			expected: newTransport("TIME_WAIT", 2000),
+		},
+		{
+			name:     "latest record without metrics",
+			incoming: newTransport("TIME_WAIT", 0),
+			existing: newTransport("ESTABLISHED", 1000),
+			isLatest: true,
+			expected: newTransport("TIME_WAIT", 1000),
+		},
+		{
+			name:     "older record with metrics",
+			incoming: newTransport("ESTABLISHED", 2000),
+			existing: newTransport("TIME_WAIT", 0),
+			isLatest: false,
+			expected: newTransport("TIME_WAIT", 2000),
+		},
+		{
+			name:     "older record does not override metrics",
+			incoming: newTransport("ESTABLISHED", 2000),
+			existing: newTransport("TIME_WAIT", 1000),
+			isLatest: false,
+			expected: newTransport("TIME_WAIT", 1000),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregateTransport(tc.incoming, tc.existing, tc.isLatest)
+			assert.True(t, proto.Equal(tc.expected, tc.existing), "unexpected transport: %v", tc.existing)
+		})
+	}
+}
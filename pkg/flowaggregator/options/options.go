@@ -17,8 +17,11 @@ package options
 import (
 	"fmt"
 	"net"
+	"slices"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
@@ -47,6 +50,18 @@ type Options struct {
 	ClickHouseCommitInterval time.Duration
 	// Flow records batch upload interval from flow aggregator to S3 bucket
 	S3UploadInterval time.Duration
+	// Maximum duration for publishing a batch of flow records to Kafka
+	KafkaWriteTimeout time.Duration
+	// Maximum duration for an export request to the OTLP receiver
+	OTLPTimeout time.Duration
+	// Duration after which a flow metrics series which has not been updated is removed
+	FlowMetricsSeriesTTL time.Duration
+	// Maximum duration over which traffic is accounted for in the flow graph
+	FlowGraphWindow time.Duration
+	// Duration of the intervals over which flow records are accumulated for anomaly detection
+	AnomalyDetectionInterval time.Duration
+	// Maximum size in bytes of the disk queue for each exporter
+	DiskQueueMaxSize int64
 }
 
 func LoadConfig(configBytes []byte) (*Options, error) {
@@ -62,7 +77,16 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	if opt.Config.S3Uploader.Enable && opt.Config.S3Uploader.BucketName == "" {
 		return nil, fmt.Errorf("s3Uploader enabled without specifying bucket name")
 	}
-	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.FlowLogger.Enable {
+	if opt.Config.Kafka.Enable && len(opt.Config.Kafka.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka enabled without specifying brokers")
+	}
+	if opt.Config.Kafka.Enable && opt.Config.Kafka.Topic == "" {
+		return nil, fmt.Errorf("kafka enabled without specifying topic")
+	}
+	if opt.Config.OTLP.Enable && opt.Config.OTLP.Endpoint == "" {
+		return nil, fmt.Errorf("otlp enabled without specifying endpoint")
+	}
+	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.FlowLogger.Enable && !opt.Config.Kafka.Enable && !opt.Config.OTLP.Enable && !opt.Config.FlowMetrics.Enable && !opt.Config.FlowGraph.Enable && !opt.Config.AnomalyDetection.Enable {
 		klog.InfoS("No collector / sink has been configured, so no flow data will be exported")
 	}
 	// Validate common parameters
@@ -71,7 +95,7 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	}
 	opt.AggregatorMode = opt.Config.Mode
 	if opt.AggregatorMode == flowaggregatorconfig.AggregatorModeProxy {
-		if opt.Config.ClickHouse.Enable || opt.Config.S3Uploader.Enable || opt.Config.FlowLogger.Enable {
+		if opt.Config.ClickHouse.Enable || opt.Config.S3Uploader.Enable || opt.Config.FlowLogger.Enable || opt.Config.Kafka.Enable || opt.Config.OTLP.Enable || opt.Config.FlowMetrics.Enable || opt.Config.FlowGraph.Enable || opt.Config.AnomalyDetection.Enable {
 			return nil, fmt.Errorf("only flow collector is supported in Proxy mode")
 		}
 	}
@@ -141,7 +165,7 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	}
 	// Validate S3Uploader specific parameters
 	if opt.Config.S3Uploader.Enable {
-		if opt.Config.S3Uploader.RecordFormat != "CSV" {
+		if opt.Config.S3Uploader.RecordFormat != flowaggregatorconfig.S3RecordFormatCSV && opt.Config.S3Uploader.RecordFormat != flowaggregatorconfig.S3RecordFormatParquet {
 			return nil, fmt.Errorf("record format %s is not supported", opt.Config.S3Uploader.RecordFormat)
 		}
 		opt.S3UploadInterval, err = time.ParseDuration(opt.Config.S3Uploader.UploadInterval)
@@ -159,5 +183,154 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 			return nil, fmt.Errorf("record format %s is not supported", opt.Config.FlowLogger.RecordFormat)
 		}
 	}
+	// Validate Kafka specific parameters
+	if opt.Config.Kafka.Enable {
+		for _, broker := range opt.Config.Kafka.Brokers {
+			if _, _, err := net.SplitHostPort(broker); err != nil {
+				return nil, fmt.Errorf("invalid Kafka broker address %s: %w", broker, err)
+			}
+		}
+		switch opt.Config.Kafka.RecordFormat {
+		case flowaggregatorconfig.KafkaRecordFormatProtobuf, flowaggregatorconfig.KafkaRecordFormatJSON:
+		default:
+			return nil, fmt.Errorf("record format %s is not supported", opt.Config.Kafka.RecordFormat)
+		}
+		switch opt.Config.Kafka.PartitionKey {
+		case flowaggregatorconfig.KafkaPartitionKeyClusterID, flowaggregatorconfig.KafkaPartitionKeyNamespace:
+		default:
+			return nil, fmt.Errorf("partition key %s is not supported", opt.Config.Kafka.PartitionKey)
+		}
+		switch opt.Config.Kafka.Compression {
+		case "none", "gzip", "snappy", "lz4", "zstd":
+		default:
+			return nil, fmt.Errorf("compression codec %s is not supported", opt.Config.Kafka.Compression)
+		}
+		if opt.Config.Kafka.MaxBatchSize < 0 {
+			return nil, fmt.Errorf("maxBatchSize cannot be negative")
+		}
+		opt.KafkaWriteTimeout, err = time.ParseDuration(opt.Config.Kafka.WriteTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("writeTimeout is not a valid duration: %w", err)
+		}
+		if opt.KafkaWriteTimeout <= 0 {
+			return nil, fmt.Errorf("writeTimeout must be a positive duration")
+		}
+		if opt.Config.Kafka.TLS.Enable {
+			if _, err := TLSVersion(opt.Config.Kafka.TLS.MinVersion); err != nil {
+				return nil, err
+			}
+		}
+		switch opt.Config.Kafka.SASL.Mechanism {
+		case "", flowaggregatorconfig.KafkaSASLMechanismPlain, flowaggregatorconfig.KafkaSASLMechanismSCRAMSHA256, flowaggregatorconfig.KafkaSASLMechanismSCRAMSHA512:
+		default:
+			return nil, fmt.Errorf("SASL mechanism %s is not supported", opt.Config.Kafka.SASL.Mechanism)
+		}
+	}
+	// Validate OTLP specific parameters
+	if opt.Config.OTLP.Enable {
+		if _, _, err := net.SplitHostPort(opt.Config.OTLP.Endpoint); err != nil {
+			return nil, fmt.Errorf("invalid OTLP endpoint %s: %w", opt.Config.OTLP.Endpoint, err)
+		}
+		switch opt.Config.OTLP.Protocol {
+		case flowaggregatorconfig.OTLPProtocolGRPC, flowaggregatorconfig.OTLPProtocolHTTP:
+		default:
+			return nil, fmt.Errorf("OTLP protocol %s is not supported", opt.Config.OTLP.Protocol)
+		}
+		switch opt.Config.OTLP.Compression {
+		case "none", "gzip":
+		default:
+			return nil, fmt.Errorf("compression codec %s is not supported", opt.Config.OTLP.Compression)
+		}
+		if opt.Config.OTLP.MaxBatchSize < 0 {
+			return nil, fmt.Errorf("maxBatchSize cannot be negative")
+		}
+		opt.OTLPTimeout, err = time.ParseDuration(opt.Config.OTLP.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("timeout is not a valid duration: %w", err)
+		}
+		if opt.OTLPTimeout <= 0 {
+			return nil, fmt.Errorf("timeout must be a positive duration")
+		}
+		if opt.Config.OTLP.TLS.Enable {
+			if _, err := TLSVersion(opt.Config.OTLP.TLS.MinVersion); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Validate flow metrics specific parameters
+	if opt.Config.FlowMetrics.Enable {
+		labels := sets.New[flowaggregatorconfig.FlowMetricsLabel]()
+		for _, label := range opt.Config.FlowMetrics.Labels {
+			if !slices.Contains(flowaggregatorconfig.FlowMetricsLabels, label) {
+				return nil, fmt.Errorf("flow metrics label %s is not supported", label)
+			}
+			if labels.Has(label) {
+				return nil, fmt.Errorf("duplicate flow metrics label %s", label)
+			}
+			labels.Insert(label)
+		}
+		if opt.Config.FlowMetrics.MaxSeries < 0 {
+			return nil, fmt.Errorf("maxSeries cannot be negative")
+		}
+		opt.FlowMetricsSeriesTTL, err = time.ParseDuration(opt.Config.FlowMetrics.SeriesTTL)
+		if err != nil {
+			return nil, fmt.Errorf("seriesTTL is not a valid duration: %w", err)
+		}
+		if opt.FlowMetricsSeriesTTL < 0 {
+			return nil, fmt.Errorf("seriesTTL cannot be negative")
+		}
+	}
+	// Validate flow graph specific parameters
+	if opt.Config.FlowGraph.Enable {
+		opt.FlowGraphWindow, err = time.ParseDuration(opt.Config.FlowGraph.Window)
+		if err != nil {
+			return nil, fmt.Errorf("flowGraph.window is not a valid duration: %w", err)
+		}
+		if opt.FlowGraphWindow < time.Minute {
+			return nil, fmt.Errorf("flowGraph.window cannot be less than 1m")
+		}
+		if opt.Config.FlowGraph.MaxEdges < 0 {
+			return nil, fmt.Errorf("flowGraph.maxEdges cannot be negative")
+		}
+	}
+	// Validate anomaly detection specific parameters
+	if opt.Config.AnomalyDetection.Enable {
+		opt.AnomalyDetectionInterval, err = time.ParseDuration(opt.Config.AnomalyDetection.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("anomalyDetection.interval is not a valid duration: %w", err)
+		}
+		if opt.AnomalyDetectionInterval < 10*time.Second {
+			return nil, fmt.Errorf("anomalyDetection.interval cannot be less than 10s")
+		}
+		if opt.Config.AnomalyDetection.WarmupIntervals < 0 {
+			return nil, fmt.Errorf("anomalyDetection.warmupIntervals cannot be negative")
+		}
+		if opt.Config.AnomalyDetection.Sensitivity < 0 {
+			return nil, fmt.Errorf("anomalyDetection.sensitivity cannot be negative")
+		}
+		if opt.Config.AnomalyDetection.MaxPods < 0 {
+			return nil, fmt.Errorf("anomalyDetection.maxPods cannot be negative")
+		}
+		for name, detectorConf := range map[string]*flowaggregatorconfig.AnomalyDetectorConfig{
+			"newDestinations": &opt.Config.AnomalyDetection.NewDestinations,
+			"connectionSpike": &opt.Config.AnomalyDetection.ConnectionSpike,
+			"externalEgress":  &opt.Config.AnomalyDetection.ExternalEgress,
+		} {
+			if detectorConf.MinValue < 0 {
+				return nil, fmt.Errorf("anomalyDetection.%s.minValue cannot be negative", name)
+			}
+		}
+	}
+	// Validate disk queue specific parameters
+	if opt.Config.DiskQueue.Enable {
+		maxSize, err := resource.ParseQuantity(opt.Config.DiskQueue.MaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("diskQueue.maxSize is not a valid quantity: %w", err)
+		}
+		opt.DiskQueueMaxSize = maxSize.Value()
+		if opt.DiskQueueMaxSize < flowaggregatorconfig.MinDiskQueueMaxSize {
+			return nil, fmt.Errorf("diskQueue.maxSize %s is too small: minimum supported size is 1Mi", opt.Config.DiskQueue.MaxSize)
+		}
+	}
 	return &opt, nil
 }
@@ -15,24 +15,74 @@
 package querier
 
 import (
+	"time"
+
 	"antrea.io/antrea/pkg/flowaggregator/intermediate"
 )
 
 type Metrics struct {
-	NumRecordsExported     int64
-	NumRecordsReceived     int64
-	NumRecordsDropped      int64
-	NumFlows               int64
-	NumConnToCollector     int64
-	WithClickHouseExporter bool
-	WithS3Exporter         bool
-	WithLogExporter        bool
-	WithIPFIXExporter      bool
+	NumRecordsExported      int64
+	NumRecordsReceived      int64
+	NumRecordsDropped       int64
+	NumFlows                int64
+	NumConnToCollector      int64
+	WithClickHouseExporter  bool
+	WithS3Exporter          bool
+	WithLogExporter         bool
+	WithIPFIXExporter       bool
+	WithKafkaExporter       bool
+	WithOTLPExporter        bool
+	WithFlowMetricsExporter bool
+	// DiskQueues is indexed by exporter name, and only includes exporters for which the
+	// disk queue is enabled.
+	DiskQueues map[string]DiskQueueMetrics
+}
+
+type DiskQueueMetrics struct {
+	NumRecords        int64
+	Size              int64
+	NumRecordsDropped int64
+}
+
+const (
+	FlowGraphNodeKindPod        = "Pod"
+	FlowGraphNodeKindDeployment = "Deployment"
+	FlowGraphNodeKindService    = "Service"
+	FlowGraphNodeKindExternal   = "External"
+)
+
+// FlowGraphNode is a node of the flow graph. Kind is the kind of the workload owning the Pods
+// (e.g., "Deployment" or "StatefulSet", or "Pod" for Pods without an owner), "Service", or
+// "External" for endpoints outside of the cluster, in which case Name is the IP address.
+type FlowGraphNode struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// FlowGraphEdge holds the traffic observed from Source to Destination. Bytes and Packets
+// include both directions of the connections.
+type FlowGraphEdge struct {
+	Source      FlowGraphNode
+	Destination FlowGraphNode
+	Bytes       int64
+	Packets     int64
+	Connections int64
+}
+
+type FlowGraph struct {
+	// Window is the duration over which traffic has been accounted for.
+	Window time.Duration
+	Edges  []FlowGraphEdge
 }
 
 type FlowAggregatorQuerier interface {
 	GetFlowRecords(flowKey *intermediate.FlowKey) []map[string]interface{}
 	GetRecordMetrics() Metrics
+	// GetFlowGraph returns the flow graph for the provided window, or for the maximum window
+	// if window is 0. If namespace is not empty, only edges with at least one endpoint in the
+	// Namespace are returned. An error is returned if the flow graph is not enabled.
+	GetFlowGraph(window time.Duration, namespace string) (*FlowGraph, error)
 }
 
 type ExternalFlowCollectorAddr struct {
@@ -26,6 +26,7 @@ package testing
 
 import (
 	reflect "reflect"
+	time "time"
 
 	intermediate "antrea.io/antrea/pkg/flowaggregator/intermediate"
 	querier "antrea.io/antrea/pkg/flowaggregator/querier"
@@ -56,6 +57,21 @@ func (m *MockFlowAggregatorQuerier) EXPECT() *MockFlowAggregatorQuerierMockRecor
 	return m.recorder
 }
 
+// GetFlowGraph mocks base method.
+func (m *MockFlowAggregatorQuerier) GetFlowGraph(window time.Duration, namespace string) (*querier.FlowGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlowGraph", window, namespace)
+	ret0, _ := ret[0].(*querier.FlowGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlowGraph indicates an expected call of GetFlowGraph.
+func (mr *MockFlowAggregatorQuerierMockRecorder) GetFlowGraph(window, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlowGraph", reflect.TypeOf((*MockFlowAggregatorQuerier)(nil).GetFlowGraph), window, namespace)
+}
+
 // GetFlowRecords mocks base method.
 func (m *MockFlowAggregatorQuerier) GetFlowRecords(flowKey *intermediate.FlowKey) []map[string]any {
 	m.ctrl.T.Helper()
@@ -0,0 +1,144 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3uploader
+
+import (
+	"time"
+
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	"antrea.io/antrea/pkg/util/parquet"
+)
+
+// parquetSchema is the schema of Parquet files uploaded to S3. Columns are in the same order as
+// in CSV files, and they use the same names as the columns of the ClickHouse flows table. Unsigned
+// integers are stored as signed integers of the same width or larger (INT32 for 8-bit and 16-bit
+// fields, INT64 for 64-bit counters), as unsigned logical types are not supported by all query
+// engines.
+var parquetSchema = []parquet.Column{
+	{Name: "flowStartSeconds", Type: parquet.TimestampMillis},
+	{Name: "flowEndSeconds", Type: parquet.TimestampMillis},
+	{Name: "flowEndSecondsFromSourceNode", Type: parquet.TimestampMillis},
+	{Name: "flowEndSecondsFromDestinationNode", Type: parquet.TimestampMillis},
+	{Name: "flowEndReason", Type: parquet.Int32},
+	{Name: "sourceIP", Type: parquet.String},
+	{Name: "destinationIP", Type: parquet.String},
+	{Name: "sourceTransportPort", Type: parquet.Int32},
+	{Name: "destinationTransportPort", Type: parquet.Int32},
+	{Name: "protocolIdentifier", Type: parquet.Int32},
+	{Name: "packetTotalCount", Type: parquet.Int64},
+	{Name: "octetTotalCount", Type: parquet.Int64},
+	{Name: "packetDeltaCount", Type: parquet.Int64},
+	{Name: "octetDeltaCount", Type: parquet.Int64},
+	{Name: "reversePacketTotalCount", Type: parquet.Int64},
+	{Name: "reverseOctetTotalCount", Type: parquet.Int64},
+	{Name: "reversePacketDeltaCount", Type: parquet.Int64},
+	{Name: "reverseOctetDeltaCount", Type: parquet.Int64},
+	{Name: "sourcePodName", Type: parquet.String},
+	{Name: "sourcePodNamespace", Type: parquet.String},
+	{Name: "sourceNodeName", Type: parquet.String},
+	{Name: "destinationPodName", Type: parquet.String},
+	{Name: "destinationPodNamespace", Type: parquet.String},
+	{Name: "destinationNodeName", Type: parquet.String},
+	{Name: "destinationClusterIP", Type: parquet.String},
+	{Name: "destinationServicePort", Type: parquet.Int32},
+	{Name: "destinationServicePortName", Type: parquet.String},
+	{Name: "ingressNetworkPolicyName", Type: parquet.String},
+	{Name: "ingressNetworkPolicyNamespace", Type: parquet.String},
+	{Name: "ingressNetworkPolicyRuleName", Type: parquet.String},
+	{Name: "ingressNetworkPolicyRuleAction", Type: parquet.Int32},
+	{Name: "ingressNetworkPolicyType", Type: parquet.Int32},
+	{Name: "egressNetworkPolicyName", Type: parquet.String},
+	{Name: "egressNetworkPolicyNamespace", Type: parquet.String},
+	{Name: "egressNetworkPolicyRuleName", Type: parquet.String},
+	{Name: "egressNetworkPolicyRuleAction", Type: parquet.Int32},
+	{Name: "egressNetworkPolicyType", Type: parquet.Int32},
+	{Name: "tcpState", Type: parquet.String},
+	{Name: "flowType", Type: parquet.Int32},
+	{Name: "sourcePodLabels", Type: parquet.String},
+	{Name: "destinationPodLabels", Type: parquet.String},
+	{Name: "throughput", Type: parquet.Int64},
+	{Name: "reverseThroughput", Type: parquet.Int64},
+	{Name: "throughputFromSourceNode", Type: parquet.Int64},
+	{Name: "throughputFromDestinationNode", Type: parquet.Int64},
+	{Name: "reverseThroughputFromSourceNode", Type: parquet.Int64},
+	{Name: "reverseThroughputFromDestinationNode", Type: parquet.Int64},
+	{Name: "clusterUUID", Type: parquet.String},
+	{Name: "timeInserted", Type: parquet.TimestampMillis},
+	{Name: "egressName", Type: parquet.String},
+	{Name: "egressIP", Type: parquet.String},
+	{Name: "appProtocolName", Type: parquet.String},
+	{Name: "httpVals", Type: parquet.String},
+	{Name: "egressNodeName", Type: parquet.String},
+}
+
+// parquetRow returns the values of a flow record, in the order of parquetSchema.
+func parquetRow(r *flowrecord.FlowRecord, clusterUUID string, timeInserted time.Time) []any {
+	return []any{
+		r.FlowStartSeconds,
+		r.FlowEndSeconds,
+		r.FlowEndSecondsFromSourceNode,
+		r.FlowEndSecondsFromDestinationNode,
+		int32(r.FlowEndReason),
+		r.SourceIP,
+		r.DestinationIP,
+		int32(r.SourceTransportPort),
+		int32(r.DestinationTransportPort),
+		int32(r.ProtocolIdentifier),
+		int64(r.PacketTotalCount),
+		int64(r.OctetTotalCount),
+		int64(r.PacketDeltaCount),
+		int64(r.OctetDeltaCount),
+		int64(r.ReversePacketTotalCount),
+		int64(r.ReverseOctetTotalCount),
+		int64(r.ReversePacketDeltaCount),
+		int64(r.ReverseOctetDeltaCount),
+		r.SourcePodName,
+		r.SourcePodNamespace,
+		r.SourceNodeName,
+		r.DestinationPodName,
+		r.DestinationPodNamespace,
+		r.DestinationNodeName,
+		r.DestinationClusterIP,
+		int32(r.DestinationServicePort),
+		r.DestinationServicePortName,
+		r.IngressNetworkPolicyName,
+		r.IngressNetworkPolicyNamespace,
+		r.IngressNetworkPolicyRuleName,
+		int32(r.IngressNetworkPolicyRuleAction),
+		int32(r.IngressNetworkPolicyType),
+		r.EgressNetworkPolicyName,
+		r.EgressNetworkPolicyNamespace,
+		r.EgressNetworkPolicyRuleName,
+		int32(r.EgressNetworkPolicyRuleAction),
+		int32(r.EgressNetworkPolicyType),
+		r.TcpState,
+		int32(r.FlowType),
+		r.SourcePodLabels,
+		r.DestinationPodLabels,
+		int64(r.Throughput),
+		int64(r.ReverseThroughput),
+		int64(r.ThroughputFromSourceNode),
+		int64(r.ThroughputFromDestinationNode),
+		int64(r.ReverseThroughputFromSourceNode),
+		int64(r.ReverseThroughputFromDestinationNode),
+		clusterUUID,
+		timeInserted,
+		r.EgressName,
+		r.EgressIP,
+		r.AppProtocolName,
+		r.HttpVals,
+		r.EgressNodeName,
+	}
+}
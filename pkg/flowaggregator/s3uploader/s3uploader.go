@@ -22,6 +22,7 @@ import (
 	"io"
 	"math/rand/v2"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -29,10 +30,12 @@ import (
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
-	config "antrea.io/antrea/pkg/config/flowaggregator"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
 	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	"antrea.io/antrea/pkg/util/parquet"
 )
 
 const (
@@ -47,11 +50,20 @@ type stopPayload struct {
 	flushQueue bool
 }
 
+// bufferedFile is a file which is ready to be uploaded.
+type bufferedFile struct {
+	data *bytes.Buffer
+	// partition is the time-based prefix under which the file is uploaded, when partitionByTime
+	// is enabled.
+	partition string
+}
+
 type S3UploadProcess struct {
 	bucketName       string
 	bucketPrefix     string
 	region           string
 	compress         bool
+	partitionByTime  bool
 	maxRecordPerFile int32
 	// uploadInterval is the interval between batch uploads
 	uploadInterval time.Duration
@@ -62,19 +74,29 @@ type S3UploadProcess struct {
 	// exportWg is to ensure that all messages have been flushed from the queue when we stop
 	exportWg             sync.WaitGroup
 	exportProcessRunning bool
+	// uploadFailed is set when the last periodic upload failed, and reset on the next
+	// successful upload.
+	uploadFailed atomic.Bool
 	// mutex protects configuration state from concurrent access
 	mutex sync.Mutex
 	// queueMutex protects currentBuffer and bufferQueue from concurrent access
 	queueMutex sync.Mutex
+	// uploadMutex serializes uploads, which can be triggered both by the periodic upload
+	// goroutine and by Flush. It protects buffersToUpload.
+	uploadMutex sync.Mutex
 	// currentBuffer caches flow record
 	currentBuffer *bytes.Buffer
 	// cachedRecordCount keeps track of the number of flow records written into currentBuffer
 	cachedRecordCount int32
 	// bufferQueue caches currentBuffer when it is full
-	bufferQueue []*bytes.Buffer
+	bufferQueue []*bufferedFile
 	// buffersToUpload stores all the buffers to be uploaded for the current uploadFile() call
-	buffersToUpload []*bytes.Buffer
+	buffersToUpload []*bufferedFile
 	gzipWriter      *gzip.Writer
+	// parquetWriter accumulates flow records in columnar format when recordFormat is
+	// "Parquet". Records are written to currentBuffer when the file is complete.
+	parquetWriter *parquet.Writer
+	clock         clock.Clock
 	// awsS3Client is used to initialize awsS3Uploader
 	awsS3Client *s3.Client
 	// awsS3Uploader makes the real call to aws-sdk Upload() method to upload an object to S3
@@ -85,7 +107,7 @@ type S3UploadProcess struct {
 }
 
 type S3Input struct {
-	Config         config.S3UploaderConfig
+	Config         flowaggregatorconfig.S3UploaderConfig
 	UploadInterval time.Duration
 }
 
@@ -140,16 +162,26 @@ func NewS3UploadProcess(input S3Input, clusterUUID string) (*S3UploadProcess, er
 		bucketPrefix:     config.BucketPrefix,
 		region:           region,
 		compress:         *config.Compress,
+		partitionByTime:  config.PartitionByTime,
 		maxRecordPerFile: config.MaxRecordsPerFile,
 		uploadInterval:   input.UploadInterval,
 		currentBuffer:    buf,
-		bufferQueue:      make([]*bytes.Buffer, 0),
-		buffersToUpload:  make([]*bytes.Buffer, 0, maxNumBuffersPendingUpload),
-		gzipWriter:       gzip.NewWriter(buf),
+		bufferQueue:      make([]*bufferedFile, 0),
+		buffersToUpload:  make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
 		awsS3Client:      awsS3Client,
 		awsS3Uploader:    awsS3Uploader,
 		s3UploaderAPI:    &S3Uploader{},
 		clusterUUID:      clusterUUID,
+		clock:            clock.RealClock{},
+	}
+	if config.RecordFormat == flowaggregatorconfig.S3RecordFormatParquet {
+		compression := parquet.Uncompressed
+		if *config.Compress {
+			compression = parquet.Gzip
+		}
+		s3ExportProcess.parquetWriter = parquet.NewWriter(parquetSchema, compression)
+	} else {
+		s3ExportProcess.gzipWriter = gzip.NewWriter(buf)
 	}
 	return s3ExportProcess, nil
 }
@@ -217,7 +249,14 @@ func (p *S3UploadProcess) CacheRecord(record *flowpb.Flow) error {
 	}
 	p.queueMutex.Lock()
 	defer p.queueMutex.Unlock()
-	p.writeRecordToBuffer(r)
+	if p.parquetWriter != nil {
+		if err := p.parquetWriter.AppendRow(parquetRow(r, p.clusterUUID, p.clock.Now())...); err != nil {
+			return err
+		}
+		p.cachedRecordCount += 1
+	} else {
+		p.writeRecordToBuffer(r)
+	}
 	// If the number of pending records in the buffer reaches maxRecordPerFile,
 	// add the buffer to bufferQueue.
 	if int32(p.cachedRecordCount) == p.maxRecordPerFile {
@@ -286,6 +325,7 @@ func (p *S3UploadProcess) flowRecordPeriodicCommit() {
 			if err != nil {
 				klog.ErrorS(err, "Error when doing batchUploadAll on triggered timer")
 			}
+			p.uploadFailed.Store(err != nil)
 		}
 	}
 }
@@ -294,6 +334,33 @@ func (p *S3UploadProcess) flowRecordPeriodicCommit() {
 // to-upload buffers stored in buffersToUpload. Returns error encountered
 // during upload if any.
 func (p *S3UploadProcess) batchUploadAll(ctx context.Context) error {
+	p.uploadMutex.Lock()
+	defer p.uploadMutex.Unlock()
+	return p.uploadAll(ctx, maxNumBuffersPendingUpload)
+}
+
+// Flush synchronously uploads all buffers cached in bufferQueue, including the
+// current buffer, and returns after all the files have been uploaded. Unlike
+// with periodic uploads, buffers are dropped in case of error: the caller is
+// expected to keep its own copy of the records until Flush succeeds, and to add
+// them again before retrying.
+func (p *S3UploadProcess) Flush() error {
+	p.uploadMutex.Lock()
+	defer p.uploadMutex.Unlock()
+	ctx, cancelFn := context.WithTimeout(context.Background(), bufferFlushTimeout)
+	defer cancelFn()
+	// No limit on pending buffers, as none of them can be dropped silently.
+	if err := p.uploadAll(ctx, 0); err != nil {
+		p.buffersToUpload = p.buffersToUpload[:0]
+		return err
+	}
+	return nil
+}
+
+// uploadAll implements batchUploadAll. If maxPendingBuffers is not 0, the
+// oldest buffers are dropped so that no more than maxPendingBuffers are
+// pending upload. Caller of this function should acquire uploadMutex.
+func (p *S3UploadProcess) uploadAll(ctx context.Context, maxPendingBuffers int) error {
 	func() {
 		p.queueMutex.Lock()
 		defer p.queueMutex.Unlock()
@@ -304,7 +371,7 @@ func (p *S3UploadProcess) batchUploadAll(ctx context.Context) error {
 		// dump cached buffers from bufferQueue to buffersToUpload
 		for _, buf := range p.bufferQueue {
 			p.buffersToUpload = append(p.buffersToUpload, buf)
-			if len(p.buffersToUpload) > maxNumBuffersPendingUpload {
+			if maxPendingBuffers > 0 && len(p.buffersToUpload) > maxPendingBuffers {
 				p.buffersToUpload = p.buffersToUpload[1:]
 			}
 		}
@@ -312,9 +379,9 @@ func (p *S3UploadProcess) batchUploadAll(ctx context.Context) error {
 	}()
 
 	uploaded := 0
-	for _, buf := range p.buffersToUpload {
-		reader := bytes.NewReader(buf.Bytes())
-		err := p.uploadFile(ctx, reader)
+	for _, file := range p.buffersToUpload {
+		reader := bytes.NewReader(file.data.Bytes())
+		err := p.uploadFile(ctx, reader, file.partition)
 		if err != nil {
 			p.buffersToUpload = p.buffersToUpload[uploaded:]
 			return err
@@ -325,6 +392,11 @@ func (p *S3UploadProcess) batchUploadAll(ctx context.Context) error {
 	return nil
 }
 
+// IsHealthy returns false if the last attempt to upload records to S3 failed.
+func (p *S3UploadProcess) IsHealthy() bool {
+	return !p.uploadFailed.Load()
+}
+
 func (p *S3UploadProcess) writeRecordToBuffer(record *flowrecord.FlowRecord) {
 	var writer io.Writer
 	writer = p.currentBuffer
@@ -336,14 +408,23 @@ func (p *S3UploadProcess) writeRecordToBuffer(record *flowrecord.FlowRecord) {
 	p.cachedRecordCount += 1
 }
 
-func (p *S3UploadProcess) uploadFile(ctx context.Context, reader *bytes.Reader) error {
-	fileName := fmt.Sprintf("records-%s.csv", randSeq(12))
-	if p.compress {
-		fileName += ".gz"
+func (p *S3UploadProcess) uploadFile(ctx context.Context, reader *bytes.Reader, partition string) error {
+	var fileName string
+	if p.parquetWriter != nil {
+		// Parquet files are compressed internally, so the extension does not change.
+		fileName = fmt.Sprintf("records-%s.parquet", randSeq(12))
+	} else {
+		fileName = fmt.Sprintf("records-%s.csv", randSeq(12))
+		if p.compress {
+			fileName += ".gz"
+		}
 	}
 	key := fileName
+	if partition != "" {
+		key = fmt.Sprintf("%s/%s", partition, key)
+	}
 	if p.bucketPrefix != "" {
-		key = fmt.Sprintf("%s/%s", p.bucketPrefix, fileName)
+		key = fmt.Sprintf("%s/%s", p.bucketPrefix, key)
 	}
 	if _, err := p.s3UploaderAPI.Upload(ctx, &s3.PutObjectInput{
 		Bucket: aws.String(p.bucketName),
@@ -358,7 +439,19 @@ func (p *S3UploadProcess) uploadFile(ctx context.Context, reader *bytes.Reader)
 // appendBufferToQueue appends currentBuffer to bufferQueue, and reset
 // currentBuffer. Caller of this function should acquire queueMutex.
 func (p *S3UploadProcess) appendBufferToQueue() {
-	p.bufferQueue = append(p.bufferQueue, p.currentBuffer)
+	if p.parquetWriter != nil {
+		// All the records of a file are written as a single row group, so the size of row
+		// groups is determined by maxRecordPerFile.
+		if err := p.parquetWriter.Flush(p.currentBuffer); err != nil {
+			// This cannot happen as writing to a bytes.Buffer never fails.
+			klog.ErrorS(err, "Error when writing Parquet file")
+		}
+	}
+	file := &bufferedFile{data: p.currentBuffer}
+	if p.partitionByTime {
+		file.partition = timePartition(p.clock.Now())
+	}
+	p.bufferQueue = append(p.bufferQueue, file)
 	newBuffer := &bytes.Buffer{}
 	// avoid too many memory allocations
 	newBuffer.Grow(p.currentBuffer.Cap())
@@ -370,6 +463,13 @@ func (p *S3UploadProcess) appendBufferToQueue() {
 	}
 }
 
+// timePartition returns the Hive-style prefix for the provided time, which lets query engines
+// such as Athena or Spark discover partitions automatically.
+func timePartition(t time.Time) string {
+	t = t.UTC()
+	return fmt.Sprintf("year=%04d/month=%02d/day=%02d/hour=%02d", t.Year(), t.Month(), t.Day(), t.Hour())
+}
+
 func randSeq(n int) string {
 	var alphabet = []rune("abcdefghijklmnopqrstuvwxyz0123456789")
 	b := make([]rune, n)
@@ -18,19 +18,27 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	clocktesting "k8s.io/utils/clock/testing"
 
+	flowrecordtesting "antrea.io/antrea/pkg/flowaggregator/flowrecord/testing"
 	s3uploadertesting "antrea.io/antrea/pkg/flowaggregator/s3uploader/testing"
 	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+	"antrea.io/antrea/pkg/util/parquet"
 )
 
 var (
@@ -62,7 +70,7 @@ func TestCacheRecord(t *testing.T) {
 		compress:         false,
 		maxRecordPerFile: 2,
 		currentBuffer:    &bytes.Buffer{},
-		bufferQueue:      make([]*bytes.Buffer, 0, maxNumBuffersPendingUpload),
+		bufferQueue:      make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
 		clusterUUID:      fakeClusterUUID,
 	}
 
@@ -79,7 +87,7 @@ func TestCacheRecord(t *testing.T) {
 	s3UploadProc.CacheRecord(record)
 	assert.Equal(t, 1, len(s3UploadProc.bufferQueue))
 	buf := s3UploadProc.bufferQueue[0]
-	currentBuf := strings.TrimRight(strings.Split(buf.String(), "\n")[1], "\n")
+	currentBuf := strings.TrimRight(strings.Split(buf.data.String(), "\n")[1], "\n")
 	assert.Equal(t, strings.Split(currentBuf, ",")[:50], strings.Split(recordStrIPv6, ",")[:50])
 	assert.Equal(t, strings.Split(currentBuf, ",")[51:], strings.Split(recordStrIPv6, ",")[51:])
 	assert.EqualValues(t, 0, s3UploadProc.cachedRecordCount)
@@ -95,8 +103,8 @@ func TestBatchUploadAll(t *testing.T) {
 		compress:         false,
 		maxRecordPerFile: 10,
 		currentBuffer:    &bytes.Buffer{},
-		bufferQueue:      make([]*bytes.Buffer, 0),
-		buffersToUpload:  make([]*bytes.Buffer, 0, maxNumBuffersPendingUpload),
+		bufferQueue:      make([]*bufferedFile, 0),
+		buffersToUpload:  make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
 		s3UploaderAPI:    mockS3Uploader,
 		clusterUUID:      fakeClusterUUID,
 	}
@@ -124,8 +132,8 @@ func TestBatchUploadAllPartialSuccess(t *testing.T) {
 		compress:         false,
 		maxRecordPerFile: 1,
 		currentBuffer:    &bytes.Buffer{},
-		bufferQueue:      make([]*bytes.Buffer, 0),
-		buffersToUpload:  make([]*bytes.Buffer, 0, maxNumBuffersPendingUpload),
+		bufferQueue:      make([]*bufferedFile, 0),
+		buffersToUpload:  make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
 		s3UploaderAPI:    mockS3Uploader,
 		clusterUUID:      fakeClusterUUID,
 	}
@@ -140,6 +148,44 @@ func TestBatchUploadAllPartialSuccess(t *testing.T) {
 	assert.EqualError(t, err, "error when uploading file to S3: random error")
 }
 
+func TestFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockS3Uploader := s3uploadertesting.NewMockS3UploaderAPI(ctrl)
+	s3UploadProc := S3UploadProcess{
+		compress:         false,
+		maxRecordPerFile: 1,
+		currentBuffer:    &bytes.Buffer{},
+		bufferQueue:      make([]*bufferedFile, 0),
+		buffersToUpload:  make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
+		s3UploaderAPI:    mockS3Uploader,
+		clusterUUID:      fakeClusterUUID,
+	}
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+
+	// Buffers are dropped on error, instead of being kept for the next upload.
+	gomock.InOrder(
+		mockS3Uploader.EXPECT().Upload(gomock.Any(), gomock.Any(), nil).Return(nil, nil),
+		mockS3Uploader.EXPECT().Upload(gomock.Any(), gomock.Any(), nil).Return(nil, fmt.Errorf("random error")),
+	)
+	s3UploadProc.CacheRecord(record)
+	s3UploadProc.CacheRecord(record)
+	err := s3UploadProc.Flush()
+	assert.EqualError(t, err, "error when uploading file to S3: random error")
+	assert.Equal(t, 0, len(s3UploadProc.bufferQueue))
+	assert.Equal(t, 0, len(s3UploadProc.buffersToUpload))
+
+	// Unlike with periodic uploads, buffers are not dropped when more than
+	// maxNumBuffersPendingUpload are pending upload.
+	const numRecords = maxNumBuffersPendingUpload + 2
+	mockS3Uploader.EXPECT().Upload(gomock.Any(), gomock.Any(), nil).Return(nil, nil).Times(numRecords)
+	for i := 0; i < numRecords; i++ {
+		s3UploadProc.CacheRecord(record)
+	}
+	require.NoError(t, s3UploadProc.Flush())
+	assert.Equal(t, 0, len(s3UploadProc.bufferQueue))
+	assert.Equal(t, 0, len(s3UploadProc.buffersToUpload))
+}
+
 func TestBatchUploadAllError(t *testing.T) {
 	ctx := context.Background()
 	s3uploader := &S3Uploader{}
@@ -148,8 +194,8 @@ func TestBatchUploadAllError(t *testing.T) {
 		compress:         false,
 		maxRecordPerFile: 10,
 		currentBuffer:    &bytes.Buffer{},
-		bufferQueue:      make([]*bytes.Buffer, 0),
-		buffersToUpload:  make([]*bytes.Buffer, 0, maxNumBuffersPendingUpload),
+		bufferQueue:      make([]*bufferedFile, 0),
+		buffersToUpload:  make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
 		s3UploaderAPI:    s3uploader,
 	}
 	cfg, _ := config.LoadDefaultConfig(ctx, config.WithRegion("us-west-2"))
@@ -186,8 +232,8 @@ func TestFlowRecordPeriodicCommit(t *testing.T) {
 		maxRecordPerFile: 10,
 		uploadInterval:   100 * time.Millisecond,
 		currentBuffer:    &bytes.Buffer{},
-		bufferQueue:      make([]*bytes.Buffer, 0),
-		buffersToUpload:  make([]*bytes.Buffer, 0, maxNumBuffersPendingUpload),
+		bufferQueue:      make([]*bufferedFile, 0),
+		buffersToUpload:  make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
 		s3UploaderAPI:    mockS3Uploader,
 		clusterUUID:      fakeClusterUUID,
 	}
@@ -222,8 +268,8 @@ func TestFlushCacheOnStop(t *testing.T) {
 		maxRecordPerFile: 10,
 		uploadInterval:   100 * time.Second,
 		currentBuffer:    &bytes.Buffer{},
-		bufferQueue:      make([]*bytes.Buffer, 0),
-		buffersToUpload:  make([]*bytes.Buffer, 0, maxNumBuffersPendingUpload),
+		bufferQueue:      make([]*bufferedFile, 0),
+		buffersToUpload:  make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
 		s3UploaderAPI:    mockS3Uploader,
 		clusterUUID:      fakeClusterUUID,
 	}
@@ -238,3 +284,110 @@ func TestFlushCacheOnStop(t *testing.T) {
 	assert.Equal(t, "", s3UploadProc.currentBuffer.String())
 	assert.EqualValues(t, 0, s3UploadProc.cachedRecordCount)
 }
+
+func TestCacheRecordParquet(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC))
+	s3UploadProc := S3UploadProcess{
+		maxRecordPerFile: 2,
+		partitionByTime:  true,
+		currentBuffer:    &bytes.Buffer{},
+		bufferQueue:      make([]*bufferedFile, 0, maxNumBuffersPendingUpload),
+		parquetWriter:    parquet.NewWriter(parquetSchema, parquet.Gzip),
+		clock:            clock,
+		clusterUUID:      fakeClusterUUID,
+	}
+
+	// Records are buffered in columnar format until the file is complete.
+	require.NoError(t, s3UploadProc.CacheRecord(flowaggregatortesting.PrepareTestFlowRecord(true)))
+	assert.EqualValues(t, 1, s3UploadProc.cachedRecordCount)
+	assert.EqualValues(t, 1, s3UploadProc.parquetWriter.NumRows())
+	assert.Zero(t, s3UploadProc.currentBuffer.Len())
+
+	clock.Step(time.Hour)
+	require.NoError(t, s3UploadProc.CacheRecord(flowaggregatortesting.PrepareTestFlowRecord(false)))
+	require.Len(t, s3UploadProc.bufferQueue, 1)
+	file := s3UploadProc.bufferQueue[0]
+	assert.Equal(t, "year=2026/month=10/day=18/hour=10", file.partition)
+	data := file.data.Bytes()
+	assert.Equal(t, "PAR1", string(data[:4]))
+	assert.Equal(t, "PAR1", string(data[len(data)-4:]))
+	assert.EqualValues(t, 0, s3UploadProc.cachedRecordCount)
+	assert.EqualValues(t, 0, s3UploadProc.parquetWriter.NumRows())
+	assert.Zero(t, s3UploadProc.currentBuffer.Len())
+}
+
+func TestParquetSchema(t *testing.T) {
+	row := parquetRow(flowrecordtesting.PrepareTestFlowRecord(), fakeClusterUUID, time.Now())
+	require.Len(t, row, len(parquetSchema))
+	// The row must match the schema, which is checked by AppendRow.
+	w := parquet.NewWriter(parquetSchema, parquet.Uncompressed)
+	require.NoError(t, w.AppendRow(row...))
+}
+
+func TestTimePartition(t *testing.T) {
+	loc := time.FixedZone("UTC-8", -8*3600)
+	assert.Equal(t, "year=2026/month=01/day=01/hour=05", timePartition(time.Date(2025, 12, 31, 21, 10, 0, 0, loc)))
+	assert.Equal(t, "year=2026/month=10/day=18/hour=23", timePartition(time.Date(2026, 10, 18, 23, 59, 59, 0, time.UTC)))
+}
+
+// TestUploadFileKey uploads files to a local S3-compatible server, to validate the object keys.
+func TestUploadFileKey(t *testing.T) {
+	keys := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		keys <- r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	awsS3Client := s3.New(s3.Options{
+		Region:       "us-west-2",
+		BaseEndpoint: aws.String(server.URL),
+		UsePathStyle: true,
+		Credentials:  aws.AnonymousCredentials{},
+	})
+
+	testCases := []struct {
+		name         string
+		bucketPrefix string
+		partition    string
+		parquet      bool
+		compress     bool
+		expectedKey  *regexp.Regexp
+	}{
+		{
+			name:        "csv",
+			compress:    true,
+			expectedKey: regexp.MustCompile(`^/test-bucket/records-[a-z0-9]{12}\.csv\.gz$`),
+		},
+		{
+			name:         "csv with prefix",
+			bucketPrefix: "flows",
+			expectedKey:  regexp.MustCompile(`^/test-bucket/flows/records-[a-z0-9]{12}\.csv$`),
+		},
+		{
+			name:         "parquet with partition",
+			bucketPrefix: "flows",
+			partition:    "year=2026/month=10/day=18/hour=09",
+			parquet:      true,
+			compress:     true,
+			expectedKey:  regexp.MustCompile(`^/test-bucket/flows/year=2026/month=10/day=18/hour=09/records-[a-z0-9]{12}\.parquet$`),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s3UploadProc := S3UploadProcess{
+				bucketName:    "test-bucket",
+				bucketPrefix:  tc.bucketPrefix,
+				compress:      tc.compress,
+				awsS3Client:   awsS3Client,
+				awsS3Uploader: s3manager.NewUploader(awsS3Client),
+				s3UploaderAPI: &S3Uploader{},
+			}
+			if tc.parquet {
+				s3UploadProc.parquetWriter = parquet.NewWriter(parquetSchema, parquet.Gzip)
+			}
+			require.NoError(t, s3UploadProc.uploadFile(context.Background(), bytes.NewReader([]byte("data")), tc.partition))
+			assert.Regexp(t, tc.expectedKey, <-keys)
+		})
+	}
+}
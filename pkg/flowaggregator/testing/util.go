@@ -56,7 +56,11 @@ func PrepareTestFlowRecord(isIPv4 bool) *flowpb.Flow {
 			ProtocolNumber:  6,
 			Protocol: &flowpb.Transport_TCP{
 				TCP: &flowpb.TCP{
-					StateName: "TIME_WAIT",
+					StateName:         "TIME_WAIT",
+					RttUs:             1500,
+					RttVarUs:          300,
+					Retransmissions:   2,
+					RwndLimitedTimeUs: 12000,
 				},
 			},
 		},
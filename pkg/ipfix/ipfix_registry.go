@@ -15,6 +15,8 @@
 package ipfix
 
 import (
+	"fmt"
+
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
 )
@@ -33,8 +35,52 @@ func NewIPFIXRegistry() *ipfixRegistry {
 	return &ipfixRegistry{}
 }
 
-func (reg *ipfixRegistry) LoadRegistry() {
+// antreaInfoElements contains the Antrea information elements which are not defined by the
+// go-ipfix registry. Their element IDs follow the last Antrea element ID defined by go-ipfix.
+var antreaInfoElements = []*ipfixentities.InfoElement{
+	ipfixentities.NewInfoElement("tcpRoundTripTime", 168, ipfixentities.Unsigned32, ipfixregistry.AntreaEnterpriseID, 4),
+	ipfixentities.NewInfoElement("tcpRoundTripTimeVariance", 169, ipfixentities.Unsigned32, ipfixregistry.AntreaEnterpriseID, 4),
+	ipfixentities.NewInfoElement("tcpRetransmissionCount", 170, ipfixentities.Unsigned32, ipfixregistry.AntreaEnterpriseID, 4),
+	ipfixentities.NewInfoElement("tcpReceiveWindowLimitedTime", 171, ipfixentities.Unsigned64, ipfixregistry.AntreaEnterpriseID, 8),
+}
+
+// addInfoElements adds the provided information elements to the go-ipfix registry. Elements
+// which are already defined by go-ipfix with the same ID and data type are skipped, as they
+// have been added to go-ipfix in the meantime. An error is returned if an element conflicts with
+// an element defined by go-ipfix, i.e., if an element with the same name has a different ID or
+// data type, or if its ID is already used by an element with a different name.
+func addInfoElements(ies []*ipfixentities.InfoElement) error {
+	for _, ie := range ies {
+		if existing, err := ipfixregistry.GetInfoElement(ie.Name, ie.EnterpriseId); err == nil {
+			if existing.ElementId != ie.ElementId || existing.DataType != ie.DataType || existing.Len != ie.Len {
+				return fmt.Errorf("information element %s conflicts with the go-ipfix registry: ID %d and data type %d, but go-ipfix defines ID %d and data type %d",
+					ie.Name, ie.ElementId, ie.DataType, existing.ElementId, existing.DataType)
+			}
+			continue
+		}
+		if existing, err := ipfixregistry.GetInfoElementFromID(ie.ElementId, ie.EnterpriseId); err == nil {
+			return fmt.Errorf("information element %s conflicts with the go-ipfix registry: ID %d is already used by %s",
+				ie.Name, ie.ElementId, existing.Name)
+		}
+		if err := ipfixregistry.PutInfoElement(*ie, ie.EnterpriseId); err != nil {
+			return fmt.Errorf("failed to add information element %s to IPFIX registry: %w", ie.Name, err)
+		}
+	}
+	return nil
+}
+
+// LoadRegistry loads the go-ipfix registry, and adds the Antrea information elements which are
+// not part of it yet. It panics if these elements conflict with the go-ipfix registry, which
+// can only happen after updating go-ipfix and is caught by unit tests.
+func LoadRegistry() {
 	ipfixregistry.LoadRegistry()
+	if err := addInfoElements(antreaInfoElements); err != nil {
+		panic(err)
+	}
+}
+
+func (reg *ipfixRegistry) LoadRegistry() {
+	LoadRegistry()
 }
 
 func (reg *ipfixRegistry) GetInfoElement(name string, enterpriseID uint32) (*ipfixentities.InfoElement, error) {
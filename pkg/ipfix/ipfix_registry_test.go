@@ -19,6 +19,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
 )
 
 func TestGetInfoElement(t *testing.T) {
@@ -42,6 +44,13 @@ func TestGetInfoElement(t *testing.T) {
 			expectedElementID: 100,
 			expectedError:     "",
 		},
+		{
+			testname:          "Antrea information element not defined by go-ipfix",
+			name:              "tcpRoundTripTime",
+			enterpriseID:      56506,
+			expectedElementID: 168,
+			expectedError:     "",
+		},
 		{
 			testname:      "Information element with given name does not exist in registry",
 			name:          "sourcePod",
@@ -63,3 +72,46 @@ func TestGetInfoElement(t *testing.T) {
 		})
 	}
 }
+
+func TestAddInfoElements(t *testing.T) {
+	LoadRegistry()
+	tc := []struct {
+		testname      string
+		ie            *ipfixentities.InfoElement
+		expectedError string
+	}{
+		{
+			testname: "Information element already defined by go-ipfix",
+			ie:       ipfixentities.NewInfoElement("sourcePodNamespace", 100, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+		},
+		{
+			testname: "Antrea information element already added",
+			ie:       antreaInfoElements[0],
+		},
+		{
+			testname:      "Information element defined by go-ipfix with a different ID",
+			ie:            ipfixentities.NewInfoElement("sourcePodNamespace", 200, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+			expectedError: "information element sourcePodNamespace conflicts with the go-ipfix registry: ID 200 and data type 13, but go-ipfix defines ID 100 and data type 13",
+		},
+		{
+			testname:      "Information element defined by go-ipfix with a different data type",
+			ie:            ipfixentities.NewInfoElement("sourcePodNamespace", 100, ipfixentities.Unsigned32, ipfixregistry.AntreaEnterpriseID, 4),
+			expectedError: "information element sourcePodNamespace conflicts with the go-ipfix registry: ID 100 and data type 3, but go-ipfix defines ID 100 and data type 13",
+		},
+		{
+			testname:      "Information element ID already used by go-ipfix",
+			ie:            ipfixentities.NewInfoElement("newElement", 100, ipfixentities.Unsigned32, ipfixregistry.AntreaEnterpriseID, 4),
+			expectedError: "information element newElement conflicts with the go-ipfix registry: ID 100 is already used by sourcePodNamespace",
+		},
+	}
+	for _, tt := range tc {
+		t.Run(tt.testname, func(t *testing.T) {
+			err := addInfoElements([]*ipfixentities.InfoElement{tt.ie})
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -352,6 +352,9 @@ type BucketBuilder interface {
 	LoadRegMark(mark *RegMark) BucketBuilder
 	ResubmitToTable(tableID uint8) BucketBuilder
 	SetTunnelDst(addr net.IP) BucketBuilder
+	SetSrcMAC(addr net.HardwareAddr) BucketBuilder
+	SetDstMAC(addr net.HardwareAddr) BucketBuilder
+	LoadPktMarkRange(value uint32, rng *Range) BucketBuilder
 	Done() Group
 }
 
@@ -308,6 +308,11 @@ func (a *ofFlowAction) LoadRegMark(marks ...*RegMark) FlowBuilder {
 
 // LoadPktMarkRange is an action to load data into pkt_mark at specified range.
 func (a *ofFlowAction) LoadPktMarkRange(value uint32, rng *Range) FlowBuilder {
+	return a.setField(newPktMarkField(value, rng))
+}
+
+// newPktMarkField returns the field to load data to pkt_mark with specified range.
+func newPktMarkField(value uint32, rng *Range) *openflow15.MatchField {
 	pktMarkField, _ := openflow15.FindFieldHeaderByName(NxmFieldPktMark, true)
 	valueBytes := make([]byte, 4)
 	maskBytes := make([]byte, 4)
@@ -321,7 +326,7 @@ func (a *ofFlowAction) LoadPktMarkRange(value uint32, rng *Range) FlowBuilder {
 	}
 	binary.BigEndian.PutUint32(valueBytes, valueData)
 	pktMarkField.Value = util.NewBuffer(valueBytes)
-	return a.setField(pktMarkField)
+	return pktMarkField
 }
 
 // LoadIPDSCP is an action to load data to IP DSCP bits.
@@ -154,6 +154,27 @@ func (b *bucketBuilder) SetTunnelDst(addr net.IP) BucketBuilder {
 	return b
 }
 
+// SetSrcMAC is an action to modify packet source MAC address to the specified address when the bucket is selected.
+func (b *bucketBuilder) SetSrcMAC(addr net.HardwareAddr) BucketBuilder {
+	setSrcMACAct := &ofctrl.SetSrcMACAction{MAC: addr}
+	b.bucket.AddAction(setSrcMACAct.GetActionMessage())
+	return b
+}
+
+// SetDstMAC is an action to modify packet destination MAC address to the specified address when the bucket is
+// selected.
+func (b *bucketBuilder) SetDstMAC(addr net.HardwareAddr) BucketBuilder {
+	setDstMACAct := &ofctrl.SetDstMACAction{MAC: addr}
+	b.bucket.AddAction(setDstMACAct.GetActionMessage())
+	return b
+}
+
+// LoadPktMarkRange is an action to load data to pkt_mark with specified range when the bucket is selected.
+func (b *bucketBuilder) LoadPktMarkRange(value uint32, rng *Range) BucketBuilder {
+	b.bucket.AddAction(openflow15.NewActionSetField(*newPktMarkField(value, rng)))
+	return b
+}
+
 // Weight sets the weight of a bucket.
 func (b *bucketBuilder) Weight(val uint16) BucketBuilder {
 	weight := openflow15.NewGroupBucketPropWeight(val)
@@ -125,6 +125,53 @@ func TestBucketBuilder(t *testing.T) {
 			},
 			expectedActionStr: "set_field:fec0::1111->tun_ipv6_dst",
 		},
+		{
+			name: "SetSrcMAC",
+			bucketFn: func(fb BucketBuilder) BucketBuilder {
+				return fb.SetSrcMAC(mac)
+			},
+			expectedActionField: &openflow15.ActionSetField{
+				Field: openflow15.MatchField{
+					Class: openflow15.OXM_CLASS_OPENFLOW_BASIC,
+					Field: openflow15.OXM_FIELD_ETH_SRC,
+					Value: &openflow15.EthSrcField{
+						EthSrc: mac,
+					},
+				},
+			},
+			expectedActionStr: "set_field:aa:bb:cc:dd:ee:ff->eth_src",
+		},
+		{
+			name: "SetDstMAC",
+			bucketFn: func(fb BucketBuilder) BucketBuilder {
+				return fb.SetDstMAC(mac)
+			},
+			expectedActionField: &openflow15.ActionSetField{
+				Field: openflow15.MatchField{
+					Class: openflow15.OXM_CLASS_OPENFLOW_BASIC,
+					Field: openflow15.OXM_FIELD_ETH_DST,
+					Value: &openflow15.EthDstField{
+						EthDst: mac,
+					},
+				},
+			},
+			expectedActionStr: "set_field:aa:bb:cc:dd:ee:ff->eth_dst",
+		},
+		{
+			name: "LoadPktMarkRange",
+			bucketFn: func(fb BucketBuilder) BucketBuilder {
+				return fb.LoadPktMarkRange(uint32(0xaeef), rng1)
+			},
+			expectedActionField: &openflow15.ActionSetField{
+				Field: openflow15.MatchField{
+					Class: openflow15.OXM_CLASS_NXM_1,
+					Field: openflow15.NXM_NX_PKT_MARK,
+					Value: util.NewBuffer([]byte{0xae, 0xef, 0x0, 0x0}),
+					Mask:  util.NewBuffer([]byte{0xff, 0xff, 0x0, 0x0}),
+				},
+			},
+			expectedActionStr: "set_field:0xaeef0000/0xffff0000->pkt_mark",
+		},
 		{
 			name: "ResubmitToTable",
 			bucketFn: func(fb BucketBuilder) BucketBuilder {
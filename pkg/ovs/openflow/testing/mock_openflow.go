@@ -2354,6 +2354,20 @@ func (mr *MockBucketBuilderMockRecorder) Done() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Done", reflect.TypeOf((*MockBucketBuilder)(nil).Done))
 }
 
+// LoadPktMarkRange mocks base method.
+func (m *MockBucketBuilder) LoadPktMarkRange(value uint32, rng *openflow.Range) openflow.BucketBuilder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadPktMarkRange", value, rng)
+	ret0, _ := ret[0].(openflow.BucketBuilder)
+	return ret0
+}
+
+// LoadPktMarkRange indicates an expected call of LoadPktMarkRange.
+func (mr *MockBucketBuilderMockRecorder) LoadPktMarkRange(value, rng any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadPktMarkRange", reflect.TypeOf((*MockBucketBuilder)(nil).LoadPktMarkRange), value, rng)
+}
+
 // LoadRegMark mocks base method.
 func (m *MockBucketBuilder) LoadRegMark(mark *openflow.RegMark) openflow.BucketBuilder {
 	m.ctrl.T.Helper()
@@ -2410,6 +2424,34 @@ func (mr *MockBucketBuilderMockRecorder) ResubmitToTable(tableID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResubmitToTable", reflect.TypeOf((*MockBucketBuilder)(nil).ResubmitToTable), tableID)
 }
 
+// SetDstMAC mocks base method.
+func (m *MockBucketBuilder) SetDstMAC(addr net.HardwareAddr) openflow.BucketBuilder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDstMAC", addr)
+	ret0, _ := ret[0].(openflow.BucketBuilder)
+	return ret0
+}
+
+// SetDstMAC indicates an expected call of SetDstMAC.
+func (mr *MockBucketBuilderMockRecorder) SetDstMAC(addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDstMAC", reflect.TypeOf((*MockBucketBuilder)(nil).SetDstMAC), addr)
+}
+
+// SetSrcMAC mocks base method.
+func (m *MockBucketBuilder) SetSrcMAC(addr net.HardwareAddr) openflow.BucketBuilder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSrcMAC", addr)
+	ret0, _ := ret[0].(openflow.BucketBuilder)
+	return ret0
+}
+
+// SetSrcMAC indicates an expected call of SetSrcMAC.
+func (mr *MockBucketBuilderMockRecorder) SetSrcMAC(addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSrcMAC", reflect.TypeOf((*MockBucketBuilder)(nil).SetSrcMAC), addr)
+}
+
 // SetTunnelDst mocks base method.
 func (m *MockBucketBuilder) SetTunnelDst(addr net.IP) openflow.BucketBuilder {
 	m.ctrl.T.Helper()
@@ -0,0 +1,713 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/util/parquet"
+)
+
+// The tests in this file read the files produced by parquet.Writer with a reader which is
+// implemented independently of the writer: it is in a separate package, does not share any code or
+// constant with the writer, and is based only on the Parquet specification
+// (https://github.com/apache/parquet-format) and on the Thrift compact protocol specification
+// (https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md). It checks
+// the consistency of the file metadata, as a Parquet implementation would, and decodes the values
+// of all the columns.
+
+// Type identifiers of the Thrift compact protocol.
+const (
+	compactBooleanTrue  = 1
+	compactBooleanFalse = 2
+	compactByte         = 3
+	compactI16          = 4
+	compactI32          = 5
+	compactI64          = 6
+	compactDouble       = 7
+	compactBinary       = 8
+	compactList         = 9
+	compactSet          = 10
+	compactMap          = 11
+	compactStruct       = 12
+)
+
+// compactReader decodes values serialized with the Thrift compact protocol.
+type compactReader struct {
+	r *bytes.Reader
+}
+
+func (c *compactReader) readVarint() (int64, error) {
+	return binary.ReadVarint(c.r)
+}
+
+func (c *compactReader) readInt(typ byte) (int64, error) {
+	if typ != compactByte && typ != compactI16 && typ != compactI32 && typ != compactI64 {
+		return 0, fmt.Errorf("expected integer but got type %d", typ)
+	}
+	if typ == compactByte {
+		b, err := c.r.ReadByte()
+		return int64(int8(b)), err
+	}
+	return c.readVarint()
+}
+
+func (c *compactReader) readBinary(typ byte) ([]byte, error) {
+	if typ != compactBinary {
+		return nil, fmt.Errorf("expected binary but got type %d", typ)
+	}
+	n, err := binary.ReadUvarint(c.r)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(c.r.Len()) {
+		return nil, fmt.Errorf("binary length %d exceeds remaining data", n)
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(c.r, b)
+	return b, err
+}
+
+// readStruct calls fieldFn for each field of the struct, which must consume the field value.
+func (c *compactReader) readStruct(fieldFn func(id int16, typ byte) error) error {
+	var lastID int16
+	for {
+		header, err := c.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if header == 0 {
+			return nil
+		}
+		typ := header & 0x0f
+		id := lastID + int16(header>>4)
+		if header>>4 == 0 {
+			v, err := c.readVarint()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		lastID = id
+		if err := fieldFn(id, typ); err != nil {
+			return fmt.Errorf("field %d: %w", id, err)
+		}
+	}
+}
+
+// readList calls elemFn for each element of the list, which must consume the element value.
+func (c *compactReader) readList(typ byte, elemFn func(elemType byte) error) error {
+	if typ != compactList && typ != compactSet {
+		return fmt.Errorf("expected list but got type %d", typ)
+	}
+	header, err := c.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	size := uint64(header >> 4)
+	if size == 15 {
+		if size, err = binary.ReadUvarint(c.r); err != nil {
+			return err
+		}
+	}
+	for i := uint64(0); i < size; i++ {
+		if err := elemFn(header & 0x0f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skip consumes a value of the provided type, for fields which are not used by the reader.
+func (c *compactReader) skip(typ byte) error {
+	switch typ {
+	case compactBooleanTrue, compactBooleanFalse:
+		return nil
+	case compactByte, compactI16, compactI32, compactI64:
+		_, err := c.readInt(typ)
+		return err
+	case compactDouble:
+		_, err := c.r.Seek(8, io.SeekCurrent)
+		return err
+	case compactBinary:
+		_, err := c.readBinary(typ)
+		return err
+	case compactList, compactSet:
+		return c.readList(typ, c.skip)
+	case compactMap:
+		size, err := binary.ReadUvarint(c.r)
+		if err != nil || size == 0 {
+			return err
+		}
+		types, err := c.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < size; i++ {
+			if err := c.skip(types >> 4); err != nil {
+				return err
+			}
+			if err := c.skip(types & 0x0f); err != nil {
+				return err
+			}
+		}
+		return nil
+	case compactStruct:
+		return c.readStruct(func(_ int16, typ byte) error { return c.skip(typ) })
+	}
+	return fmt.Errorf("unknown type %d", typ)
+}
+
+// The following types hold the subset of the Parquet Thrift structs used by the reader. Field IDs
+// and enum values are taken from parquet.thrift.
+
+// Physical types.
+const (
+	typeInt32     = 1
+	typeInt64     = 2
+	typeByteArray = 6
+)
+
+// Other enum values.
+const (
+	convertedUTF8            = 0
+	convertedTimestampMillis = 9
+
+	fieldRepetitionRequired = 0
+
+	encodingPLAIN = 0
+
+	compressionUncompressed = 0
+	compressionGzip         = 2
+
+	pageTypeDataPage = 0
+)
+
+type schemaElement struct {
+	typ           *int64
+	repetition    *int64
+	name          string
+	numChildren   int64
+	convertedType *int64
+	// logicalType is the ID of the member set in the LogicalType union, or 0.
+	logicalType int16
+	// timestampMillis and timestampUTC are set for TIMESTAMP logical types.
+	timestampMillis bool
+	timestampUTC    bool
+}
+
+type columnMetaData struct {
+	typ                   int64
+	encodings             []int64
+	pathInSchema          []string
+	codec                 int64
+	numValues             int64
+	totalUncompressedSize int64
+	totalCompressedSize   int64
+	dataPageOffset        int64
+}
+
+type columnChunk struct {
+	metaData *columnMetaData
+}
+
+type rowGroup struct {
+	columns       []columnChunk
+	totalByteSize int64
+	numRows       int64
+}
+
+type fileMetaData struct {
+	version   int64
+	schema    []schemaElement
+	numRows   int64
+	rowGroups []rowGroup
+	createdBy string
+}
+
+type pageHeader struct {
+	typ                  int64
+	uncompressedPageSize int64
+	compressedPageSize   int64
+	dataPageHeader       *dataPageHeader
+}
+
+type dataPageHeader struct {
+	numValues               int64
+	encoding                int64
+	definitionLevelEncoding int64
+	repetitionLevelEncoding int64
+}
+
+func readInt64Field(c *compactReader, typ byte, v *int64) error {
+	var err error
+	*v, err = c.readInt(typ)
+	return err
+}
+
+func readOptionalInt64Field(c *compactReader, typ byte, v **int64) error {
+	i, err := c.readInt(typ)
+	*v = &i
+	return err
+}
+
+func readLogicalType(c *compactReader, typ byte, e *schemaElement) error {
+	if typ != compactStruct {
+		return fmt.Errorf("expected struct but got type %d", typ)
+	}
+	return c.readStruct(func(id int16, typ byte) error {
+		e.logicalType = id
+		if id != 8 {
+			return c.skip(typ)
+		}
+		// TimestampType.
+		return c.readStruct(func(id int16, typ byte) error {
+			switch id {
+			case 1:
+				e.timestampUTC = typ == compactBooleanTrue
+				return nil
+			case 2:
+				// TimeUnit union: MILLIS is member 1.
+				return c.readStruct(func(id int16, typ byte) error {
+					e.timestampMillis = id == 1
+					return c.skip(typ)
+				})
+			}
+			return c.skip(typ)
+		})
+	})
+}
+
+func readSchemaElement(c *compactReader) (schemaElement, error) {
+	var e schemaElement
+	err := c.readStruct(func(id int16, typ byte) error {
+		switch id {
+		case 1:
+			return readOptionalInt64Field(c, typ, &e.typ)
+		case 3:
+			return readOptionalInt64Field(c, typ, &e.repetition)
+		case 4:
+			name, err := c.readBinary(typ)
+			e.name = string(name)
+			return err
+		case 5:
+			return readInt64Field(c, typ, &e.numChildren)
+		case 6:
+			return readOptionalInt64Field(c, typ, &e.convertedType)
+		case 10:
+			return readLogicalType(c, typ, &e)
+		}
+		return c.skip(typ)
+	})
+	return e, err
+}
+
+func readColumnMetaData(c *compactReader) (*columnMetaData, error) {
+	m := &columnMetaData{}
+	err := c.readStruct(func(id int16, typ byte) error {
+		switch id {
+		case 1:
+			return readInt64Field(c, typ, &m.typ)
+		case 2:
+			return c.readList(typ, func(elemType byte) error {
+				v, err := c.readInt(elemType)
+				m.encodings = append(m.encodings, v)
+				return err
+			})
+		case 3:
+			return c.readList(typ, func(elemType byte) error {
+				v, err := c.readBinary(elemType)
+				m.pathInSchema = append(m.pathInSchema, string(v))
+				return err
+			})
+		case 4:
+			return readInt64Field(c, typ, &m.codec)
+		case 5:
+			return readInt64Field(c, typ, &m.numValues)
+		case 6:
+			return readInt64Field(c, typ, &m.totalUncompressedSize)
+		case 7:
+			return readInt64Field(c, typ, &m.totalCompressedSize)
+		case 9:
+			return readInt64Field(c, typ, &m.dataPageOffset)
+		}
+		return c.skip(typ)
+	})
+	return m, err
+}
+
+func readRowGroup(c *compactReader) (rowGroup, error) {
+	var g rowGroup
+	err := c.readStruct(func(id int16, typ byte) error {
+		switch id {
+		case 1:
+			return c.readList(typ, func(elemType byte) error {
+				var chunk columnChunk
+				err := c.readStruct(func(id int16, typ byte) error {
+					if id == 3 {
+						var err error
+						chunk.metaData, err = readColumnMetaData(c)
+						return err
+					}
+					return c.skip(typ)
+				})
+				g.columns = append(g.columns, chunk)
+				return err
+			})
+		case 2:
+			return readInt64Field(c, typ, &g.totalByteSize)
+		case 3:
+			return readInt64Field(c, typ, &g.numRows)
+		}
+		return c.skip(typ)
+	})
+	return g, err
+}
+
+func readFileMetaData(c *compactReader) (*fileMetaData, error) {
+	m := &fileMetaData{}
+	err := c.readStruct(func(id int16, typ byte) error {
+		switch id {
+		case 1:
+			return readInt64Field(c, typ, &m.version)
+		case 2:
+			return c.readList(typ, func(elemType byte) error {
+				e, err := readSchemaElement(c)
+				m.schema = append(m.schema, e)
+				return err
+			})
+		case 3:
+			return readInt64Field(c, typ, &m.numRows)
+		case 4:
+			return c.readList(typ, func(elemType byte) error {
+				g, err := readRowGroup(c)
+				m.rowGroups = append(m.rowGroups, g)
+				return err
+			})
+		case 6:
+			createdBy, err := c.readBinary(typ)
+			m.createdBy = string(createdBy)
+			return err
+		}
+		return c.skip(typ)
+	})
+	return m, err
+}
+
+func readPageHeader(c *compactReader) (*pageHeader, error) {
+	h := &pageHeader{}
+	err := c.readStruct(func(id int16, typ byte) error {
+		switch id {
+		case 1:
+			return readInt64Field(c, typ, &h.typ)
+		case 2:
+			return readInt64Field(c, typ, &h.uncompressedPageSize)
+		case 3:
+			return readInt64Field(c, typ, &h.compressedPageSize)
+		case 5:
+			h.dataPageHeader = &dataPageHeader{}
+			return c.readStruct(func(id int16, typ byte) error {
+				switch id {
+				case 1:
+					return readInt64Field(c, typ, &h.dataPageHeader.numValues)
+				case 2:
+					return readInt64Field(c, typ, &h.dataPageHeader.encoding)
+				case 3:
+					return readInt64Field(c, typ, &h.dataPageHeader.definitionLevelEncoding)
+				case 4:
+					return readInt64Field(c, typ, &h.dataPageHeader.repetitionLevelEncoding)
+				}
+				return c.skip(typ)
+			})
+		}
+		return c.skip(typ)
+	})
+	return h, err
+}
+
+// decodePlainValues decodes numValues PLAIN-encoded values of a required column.
+func decodePlainValues(data []byte, numValues int64, e *schemaElement) ([]any, error) {
+	r := bytes.NewReader(data)
+	var values []any
+	for i := int64(0); i < numValues; i++ {
+		switch *e.typ {
+		case typeInt32:
+			var v int32
+			if err := binary.Read(r, binary.LittleEndian, &v); err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		case typeInt64:
+			var v int64
+			if err := binary.Read(r, binary.LittleEndian, &v); err != nil {
+				return nil, err
+			}
+			if e.logicalType == 8 || (e.convertedType != nil && *e.convertedType == convertedTimestampMillis) {
+				if !e.timestampMillis || !e.timestampUTC {
+					return nil, fmt.Errorf("unsupported timestamp type for column %s", e.name)
+				}
+				values = append(values, time.UnixMilli(v).UTC())
+			} else {
+				values = append(values, v)
+			}
+		case typeByteArray:
+			var n uint32
+			if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+				return nil, err
+			}
+			if int(n) > r.Len() {
+				return nil, fmt.Errorf("byte array length %d exceeds page data", n)
+			}
+			b := make([]byte, n)
+			if _, err := io.ReadFull(r, b); err != nil {
+				return nil, err
+			}
+			if e.logicalType == 1 || (e.convertedType != nil && *e.convertedType == convertedUTF8) {
+				values = append(values, string(b))
+			} else {
+				values = append(values, b)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported physical type %d for column %s", *e.typ, e.name)
+		}
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d unexpected bytes at the end of page for column %s", r.Len(), e.name)
+	}
+	return values, nil
+}
+
+// readColumnChunk reads all the pages of a column chunk, and returns the decoded values.
+func readColumnChunk(data []byte, chunk columnChunk, e *schemaElement) ([]any, error) {
+	meta := chunk.metaData
+	if meta.typ != *e.typ {
+		return nil, fmt.Errorf("column %s has type %d in chunk metadata but %d in schema", e.name, meta.typ, *e.typ)
+	}
+	if len(meta.pathInSchema) != 1 || meta.pathInSchema[0] != e.name {
+		return nil, fmt.Errorf("unexpected path %v for column %s", meta.pathInSchema, e.name)
+	}
+	if meta.dataPageOffset < 4 || meta.totalCompressedSize < 0 || meta.dataPageOffset+meta.totalCompressedSize > int64(len(data)) {
+		return nil, fmt.Errorf("invalid column chunk location for column %s", e.name)
+	}
+	r := bytes.NewReader(data[meta.dataPageOffset : meta.dataPageOffset+meta.totalCompressedSize])
+	var values []any
+	var uncompressedSize int64
+	for r.Len() > 0 {
+		start := r.Len()
+		header, err := readPageHeader(&compactReader{r: r})
+		if err != nil {
+			return nil, fmt.Errorf("error when reading page header for column %s: %w", e.name, err)
+		}
+		headerSize := int64(start - r.Len())
+		if header.typ != pageTypeDataPage || header.dataPageHeader == nil {
+			return nil, fmt.Errorf("unsupported page type %d for column %s", header.typ, e.name)
+		}
+		dph := header.dataPageHeader
+		for _, encoding := range []int64{dph.encoding, dph.definitionLevelEncoding, dph.repetitionLevelEncoding} {
+			if !slices.Contains(meta.encodings, encoding) {
+				return nil, fmt.Errorf("page encoding %d of column %s is missing from chunk encodings %v", encoding, e.name, meta.encodings)
+			}
+		}
+		if dph.encoding != encodingPLAIN {
+			return nil, fmt.Errorf("unsupported encoding %d for column %s", dph.encoding, e.name)
+		}
+		if header.compressedPageSize > int64(r.Len()) {
+			return nil, fmt.Errorf("page size %d exceeds column chunk for column %s", header.compressedPageSize, e.name)
+		}
+		page := make([]byte, header.compressedPageSize)
+		if _, err := io.ReadFull(r, page); err != nil {
+			return nil, err
+		}
+		switch meta.codec {
+		case compressionUncompressed:
+		case compressionGzip:
+			gr, err := gzip.NewReader(bytes.NewReader(page))
+			if err != nil {
+				return nil, err
+			}
+			if page, err = io.ReadAll(gr); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported codec %d for column %s", meta.codec, e.name)
+		}
+		if int64(len(page)) != header.uncompressedPageSize {
+			return nil, fmt.Errorf("page of column %s has %d bytes but header declares %d", e.name, len(page), header.uncompressedPageSize)
+		}
+		uncompressedSize += headerSize + header.uncompressedPageSize
+		// The column is required and not nested, so the maximum definition and repetition
+		// levels are 0, and the page does not include any level.
+		pageValues, err := decodePlainValues(page, dph.numValues, e)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, pageValues...)
+	}
+	if int64(len(values)) != meta.numValues {
+		return nil, fmt.Errorf("column %s has %d values but metadata declares %d", e.name, len(values), meta.numValues)
+	}
+	if uncompressedSize != meta.totalUncompressedSize {
+		return nil, fmt.Errorf("column %s has %d uncompressed bytes but metadata declares %d", e.name, uncompressedSize, meta.totalUncompressedSize)
+	}
+	return values, nil
+}
+
+type parquetFile struct {
+	metadata *fileMetaData
+	// rows holds the values of each row, indexed by column name.
+	rows []map[string]any
+}
+
+// readParquetFile validates and decodes a Parquet file with a flat schema of required columns.
+func readParquetFile(data []byte) (*parquetFile, error) {
+	if len(data) < 12 || string(data[:4]) != "PAR1" || string(data[len(data)-4:]) != "PAR1" {
+		return nil, fmt.Errorf("missing magic number")
+	}
+	footerLen := int64(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	if footerLen > int64(len(data)-12) {
+		return nil, fmt.Errorf("invalid footer length %d", footerLen)
+	}
+	footer := bytes.NewReader(data[int64(len(data)-8)-footerLen : len(data)-8])
+	metadata, err := readFileMetaData(&compactReader{r: footer})
+	if err != nil {
+		return nil, fmt.Errorf("error when reading file metadata: %w", err)
+	}
+	if footer.Len() != 0 {
+		return nil, fmt.Errorf("%d unexpected bytes at the end of file metadata", footer.Len())
+	}
+	if len(metadata.schema) == 0 || metadata.schema[0].typ != nil || metadata.schema[0].numChildren != int64(len(metadata.schema)-1) {
+		return nil, fmt.Errorf("invalid schema root")
+	}
+	leaves := metadata.schema[1:]
+	for i := range leaves {
+		if leaves[i].typ == nil || leaves[i].numChildren != 0 || leaves[i].repetition == nil || *leaves[i].repetition != fieldRepetitionRequired {
+			return nil, fmt.Errorf("unsupported schema element %s", leaves[i].name)
+		}
+	}
+	file := &parquetFile{metadata: metadata}
+	var numRows int64
+	for _, g := range metadata.rowGroups {
+		if len(g.columns) != len(leaves) {
+			return nil, fmt.Errorf("row group has %d columns but schema has %d", len(g.columns), len(leaves))
+		}
+		rows := make([]map[string]any, g.numRows)
+		for i := range rows {
+			rows[i] = map[string]any{}
+		}
+		var totalByteSize int64
+		for i, chunk := range g.columns {
+			if chunk.metaData == nil {
+				return nil, fmt.Errorf("missing metadata for column chunk %d", i)
+			}
+			values, err := readColumnChunk(data, chunk, &leaves[i])
+			if err != nil {
+				return nil, err
+			}
+			if int64(len(values)) != g.numRows {
+				return nil, fmt.Errorf("column %s has %d values but row group has %d rows", leaves[i].name, len(values), g.numRows)
+			}
+			for j, v := range values {
+				rows[j][leaves[i].name] = v
+			}
+			totalByteSize += chunk.metaData.totalUncompressedSize
+		}
+		if totalByteSize != g.totalByteSize {
+			return nil, fmt.Errorf("row group has %d bytes but metadata declares %d", totalByteSize, g.totalByteSize)
+		}
+		file.rows = append(file.rows, rows...)
+		numRows += g.numRows
+	}
+	if numRows != metadata.numRows {
+		return nil, fmt.Errorf("file has %d rows but metadata declares %d", numRows, metadata.numRows)
+	}
+	return file, nil
+}
+
+var (
+	readerTestSchema = []parquet.Column{
+		{Name: "name", Type: parquet.String},
+		{Name: "port", Type: parquet.Int32},
+		{Name: "bytes", Type: parquet.Int64},
+		{Name: "time", Type: parquet.TimestampMillis},
+	}
+	readerTestTime = time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	readerTestRows = []map[string]any{
+		{"name": "foo", "port": int32(80), "bytes": int64(1000), "time": readerTestTime},
+		{"name": "", "port": int32(443), "bytes": int64(-1), "time": readerTestTime.Add(time.Second)},
+	}
+)
+
+func TestReadGoldenFile(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "flows.parquet"))
+	require.NoError(t, err)
+	file, err := readParquetFile(data)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), file.metadata.version)
+	assert.Equal(t, readerTestRows, file.rows)
+}
+
+func TestReadWriterOutput(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		compression parquet.Compression
+		numRows     int
+	}{
+		{name: "uncompressed", compression: parquet.Uncompressed, numRows: 2},
+		{name: "gzip", compression: parquet.Gzip, numRows: 2},
+		{name: "empty", compression: parquet.Uncompressed, numRows: 0},
+		// Large enough for the columns to be split into multiple pages.
+		{name: "multiple pages", compression: parquet.Gzip, numRows: 100000},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := parquet.NewWriter(readerTestSchema, tc.compression)
+			expectedRows := []map[string]any{}
+			for i := 0; i < tc.numRows; i++ {
+				row := readerTestRows[i%len(readerTestRows)]
+				if tc.numRows > len(readerTestRows) {
+					row = map[string]any{
+						"name":  fmt.Sprintf("row-%d", i),
+						"port":  int32(i % math.MaxUint16),
+						"bytes": int64(i) << 32,
+						"time":  readerTestTime.Add(time.Duration(i) * time.Millisecond),
+					}
+				}
+				require.NoError(t, w.AppendRow(row["name"], row["port"], row["bytes"], row["time"]))
+				expectedRows = append(expectedRows, row)
+			}
+			var buf bytes.Buffer
+			require.NoError(t, w.Flush(&buf))
+			file, err := readParquetFile(buf.Bytes())
+			require.NoError(t, err)
+			if tc.numRows == 0 {
+				assert.Empty(t, file.rows)
+			} else {
+				assert.Equal(t, expectedRows, file.rows)
+			}
+		})
+	}
+}
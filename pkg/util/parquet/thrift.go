@@ -0,0 +1,123 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+)
+
+// Type identifiers used by the Thrift compact protocol.
+const (
+	thriftBoolTrue  byte = 1
+	thriftBoolFalse byte = 2
+	thriftI32       byte = 5
+	thriftI64       byte = 6
+	thriftBinary    byte = 8
+	thriftList      byte = 9
+	thriftStruct    byte = 12
+)
+
+// thriftEncoder serializes Thrift structs using the compact protocol, which is used by Parquet for
+// page headers and for the file footer. Only the subset of the protocol required by this package
+// is implemented. Fields must be written in increasing field ID order.
+type thriftEncoder struct {
+	b []byte
+	// lastFieldIDs is a stack holding the ID of the last field written for each struct being
+	// encoded, as field IDs are delta-encoded.
+	lastFieldIDs []int16
+}
+
+func newThriftEncoder() *thriftEncoder {
+	return &thriftEncoder{
+		lastFieldIDs: []int16{0},
+	}
+}
+
+func (e *thriftEncoder) fieldHeader(id int16, fieldType byte) {
+	last := &e.lastFieldIDs[len(e.lastFieldIDs)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		e.b = append(e.b, byte(delta)<<4|fieldType)
+	} else {
+		e.b = append(e.b, fieldType)
+		e.b = binary.AppendVarint(e.b, int64(id))
+	}
+	*last = id
+}
+
+func (e *thriftEncoder) writeBool(id int16, v bool) {
+	if v {
+		e.fieldHeader(id, thriftBoolTrue)
+	} else {
+		e.fieldHeader(id, thriftBoolFalse)
+	}
+}
+
+func (e *thriftEncoder) writeI32(id int16, v int32) {
+	e.fieldHeader(id, thriftI32)
+	e.b = binary.AppendVarint(e.b, int64(v))
+}
+
+func (e *thriftEncoder) writeI64(id int16, v int64) {
+	e.fieldHeader(id, thriftI64)
+	e.b = binary.AppendVarint(e.b, v)
+}
+
+func (e *thriftEncoder) writeString(id int16, v string) {
+	e.fieldHeader(id, thriftBinary)
+	e.appendString(v)
+}
+
+func (e *thriftEncoder) appendString(v string) {
+	e.b = binary.AppendUvarint(e.b, uint64(len(v)))
+	e.b = append(e.b, v...)
+}
+
+// beginStruct starts a struct field. It must be followed by a call to endStruct.
+func (e *thriftEncoder) beginStruct(id int16) {
+	e.fieldHeader(id, thriftStruct)
+	e.lastFieldIDs = append(e.lastFieldIDs, 0)
+}
+
+// beginListElement starts a struct which is an element of a list. It must be followed by a call
+// to endStruct.
+func (e *thriftEncoder) beginListElement() {
+	e.lastFieldIDs = append(e.lastFieldIDs, 0)
+}
+
+func (e *thriftEncoder) endStruct() {
+	e.b = append(e.b, 0)
+	e.lastFieldIDs = e.lastFieldIDs[:len(e.lastFieldIDs)-1]
+}
+
+// beginList starts a list field. It must be followed by exactly size elements of type
+// elementType.
+func (e *thriftEncoder) beginList(id int16, elementType byte, size int) {
+	e.fieldHeader(id, thriftList)
+	if size < 15 {
+		e.b = append(e.b, byte(size)<<4|elementType)
+	} else {
+		e.b = append(e.b, 0xf0|elementType)
+		e.b = binary.AppendUvarint(e.b, uint64(size))
+	}
+}
+
+func (e *thriftEncoder) appendI32(v int32) {
+	e.b = binary.AppendVarint(e.b, int64(v))
+}
+
+// bytes returns the encoded top-level struct.
+func (e *thriftEncoder) bytes() []byte {
+	return append(e.b, 0)
+}
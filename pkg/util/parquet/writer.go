@@ -0,0 +1,367 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package parquet implements a minimal writer for the Apache Parquet file format
+// (https://parquet.apache.org/docs/file-format/). It supports flat schemas made of required
+// columns, which are written with the PLAIN encoding, optionally compressed with gzip.
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Type is the type of a column.
+type Type int
+
+const (
+	// String values are stored as UTF-8 encoded byte arrays.
+	String Type = iota
+	Int32
+	Int64
+	// TimestampMillis values are time.Time values, stored as the number of milliseconds since
+	// the Unix epoch (UTC).
+	TimestampMillis
+)
+
+type Column struct {
+	Name string
+	Type Type
+}
+
+type Compression int
+
+const (
+	Uncompressed Compression = iota
+	Gzip
+)
+
+const (
+	magic = "PAR1"
+
+	// defaultPageSize is the size of the uncompressed data after which a new page is started
+	// for a column.
+	defaultPageSize = 1 << 20
+
+	createdBy = "antrea"
+)
+
+// Values defined by the Parquet Thrift definitions
+// (https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift).
+const (
+	physicalTypeInt32     int32 = 1
+	physicalTypeInt64     int32 = 2
+	physicalTypeByteArray int32 = 6
+
+	convertedTypeUTF8            int32 = 0
+	convertedTypeTimestampMillis int32 = 9
+
+	repetitionRequired int32 = 0
+
+	encodingPlain int32 = 0
+	encodingRLE   int32 = 3
+
+	codecUncompressed int32 = 0
+	codecGzip         int32 = 2
+
+	pageTypeDataPage int32 = 0
+)
+
+type column struct {
+	Column
+	// page holds the PLAIN-encoded values of the current page.
+	page          bytes.Buffer
+	pageNumValues int32
+	// chunk holds the pages which have been completed, with their headers.
+	chunk            bytes.Buffer
+	numValues        int64
+	uncompressedSize int64
+}
+
+// Writer buffers rows in memory, in columnar format, and writes them as a Parquet file with a
+// single row group when Flush is called. Writer is not safe for concurrent use.
+type Writer struct {
+	columns     []*column
+	compression Compression
+	pageSize    int
+	numRows     int64
+	gzipBuffer  bytes.Buffer
+	gzipWriter  *gzip.Writer
+}
+
+func NewWriter(schema []Column, compression Compression) *Writer {
+	w := &Writer{
+		compression: compression,
+		pageSize:    defaultPageSize,
+	}
+	for _, c := range schema {
+		w.columns = append(w.columns, &column{Column: c})
+	}
+	if compression == Gzip {
+		w.gzipWriter = gzip.NewWriter(&w.gzipBuffer)
+	}
+	return w
+}
+
+// NumRows returns the number of rows which have been appended since the last call to Flush.
+func (w *Writer) NumRows() int64 {
+	return w.numRows
+}
+
+func checkValue(c *column, v any) error {
+	var ok bool
+	switch c.Type {
+	case String:
+		_, ok = v.(string)
+	case Int32:
+		_, ok = v.(int32)
+	case Int64:
+		_, ok = v.(int64)
+	case TimestampMillis:
+		_, ok = v.(time.Time)
+	}
+	if !ok {
+		return fmt.Errorf("invalid value of type %T for column %s", v, c.Name)
+	}
+	return nil
+}
+
+// AppendRow appends a row to the Writer. There must be exactly one value per column, in the order
+// of the schema, and the Go type of each value must match the column type: string for String,
+// int32 for Int32, int64 for Int64 and time.Time for TimestampMillis.
+func (w *Writer) AppendRow(values ...any) error {
+	if len(values) != len(w.columns) {
+		return fmt.Errorf("expected %d values but got %d", len(w.columns), len(values))
+	}
+	// Validate all values first, so that a row is never partially appended.
+	for i, v := range values {
+		if err := checkValue(w.columns[i], v); err != nil {
+			return err
+		}
+	}
+	for i, v := range values {
+		c := w.columns[i]
+		switch v := v.(type) {
+		case string:
+			c.page.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(v))))
+			c.page.WriteString(v)
+		case int32:
+			c.page.Write(binary.LittleEndian.AppendUint32(nil, uint32(v)))
+		case int64:
+			c.page.Write(binary.LittleEndian.AppendUint64(nil, uint64(v)))
+		case time.Time:
+			c.page.Write(binary.LittleEndian.AppendUint64(nil, uint64(v.UnixMilli())))
+		}
+		c.pageNumValues++
+		if c.page.Len() >= w.pageSize {
+			if err := w.flushPage(c); err != nil {
+				return err
+			}
+		}
+	}
+	w.numRows++
+	return nil
+}
+
+func (w *Writer) compress(data []byte) ([]byte, error) {
+	if w.compression != Gzip {
+		return data, nil
+	}
+	w.gzipBuffer.Reset()
+	w.gzipWriter.Reset(&w.gzipBuffer)
+	if _, err := w.gzipWriter.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return w.gzipBuffer.Bytes(), nil
+}
+
+// flushPage completes the current page of the column, and adds it to the column chunk.
+func (w *Writer) flushPage(c *column) error {
+	data := c.page.Bytes()
+	compressed, err := w.compress(data)
+	if err != nil {
+		return fmt.Errorf("error when compressing page for column %s: %w", c.Name, err)
+	}
+	e := newThriftEncoder()
+	e.writeI32(1, pageTypeDataPage)
+	e.writeI32(2, int32(len(data)))
+	e.writeI32(3, int32(len(compressed)))
+	e.beginStruct(5)
+	e.writeI32(1, c.pageNumValues)
+	e.writeI32(2, encodingPlain)
+	e.writeI32(3, encodingRLE)
+	e.writeI32(4, encodingRLE)
+	e.endStruct()
+	header := e.bytes()
+	c.chunk.Write(header)
+	c.chunk.Write(compressed)
+	c.uncompressedSize += int64(len(header) + len(data))
+	c.numValues += int64(c.pageNumValues)
+	c.page.Reset()
+	c.pageNumValues = 0
+	return nil
+}
+
+func (w *Writer) codec() int32 {
+	if w.compression == Gzip {
+		return codecGzip
+	}
+	return codecUncompressed
+}
+
+func physicalType(t Type) int32 {
+	switch t {
+	case String:
+		return physicalTypeByteArray
+	case Int32:
+		return physicalTypeInt32
+	default:
+		return physicalTypeInt64
+	}
+}
+
+func writeSchemaElement(e *thriftEncoder, c *column) {
+	e.beginListElement()
+	e.writeI32(1, physicalType(c.Type))
+	e.writeI32(3, repetitionRequired)
+	e.writeString(4, c.Name)
+	switch c.Type {
+	case String:
+		e.writeI32(6, convertedTypeUTF8)
+		// LogicalType union, with the STRING member set.
+		e.beginStruct(10)
+		e.beginStruct(1)
+		e.endStruct()
+		e.endStruct()
+	case TimestampMillis:
+		e.writeI32(6, convertedTypeTimestampMillis)
+		// LogicalType union, with the TIMESTAMP member set.
+		e.beginStruct(10)
+		e.beginStruct(8)
+		e.writeBool(1, true)
+		// TimeUnit union, with the MILLIS member set.
+		e.beginStruct(2)
+		e.beginStruct(1)
+		e.endStruct()
+		e.endStruct()
+		e.endStruct()
+		e.endStruct()
+	}
+	e.endStruct()
+}
+
+// footer returns the serialized FileMetaData for the file. chunkOffsets holds the offset of each
+// column chunk in the file.
+func (w *Writer) footer(chunkOffsets []int64) []byte {
+	e := newThriftEncoder()
+	e.writeI32(1, 1)
+	e.beginList(2, thriftStruct, len(w.columns)+1)
+	e.beginListElement()
+	e.writeString(4, "schema")
+	e.writeI32(5, int32(len(w.columns)))
+	e.endStruct()
+	for _, c := range w.columns {
+		writeSchemaElement(e, c)
+	}
+	e.writeI64(3, w.numRows)
+	if w.numRows == 0 {
+		e.beginList(4, thriftStruct, 0)
+	} else {
+		e.beginList(4, thriftStruct, 1)
+		e.beginListElement()
+		e.beginList(1, thriftStruct, len(w.columns))
+		var totalUncompressedSize, totalCompressedSize int64
+		for i, c := range w.columns {
+			e.beginListElement()
+			e.writeI64(2, chunkOffsets[i])
+			e.beginStruct(3)
+			e.writeI32(1, physicalType(c.Type))
+			// All the encodings used in the column chunk: PLAIN for the values and RLE
+			// for the definition and repetition levels declared in the page headers.
+			e.beginList(2, thriftI32, 2)
+			e.appendI32(encodingPlain)
+			e.appendI32(encodingRLE)
+			e.beginList(3, thriftBinary, 1)
+			e.appendString(c.Name)
+			e.writeI32(4, w.codec())
+			e.writeI64(5, c.numValues)
+			e.writeI64(6, c.uncompressedSize)
+			e.writeI64(7, int64(c.chunk.Len()))
+			e.writeI64(9, chunkOffsets[i])
+			e.endStruct()
+			e.endStruct()
+			totalUncompressedSize += c.uncompressedSize
+			totalCompressedSize += int64(c.chunk.Len())
+		}
+		e.writeI64(2, totalUncompressedSize)
+		e.writeI64(3, w.numRows)
+		e.writeI64(5, chunkOffsets[0])
+		e.writeI64(6, totalCompressedSize)
+		e.endStruct()
+	}
+	e.writeString(6, createdBy)
+	return e.bytes()
+}
+
+// Flush writes all the rows appended since the last call to Flush to out, as a complete Parquet
+// file with a single row group. The Writer is reset and can be reused to write another file.
+func (w *Writer) Flush(out io.Writer) error {
+	defer w.reset()
+	offset := int64(len(magic))
+	chunkOffsets := make([]int64, len(w.columns))
+	for i, c := range w.columns {
+		if c.pageNumValues > 0 {
+			if err := w.flushPage(c); err != nil {
+				return err
+			}
+		}
+		chunkOffsets[i] = offset
+		offset += int64(c.chunk.Len())
+	}
+	footer := w.footer(chunkOffsets)
+	if _, err := io.WriteString(out, magic); err != nil {
+		return err
+	}
+	for _, c := range w.columns {
+		if _, err := out.Write(c.chunk.Bytes()); err != nil {
+			return err
+		}
+	}
+	if _, err := out.Write(footer); err != nil {
+		return err
+	}
+	if _, err := out.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(footer)))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(out, magic)
+	return err
+}
+
+func (w *Writer) reset() {
+	for _, c := range w.columns {
+		c.page.Reset()
+		c.pageNumValues = 0
+		c.chunk.Reset()
+		c.numValues = 0
+		c.uncompressedSize = 0
+	}
+	w.numRows = 0
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+// thriftStructValue is a decoded Thrift struct, indexed by field ID.
+type thriftStructValue map[int16]any
+
+// thriftDecoder decodes Thrift structs encoded with the compact protocol into generic values:
+// integers are decoded as int64, binary fields as string, lists as []any and structs as
+// thriftStructValue.
+type thriftDecoder struct {
+	r *bytes.Reader
+}
+
+func (d *thriftDecoder) readValue(t byte) (any, error) {
+	switch t {
+	case thriftBoolTrue:
+		return true, nil
+	case thriftBoolFalse:
+		return false, nil
+	case thriftI32, thriftI64:
+		return binary.ReadVarint(d.r)
+	case thriftBinary:
+		n, err := binary.ReadUvarint(d.r)
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, n)
+		if _, err := io.ReadFull(d.r, b); err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case thriftList:
+		header, err := d.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		size := int(header >> 4)
+		if size == 15 {
+			n, err := binary.ReadUvarint(d.r)
+			if err != nil {
+				return nil, err
+			}
+			size = int(n)
+		}
+		list := []any{}
+		for i := 0; i < size; i++ {
+			v, err := d.readValue(header & 0x0f)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case thriftStruct:
+		return d.readStruct()
+	}
+	return nil, fmt.Errorf("unsupported type %d", t)
+}
+
+func (d *thriftDecoder) readStruct() (thriftStructValue, error) {
+	s := thriftStructValue{}
+	var lastID int16
+	for {
+		header, err := d.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if header == 0 {
+			return s, nil
+		}
+		t := header & 0x0f
+		id := lastID + int16(header>>4)
+		if header>>4 == 0 {
+			v, err := binary.ReadVarint(d.r)
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		lastID = id
+		if s[id], err = d.readValue(t); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// readFile parses a Parquet file produced by Writer, and returns the file metadata as well as the
+// values of each column.
+func readFile(t *testing.T, data []byte) (thriftStructValue, [][]any) {
+	require.Equal(t, magic, string(data[:4]))
+	require.Equal(t, magic, string(data[len(data)-4:]))
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := data[len(data)-8-footerLen : len(data)-8]
+	metadata, err := (&thriftDecoder{r: bytes.NewReader(footer)}).readStruct()
+	require.NoError(t, err)
+
+	schema := metadata[2].([]any)
+	columns := make([][]any, len(schema)-1)
+	for _, rowGroup := range metadata[4].([]any) {
+		for i, chunk := range rowGroup.(thriftStructValue)[1].([]any) {
+			meta := chunk.(thriftStructValue)[3].(thriftStructValue)
+			offset := meta[9].(int64)
+			end := offset + meta[7].(int64)
+			r := bytes.NewReader(data[offset:end])
+			for r.Len() > 0 {
+				header, err := (&thriftDecoder{r: r}).readStruct()
+				require.NoError(t, err)
+				page := make([]byte, header[3].(int64))
+				_, err = io.ReadFull(r, page)
+				require.NoError(t, err)
+				if meta[4].(int64) == int64(codecGzip) {
+					gr, err := gzip.NewReader(bytes.NewReader(page))
+					require.NoError(t, err)
+					page, err = io.ReadAll(gr)
+					require.NoError(t, err)
+				}
+				require.Len(t, page, int(header[2].(int64)))
+				numValues := int(header[5].(thriftStructValue)[1].(int64))
+				pr := bytes.NewReader(page)
+				for j := 0; j < numValues; j++ {
+					switch meta[1].(int64) {
+					case int64(physicalTypeByteArray):
+						var n uint32
+						require.NoError(t, binary.Read(pr, binary.LittleEndian, &n))
+						b := make([]byte, n)
+						_, err := io.ReadFull(pr, b)
+						require.NoError(t, err)
+						columns[i] = append(columns[i], string(b))
+					case int64(physicalTypeInt32):
+						var v int32
+						require.NoError(t, binary.Read(pr, binary.LittleEndian, &v))
+						columns[i] = append(columns[i], v)
+					case int64(physicalTypeInt64):
+						var v int64
+						require.NoError(t, binary.Read(pr, binary.LittleEndian, &v))
+						columns[i] = append(columns[i], v)
+					}
+				}
+				assert.Zero(t, pr.Len())
+			}
+		}
+	}
+	return metadata, columns
+}
+
+var testSchema = []Column{
+	{Name: "name", Type: String},
+	{Name: "port", Type: Int32},
+	{Name: "bytes", Type: Int64},
+	{Name: "time", Type: TimestampMillis},
+}
+
+func TestWriter(t *testing.T) {
+	ts := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	for _, compression := range []Compression{Uncompressed, Gzip} {
+		t.Run(fmt.Sprintf("compression-%d", compression), func(t *testing.T) {
+			w := NewWriter(testSchema, compression)
+			require.NoError(t, w.AppendRow("foo", int32(80), int64(1000), ts))
+			require.NoError(t, w.AppendRow("", int32(443), int64(-1), ts.Add(time.Second)))
+			assert.EqualValues(t, 2, w.NumRows())
+
+			var buf bytes.Buffer
+			require.NoError(t, w.Flush(&buf))
+			assert.EqualValues(t, 0, w.NumRows())
+
+			metadata, columns := readFile(t, buf.Bytes())
+			assert.Equal(t, int64(2), metadata[3])
+			assert.Equal(t, createdBy, metadata[6])
+			schema := metadata[2].([]any)
+			require.Len(t, schema, 5)
+			assert.Equal(t, thriftStructValue{4: "schema", 5: int64(4)}, schema[0])
+			assert.Equal(t, thriftStructValue{
+				1:  int64(physicalTypeByteArray),
+				3:  int64(repetitionRequired),
+				4:  "name",
+				6:  int64(convertedTypeUTF8),
+				10: thriftStructValue{1: thriftStructValue{}},
+			}, schema[1])
+			assert.Equal(t, thriftStructValue{
+				1:  int64(physicalTypeInt64),
+				3:  int64(repetitionRequired),
+				4:  "time",
+				6:  int64(convertedTypeTimestampMillis),
+				10: thriftStructValue{8: thriftStructValue{1: true, 2: thriftStructValue{1: thriftStructValue{}}}},
+			}, schema[4])
+			for _, chunk := range metadata[4].([]any)[0].(thriftStructValue)[1].([]any) {
+				meta := chunk.(thriftStructValue)[3].(thriftStructValue)
+				assert.Equal(t, []any{int64(encodingPlain), int64(encodingRLE)}, meta[2])
+			}
+			assert.Equal(t, [][]any{
+				{"foo", ""},
+				{int32(80), int32(443)},
+				{int64(1000), int64(-1)},
+				{ts.UnixMilli(), ts.Add(time.Second).UnixMilli()},
+			}, columns)
+		})
+	}
+}
+
+// TestWriterGolden compares the output of Writer with a golden file. When the golden file is
+// updated (with "go test -update"), it must be validated with a reference Parquet
+// implementation, for example with pyarrow:
+//
+//	python3 -c 'import pyarrow.parquet as pq; print(pq.read_table("testdata/flows.parquet").to_pylist())'
+//
+// which is expected to print:
+//
+//	[{'name': 'foo', 'port': 80, 'bytes': 1000, 'time': datetime.datetime(2026, 10, 18, 9, 30, tzinfo=<UTC>)},
+//	 {'name': '', 'port': 443, 'bytes': -1, 'time': datetime.datetime(2026, 10, 18, 9, 30, 1, tzinfo=<UTC>)}]
+//
+// The golden file is also decoded by the independent reader in reader_test.go (TestReadGoldenFile).
+func TestWriterGolden(t *testing.T) {
+	// The output is not compressed, as the gzip output may change across Go versions.
+	w := NewWriter(testSchema, Uncompressed)
+	ts := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	require.NoError(t, w.AppendRow("foo", int32(80), int64(1000), ts))
+	require.NoError(t, w.AppendRow("", int32(443), int64(-1), ts.Add(time.Second)))
+	var buf bytes.Buffer
+	require.NoError(t, w.Flush(&buf))
+
+	goldenFile := filepath.Join("testdata", "flows.parquet")
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll("testdata", 0755))
+		require.NoError(t, os.WriteFile(goldenFile, buf.Bytes(), 0644))
+	}
+	expected, err := os.ReadFile(goldenFile)
+	require.NoError(t, err)
+	assert.Equal(t, expected, buf.Bytes())
+}
+
+func TestWriterPages(t *testing.T) {
+	w := NewWriter(testSchema, Gzip)
+	w.pageSize = 100
+	const numRows = 1000
+	for i := 0; i < numRows; i++ {
+		require.NoError(t, w.AppendRow(fmt.Sprintf("row-%d", i), int32(i), int64(i), time.UnixMilli(int64(i))))
+	}
+	var buf bytes.Buffer
+	require.NoError(t, w.Flush(&buf))
+	metadata, columns := readFile(t, buf.Bytes())
+	assert.Equal(t, int64(numRows), metadata[3])
+	for i := range columns {
+		require.Len(t, columns[i], numRows)
+	}
+	assert.Equal(t, "row-999", columns[0][999])
+	assert.Equal(t, int32(500), columns[1][500])
+
+	// The Writer can be reused after Flush.
+	require.NoError(t, w.AppendRow("foo", int32(0), int64(0), time.UnixMilli(0)))
+	buf.Reset()
+	require.NoError(t, w.Flush(&buf))
+	_, columns = readFile(t, buf.Bytes())
+	assert.Equal(t, []any{"foo"}, columns[0])
+}
+
+func TestWriterEmpty(t *testing.T) {
+	w := NewWriter(testSchema, Uncompressed)
+	var buf bytes.Buffer
+	require.NoError(t, w.Flush(&buf))
+	metadata, columns := readFile(t, buf.Bytes())
+	assert.Equal(t, int64(0), metadata[3])
+	assert.Empty(t, metadata[4])
+	assert.Equal(t, [][]any{nil, nil, nil, nil}, columns)
+}
+
+func TestWriterInvalidRow(t *testing.T) {
+	w := NewWriter(testSchema, Uncompressed)
+	assert.EqualError(t, w.AppendRow("foo"), "expected 4 values but got 1")
+	assert.EqualError(t, w.AppendRow("foo", 80, int64(1000), time.Now()), "invalid value of type int for column port")
+	assert.EqualValues(t, 0, w.NumRows())
+	for _, c := range w.columns {
+		assert.Zero(t, c.page.Len())
+	}
+}
@@ -729,6 +729,62 @@ func testACNPDropEgress(t *testing.T, protocol AntreaPolicyProtocol) {
 	executeTests(t, testCase)
 }
 
+// testACNPAuditDropAboveEnforcedDrop tests that the traffic matching a Drop rule of an ACNP in Audit mode is still
+// evaluated against the rules of the ACNPs with lower priorities, and dropped by an enforced Drop rule.
+func testACNPAuditDropAboveEnforcedDrop(t *testing.T) {
+	builderAudit := &ClusterNetworkPolicySpecBuilder{}
+	builderAudit = builderAudit.SetName("acnp-audit-deny-a-to-z-egress").
+		SetPriority(1.0).
+		SetEnforcementMode(crdv1beta1.EnforcementModeAudit).
+		SetAppliedToGroup([]ACNPAppliedToSpec{{PodSelector: map[string]string{"pod": "a"}}})
+	builderAudit.AddEgress(ACNPRuleBuilder{
+		BaseRuleBuilder: BaseRuleBuilder{
+			Protoc:     ProtocolTCP,
+			Port:       &p80,
+			NSSelector: map[string]string{"ns": getNS("z")},
+			Action:     crdv1beta1.RuleActionDrop,
+		}})
+
+	builderEnforced := &ClusterNetworkPolicySpecBuilder{}
+	builderEnforced = builderEnforced.SetName("acnp-deny-a-to-z-egress").
+		SetPriority(2.0).
+		SetAppliedToGroup([]ACNPAppliedToSpec{{PodSelector: map[string]string{"pod": "a"}}})
+	builderEnforced.AddEgress(ACNPRuleBuilder{
+		BaseRuleBuilder: BaseRuleBuilder{
+			Protoc:     ProtocolTCP,
+			Port:       &p80,
+			NSSelector: map[string]string{"ns": getNS("z")},
+			Action:     crdv1beta1.RuleActionDrop,
+		}})
+
+	reachabilityAudit := NewReachability(allPods, Connected)
+	reachabilityEnforced := NewReachability(allPods, Connected)
+	reachabilityEnforced.ExpectEgressToNamespace(getPod("x", "a"), getNS("z"), Dropped)
+	reachabilityEnforced.ExpectEgressToNamespace(getPod("y", "a"), getNS("z"), Dropped)
+	reachabilityEnforced.Expect(getPod("z", "a"), getPod("z", "b"), Dropped)
+	reachabilityEnforced.Expect(getPod("z", "a"), getPod("z", "c"), Dropped)
+	testStep := []*TestStep{
+		{
+			Name:          "Port 80 Audit only",
+			Reachability:  reachabilityAudit,
+			TestResources: []metav1.Object{builderAudit.Get()},
+			Ports:         []int32{80},
+			Protocol:      ProtocolTCP,
+		},
+		{
+			Name:          "Port 80 Audit and Enforce",
+			Reachability:  reachabilityEnforced,
+			TestResources: []metav1.Object{builderEnforced.Get()},
+			Ports:         []int32{80},
+			Protocol:      ProtocolTCP,
+		},
+	}
+	testCase := []*TestCase{
+		{"ACNP Drop Egress in Audit mode above enforced Drop Egress", testStep},
+	}
+	executeTests(t, testCase)
+}
+
 // testACNPDropIngressInSelectedNamespace tests that an ACNP is able to drop all ingress traffic towards a specific Namespace.
 // The ACNP is created by selecting the Namespace as an appliedTo, and adding an ingress rule with Drop action and
 // no `From` (which translate to drop ingress from everywhere).
@@ -5152,6 +5208,7 @@ func TestAntreaPolicy(t *testing.T) {
 		t.Run("Case=ACNPDropEgress", func(t *testing.T) { testACNPDropEgress(t, ProtocolTCP) })
 		t.Run("Case=ACNPDropEgressUDP", func(t *testing.T) { testACNPDropEgress(t, ProtocolUDP) })
 		t.Run("Case=ACNPDropEgressSCTP", func(t *testing.T) { testACNPDropEgress(t, ProtocolSCTP) })
+		t.Run("Case=ACNPAuditDropAboveEnforcedDrop", func(t *testing.T) { testACNPAuditDropAboveEnforcedDrop(t) })
 		t.Run("Case=ACNPDropIngressInNamespace", func(t *testing.T) { testACNPDropIngressInSelectedNamespace(t) })
 		t.Run("Case=ACNPDropIPBlockWithExcept", func(t *testing.T) { testACNPDropIPBlockWithExcept(t) })
 		t.Run("Case=ACNPPortRange", func(t *testing.T) { testACNPPortRange(t) })
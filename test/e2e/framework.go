@@ -1264,6 +1264,9 @@ func (data *TestData) mutateFlowAggregatorConfigMap(ipfixCollectorAddr string, o
 	flowAggregatorConf.ActiveFlowRecordTimeout = aggregatorActiveFlowRecordTimeout.String()
 	flowAggregatorConf.InactiveFlowRecordTimeout = aggregatorInactiveFlowRecordTimeout.String()
 	flowAggregatorConf.RecordContents.PodLabels = true
+	// The flow graph is not supported in Proxy mode. It is enabled otherwise so that all
+	// antctl commands can be tested.
+	flowAggregatorConf.FlowGraph.Enable = o.mode != flowaggregatorconfig.AggregatorModeProxy
 	flowAggregatorConf.ClusterID = o.clusterID
 
 	b, err := yaml.Marshal(&flowAggregatorConf)
@@ -67,6 +67,11 @@ func (b *ClusterNetworkPolicySpecBuilder) SetTier(tier string) *ClusterNetworkPo
 	return b
 }
 
+func (b *ClusterNetworkPolicySpecBuilder) SetEnforcementMode(mode crdv1beta1.PolicyEnforcementMode) *ClusterNetworkPolicySpecBuilder {
+	b.Spec.EnforcementMode = mode
+	return b
+}
+
 func (b *ClusterNetworkPolicySpecBuilder) SetAppliedToGroup(specs []ACNPAppliedToSpec) *ClusterNetworkPolicySpecBuilder {
 	for _, spec := range specs {
 		appliedToPeer := ACNPGetAppliedToPeer(spec.PodSelector,